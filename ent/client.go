@@ -12,10 +12,16 @@ import (
 	"doomlike/ent/migrate"
 
 	"doomlike/ent/gamesettings"
+	"doomlike/ent/highscore"
+	"doomlike/ent/levelresult"
+	"doomlike/ent/profile"
+	"doomlike/ent/run"
+	"doomlike/ent/saveslot"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 )
 
 // Client is the client that holds all ent builders.
@@ -25,6 +31,16 @@ type Client struct {
 	Schema *migrate.Schema
 	// GameSettings is the client for interacting with the GameSettings builders.
 	GameSettings *GameSettingsClient
+	// HighScore is the client for interacting with the HighScore builders.
+	HighScore *HighScoreClient
+	// LevelResult is the client for interacting with the LevelResult builders.
+	LevelResult *LevelResultClient
+	// Profile is the client for interacting with the Profile builders.
+	Profile *ProfileClient
+	// Run is the client for interacting with the Run builders.
+	Run *RunClient
+	// SaveSlot is the client for interacting with the SaveSlot builders.
+	SaveSlot *SaveSlotClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -37,6 +53,11 @@ func NewClient(opts ...Option) *Client {
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
 	c.GameSettings = NewGameSettingsClient(c.config)
+	c.HighScore = NewHighScoreClient(c.config)
+	c.LevelResult = NewLevelResultClient(c.config)
+	c.Profile = NewProfileClient(c.config)
+	c.Run = NewRunClient(c.config)
+	c.SaveSlot = NewSaveSlotClient(c.config)
 }
 
 type (
@@ -130,6 +151,11 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		ctx:          ctx,
 		config:       cfg,
 		GameSettings: NewGameSettingsClient(cfg),
+		HighScore:    NewHighScoreClient(cfg),
+		LevelResult:  NewLevelResultClient(cfg),
+		Profile:      NewProfileClient(cfg),
+		Run:          NewRunClient(cfg),
+		SaveSlot:     NewSaveSlotClient(cfg),
 	}, nil
 }
 
@@ -150,6 +176,11 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		ctx:          ctx,
 		config:       cfg,
 		GameSettings: NewGameSettingsClient(cfg),
+		HighScore:    NewHighScoreClient(cfg),
+		LevelResult:  NewLevelResultClient(cfg),
+		Profile:      NewProfileClient(cfg),
+		Run:          NewRunClient(cfg),
+		SaveSlot:     NewSaveSlotClient(cfg),
 	}, nil
 }
 
@@ -178,13 +209,21 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
-	c.GameSettings.Use(hooks...)
+	for _, n := range []interface{ Use(...Hook) }{
+		c.GameSettings, c.HighScore, c.LevelResult, c.Profile, c.Run, c.SaveSlot,
+	} {
+		n.Use(hooks...)
+	}
 }
 
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
-	c.GameSettings.Intercept(interceptors...)
+	for _, n := range []interface{ Intercept(...Interceptor) }{
+		c.GameSettings, c.HighScore, c.LevelResult, c.Profile, c.Run, c.SaveSlot,
+	} {
+		n.Intercept(interceptors...)
+	}
 }
 
 // Mutate implements the ent.Mutator interface.
@@ -192,6 +231,16 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
 	case *GameSettingsMutation:
 		return c.GameSettings.mutate(ctx, m)
+	case *HighScoreMutation:
+		return c.HighScore.mutate(ctx, m)
+	case *LevelResultMutation:
+		return c.LevelResult.mutate(ctx, m)
+	case *ProfileMutation:
+		return c.Profile.mutate(ctx, m)
+	case *RunMutation:
+		return c.Run.mutate(ctx, m)
+	case *SaveSlotMutation:
+		return c.SaveSlot.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
@@ -305,6 +354,22 @@ func (c *GameSettingsClient) GetX(ctx context.Context, id string) *GameSettings
 	return obj
 }
 
+// QueryProfile queries the profile edge of a GameSettings.
+func (c *GameSettingsClient) QueryProfile(_m *GameSettings) *ProfileQuery {
+	query := (&ProfileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(gamesettings.Table, gamesettings.FieldID, id),
+			sqlgraph.To(profile.Table, profile.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, gamesettings.ProfileTable, gamesettings.ProfileColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *GameSettingsClient) Hooks() []Hook {
 	return c.hooks.GameSettings
@@ -330,12 +395,821 @@ func (c *GameSettingsClient) mutate(ctx context.Context, m *GameSettingsMutation
 	}
 }
 
+// HighScoreClient is a client for the HighScore schema.
+type HighScoreClient struct {
+	config
+}
+
+// NewHighScoreClient returns a client for the HighScore from the given config.
+func NewHighScoreClient(c config) *HighScoreClient {
+	return &HighScoreClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `highscore.Hooks(f(g(h())))`.
+func (c *HighScoreClient) Use(hooks ...Hook) {
+	c.hooks.HighScore = append(c.hooks.HighScore, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `highscore.Intercept(f(g(h())))`.
+func (c *HighScoreClient) Intercept(interceptors ...Interceptor) {
+	c.inters.HighScore = append(c.inters.HighScore, interceptors...)
+}
+
+// Create returns a builder for creating a HighScore entity.
+func (c *HighScoreClient) Create() *HighScoreCreate {
+	mutation := newHighScoreMutation(c.config, OpCreate)
+	return &HighScoreCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of HighScore entities.
+func (c *HighScoreClient) CreateBulk(builders ...*HighScoreCreate) *HighScoreCreateBulk {
+	return &HighScoreCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *HighScoreClient) MapCreateBulk(slice any, setFunc func(*HighScoreCreate, int)) *HighScoreCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &HighScoreCreateBulk{err: fmt.Errorf("calling to HighScoreClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*HighScoreCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &HighScoreCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for HighScore.
+func (c *HighScoreClient) Update() *HighScoreUpdate {
+	mutation := newHighScoreMutation(c.config, OpUpdate)
+	return &HighScoreUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *HighScoreClient) UpdateOne(_m *HighScore) *HighScoreUpdateOne {
+	mutation := newHighScoreMutation(c.config, OpUpdateOne, withHighScore(_m))
+	return &HighScoreUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *HighScoreClient) UpdateOneID(id int) *HighScoreUpdateOne {
+	mutation := newHighScoreMutation(c.config, OpUpdateOne, withHighScoreID(id))
+	return &HighScoreUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for HighScore.
+func (c *HighScoreClient) Delete() *HighScoreDelete {
+	mutation := newHighScoreMutation(c.config, OpDelete)
+	return &HighScoreDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *HighScoreClient) DeleteOne(_m *HighScore) *HighScoreDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *HighScoreClient) DeleteOneID(id int) *HighScoreDeleteOne {
+	builder := c.Delete().Where(highscore.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &HighScoreDeleteOne{builder}
+}
+
+// Query returns a query builder for HighScore.
+func (c *HighScoreClient) Query() *HighScoreQuery {
+	return &HighScoreQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeHighScore},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a HighScore entity by its id.
+func (c *HighScoreClient) Get(ctx context.Context, id int) (*HighScore, error) {
+	return c.Query().Where(highscore.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *HighScoreClient) GetX(ctx context.Context, id int) *HighScore {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryProfile queries the profile edge of a HighScore.
+func (c *HighScoreClient) QueryProfile(_m *HighScore) *ProfileQuery {
+	query := (&ProfileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(highscore.Table, highscore.FieldID, id),
+			sqlgraph.To(profile.Table, profile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, highscore.ProfileTable, highscore.ProfileColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *HighScoreClient) Hooks() []Hook {
+	return c.hooks.HighScore
+}
+
+// Interceptors returns the client interceptors.
+func (c *HighScoreClient) Interceptors() []Interceptor {
+	return c.inters.HighScore
+}
+
+func (c *HighScoreClient) mutate(ctx context.Context, m *HighScoreMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&HighScoreCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&HighScoreUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&HighScoreUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&HighScoreDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown HighScore mutation op: %q", m.Op())
+	}
+}
+
+// LevelResultClient is a client for the LevelResult schema.
+type LevelResultClient struct {
+	config
+}
+
+// NewLevelResultClient returns a client for the LevelResult from the given config.
+func NewLevelResultClient(c config) *LevelResultClient {
+	return &LevelResultClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `levelresult.Hooks(f(g(h())))`.
+func (c *LevelResultClient) Use(hooks ...Hook) {
+	c.hooks.LevelResult = append(c.hooks.LevelResult, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `levelresult.Intercept(f(g(h())))`.
+func (c *LevelResultClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LevelResult = append(c.inters.LevelResult, interceptors...)
+}
+
+// Create returns a builder for creating a LevelResult entity.
+func (c *LevelResultClient) Create() *LevelResultCreate {
+	mutation := newLevelResultMutation(c.config, OpCreate)
+	return &LevelResultCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LevelResult entities.
+func (c *LevelResultClient) CreateBulk(builders ...*LevelResultCreate) *LevelResultCreateBulk {
+	return &LevelResultCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LevelResultClient) MapCreateBulk(slice any, setFunc func(*LevelResultCreate, int)) *LevelResultCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LevelResultCreateBulk{err: fmt.Errorf("calling to LevelResultClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LevelResultCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LevelResultCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LevelResult.
+func (c *LevelResultClient) Update() *LevelResultUpdate {
+	mutation := newLevelResultMutation(c.config, OpUpdate)
+	return &LevelResultUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LevelResultClient) UpdateOne(_m *LevelResult) *LevelResultUpdateOne {
+	mutation := newLevelResultMutation(c.config, OpUpdateOne, withLevelResult(_m))
+	return &LevelResultUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LevelResultClient) UpdateOneID(id int) *LevelResultUpdateOne {
+	mutation := newLevelResultMutation(c.config, OpUpdateOne, withLevelResultID(id))
+	return &LevelResultUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LevelResult.
+func (c *LevelResultClient) Delete() *LevelResultDelete {
+	mutation := newLevelResultMutation(c.config, OpDelete)
+	return &LevelResultDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LevelResultClient) DeleteOne(_m *LevelResult) *LevelResultDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LevelResultClient) DeleteOneID(id int) *LevelResultDeleteOne {
+	builder := c.Delete().Where(levelresult.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LevelResultDeleteOne{builder}
+}
+
+// Query returns a query builder for LevelResult.
+func (c *LevelResultClient) Query() *LevelResultQuery {
+	return &LevelResultQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLevelResult},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LevelResult entity by its id.
+func (c *LevelResultClient) Get(ctx context.Context, id int) (*LevelResult, error) {
+	return c.Query().Where(levelresult.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LevelResultClient) GetX(ctx context.Context, id int) *LevelResult {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryRun queries the run edge of a LevelResult.
+func (c *LevelResultClient) QueryRun(_m *LevelResult) *RunQuery {
+	query := (&RunClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(levelresult.Table, levelresult.FieldID, id),
+			sqlgraph.To(run.Table, run.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, levelresult.RunTable, levelresult.RunColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *LevelResultClient) Hooks() []Hook {
+	return c.hooks.LevelResult
+}
+
+// Interceptors returns the client interceptors.
+func (c *LevelResultClient) Interceptors() []Interceptor {
+	return c.inters.LevelResult
+}
+
+func (c *LevelResultClient) mutate(ctx context.Context, m *LevelResultMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LevelResultCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LevelResultUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LevelResultUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LevelResultDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown LevelResult mutation op: %q", m.Op())
+	}
+}
+
+// ProfileClient is a client for the Profile schema.
+type ProfileClient struct {
+	config
+}
+
+// NewProfileClient returns a client for the Profile from the given config.
+func NewProfileClient(c config) *ProfileClient {
+	return &ProfileClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `profile.Hooks(f(g(h())))`.
+func (c *ProfileClient) Use(hooks ...Hook) {
+	c.hooks.Profile = append(c.hooks.Profile, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `profile.Intercept(f(g(h())))`.
+func (c *ProfileClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Profile = append(c.inters.Profile, interceptors...)
+}
+
+// Create returns a builder for creating a Profile entity.
+func (c *ProfileClient) Create() *ProfileCreate {
+	mutation := newProfileMutation(c.config, OpCreate)
+	return &ProfileCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Profile entities.
+func (c *ProfileClient) CreateBulk(builders ...*ProfileCreate) *ProfileCreateBulk {
+	return &ProfileCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ProfileClient) MapCreateBulk(slice any, setFunc func(*ProfileCreate, int)) *ProfileCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ProfileCreateBulk{err: fmt.Errorf("calling to ProfileClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ProfileCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ProfileCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Profile.
+func (c *ProfileClient) Update() *ProfileUpdate {
+	mutation := newProfileMutation(c.config, OpUpdate)
+	return &ProfileUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ProfileClient) UpdateOne(_m *Profile) *ProfileUpdateOne {
+	mutation := newProfileMutation(c.config, OpUpdateOne, withProfile(_m))
+	return &ProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ProfileClient) UpdateOneID(id int) *ProfileUpdateOne {
+	mutation := newProfileMutation(c.config, OpUpdateOne, withProfileID(id))
+	return &ProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Profile.
+func (c *ProfileClient) Delete() *ProfileDelete {
+	mutation := newProfileMutation(c.config, OpDelete)
+	return &ProfileDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ProfileClient) DeleteOne(_m *Profile) *ProfileDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ProfileClient) DeleteOneID(id int) *ProfileDeleteOne {
+	builder := c.Delete().Where(profile.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ProfileDeleteOne{builder}
+}
+
+// Query returns a query builder for Profile.
+func (c *ProfileClient) Query() *ProfileQuery {
+	return &ProfileQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeProfile},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Profile entity by its id.
+func (c *ProfileClient) Get(ctx context.Context, id int) (*Profile, error) {
+	return c.Query().Where(profile.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ProfileClient) GetX(ctx context.Context, id int) *Profile {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QuerySettings queries the settings edge of a Profile.
+func (c *ProfileClient) QuerySettings(_m *Profile) *GameSettingsQuery {
+	query := (&GameSettingsClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profile.Table, profile.FieldID, id),
+			sqlgraph.To(gamesettings.Table, gamesettings.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, profile.SettingsTable, profile.SettingsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryHighScores queries the high_scores edge of a Profile.
+func (c *ProfileClient) QueryHighScores(_m *Profile) *HighScoreQuery {
+	query := (&HighScoreClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profile.Table, profile.FieldID, id),
+			sqlgraph.To(highscore.Table, highscore.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, profile.HighScoresTable, profile.HighScoresColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRuns queries the runs edge of a Profile.
+func (c *ProfileClient) QueryRuns(_m *Profile) *RunQuery {
+	query := (&RunClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profile.Table, profile.FieldID, id),
+			sqlgraph.To(run.Table, run.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, profile.RunsTable, profile.RunsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySaves queries the saves edge of a Profile.
+func (c *ProfileClient) QuerySaves(_m *Profile) *SaveSlotQuery {
+	query := (&SaveSlotClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profile.Table, profile.FieldID, id),
+			sqlgraph.To(saveslot.Table, saveslot.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, profile.SavesTable, profile.SavesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ProfileClient) Hooks() []Hook {
+	return c.hooks.Profile
+}
+
+// Interceptors returns the client interceptors.
+func (c *ProfileClient) Interceptors() []Interceptor {
+	return c.inters.Profile
+}
+
+func (c *ProfileClient) mutate(ctx context.Context, m *ProfileMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ProfileCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ProfileUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ProfileDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Profile mutation op: %q", m.Op())
+	}
+}
+
+// RunClient is a client for the Run schema.
+type RunClient struct {
+	config
+}
+
+// NewRunClient returns a client for the Run from the given config.
+func NewRunClient(c config) *RunClient {
+	return &RunClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `run.Hooks(f(g(h())))`.
+func (c *RunClient) Use(hooks ...Hook) {
+	c.hooks.Run = append(c.hooks.Run, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `run.Intercept(f(g(h())))`.
+func (c *RunClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Run = append(c.inters.Run, interceptors...)
+}
+
+// Create returns a builder for creating a Run entity.
+func (c *RunClient) Create() *RunCreate {
+	mutation := newRunMutation(c.config, OpCreate)
+	return &RunCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Run entities.
+func (c *RunClient) CreateBulk(builders ...*RunCreate) *RunCreateBulk {
+	return &RunCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RunClient) MapCreateBulk(slice any, setFunc func(*RunCreate, int)) *RunCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RunCreateBulk{err: fmt.Errorf("calling to RunClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RunCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RunCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Run.
+func (c *RunClient) Update() *RunUpdate {
+	mutation := newRunMutation(c.config, OpUpdate)
+	return &RunUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RunClient) UpdateOne(_m *Run) *RunUpdateOne {
+	mutation := newRunMutation(c.config, OpUpdateOne, withRun(_m))
+	return &RunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RunClient) UpdateOneID(id int) *RunUpdateOne {
+	mutation := newRunMutation(c.config, OpUpdateOne, withRunID(id))
+	return &RunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Run.
+func (c *RunClient) Delete() *RunDelete {
+	mutation := newRunMutation(c.config, OpDelete)
+	return &RunDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RunClient) DeleteOne(_m *Run) *RunDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RunClient) DeleteOneID(id int) *RunDeleteOne {
+	builder := c.Delete().Where(run.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RunDeleteOne{builder}
+}
+
+// Query returns a query builder for Run.
+func (c *RunClient) Query() *RunQuery {
+	return &RunQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRun},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Run entity by its id.
+func (c *RunClient) Get(ctx context.Context, id int) (*Run, error) {
+	return c.Query().Where(run.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RunClient) GetX(ctx context.Context, id int) *Run {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryLevels queries the levels edge of a Run.
+func (c *RunClient) QueryLevels(_m *Run) *LevelResultQuery {
+	query := (&LevelResultClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(run.Table, run.FieldID, id),
+			sqlgraph.To(levelresult.Table, levelresult.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, run.LevelsTable, run.LevelsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryProfile queries the profile edge of a Run.
+func (c *RunClient) QueryProfile(_m *Run) *ProfileQuery {
+	query := (&ProfileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(run.Table, run.FieldID, id),
+			sqlgraph.To(profile.Table, profile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, run.ProfileTable, run.ProfileColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *RunClient) Hooks() []Hook {
+	return c.hooks.Run
+}
+
+// Interceptors returns the client interceptors.
+func (c *RunClient) Interceptors() []Interceptor {
+	return c.inters.Run
+}
+
+func (c *RunClient) mutate(ctx context.Context, m *RunMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RunCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RunUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RunDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Run mutation op: %q", m.Op())
+	}
+}
+
+// SaveSlotClient is a client for the SaveSlot schema.
+type SaveSlotClient struct {
+	config
+}
+
+// NewSaveSlotClient returns a client for the SaveSlot from the given config.
+func NewSaveSlotClient(c config) *SaveSlotClient {
+	return &SaveSlotClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `saveslot.Hooks(f(g(h())))`.
+func (c *SaveSlotClient) Use(hooks ...Hook) {
+	c.hooks.SaveSlot = append(c.hooks.SaveSlot, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `saveslot.Intercept(f(g(h())))`.
+func (c *SaveSlotClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SaveSlot = append(c.inters.SaveSlot, interceptors...)
+}
+
+// Create returns a builder for creating a SaveSlot entity.
+func (c *SaveSlotClient) Create() *SaveSlotCreate {
+	mutation := newSaveSlotMutation(c.config, OpCreate)
+	return &SaveSlotCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SaveSlot entities.
+func (c *SaveSlotClient) CreateBulk(builders ...*SaveSlotCreate) *SaveSlotCreateBulk {
+	return &SaveSlotCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SaveSlotClient) MapCreateBulk(slice any, setFunc func(*SaveSlotCreate, int)) *SaveSlotCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SaveSlotCreateBulk{err: fmt.Errorf("calling to SaveSlotClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SaveSlotCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SaveSlotCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SaveSlot.
+func (c *SaveSlotClient) Update() *SaveSlotUpdate {
+	mutation := newSaveSlotMutation(c.config, OpUpdate)
+	return &SaveSlotUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SaveSlotClient) UpdateOne(_m *SaveSlot) *SaveSlotUpdateOne {
+	mutation := newSaveSlotMutation(c.config, OpUpdateOne, withSaveSlot(_m))
+	return &SaveSlotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SaveSlotClient) UpdateOneID(id int) *SaveSlotUpdateOne {
+	mutation := newSaveSlotMutation(c.config, OpUpdateOne, withSaveSlotID(id))
+	return &SaveSlotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SaveSlot.
+func (c *SaveSlotClient) Delete() *SaveSlotDelete {
+	mutation := newSaveSlotMutation(c.config, OpDelete)
+	return &SaveSlotDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SaveSlotClient) DeleteOne(_m *SaveSlot) *SaveSlotDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SaveSlotClient) DeleteOneID(id int) *SaveSlotDeleteOne {
+	builder := c.Delete().Where(saveslot.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SaveSlotDeleteOne{builder}
+}
+
+// Query returns a query builder for SaveSlot.
+func (c *SaveSlotClient) Query() *SaveSlotQuery {
+	return &SaveSlotQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSaveSlot},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SaveSlot entity by its id.
+func (c *SaveSlotClient) Get(ctx context.Context, id int) (*SaveSlot, error) {
+	return c.Query().Where(saveslot.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SaveSlotClient) GetX(ctx context.Context, id int) *SaveSlot {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryProfile queries the profile edge of a SaveSlot.
+func (c *SaveSlotClient) QueryProfile(_m *SaveSlot) *ProfileQuery {
+	query := (&ProfileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(saveslot.Table, saveslot.FieldID, id),
+			sqlgraph.To(profile.Table, profile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, saveslot.ProfileTable, saveslot.ProfileColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *SaveSlotClient) Hooks() []Hook {
+	return c.hooks.SaveSlot
+}
+
+// Interceptors returns the client interceptors.
+func (c *SaveSlotClient) Interceptors() []Interceptor {
+	return c.inters.SaveSlot
+}
+
+func (c *SaveSlotClient) mutate(ctx context.Context, m *SaveSlotMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SaveSlotCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SaveSlotUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SaveSlotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SaveSlotDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SaveSlot mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		GameSettings []ent.Hook
+		GameSettings, HighScore, LevelResult, Profile, Run, SaveSlot []ent.Hook
 	}
 	inters struct {
-		GameSettings []ent.Interceptor
+		GameSettings, HighScore, LevelResult, Profile, Run, SaveSlot []ent.Interceptor
 	}
 )
@@ -5,6 +5,11 @@ package ent
 import (
 	"context"
 	"doomlike/ent/gamesettings"
+	"doomlike/ent/highscore"
+	"doomlike/ent/levelresult"
+	"doomlike/ent/profile"
+	"doomlike/ent/run"
+	"doomlike/ent/saveslot"
 	"errors"
 	"fmt"
 	"reflect"
@@ -74,6 +79,11 @@ func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
 			gamesettings.Table: gamesettings.ValidColumn,
+			highscore.Table:    highscore.ValidColumn,
+			levelresult.Table:  levelresult.ValidColumn,
+			profile.Table:      profile.ValidColumn,
+			run.Table:          run.ValidColumn,
+			saveslot.Table:     saveslot.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
@@ -4,6 +4,7 @@ package ent
 
 import (
 	"doomlike/ent/gamesettings"
+	"doomlike/ent/profile"
 	"fmt"
 	"strings"
 	"time"
@@ -24,11 +25,55 @@ type GameSettings struct {
 	BulletSpeed float64 `json:"bullet_speed,omitempty"`
 	// Number of levels to play
 	LevelCount int `json:"level_count,omitempty"`
+	// Run seed for level generation (0 = random each run)
+	Seed int64 `json:"seed,omitempty"`
+	// Turn in radians per pixel of mouse movement
+	MouseSensitivity float64 `json:"mouse_sensitivity,omitempty"`
+	// Turn the other way for horizontal mouse movement
+	InvertMouse bool `json:"invert_mouse,omitempty"`
+	// Keyboard turn speed in radians per second
+	TurnSpeed float64 `json:"turn_speed,omitempty"`
+	// Horizontal field of view in degrees
+	Fov float64 `json:"fov,omitempty"`
+	// Width of the internal render resolution
+	RenderWidth int `json:"render_width,omitempty"`
+	// Height of the internal render resolution
+	RenderHeight int `json:"render_height,omitempty"`
+	// Right stick turn speed at full deflection, in radians per second
+	GamepadTurnSpeed float64 `json:"gamepad_turn_speed,omitempty"`
+	// Stick deflection below which a gamepad stick reads as centred
+	GamepadDeadzone float64 `json:"gamepad_deadzone,omitempty"`
+	// Exponent of the stick response curve; 1 is linear
+	GamepadCurve float64 `json:"gamepad_curve,omitempty"`
 	// When these settings were created
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// When these settings were last updated
-	UpdatedAt    time.Time `json:"updated_at,omitempty"`
-	selectValues sql.SelectValues
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the GameSettingsQuery when eager-loading is set.
+	Edges            GameSettingsEdges `json:"edges"`
+	profile_settings *int
+	selectValues     sql.SelectValues
+}
+
+// GameSettingsEdges holds the relations/edges for other nodes in the graph.
+type GameSettingsEdges struct {
+	// Profile the settings belong to, unset for settings kept from before profiles
+	Profile *Profile `json:"profile,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ProfileOrErr returns the Profile value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e GameSettingsEdges) ProfileOrErr() (*Profile, error) {
+	if e.Profile != nil {
+		return e.Profile, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: profile.Label}
+	}
+	return nil, &NotLoadedError{edge: "profile"}
 }
 
 // scanValues returns the types for scanning values from sql.Rows.
@@ -36,14 +81,18 @@ func (*GameSettings) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case gamesettings.FieldFireRate, gamesettings.FieldBulletSpeed:
+		case gamesettings.FieldInvertMouse:
+			values[i] = new(sql.NullBool)
+		case gamesettings.FieldFireRate, gamesettings.FieldBulletSpeed, gamesettings.FieldMouseSensitivity, gamesettings.FieldTurnSpeed, gamesettings.FieldFov, gamesettings.FieldGamepadTurnSpeed, gamesettings.FieldGamepadDeadzone, gamesettings.FieldGamepadCurve:
 			values[i] = new(sql.NullFloat64)
-		case gamesettings.FieldLevelCount:
+		case gamesettings.FieldLevelCount, gamesettings.FieldSeed, gamesettings.FieldRenderWidth, gamesettings.FieldRenderHeight:
 			values[i] = new(sql.NullInt64)
 		case gamesettings.FieldID:
 			values[i] = new(sql.NullString)
 		case gamesettings.FieldCreatedAt, gamesettings.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
+		case gamesettings.ForeignKeys[0]: // profile_settings
+			values[i] = new(sql.NullInt64)
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -83,6 +132,66 @@ func (_m *GameSettings) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.LevelCount = int(value.Int64)
 			}
+		case gamesettings.FieldSeed:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field seed", values[i])
+			} else if value.Valid {
+				_m.Seed = value.Int64
+			}
+		case gamesettings.FieldMouseSensitivity:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field mouse_sensitivity", values[i])
+			} else if value.Valid {
+				_m.MouseSensitivity = value.Float64
+			}
+		case gamesettings.FieldInvertMouse:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field invert_mouse", values[i])
+			} else if value.Valid {
+				_m.InvertMouse = value.Bool
+			}
+		case gamesettings.FieldTurnSpeed:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field turn_speed", values[i])
+			} else if value.Valid {
+				_m.TurnSpeed = value.Float64
+			}
+		case gamesettings.FieldFov:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field fov", values[i])
+			} else if value.Valid {
+				_m.Fov = value.Float64
+			}
+		case gamesettings.FieldRenderWidth:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field render_width", values[i])
+			} else if value.Valid {
+				_m.RenderWidth = int(value.Int64)
+			}
+		case gamesettings.FieldRenderHeight:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field render_height", values[i])
+			} else if value.Valid {
+				_m.RenderHeight = int(value.Int64)
+			}
+		case gamesettings.FieldGamepadTurnSpeed:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field gamepad_turn_speed", values[i])
+			} else if value.Valid {
+				_m.GamepadTurnSpeed = value.Float64
+			}
+		case gamesettings.FieldGamepadDeadzone:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field gamepad_deadzone", values[i])
+			} else if value.Valid {
+				_m.GamepadDeadzone = value.Float64
+			}
+		case gamesettings.FieldGamepadCurve:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field gamepad_curve", values[i])
+			} else if value.Valid {
+				_m.GamepadCurve = value.Float64
+			}
 		case gamesettings.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -95,6 +204,13 @@ func (_m *GameSettings) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.UpdatedAt = value.Time
 			}
+		case gamesettings.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field profile_settings", value)
+			} else if value.Valid {
+				_m.profile_settings = new(int)
+				*_m.profile_settings = int(value.Int64)
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -108,6 +224,11 @@ func (_m *GameSettings) Value(name string) (ent.Value, error) {
 	return _m.selectValues.Get(name)
 }
 
+// QueryProfile queries the "profile" edge of the GameSettings entity.
+func (_m *GameSettings) QueryProfile() *ProfileQuery {
+	return NewGameSettingsClient(_m.config).QueryProfile(_m)
+}
+
 // Update returns a builder for updating this GameSettings.
 // Note that you need to call GameSettings.Unwrap() before calling this method if this GameSettings
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -140,6 +261,36 @@ func (_m *GameSettings) String() string {
 	builder.WriteString("level_count=")
 	builder.WriteString(fmt.Sprintf("%v", _m.LevelCount))
 	builder.WriteString(", ")
+	builder.WriteString("seed=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Seed))
+	builder.WriteString(", ")
+	builder.WriteString("mouse_sensitivity=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MouseSensitivity))
+	builder.WriteString(", ")
+	builder.WriteString("invert_mouse=")
+	builder.WriteString(fmt.Sprintf("%v", _m.InvertMouse))
+	builder.WriteString(", ")
+	builder.WriteString("turn_speed=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TurnSpeed))
+	builder.WriteString(", ")
+	builder.WriteString("fov=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Fov))
+	builder.WriteString(", ")
+	builder.WriteString("render_width=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RenderWidth))
+	builder.WriteString(", ")
+	builder.WriteString("render_height=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RenderHeight))
+	builder.WriteString(", ")
+	builder.WriteString("gamepad_turn_speed=")
+	builder.WriteString(fmt.Sprintf("%v", _m.GamepadTurnSpeed))
+	builder.WriteString(", ")
+	builder.WriteString("gamepad_deadzone=")
+	builder.WriteString(fmt.Sprintf("%v", _m.GamepadDeadzone))
+	builder.WriteString(", ")
+	builder.WriteString("gamepad_curve=")
+	builder.WriteString(fmt.Sprintf("%v", _m.GamepadCurve))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
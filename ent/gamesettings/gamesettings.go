@@ -4,6 +4,7 @@ package gamesettings
 
 import (
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 )
 
 const (
@@ -17,12 +18,41 @@ const (
 	FieldBulletSpeed = "bullet_speed"
 	// FieldLevelCount holds the string denoting the level_count field in the database.
 	FieldLevelCount = "level_count"
+	// FieldSeed holds the string denoting the seed field in the database.
+	FieldSeed = "seed"
+	// FieldMouseSensitivity holds the string denoting the mouse_sensitivity field in the database.
+	FieldMouseSensitivity = "mouse_sensitivity"
+	// FieldInvertMouse holds the string denoting the invert_mouse field in the database.
+	FieldInvertMouse = "invert_mouse"
+	// FieldTurnSpeed holds the string denoting the turn_speed field in the database.
+	FieldTurnSpeed = "turn_speed"
+	// FieldFov holds the string denoting the fov field in the database.
+	FieldFov = "fov"
+	// FieldRenderWidth holds the string denoting the render_width field in the database.
+	FieldRenderWidth = "render_width"
+	// FieldRenderHeight holds the string denoting the render_height field in the database.
+	FieldRenderHeight = "render_height"
+	// FieldGamepadTurnSpeed holds the string denoting the gamepad_turn_speed field in the database.
+	FieldGamepadTurnSpeed = "gamepad_turn_speed"
+	// FieldGamepadDeadzone holds the string denoting the gamepad_deadzone field in the database.
+	FieldGamepadDeadzone = "gamepad_deadzone"
+	// FieldGamepadCurve holds the string denoting the gamepad_curve field in the database.
+	FieldGamepadCurve = "gamepad_curve"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
 	FieldUpdatedAt = "updated_at"
+	// EdgeProfile holds the string denoting the profile edge name in mutations.
+	EdgeProfile = "profile"
 	// Table holds the table name of the gamesettings in the database.
 	Table = "game_settings"
+	// ProfileTable is the table that holds the profile relation/edge.
+	ProfileTable = "game_settings"
+	// ProfileInverseTable is the table name for the Profile entity.
+	// It exists in this package in order to avoid circular dependency with the "profile" package.
+	ProfileInverseTable = "profiles"
+	// ProfileColumn is the table column denoting the profile relation/edge.
+	ProfileColumn = "profile_settings"
 )
 
 // Columns holds all SQL columns for gamesettings fields.
@@ -31,10 +61,26 @@ var Columns = []string{
 	FieldFireRate,
 	FieldBulletSpeed,
 	FieldLevelCount,
+	FieldSeed,
+	FieldMouseSensitivity,
+	FieldInvertMouse,
+	FieldTurnSpeed,
+	FieldFov,
+	FieldRenderWidth,
+	FieldRenderHeight,
+	FieldGamepadTurnSpeed,
+	FieldGamepadDeadzone,
+	FieldGamepadCurve,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
 
+// ForeignKeys holds the SQL foreign-keys that are owned by the "game_settings"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"profile_settings",
+}
+
 // ValidColumn reports if the column name is valid (part of the table columns).
 func ValidColumn(column string) bool {
 	for i := range Columns {
@@ -42,6 +88,11 @@ func ValidColumn(column string) bool {
 			return true
 		}
 	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
 	return false
 }
 
@@ -52,6 +103,26 @@ var (
 	DefaultBulletSpeed float64
 	// DefaultLevelCount holds the default value on creation for the "level_count" field.
 	DefaultLevelCount int
+	// DefaultSeed holds the default value on creation for the "seed" field.
+	DefaultSeed int64
+	// DefaultMouseSensitivity holds the default value on creation for the "mouse_sensitivity" field.
+	DefaultMouseSensitivity float64
+	// DefaultInvertMouse holds the default value on creation for the "invert_mouse" field.
+	DefaultInvertMouse bool
+	// DefaultTurnSpeed holds the default value on creation for the "turn_speed" field.
+	DefaultTurnSpeed float64
+	// DefaultFov holds the default value on creation for the "fov" field.
+	DefaultFov float64
+	// DefaultRenderWidth holds the default value on creation for the "render_width" field.
+	DefaultRenderWidth int
+	// DefaultRenderHeight holds the default value on creation for the "render_height" field.
+	DefaultRenderHeight int
+	// DefaultGamepadTurnSpeed holds the default value on creation for the "gamepad_turn_speed" field.
+	DefaultGamepadTurnSpeed float64
+	// DefaultGamepadDeadzone holds the default value on creation for the "gamepad_deadzone" field.
+	DefaultGamepadDeadzone float64
+	// DefaultGamepadCurve holds the default value on creation for the "gamepad_curve" field.
+	DefaultGamepadCurve float64
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID string
 )
@@ -79,6 +150,56 @@ func ByLevelCount(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldLevelCount, opts...).ToFunc()
 }
 
+// BySeed orders the results by the seed field.
+func BySeed(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSeed, opts...).ToFunc()
+}
+
+// ByMouseSensitivity orders the results by the mouse_sensitivity field.
+func ByMouseSensitivity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMouseSensitivity, opts...).ToFunc()
+}
+
+// ByInvertMouse orders the results by the invert_mouse field.
+func ByInvertMouse(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInvertMouse, opts...).ToFunc()
+}
+
+// ByTurnSpeed orders the results by the turn_speed field.
+func ByTurnSpeed(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTurnSpeed, opts...).ToFunc()
+}
+
+// ByFov orders the results by the fov field.
+func ByFov(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFov, opts...).ToFunc()
+}
+
+// ByRenderWidth orders the results by the render_width field.
+func ByRenderWidth(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRenderWidth, opts...).ToFunc()
+}
+
+// ByRenderHeight orders the results by the render_height field.
+func ByRenderHeight(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRenderHeight, opts...).ToFunc()
+}
+
+// ByGamepadTurnSpeed orders the results by the gamepad_turn_speed field.
+func ByGamepadTurnSpeed(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGamepadTurnSpeed, opts...).ToFunc()
+}
+
+// ByGamepadDeadzone orders the results by the gamepad_deadzone field.
+func ByGamepadDeadzone(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGamepadDeadzone, opts...).ToFunc()
+}
+
+// ByGamepadCurve orders the results by the gamepad_curve field.
+func ByGamepadCurve(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGamepadCurve, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -88,3 +209,17 @@ func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
 }
+
+// ByProfileField orders the results by profile field.
+func ByProfileField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newProfileStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newProfileStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ProfileInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, true, ProfileTable, ProfileColumn),
+	)
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 )
 
 // ID filters vertices based on their ID field.
@@ -79,6 +80,56 @@ func LevelCount(v int) predicate.GameSettings {
 	return predicate.GameSettings(sql.FieldEQ(FieldLevelCount, v))
 }
 
+// Seed applies equality check predicate on the "seed" field. It's identical to SeedEQ.
+func Seed(v int64) predicate.GameSettings {
+	return predicate.GameSettings(sql.FieldEQ(FieldSeed, v))
+}
+
+// MouseSensitivity applies equality check predicate on the "mouse_sensitivity" field. It's identical to MouseSensitivityEQ.
+func MouseSensitivity(v float64) predicate.GameSettings {
+	return predicate.GameSettings(sql.FieldEQ(FieldMouseSensitivity, v))
+}
+
+// InvertMouse applies equality check predicate on the "invert_mouse" field. It's identical to InvertMouseEQ.
+func InvertMouse(v bool) predicate.GameSettings {
+	return predicate.GameSettings(sql.FieldEQ(FieldInvertMouse, v))
+}
+
+// TurnSpeed applies equality check predicate on the "turn_speed" field. It's identical to TurnSpeedEQ.
+func TurnSpeed(v float64) predicate.GameSettings {
+	return predicate.GameSettings(sql.FieldEQ(FieldTurnSpeed, v))
+}
+
+// Fov applies equality check predicate on the "fov" field. It's identical to FovEQ.
+func Fov(v float64) predicate.GameSettings {
+	return predicate.GameSettings(sql.FieldEQ(FieldFov, v))
+}
+
+// RenderWidth applies equality check predicate on the "render_width" field. It's identical to RenderWidthEQ.
+func RenderWidth(v int) predicate.GameSettings {
+	return predicate.GameSettings(sql.FieldEQ(FieldRenderWidth, v))
+}
+
+// RenderHeight applies equality check predicate on the "render_height" field. It's identical to RenderHeightEQ.
+func RenderHeight(v int) predicate.GameSettings {
+	return predicate.GameSettings(sql.FieldEQ(FieldRenderHeight, v))
+}
+
+// GamepadTurnSpeed applies equality check predicate on the "gamepad_turn_speed" field. It's identical to GamepadTurnSpeedEQ.
+func GamepadTurnSpeed(v float64) predicate.GameSettings {
+	return predicate.GameSettings(sql.FieldEQ(FieldGamepadTurnSpeed, v))
+}
+
+// GamepadDeadzone applies equality check predicate on the "gamepad_deadzone" field. It's identical to GamepadDeadzoneEQ.
+func GamepadDeadzone(v float64) predicate.GameSettings {
+	return predicate.GameSettings(sql.FieldEQ(FieldGamepadDeadzone, v))
+}
+
+// GamepadCurve applies equality check predicate on the "gamepad_curve" field. It's identical to GamepadCurveEQ.
+func GamepadCurve(v float64) predicate.GameSettings {
+	return predicate.GameSettings(sql.FieldEQ(FieldGamepadCurve, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.GameSettings {
 	return predicate.GameSettings(sql.FieldEQ(FieldCreatedAt, v))
@@ -209,6 +260,376 @@ func LevelCountLTE(v int) predicate.GameSettings {
 	return predicate.GameSettings(sql.FieldLTE(FieldLevelCount, v))
 }
 
+// SeedEQ applies the EQ predicate on the "seed" field.
+func SeedEQ(v int64) predicate.GameSettings {
+	return predicate.GameSettings(sql.FieldEQ(FieldSeed, v))
+}
+
+// SeedNEQ applies the NEQ predicate on the "seed" field.
+func SeedNEQ(v int64) predicate.GameSettings {
+	return predicate.GameSettings(sql.FieldNEQ(FieldSeed, v))
+}
+
+// SeedIn applies the In predicate on the "seed" field.
+func SeedIn(vs ...int64) predicate.GameSettings {
+	return predicate.GameSettings(sql.FieldIn(FieldSeed, vs...))
+}
+
+// SeedNotIn applies the NotIn predicate on the "seed" field.
+func SeedNotIn(vs ...int64) predicate.GameSettings {
+	return predicate.GameSettings(sql.FieldNotIn(FieldSeed, vs...))
+}
+
+// SeedGT applies the GT predicate on the "seed" field.
+func SeedGT(v int64) predicate.GameSettings {
+	return predicate.GameSettings(sql.FieldGT(FieldSeed, v))
+}
+
+// SeedGTE applies the GTE predicate on the "seed" field.
+func SeedGTE(v int64) predicate.GameSettings {
+	return predicate.GameSettings(sql.FieldGTE(FieldSeed, v))
+}
+
+// SeedLT applies the LT predicate on the "seed" field.
+func SeedLT(v int64) predicate.GameSettings {
+	return predicate.GameSettings(sql.FieldLT(FieldSeed, v))
+}
+
+// SeedLTE applies the LTE predicate on the "seed" field.
+func SeedLTE(v int64) predicate.GameSettings {
+	return predicate.GameSettings(sql.FieldLTE(FieldSeed, v))
+}
+
+// MouseSensitivityEQ applies the EQ predicate on the "mouse_sensitivity" field.
+func MouseSensitivityEQ(v float64) predicate.GameSettings {
+	return predicate.GameSettings(sql.FieldEQ(FieldMouseSensitivity, v))
+}
+
+// MouseSensitivityNEQ applies the NEQ predicate on the "mouse_sensitivity" field.
+func MouseSensitivityNEQ(v float64) predicate.GameSettings {
+	return predicate.GameSettings(sql.FieldNEQ(FieldMouseSensitivity, v))
+}
+
+// MouseSensitivityIn applies the In predicate on the "mouse_sensitivity" field.
+func MouseSensitivityIn(vs ...float64) predicate.GameSettings {
+	return predicate.GameSettings(sql.FieldIn(FieldMouseSensitivity, vs...))
+}
+
+// MouseSensitivityNotIn applies the NotIn predicate on the "mouse_sensitivity" field.
+func MouseSensitivityNotIn(vs ...float64) predicate.GameSettings {
+	return predicate.GameSettings(sql.FieldNotIn(FieldMouseSensitivity, vs...))
+}
+
+// MouseSensitivityGT applies the GT predicate on the "mouse_sensitivity" field.
+func MouseSensitivityGT(v float64) predicate.GameSettings {
+	return predicate.GameSettings(sql.FieldGT(FieldMouseSensitivity, v))
+}
+
+// MouseSensitivityGTE applies the GTE predicate on the "mouse_sensitivity" field.
+func MouseSensitivityGTE(v float64) predicate.GameSettings {
+	return predicate.GameSettings(sql.FieldGTE(FieldMouseSensitivity, v))
+}
+
+// MouseSensitivityLT applies the LT predicate on the "mouse_sensitivity" field.
+func MouseSensitivityLT(v float64) predicate.GameSettings {
+	return predicate.GameSettings(sql.FieldLT(FieldMouseSensitivity, v))
+}
+
+// MouseSensitivityLTE applies the LTE predicate on the "mouse_sensitivity" field.
+func MouseSensitivityLTE(v float64) predicate.GameSettings {
+	return predicate.GameSettings(sql.FieldLTE(FieldMouseSensitivity, v))
+}
+
+// InvertMouseEQ applies the EQ predicate on the "invert_mouse" field.
+func InvertMouseEQ(v bool) predicate.GameSettings {
+	return predicate.GameSettings(sql.FieldEQ(FieldInvertMouse, v))
+}
+
+// InvertMouseNEQ applies the NEQ predicate on the "invert_mouse" field.
+func InvertMouseNEQ(v bool) predicate.GameSettings {
+	return predicate.GameSettings(sql.FieldNEQ(FieldInvertMouse, v))
+}
+
+// TurnSpeedEQ applies the EQ predicate on the "turn_speed" field.
+func TurnSpeedEQ(v float64) predicate.GameSettings {
+	return predicate.GameSettings(sql.FieldEQ(FieldTurnSpeed, v))
+}
+
+// TurnSpeedNEQ applies the NEQ predicate on the "turn_speed" field.
+func TurnSpeedNEQ(v float64) predicate.GameSettings {
+	return predicate.GameSettings(sql.FieldNEQ(FieldTurnSpeed, v))
+}
+
+// TurnSpeedIn applies the In predicate on the "turn_speed" field.
+func TurnSpeedIn(vs ...float64) predicate.GameSettings {
+	return predicate.GameSettings(sql.FieldIn(FieldTurnSpeed, vs...))
+}
+
+// TurnSpeedNotIn applies the NotIn predicate on the "turn_speed" field.
+func TurnSpeedNotIn(vs ...float64) predicate.GameSettings {
+	return predicate.GameSettings(sql.FieldNotIn(FieldTurnSpeed, vs...))
+}
+
+// TurnSpeedGT applies the GT predicate on the "turn_speed" field.
+func TurnSpeedGT(v float64) predicate.GameSettings {
+	return predicate.GameSettings(sql.FieldGT(FieldTurnSpeed, v))
+}
+
+// TurnSpeedGTE applies the GTE predicate on the "turn_speed" field.
+func TurnSpeedGTE(v float64) predicate.GameSettings {
+	return predicate.GameSettings(sql.FieldGTE(FieldTurnSpeed, v))
+}
+
+// TurnSpeedLT applies the LT predicate on the "turn_speed" field.
+func TurnSpeedLT(v float64) predicate.GameSettings {
+	return predicate.GameSettings(sql.FieldLT(FieldTurnSpeed, v))
+}
+
+// TurnSpeedLTE applies the LTE predicate on the "turn_speed" field.
+func TurnSpeedLTE(v float64) predicate.GameSettings {
+	return predicate.GameSettings(sql.FieldLTE(FieldTurnSpeed, v))
+}
+
+// FovEQ applies the EQ predicate on the "fov" field.
+func FovEQ(v float64) predicate.GameSettings {
+	return predicate.GameSettings(sql.FieldEQ(FieldFov, v))
+}
+
+// FovNEQ applies the NEQ predicate on the "fov" field.
+func FovNEQ(v float64) predicate.GameSettings {
+	return predicate.GameSettings(sql.FieldNEQ(FieldFov, v))
+}
+
+// FovIn applies the In predicate on the "fov" field.
+func FovIn(vs ...float64) predicate.GameSettings {
+	return predicate.GameSettings(sql.FieldIn(FieldFov, vs...))
+}
+
+// FovNotIn applies the NotIn predicate on the "fov" field.
+func FovNotIn(vs ...float64) predicate.GameSettings {
+	return predicate.GameSettings(sql.FieldNotIn(FieldFov, vs...))
+}
+
+// FovGT applies the GT predicate on the "fov" field.
+func FovGT(v float64) predicate.GameSettings {
+	return predicate.GameSettings(sql.FieldGT(FieldFov, v))
+}
+
+// FovGTE applies the GTE predicate on the "fov" field.
+func FovGTE(v float64) predicate.GameSettings {
+	return predicate.GameSettings(sql.FieldGTE(FieldFov, v))
+}
+
+// FovLT applies the LT predicate on the "fov" field.
+func FovLT(v float64) predicate.GameSettings {
+	return predicate.GameSettings(sql.FieldLT(FieldFov, v))
+}
+
+// FovLTE applies the LTE predicate on the "fov" field.
+func FovLTE(v float64) predicate.GameSettings {
+	return predicate.GameSettings(sql.FieldLTE(FieldFov, v))
+}
+
+// RenderWidthEQ applies the EQ predicate on the "render_width" field.
+func RenderWidthEQ(v int) predicate.GameSettings {
+	return predicate.GameSettings(sql.FieldEQ(FieldRenderWidth, v))
+}
+
+// RenderWidthNEQ applies the NEQ predicate on the "render_width" field.
+func RenderWidthNEQ(v int) predicate.GameSettings {
+	return predicate.GameSettings(sql.FieldNEQ(FieldRenderWidth, v))
+}
+
+// RenderWidthIn applies the In predicate on the "render_width" field.
+func RenderWidthIn(vs ...int) predicate.GameSettings {
+	return predicate.GameSettings(sql.FieldIn(FieldRenderWidth, vs...))
+}
+
+// RenderWidthNotIn applies the NotIn predicate on the "render_width" field.
+func RenderWidthNotIn(vs ...int) predicate.GameSettings {
+	return predicate.GameSettings(sql.FieldNotIn(FieldRenderWidth, vs...))
+}
+
+// RenderWidthGT applies the GT predicate on the "render_width" field.
+func RenderWidthGT(v int) predicate.GameSettings {
+	return predicate.GameSettings(sql.FieldGT(FieldRenderWidth, v))
+}
+
+// RenderWidthGTE applies the GTE predicate on the "render_width" field.
+func RenderWidthGTE(v int) predicate.GameSettings {
+	return predicate.GameSettings(sql.FieldGTE(FieldRenderWidth, v))
+}
+
+// RenderWidthLT applies the LT predicate on the "render_width" field.
+func RenderWidthLT(v int) predicate.GameSettings {
+	return predicate.GameSettings(sql.FieldLT(FieldRenderWidth, v))
+}
+
+// RenderWidthLTE applies the LTE predicate on the "render_width" field.
+func RenderWidthLTE(v int) predicate.GameSettings {
+	return predicate.GameSettings(sql.FieldLTE(FieldRenderWidth, v))
+}
+
+// RenderHeightEQ applies the EQ predicate on the "render_height" field.
+func RenderHeightEQ(v int) predicate.GameSettings {
+	return predicate.GameSettings(sql.FieldEQ(FieldRenderHeight, v))
+}
+
+// RenderHeightNEQ applies the NEQ predicate on the "render_height" field.
+func RenderHeightNEQ(v int) predicate.GameSettings {
+	return predicate.GameSettings(sql.FieldNEQ(FieldRenderHeight, v))
+}
+
+// RenderHeightIn applies the In predicate on the "render_height" field.
+func RenderHeightIn(vs ...int) predicate.GameSettings {
+	return predicate.GameSettings(sql.FieldIn(FieldRenderHeight, vs...))
+}
+
+// RenderHeightNotIn applies the NotIn predicate on the "render_height" field.
+func RenderHeightNotIn(vs ...int) predicate.GameSettings {
+	return predicate.GameSettings(sql.FieldNotIn(FieldRenderHeight, vs...))
+}
+
+// RenderHeightGT applies the GT predicate on the "render_height" field.
+func RenderHeightGT(v int) predicate.GameSettings {
+	return predicate.GameSettings(sql.FieldGT(FieldRenderHeight, v))
+}
+
+// RenderHeightGTE applies the GTE predicate on the "render_height" field.
+func RenderHeightGTE(v int) predicate.GameSettings {
+	return predicate.GameSettings(sql.FieldGTE(FieldRenderHeight, v))
+}
+
+// RenderHeightLT applies the LT predicate on the "render_height" field.
+func RenderHeightLT(v int) predicate.GameSettings {
+	return predicate.GameSettings(sql.FieldLT(FieldRenderHeight, v))
+}
+
+// RenderHeightLTE applies the LTE predicate on the "render_height" field.
+func RenderHeightLTE(v int) predicate.GameSettings {
+	return predicate.GameSettings(sql.FieldLTE(FieldRenderHeight, v))
+}
+
+// GamepadTurnSpeedEQ applies the EQ predicate on the "gamepad_turn_speed" field.
+func GamepadTurnSpeedEQ(v float64) predicate.GameSettings {
+	return predicate.GameSettings(sql.FieldEQ(FieldGamepadTurnSpeed, v))
+}
+
+// GamepadTurnSpeedNEQ applies the NEQ predicate on the "gamepad_turn_speed" field.
+func GamepadTurnSpeedNEQ(v float64) predicate.GameSettings {
+	return predicate.GameSettings(sql.FieldNEQ(FieldGamepadTurnSpeed, v))
+}
+
+// GamepadTurnSpeedIn applies the In predicate on the "gamepad_turn_speed" field.
+func GamepadTurnSpeedIn(vs ...float64) predicate.GameSettings {
+	return predicate.GameSettings(sql.FieldIn(FieldGamepadTurnSpeed, vs...))
+}
+
+// GamepadTurnSpeedNotIn applies the NotIn predicate on the "gamepad_turn_speed" field.
+func GamepadTurnSpeedNotIn(vs ...float64) predicate.GameSettings {
+	return predicate.GameSettings(sql.FieldNotIn(FieldGamepadTurnSpeed, vs...))
+}
+
+// GamepadTurnSpeedGT applies the GT predicate on the "gamepad_turn_speed" field.
+func GamepadTurnSpeedGT(v float64) predicate.GameSettings {
+	return predicate.GameSettings(sql.FieldGT(FieldGamepadTurnSpeed, v))
+}
+
+// GamepadTurnSpeedGTE applies the GTE predicate on the "gamepad_turn_speed" field.
+func GamepadTurnSpeedGTE(v float64) predicate.GameSettings {
+	return predicate.GameSettings(sql.FieldGTE(FieldGamepadTurnSpeed, v))
+}
+
+// GamepadTurnSpeedLT applies the LT predicate on the "gamepad_turn_speed" field.
+func GamepadTurnSpeedLT(v float64) predicate.GameSettings {
+	return predicate.GameSettings(sql.FieldLT(FieldGamepadTurnSpeed, v))
+}
+
+// GamepadTurnSpeedLTE applies the LTE predicate on the "gamepad_turn_speed" field.
+func GamepadTurnSpeedLTE(v float64) predicate.GameSettings {
+	return predicate.GameSettings(sql.FieldLTE(FieldGamepadTurnSpeed, v))
+}
+
+// GamepadDeadzoneEQ applies the EQ predicate on the "gamepad_deadzone" field.
+func GamepadDeadzoneEQ(v float64) predicate.GameSettings {
+	return predicate.GameSettings(sql.FieldEQ(FieldGamepadDeadzone, v))
+}
+
+// GamepadDeadzoneNEQ applies the NEQ predicate on the "gamepad_deadzone" field.
+func GamepadDeadzoneNEQ(v float64) predicate.GameSettings {
+	return predicate.GameSettings(sql.FieldNEQ(FieldGamepadDeadzone, v))
+}
+
+// GamepadDeadzoneIn applies the In predicate on the "gamepad_deadzone" field.
+func GamepadDeadzoneIn(vs ...float64) predicate.GameSettings {
+	return predicate.GameSettings(sql.FieldIn(FieldGamepadDeadzone, vs...))
+}
+
+// GamepadDeadzoneNotIn applies the NotIn predicate on the "gamepad_deadzone" field.
+func GamepadDeadzoneNotIn(vs ...float64) predicate.GameSettings {
+	return predicate.GameSettings(sql.FieldNotIn(FieldGamepadDeadzone, vs...))
+}
+
+// GamepadDeadzoneGT applies the GT predicate on the "gamepad_deadzone" field.
+func GamepadDeadzoneGT(v float64) predicate.GameSettings {
+	return predicate.GameSettings(sql.FieldGT(FieldGamepadDeadzone, v))
+}
+
+// GamepadDeadzoneGTE applies the GTE predicate on the "gamepad_deadzone" field.
+func GamepadDeadzoneGTE(v float64) predicate.GameSettings {
+	return predicate.GameSettings(sql.FieldGTE(FieldGamepadDeadzone, v))
+}
+
+// GamepadDeadzoneLT applies the LT predicate on the "gamepad_deadzone" field.
+func GamepadDeadzoneLT(v float64) predicate.GameSettings {
+	return predicate.GameSettings(sql.FieldLT(FieldGamepadDeadzone, v))
+}
+
+// GamepadDeadzoneLTE applies the LTE predicate on the "gamepad_deadzone" field.
+func GamepadDeadzoneLTE(v float64) predicate.GameSettings {
+	return predicate.GameSettings(sql.FieldLTE(FieldGamepadDeadzone, v))
+}
+
+// GamepadCurveEQ applies the EQ predicate on the "gamepad_curve" field.
+func GamepadCurveEQ(v float64) predicate.GameSettings {
+	return predicate.GameSettings(sql.FieldEQ(FieldGamepadCurve, v))
+}
+
+// GamepadCurveNEQ applies the NEQ predicate on the "gamepad_curve" field.
+func GamepadCurveNEQ(v float64) predicate.GameSettings {
+	return predicate.GameSettings(sql.FieldNEQ(FieldGamepadCurve, v))
+}
+
+// GamepadCurveIn applies the In predicate on the "gamepad_curve" field.
+func GamepadCurveIn(vs ...float64) predicate.GameSettings {
+	return predicate.GameSettings(sql.FieldIn(FieldGamepadCurve, vs...))
+}
+
+// GamepadCurveNotIn applies the NotIn predicate on the "gamepad_curve" field.
+func GamepadCurveNotIn(vs ...float64) predicate.GameSettings {
+	return predicate.GameSettings(sql.FieldNotIn(FieldGamepadCurve, vs...))
+}
+
+// GamepadCurveGT applies the GT predicate on the "gamepad_curve" field.
+func GamepadCurveGT(v float64) predicate.GameSettings {
+	return predicate.GameSettings(sql.FieldGT(FieldGamepadCurve, v))
+}
+
+// GamepadCurveGTE applies the GTE predicate on the "gamepad_curve" field.
+func GamepadCurveGTE(v float64) predicate.GameSettings {
+	return predicate.GameSettings(sql.FieldGTE(FieldGamepadCurve, v))
+}
+
+// GamepadCurveLT applies the LT predicate on the "gamepad_curve" field.
+func GamepadCurveLT(v float64) predicate.GameSettings {
+	return predicate.GameSettings(sql.FieldLT(FieldGamepadCurve, v))
+}
+
+// GamepadCurveLTE applies the LTE predicate on the "gamepad_curve" field.
+func GamepadCurveLTE(v float64) predicate.GameSettings {
+	return predicate.GameSettings(sql.FieldLTE(FieldGamepadCurve, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.GameSettings {
 	return predicate.GameSettings(sql.FieldEQ(FieldCreatedAt, v))
@@ -309,6 +730,29 @@ func UpdatedAtNotNil() predicate.GameSettings {
 	return predicate.GameSettings(sql.FieldNotNull(FieldUpdatedAt))
 }
 
+// HasProfile applies the HasEdge predicate on the "profile" edge.
+func HasProfile() predicate.GameSettings {
+	return predicate.GameSettings(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, ProfileTable, ProfileColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasProfileWith applies the HasEdge predicate on the "profile" edge with a given conditions (other predicates).
+func HasProfileWith(preds ...predicate.Profile) predicate.GameSettings {
+	return predicate.GameSettings(func(s *sql.Selector) {
+		step := newProfileStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.GameSettings) predicate.GameSettings {
 	return predicate.GameSettings(sql.AndPredicates(predicates...))
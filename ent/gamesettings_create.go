@@ -5,6 +5,7 @@ package ent
 import (
 	"context"
 	"doomlike/ent/gamesettings"
+	"doomlike/ent/profile"
 	"errors"
 	"fmt"
 	"time"
@@ -62,6 +63,146 @@ func (_c *GameSettingsCreate) SetNillableLevelCount(v *int) *GameSettingsCreate
 	return _c
 }
 
+// SetSeed sets the "seed" field.
+func (_c *GameSettingsCreate) SetSeed(v int64) *GameSettingsCreate {
+	_c.mutation.SetSeed(v)
+	return _c
+}
+
+// SetNillableSeed sets the "seed" field if the given value is not nil.
+func (_c *GameSettingsCreate) SetNillableSeed(v *int64) *GameSettingsCreate {
+	if v != nil {
+		_c.SetSeed(*v)
+	}
+	return _c
+}
+
+// SetMouseSensitivity sets the "mouse_sensitivity" field.
+func (_c *GameSettingsCreate) SetMouseSensitivity(v float64) *GameSettingsCreate {
+	_c.mutation.SetMouseSensitivity(v)
+	return _c
+}
+
+// SetNillableMouseSensitivity sets the "mouse_sensitivity" field if the given value is not nil.
+func (_c *GameSettingsCreate) SetNillableMouseSensitivity(v *float64) *GameSettingsCreate {
+	if v != nil {
+		_c.SetMouseSensitivity(*v)
+	}
+	return _c
+}
+
+// SetInvertMouse sets the "invert_mouse" field.
+func (_c *GameSettingsCreate) SetInvertMouse(v bool) *GameSettingsCreate {
+	_c.mutation.SetInvertMouse(v)
+	return _c
+}
+
+// SetNillableInvertMouse sets the "invert_mouse" field if the given value is not nil.
+func (_c *GameSettingsCreate) SetNillableInvertMouse(v *bool) *GameSettingsCreate {
+	if v != nil {
+		_c.SetInvertMouse(*v)
+	}
+	return _c
+}
+
+// SetTurnSpeed sets the "turn_speed" field.
+func (_c *GameSettingsCreate) SetTurnSpeed(v float64) *GameSettingsCreate {
+	_c.mutation.SetTurnSpeed(v)
+	return _c
+}
+
+// SetNillableTurnSpeed sets the "turn_speed" field if the given value is not nil.
+func (_c *GameSettingsCreate) SetNillableTurnSpeed(v *float64) *GameSettingsCreate {
+	if v != nil {
+		_c.SetTurnSpeed(*v)
+	}
+	return _c
+}
+
+// SetFov sets the "fov" field.
+func (_c *GameSettingsCreate) SetFov(v float64) *GameSettingsCreate {
+	_c.mutation.SetFov(v)
+	return _c
+}
+
+// SetNillableFov sets the "fov" field if the given value is not nil.
+func (_c *GameSettingsCreate) SetNillableFov(v *float64) *GameSettingsCreate {
+	if v != nil {
+		_c.SetFov(*v)
+	}
+	return _c
+}
+
+// SetRenderWidth sets the "render_width" field.
+func (_c *GameSettingsCreate) SetRenderWidth(v int) *GameSettingsCreate {
+	_c.mutation.SetRenderWidth(v)
+	return _c
+}
+
+// SetNillableRenderWidth sets the "render_width" field if the given value is not nil.
+func (_c *GameSettingsCreate) SetNillableRenderWidth(v *int) *GameSettingsCreate {
+	if v != nil {
+		_c.SetRenderWidth(*v)
+	}
+	return _c
+}
+
+// SetRenderHeight sets the "render_height" field.
+func (_c *GameSettingsCreate) SetRenderHeight(v int) *GameSettingsCreate {
+	_c.mutation.SetRenderHeight(v)
+	return _c
+}
+
+// SetNillableRenderHeight sets the "render_height" field if the given value is not nil.
+func (_c *GameSettingsCreate) SetNillableRenderHeight(v *int) *GameSettingsCreate {
+	if v != nil {
+		_c.SetRenderHeight(*v)
+	}
+	return _c
+}
+
+// SetGamepadTurnSpeed sets the "gamepad_turn_speed" field.
+func (_c *GameSettingsCreate) SetGamepadTurnSpeed(v float64) *GameSettingsCreate {
+	_c.mutation.SetGamepadTurnSpeed(v)
+	return _c
+}
+
+// SetNillableGamepadTurnSpeed sets the "gamepad_turn_speed" field if the given value is not nil.
+func (_c *GameSettingsCreate) SetNillableGamepadTurnSpeed(v *float64) *GameSettingsCreate {
+	if v != nil {
+		_c.SetGamepadTurnSpeed(*v)
+	}
+	return _c
+}
+
+// SetGamepadDeadzone sets the "gamepad_deadzone" field.
+func (_c *GameSettingsCreate) SetGamepadDeadzone(v float64) *GameSettingsCreate {
+	_c.mutation.SetGamepadDeadzone(v)
+	return _c
+}
+
+// SetNillableGamepadDeadzone sets the "gamepad_deadzone" field if the given value is not nil.
+func (_c *GameSettingsCreate) SetNillableGamepadDeadzone(v *float64) *GameSettingsCreate {
+	if v != nil {
+		_c.SetGamepadDeadzone(*v)
+	}
+	return _c
+}
+
+// SetGamepadCurve sets the "gamepad_curve" field.
+func (_c *GameSettingsCreate) SetGamepadCurve(v float64) *GameSettingsCreate {
+	_c.mutation.SetGamepadCurve(v)
+	return _c
+}
+
+// SetNillableGamepadCurve sets the "gamepad_curve" field if the given value is not nil.
+func (_c *GameSettingsCreate) SetNillableGamepadCurve(v *float64) *GameSettingsCreate {
+	if v != nil {
+		_c.SetGamepadCurve(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *GameSettingsCreate) SetCreatedAt(v time.Time) *GameSettingsCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -104,6 +245,25 @@ func (_c *GameSettingsCreate) SetNillableID(v *string) *GameSettingsCreate {
 	return _c
 }
 
+// SetProfileID sets the "profile" edge to the Profile entity by ID.
+func (_c *GameSettingsCreate) SetProfileID(id int) *GameSettingsCreate {
+	_c.mutation.SetProfileID(id)
+	return _c
+}
+
+// SetNillableProfileID sets the "profile" edge to the Profile entity by ID if the given value is not nil.
+func (_c *GameSettingsCreate) SetNillableProfileID(id *int) *GameSettingsCreate {
+	if id != nil {
+		_c = _c.SetProfileID(*id)
+	}
+	return _c
+}
+
+// SetProfile sets the "profile" edge to the Profile entity.
+func (_c *GameSettingsCreate) SetProfile(v *Profile) *GameSettingsCreate {
+	return _c.SetProfileID(v.ID)
+}
+
 // Mutation returns the GameSettingsMutation object of the builder.
 func (_c *GameSettingsCreate) Mutation() *GameSettingsMutation {
 	return _c.mutation
@@ -151,6 +311,46 @@ func (_c *GameSettingsCreate) defaults() {
 		v := gamesettings.DefaultLevelCount
 		_c.mutation.SetLevelCount(v)
 	}
+	if _, ok := _c.mutation.Seed(); !ok {
+		v := gamesettings.DefaultSeed
+		_c.mutation.SetSeed(v)
+	}
+	if _, ok := _c.mutation.MouseSensitivity(); !ok {
+		v := gamesettings.DefaultMouseSensitivity
+		_c.mutation.SetMouseSensitivity(v)
+	}
+	if _, ok := _c.mutation.InvertMouse(); !ok {
+		v := gamesettings.DefaultInvertMouse
+		_c.mutation.SetInvertMouse(v)
+	}
+	if _, ok := _c.mutation.TurnSpeed(); !ok {
+		v := gamesettings.DefaultTurnSpeed
+		_c.mutation.SetTurnSpeed(v)
+	}
+	if _, ok := _c.mutation.Fov(); !ok {
+		v := gamesettings.DefaultFov
+		_c.mutation.SetFov(v)
+	}
+	if _, ok := _c.mutation.RenderWidth(); !ok {
+		v := gamesettings.DefaultRenderWidth
+		_c.mutation.SetRenderWidth(v)
+	}
+	if _, ok := _c.mutation.RenderHeight(); !ok {
+		v := gamesettings.DefaultRenderHeight
+		_c.mutation.SetRenderHeight(v)
+	}
+	if _, ok := _c.mutation.GamepadTurnSpeed(); !ok {
+		v := gamesettings.DefaultGamepadTurnSpeed
+		_c.mutation.SetGamepadTurnSpeed(v)
+	}
+	if _, ok := _c.mutation.GamepadDeadzone(); !ok {
+		v := gamesettings.DefaultGamepadDeadzone
+		_c.mutation.SetGamepadDeadzone(v)
+	}
+	if _, ok := _c.mutation.GamepadCurve(); !ok {
+		v := gamesettings.DefaultGamepadCurve
+		_c.mutation.SetGamepadCurve(v)
+	}
 	if _, ok := _c.mutation.ID(); !ok {
 		v := gamesettings.DefaultID
 		_c.mutation.SetID(v)
@@ -168,6 +368,36 @@ func (_c *GameSettingsCreate) check() error {
 	if _, ok := _c.mutation.LevelCount(); !ok {
 		return &ValidationError{Name: "level_count", err: errors.New(`ent: missing required field "GameSettings.level_count"`)}
 	}
+	if _, ok := _c.mutation.Seed(); !ok {
+		return &ValidationError{Name: "seed", err: errors.New(`ent: missing required field "GameSettings.seed"`)}
+	}
+	if _, ok := _c.mutation.MouseSensitivity(); !ok {
+		return &ValidationError{Name: "mouse_sensitivity", err: errors.New(`ent: missing required field "GameSettings.mouse_sensitivity"`)}
+	}
+	if _, ok := _c.mutation.InvertMouse(); !ok {
+		return &ValidationError{Name: "invert_mouse", err: errors.New(`ent: missing required field "GameSettings.invert_mouse"`)}
+	}
+	if _, ok := _c.mutation.TurnSpeed(); !ok {
+		return &ValidationError{Name: "turn_speed", err: errors.New(`ent: missing required field "GameSettings.turn_speed"`)}
+	}
+	if _, ok := _c.mutation.Fov(); !ok {
+		return &ValidationError{Name: "fov", err: errors.New(`ent: missing required field "GameSettings.fov"`)}
+	}
+	if _, ok := _c.mutation.RenderWidth(); !ok {
+		return &ValidationError{Name: "render_width", err: errors.New(`ent: missing required field "GameSettings.render_width"`)}
+	}
+	if _, ok := _c.mutation.RenderHeight(); !ok {
+		return &ValidationError{Name: "render_height", err: errors.New(`ent: missing required field "GameSettings.render_height"`)}
+	}
+	if _, ok := _c.mutation.GamepadTurnSpeed(); !ok {
+		return &ValidationError{Name: "gamepad_turn_speed", err: errors.New(`ent: missing required field "GameSettings.gamepad_turn_speed"`)}
+	}
+	if _, ok := _c.mutation.GamepadDeadzone(); !ok {
+		return &ValidationError{Name: "gamepad_deadzone", err: errors.New(`ent: missing required field "GameSettings.gamepad_deadzone"`)}
+	}
+	if _, ok := _c.mutation.GamepadCurve(); !ok {
+		return &ValidationError{Name: "gamepad_curve", err: errors.New(`ent: missing required field "GameSettings.gamepad_curve"`)}
+	}
 	return nil
 }
 
@@ -215,6 +445,46 @@ func (_c *GameSettingsCreate) createSpec() (*GameSettings, *sqlgraph.CreateSpec)
 		_spec.SetField(gamesettings.FieldLevelCount, field.TypeInt, value)
 		_node.LevelCount = value
 	}
+	if value, ok := _c.mutation.Seed(); ok {
+		_spec.SetField(gamesettings.FieldSeed, field.TypeInt64, value)
+		_node.Seed = value
+	}
+	if value, ok := _c.mutation.MouseSensitivity(); ok {
+		_spec.SetField(gamesettings.FieldMouseSensitivity, field.TypeFloat64, value)
+		_node.MouseSensitivity = value
+	}
+	if value, ok := _c.mutation.InvertMouse(); ok {
+		_spec.SetField(gamesettings.FieldInvertMouse, field.TypeBool, value)
+		_node.InvertMouse = value
+	}
+	if value, ok := _c.mutation.TurnSpeed(); ok {
+		_spec.SetField(gamesettings.FieldTurnSpeed, field.TypeFloat64, value)
+		_node.TurnSpeed = value
+	}
+	if value, ok := _c.mutation.Fov(); ok {
+		_spec.SetField(gamesettings.FieldFov, field.TypeFloat64, value)
+		_node.Fov = value
+	}
+	if value, ok := _c.mutation.RenderWidth(); ok {
+		_spec.SetField(gamesettings.FieldRenderWidth, field.TypeInt, value)
+		_node.RenderWidth = value
+	}
+	if value, ok := _c.mutation.RenderHeight(); ok {
+		_spec.SetField(gamesettings.FieldRenderHeight, field.TypeInt, value)
+		_node.RenderHeight = value
+	}
+	if value, ok := _c.mutation.GamepadTurnSpeed(); ok {
+		_spec.SetField(gamesettings.FieldGamepadTurnSpeed, field.TypeFloat64, value)
+		_node.GamepadTurnSpeed = value
+	}
+	if value, ok := _c.mutation.GamepadDeadzone(); ok {
+		_spec.SetField(gamesettings.FieldGamepadDeadzone, field.TypeFloat64, value)
+		_node.GamepadDeadzone = value
+	}
+	if value, ok := _c.mutation.GamepadCurve(); ok {
+		_spec.SetField(gamesettings.FieldGamepadCurve, field.TypeFloat64, value)
+		_node.GamepadCurve = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(gamesettings.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -223,6 +493,23 @@ func (_c *GameSettingsCreate) createSpec() (*GameSettings, *sqlgraph.CreateSpec)
 		_spec.SetField(gamesettings.FieldUpdatedAt, field.TypeTime, value)
 		_node.UpdatedAt = value
 	}
+	if nodes := _c.mutation.ProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   gamesettings.ProfileTable,
+			Columns: []string{gamesettings.ProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.profile_settings = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
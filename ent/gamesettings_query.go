@@ -6,6 +6,7 @@ import (
 	"context"
 	"doomlike/ent/gamesettings"
 	"doomlike/ent/predicate"
+	"doomlike/ent/profile"
 	"fmt"
 	"math"
 
@@ -18,10 +19,12 @@ import (
 // GameSettingsQuery is the builder for querying GameSettings entities.
 type GameSettingsQuery struct {
 	config
-	ctx        *QueryContext
-	order      []gamesettings.OrderOption
-	inters     []Interceptor
-	predicates []predicate.GameSettings
+	ctx         *QueryContext
+	order       []gamesettings.OrderOption
+	inters      []Interceptor
+	predicates  []predicate.GameSettings
+	withProfile *ProfileQuery
+	withFKs     bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -58,6 +61,28 @@ func (_q *GameSettingsQuery) Order(o ...gamesettings.OrderOption) *GameSettingsQ
 	return _q
 }
 
+// QueryProfile chains the current query on the "profile" edge.
+func (_q *GameSettingsQuery) QueryProfile() *ProfileQuery {
+	query := (&ProfileClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(gamesettings.Table, gamesettings.FieldID, selector),
+			sqlgraph.To(profile.Table, profile.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, gamesettings.ProfileTable, gamesettings.ProfileColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first GameSettings entity from the query.
 // Returns a *NotFoundError when no GameSettings was found.
 func (_q *GameSettingsQuery) First(ctx context.Context) (*GameSettings, error) {
@@ -245,17 +270,29 @@ func (_q *GameSettingsQuery) Clone() *GameSettingsQuery {
 		return nil
 	}
 	return &GameSettingsQuery{
-		config:     _q.config,
-		ctx:        _q.ctx.Clone(),
-		order:      append([]gamesettings.OrderOption{}, _q.order...),
-		inters:     append([]Interceptor{}, _q.inters...),
-		predicates: append([]predicate.GameSettings{}, _q.predicates...),
+		config:      _q.config,
+		ctx:         _q.ctx.Clone(),
+		order:       append([]gamesettings.OrderOption{}, _q.order...),
+		inters:      append([]Interceptor{}, _q.inters...),
+		predicates:  append([]predicate.GameSettings{}, _q.predicates...),
+		withProfile: _q.withProfile.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
 	}
 }
 
+// WithProfile tells the query-builder to eager-load the nodes that are connected to
+// the "profile" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *GameSettingsQuery) WithProfile(opts ...func(*ProfileQuery)) *GameSettingsQuery {
+	query := (&ProfileClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withProfile = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -332,15 +369,26 @@ func (_q *GameSettingsQuery) prepareQuery(ctx context.Context) error {
 
 func (_q *GameSettingsQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*GameSettings, error) {
 	var (
-		nodes = []*GameSettings{}
-		_spec = _q.querySpec()
+		nodes       = []*GameSettings{}
+		withFKs     = _q.withFKs
+		_spec       = _q.querySpec()
+		loadedTypes = [1]bool{
+			_q.withProfile != nil,
+		}
 	)
+	if _q.withProfile != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, gamesettings.ForeignKeys...)
+	}
 	_spec.ScanValues = func(columns []string) ([]any, error) {
 		return (*GameSettings).scanValues(nil, columns)
 	}
 	_spec.Assign = func(columns []string, values []any) error {
 		node := &GameSettings{config: _q.config}
 		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
 	for i := range hooks {
@@ -352,9 +400,48 @@ func (_q *GameSettingsQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]
 	if len(nodes) == 0 {
 		return nodes, nil
 	}
+	if query := _q.withProfile; query != nil {
+		if err := _q.loadProfile(ctx, query, nodes, nil,
+			func(n *GameSettings, e *Profile) { n.Edges.Profile = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
+func (_q *GameSettingsQuery) loadProfile(ctx context.Context, query *ProfileQuery, nodes []*GameSettings, init func(*GameSettings), assign func(*GameSettings, *Profile)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*GameSettings)
+	for i := range nodes {
+		if nodes[i].profile_settings == nil {
+			continue
+		}
+		fk := *nodes[i].profile_settings
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(profile.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "profile_settings" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
 func (_q *GameSettingsQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
 	_spec.Node.Columns = _q.ctx.Fields
@@ -6,6 +6,7 @@ import (
 	"context"
 	"doomlike/ent/gamesettings"
 	"doomlike/ent/predicate"
+	"doomlike/ent/profile"
 	"errors"
 	"fmt"
 	"time"
@@ -91,6 +92,209 @@ func (_u *GameSettingsUpdate) AddLevelCount(v int) *GameSettingsUpdate {
 	return _u
 }
 
+// SetSeed sets the "seed" field.
+func (_u *GameSettingsUpdate) SetSeed(v int64) *GameSettingsUpdate {
+	_u.mutation.ResetSeed()
+	_u.mutation.SetSeed(v)
+	return _u
+}
+
+// SetNillableSeed sets the "seed" field if the given value is not nil.
+func (_u *GameSettingsUpdate) SetNillableSeed(v *int64) *GameSettingsUpdate {
+	if v != nil {
+		_u.SetSeed(*v)
+	}
+	return _u
+}
+
+// AddSeed adds value to the "seed" field.
+func (_u *GameSettingsUpdate) AddSeed(v int64) *GameSettingsUpdate {
+	_u.mutation.AddSeed(v)
+	return _u
+}
+
+// SetMouseSensitivity sets the "mouse_sensitivity" field.
+func (_u *GameSettingsUpdate) SetMouseSensitivity(v float64) *GameSettingsUpdate {
+	_u.mutation.ResetMouseSensitivity()
+	_u.mutation.SetMouseSensitivity(v)
+	return _u
+}
+
+// SetNillableMouseSensitivity sets the "mouse_sensitivity" field if the given value is not nil.
+func (_u *GameSettingsUpdate) SetNillableMouseSensitivity(v *float64) *GameSettingsUpdate {
+	if v != nil {
+		_u.SetMouseSensitivity(*v)
+	}
+	return _u
+}
+
+// AddMouseSensitivity adds value to the "mouse_sensitivity" field.
+func (_u *GameSettingsUpdate) AddMouseSensitivity(v float64) *GameSettingsUpdate {
+	_u.mutation.AddMouseSensitivity(v)
+	return _u
+}
+
+// SetInvertMouse sets the "invert_mouse" field.
+func (_u *GameSettingsUpdate) SetInvertMouse(v bool) *GameSettingsUpdate {
+	_u.mutation.SetInvertMouse(v)
+	return _u
+}
+
+// SetNillableInvertMouse sets the "invert_mouse" field if the given value is not nil.
+func (_u *GameSettingsUpdate) SetNillableInvertMouse(v *bool) *GameSettingsUpdate {
+	if v != nil {
+		_u.SetInvertMouse(*v)
+	}
+	return _u
+}
+
+// SetTurnSpeed sets the "turn_speed" field.
+func (_u *GameSettingsUpdate) SetTurnSpeed(v float64) *GameSettingsUpdate {
+	_u.mutation.ResetTurnSpeed()
+	_u.mutation.SetTurnSpeed(v)
+	return _u
+}
+
+// SetNillableTurnSpeed sets the "turn_speed" field if the given value is not nil.
+func (_u *GameSettingsUpdate) SetNillableTurnSpeed(v *float64) *GameSettingsUpdate {
+	if v != nil {
+		_u.SetTurnSpeed(*v)
+	}
+	return _u
+}
+
+// AddTurnSpeed adds value to the "turn_speed" field.
+func (_u *GameSettingsUpdate) AddTurnSpeed(v float64) *GameSettingsUpdate {
+	_u.mutation.AddTurnSpeed(v)
+	return _u
+}
+
+// SetFov sets the "fov" field.
+func (_u *GameSettingsUpdate) SetFov(v float64) *GameSettingsUpdate {
+	_u.mutation.ResetFov()
+	_u.mutation.SetFov(v)
+	return _u
+}
+
+// SetNillableFov sets the "fov" field if the given value is not nil.
+func (_u *GameSettingsUpdate) SetNillableFov(v *float64) *GameSettingsUpdate {
+	if v != nil {
+		_u.SetFov(*v)
+	}
+	return _u
+}
+
+// AddFov adds value to the "fov" field.
+func (_u *GameSettingsUpdate) AddFov(v float64) *GameSettingsUpdate {
+	_u.mutation.AddFov(v)
+	return _u
+}
+
+// SetRenderWidth sets the "render_width" field.
+func (_u *GameSettingsUpdate) SetRenderWidth(v int) *GameSettingsUpdate {
+	_u.mutation.ResetRenderWidth()
+	_u.mutation.SetRenderWidth(v)
+	return _u
+}
+
+// SetNillableRenderWidth sets the "render_width" field if the given value is not nil.
+func (_u *GameSettingsUpdate) SetNillableRenderWidth(v *int) *GameSettingsUpdate {
+	if v != nil {
+		_u.SetRenderWidth(*v)
+	}
+	return _u
+}
+
+// AddRenderWidth adds value to the "render_width" field.
+func (_u *GameSettingsUpdate) AddRenderWidth(v int) *GameSettingsUpdate {
+	_u.mutation.AddRenderWidth(v)
+	return _u
+}
+
+// SetRenderHeight sets the "render_height" field.
+func (_u *GameSettingsUpdate) SetRenderHeight(v int) *GameSettingsUpdate {
+	_u.mutation.ResetRenderHeight()
+	_u.mutation.SetRenderHeight(v)
+	return _u
+}
+
+// SetNillableRenderHeight sets the "render_height" field if the given value is not nil.
+func (_u *GameSettingsUpdate) SetNillableRenderHeight(v *int) *GameSettingsUpdate {
+	if v != nil {
+		_u.SetRenderHeight(*v)
+	}
+	return _u
+}
+
+// AddRenderHeight adds value to the "render_height" field.
+func (_u *GameSettingsUpdate) AddRenderHeight(v int) *GameSettingsUpdate {
+	_u.mutation.AddRenderHeight(v)
+	return _u
+}
+
+// SetGamepadTurnSpeed sets the "gamepad_turn_speed" field.
+func (_u *GameSettingsUpdate) SetGamepadTurnSpeed(v float64) *GameSettingsUpdate {
+	_u.mutation.ResetGamepadTurnSpeed()
+	_u.mutation.SetGamepadTurnSpeed(v)
+	return _u
+}
+
+// SetNillableGamepadTurnSpeed sets the "gamepad_turn_speed" field if the given value is not nil.
+func (_u *GameSettingsUpdate) SetNillableGamepadTurnSpeed(v *float64) *GameSettingsUpdate {
+	if v != nil {
+		_u.SetGamepadTurnSpeed(*v)
+	}
+	return _u
+}
+
+// AddGamepadTurnSpeed adds value to the "gamepad_turn_speed" field.
+func (_u *GameSettingsUpdate) AddGamepadTurnSpeed(v float64) *GameSettingsUpdate {
+	_u.mutation.AddGamepadTurnSpeed(v)
+	return _u
+}
+
+// SetGamepadDeadzone sets the "gamepad_deadzone" field.
+func (_u *GameSettingsUpdate) SetGamepadDeadzone(v float64) *GameSettingsUpdate {
+	_u.mutation.ResetGamepadDeadzone()
+	_u.mutation.SetGamepadDeadzone(v)
+	return _u
+}
+
+// SetNillableGamepadDeadzone sets the "gamepad_deadzone" field if the given value is not nil.
+func (_u *GameSettingsUpdate) SetNillableGamepadDeadzone(v *float64) *GameSettingsUpdate {
+	if v != nil {
+		_u.SetGamepadDeadzone(*v)
+	}
+	return _u
+}
+
+// AddGamepadDeadzone adds value to the "gamepad_deadzone" field.
+func (_u *GameSettingsUpdate) AddGamepadDeadzone(v float64) *GameSettingsUpdate {
+	_u.mutation.AddGamepadDeadzone(v)
+	return _u
+}
+
+// SetGamepadCurve sets the "gamepad_curve" field.
+func (_u *GameSettingsUpdate) SetGamepadCurve(v float64) *GameSettingsUpdate {
+	_u.mutation.ResetGamepadCurve()
+	_u.mutation.SetGamepadCurve(v)
+	return _u
+}
+
+// SetNillableGamepadCurve sets the "gamepad_curve" field if the given value is not nil.
+func (_u *GameSettingsUpdate) SetNillableGamepadCurve(v *float64) *GameSettingsUpdate {
+	if v != nil {
+		_u.SetGamepadCurve(*v)
+	}
+	return _u
+}
+
+// AddGamepadCurve adds value to the "gamepad_curve" field.
+func (_u *GameSettingsUpdate) AddGamepadCurve(v float64) *GameSettingsUpdate {
+	_u.mutation.AddGamepadCurve(v)
+	return _u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_u *GameSettingsUpdate) SetCreatedAt(v time.Time) *GameSettingsUpdate {
 	_u.mutation.SetCreatedAt(v)
@@ -131,11 +335,36 @@ func (_u *GameSettingsUpdate) ClearUpdatedAt() *GameSettingsUpdate {
 	return _u
 }
 
+// SetProfileID sets the "profile" edge to the Profile entity by ID.
+func (_u *GameSettingsUpdate) SetProfileID(id int) *GameSettingsUpdate {
+	_u.mutation.SetProfileID(id)
+	return _u
+}
+
+// SetNillableProfileID sets the "profile" edge to the Profile entity by ID if the given value is not nil.
+func (_u *GameSettingsUpdate) SetNillableProfileID(id *int) *GameSettingsUpdate {
+	if id != nil {
+		_u = _u.SetProfileID(*id)
+	}
+	return _u
+}
+
+// SetProfile sets the "profile" edge to the Profile entity.
+func (_u *GameSettingsUpdate) SetProfile(v *Profile) *GameSettingsUpdate {
+	return _u.SetProfileID(v.ID)
+}
+
 // Mutation returns the GameSettingsMutation object of the builder.
 func (_u *GameSettingsUpdate) Mutation() *GameSettingsMutation {
 	return _u.mutation
 }
 
+// ClearProfile clears the "profile" edge to the Profile entity.
+func (_u *GameSettingsUpdate) ClearProfile() *GameSettingsUpdate {
+	_u.mutation.ClearProfile()
+	return _u
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *GameSettingsUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -190,6 +419,63 @@ func (_u *GameSettingsUpdate) sqlSave(ctx context.Context) (_node int, err error
 	if value, ok := _u.mutation.AddedLevelCount(); ok {
 		_spec.AddField(gamesettings.FieldLevelCount, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.Seed(); ok {
+		_spec.SetField(gamesettings.FieldSeed, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSeed(); ok {
+		_spec.AddField(gamesettings.FieldSeed, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.MouseSensitivity(); ok {
+		_spec.SetField(gamesettings.FieldMouseSensitivity, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedMouseSensitivity(); ok {
+		_spec.AddField(gamesettings.FieldMouseSensitivity, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.InvertMouse(); ok {
+		_spec.SetField(gamesettings.FieldInvertMouse, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.TurnSpeed(); ok {
+		_spec.SetField(gamesettings.FieldTurnSpeed, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedTurnSpeed(); ok {
+		_spec.AddField(gamesettings.FieldTurnSpeed, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Fov(); ok {
+		_spec.SetField(gamesettings.FieldFov, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedFov(); ok {
+		_spec.AddField(gamesettings.FieldFov, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.RenderWidth(); ok {
+		_spec.SetField(gamesettings.FieldRenderWidth, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRenderWidth(); ok {
+		_spec.AddField(gamesettings.FieldRenderWidth, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.RenderHeight(); ok {
+		_spec.SetField(gamesettings.FieldRenderHeight, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRenderHeight(); ok {
+		_spec.AddField(gamesettings.FieldRenderHeight, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.GamepadTurnSpeed(); ok {
+		_spec.SetField(gamesettings.FieldGamepadTurnSpeed, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedGamepadTurnSpeed(); ok {
+		_spec.AddField(gamesettings.FieldGamepadTurnSpeed, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.GamepadDeadzone(); ok {
+		_spec.SetField(gamesettings.FieldGamepadDeadzone, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedGamepadDeadzone(); ok {
+		_spec.AddField(gamesettings.FieldGamepadDeadzone, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.GamepadCurve(); ok {
+		_spec.SetField(gamesettings.FieldGamepadCurve, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedGamepadCurve(); ok {
+		_spec.AddField(gamesettings.FieldGamepadCurve, field.TypeFloat64, value)
+	}
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(gamesettings.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -202,6 +488,35 @@ func (_u *GameSettingsUpdate) sqlSave(ctx context.Context) (_node int, err error
 	if _u.mutation.UpdatedAtCleared() {
 		_spec.ClearField(gamesettings.FieldUpdatedAt, field.TypeTime)
 	}
+	if _u.mutation.ProfileCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   gamesettings.ProfileTable,
+			Columns: []string{gamesettings.ProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   gamesettings.ProfileTable,
+			Columns: []string{gamesettings.ProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{gamesettings.Label}
@@ -285,6 +600,209 @@ func (_u *GameSettingsUpdateOne) AddLevelCount(v int) *GameSettingsUpdateOne {
 	return _u
 }
 
+// SetSeed sets the "seed" field.
+func (_u *GameSettingsUpdateOne) SetSeed(v int64) *GameSettingsUpdateOne {
+	_u.mutation.ResetSeed()
+	_u.mutation.SetSeed(v)
+	return _u
+}
+
+// SetNillableSeed sets the "seed" field if the given value is not nil.
+func (_u *GameSettingsUpdateOne) SetNillableSeed(v *int64) *GameSettingsUpdateOne {
+	if v != nil {
+		_u.SetSeed(*v)
+	}
+	return _u
+}
+
+// AddSeed adds value to the "seed" field.
+func (_u *GameSettingsUpdateOne) AddSeed(v int64) *GameSettingsUpdateOne {
+	_u.mutation.AddSeed(v)
+	return _u
+}
+
+// SetMouseSensitivity sets the "mouse_sensitivity" field.
+func (_u *GameSettingsUpdateOne) SetMouseSensitivity(v float64) *GameSettingsUpdateOne {
+	_u.mutation.ResetMouseSensitivity()
+	_u.mutation.SetMouseSensitivity(v)
+	return _u
+}
+
+// SetNillableMouseSensitivity sets the "mouse_sensitivity" field if the given value is not nil.
+func (_u *GameSettingsUpdateOne) SetNillableMouseSensitivity(v *float64) *GameSettingsUpdateOne {
+	if v != nil {
+		_u.SetMouseSensitivity(*v)
+	}
+	return _u
+}
+
+// AddMouseSensitivity adds value to the "mouse_sensitivity" field.
+func (_u *GameSettingsUpdateOne) AddMouseSensitivity(v float64) *GameSettingsUpdateOne {
+	_u.mutation.AddMouseSensitivity(v)
+	return _u
+}
+
+// SetInvertMouse sets the "invert_mouse" field.
+func (_u *GameSettingsUpdateOne) SetInvertMouse(v bool) *GameSettingsUpdateOne {
+	_u.mutation.SetInvertMouse(v)
+	return _u
+}
+
+// SetNillableInvertMouse sets the "invert_mouse" field if the given value is not nil.
+func (_u *GameSettingsUpdateOne) SetNillableInvertMouse(v *bool) *GameSettingsUpdateOne {
+	if v != nil {
+		_u.SetInvertMouse(*v)
+	}
+	return _u
+}
+
+// SetTurnSpeed sets the "turn_speed" field.
+func (_u *GameSettingsUpdateOne) SetTurnSpeed(v float64) *GameSettingsUpdateOne {
+	_u.mutation.ResetTurnSpeed()
+	_u.mutation.SetTurnSpeed(v)
+	return _u
+}
+
+// SetNillableTurnSpeed sets the "turn_speed" field if the given value is not nil.
+func (_u *GameSettingsUpdateOne) SetNillableTurnSpeed(v *float64) *GameSettingsUpdateOne {
+	if v != nil {
+		_u.SetTurnSpeed(*v)
+	}
+	return _u
+}
+
+// AddTurnSpeed adds value to the "turn_speed" field.
+func (_u *GameSettingsUpdateOne) AddTurnSpeed(v float64) *GameSettingsUpdateOne {
+	_u.mutation.AddTurnSpeed(v)
+	return _u
+}
+
+// SetFov sets the "fov" field.
+func (_u *GameSettingsUpdateOne) SetFov(v float64) *GameSettingsUpdateOne {
+	_u.mutation.ResetFov()
+	_u.mutation.SetFov(v)
+	return _u
+}
+
+// SetNillableFov sets the "fov" field if the given value is not nil.
+func (_u *GameSettingsUpdateOne) SetNillableFov(v *float64) *GameSettingsUpdateOne {
+	if v != nil {
+		_u.SetFov(*v)
+	}
+	return _u
+}
+
+// AddFov adds value to the "fov" field.
+func (_u *GameSettingsUpdateOne) AddFov(v float64) *GameSettingsUpdateOne {
+	_u.mutation.AddFov(v)
+	return _u
+}
+
+// SetRenderWidth sets the "render_width" field.
+func (_u *GameSettingsUpdateOne) SetRenderWidth(v int) *GameSettingsUpdateOne {
+	_u.mutation.ResetRenderWidth()
+	_u.mutation.SetRenderWidth(v)
+	return _u
+}
+
+// SetNillableRenderWidth sets the "render_width" field if the given value is not nil.
+func (_u *GameSettingsUpdateOne) SetNillableRenderWidth(v *int) *GameSettingsUpdateOne {
+	if v != nil {
+		_u.SetRenderWidth(*v)
+	}
+	return _u
+}
+
+// AddRenderWidth adds value to the "render_width" field.
+func (_u *GameSettingsUpdateOne) AddRenderWidth(v int) *GameSettingsUpdateOne {
+	_u.mutation.AddRenderWidth(v)
+	return _u
+}
+
+// SetRenderHeight sets the "render_height" field.
+func (_u *GameSettingsUpdateOne) SetRenderHeight(v int) *GameSettingsUpdateOne {
+	_u.mutation.ResetRenderHeight()
+	_u.mutation.SetRenderHeight(v)
+	return _u
+}
+
+// SetNillableRenderHeight sets the "render_height" field if the given value is not nil.
+func (_u *GameSettingsUpdateOne) SetNillableRenderHeight(v *int) *GameSettingsUpdateOne {
+	if v != nil {
+		_u.SetRenderHeight(*v)
+	}
+	return _u
+}
+
+// AddRenderHeight adds value to the "render_height" field.
+func (_u *GameSettingsUpdateOne) AddRenderHeight(v int) *GameSettingsUpdateOne {
+	_u.mutation.AddRenderHeight(v)
+	return _u
+}
+
+// SetGamepadTurnSpeed sets the "gamepad_turn_speed" field.
+func (_u *GameSettingsUpdateOne) SetGamepadTurnSpeed(v float64) *GameSettingsUpdateOne {
+	_u.mutation.ResetGamepadTurnSpeed()
+	_u.mutation.SetGamepadTurnSpeed(v)
+	return _u
+}
+
+// SetNillableGamepadTurnSpeed sets the "gamepad_turn_speed" field if the given value is not nil.
+func (_u *GameSettingsUpdateOne) SetNillableGamepadTurnSpeed(v *float64) *GameSettingsUpdateOne {
+	if v != nil {
+		_u.SetGamepadTurnSpeed(*v)
+	}
+	return _u
+}
+
+// AddGamepadTurnSpeed adds value to the "gamepad_turn_speed" field.
+func (_u *GameSettingsUpdateOne) AddGamepadTurnSpeed(v float64) *GameSettingsUpdateOne {
+	_u.mutation.AddGamepadTurnSpeed(v)
+	return _u
+}
+
+// SetGamepadDeadzone sets the "gamepad_deadzone" field.
+func (_u *GameSettingsUpdateOne) SetGamepadDeadzone(v float64) *GameSettingsUpdateOne {
+	_u.mutation.ResetGamepadDeadzone()
+	_u.mutation.SetGamepadDeadzone(v)
+	return _u
+}
+
+// SetNillableGamepadDeadzone sets the "gamepad_deadzone" field if the given value is not nil.
+func (_u *GameSettingsUpdateOne) SetNillableGamepadDeadzone(v *float64) *GameSettingsUpdateOne {
+	if v != nil {
+		_u.SetGamepadDeadzone(*v)
+	}
+	return _u
+}
+
+// AddGamepadDeadzone adds value to the "gamepad_deadzone" field.
+func (_u *GameSettingsUpdateOne) AddGamepadDeadzone(v float64) *GameSettingsUpdateOne {
+	_u.mutation.AddGamepadDeadzone(v)
+	return _u
+}
+
+// SetGamepadCurve sets the "gamepad_curve" field.
+func (_u *GameSettingsUpdateOne) SetGamepadCurve(v float64) *GameSettingsUpdateOne {
+	_u.mutation.ResetGamepadCurve()
+	_u.mutation.SetGamepadCurve(v)
+	return _u
+}
+
+// SetNillableGamepadCurve sets the "gamepad_curve" field if the given value is not nil.
+func (_u *GameSettingsUpdateOne) SetNillableGamepadCurve(v *float64) *GameSettingsUpdateOne {
+	if v != nil {
+		_u.SetGamepadCurve(*v)
+	}
+	return _u
+}
+
+// AddGamepadCurve adds value to the "gamepad_curve" field.
+func (_u *GameSettingsUpdateOne) AddGamepadCurve(v float64) *GameSettingsUpdateOne {
+	_u.mutation.AddGamepadCurve(v)
+	return _u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_u *GameSettingsUpdateOne) SetCreatedAt(v time.Time) *GameSettingsUpdateOne {
 	_u.mutation.SetCreatedAt(v)
@@ -325,11 +843,36 @@ func (_u *GameSettingsUpdateOne) ClearUpdatedAt() *GameSettingsUpdateOne {
 	return _u
 }
 
+// SetProfileID sets the "profile" edge to the Profile entity by ID.
+func (_u *GameSettingsUpdateOne) SetProfileID(id int) *GameSettingsUpdateOne {
+	_u.mutation.SetProfileID(id)
+	return _u
+}
+
+// SetNillableProfileID sets the "profile" edge to the Profile entity by ID if the given value is not nil.
+func (_u *GameSettingsUpdateOne) SetNillableProfileID(id *int) *GameSettingsUpdateOne {
+	if id != nil {
+		_u = _u.SetProfileID(*id)
+	}
+	return _u
+}
+
+// SetProfile sets the "profile" edge to the Profile entity.
+func (_u *GameSettingsUpdateOne) SetProfile(v *Profile) *GameSettingsUpdateOne {
+	return _u.SetProfileID(v.ID)
+}
+
 // Mutation returns the GameSettingsMutation object of the builder.
 func (_u *GameSettingsUpdateOne) Mutation() *GameSettingsMutation {
 	return _u.mutation
 }
 
+// ClearProfile clears the "profile" edge to the Profile entity.
+func (_u *GameSettingsUpdateOne) ClearProfile() *GameSettingsUpdateOne {
+	_u.mutation.ClearProfile()
+	return _u
+}
+
 // Where appends a list predicates to the GameSettingsUpdate builder.
 func (_u *GameSettingsUpdateOne) Where(ps ...predicate.GameSettings) *GameSettingsUpdateOne {
 	_u.mutation.Where(ps...)
@@ -414,6 +957,63 @@ func (_u *GameSettingsUpdateOne) sqlSave(ctx context.Context) (_node *GameSettin
 	if value, ok := _u.mutation.AddedLevelCount(); ok {
 		_spec.AddField(gamesettings.FieldLevelCount, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.Seed(); ok {
+		_spec.SetField(gamesettings.FieldSeed, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSeed(); ok {
+		_spec.AddField(gamesettings.FieldSeed, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.MouseSensitivity(); ok {
+		_spec.SetField(gamesettings.FieldMouseSensitivity, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedMouseSensitivity(); ok {
+		_spec.AddField(gamesettings.FieldMouseSensitivity, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.InvertMouse(); ok {
+		_spec.SetField(gamesettings.FieldInvertMouse, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.TurnSpeed(); ok {
+		_spec.SetField(gamesettings.FieldTurnSpeed, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedTurnSpeed(); ok {
+		_spec.AddField(gamesettings.FieldTurnSpeed, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Fov(); ok {
+		_spec.SetField(gamesettings.FieldFov, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedFov(); ok {
+		_spec.AddField(gamesettings.FieldFov, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.RenderWidth(); ok {
+		_spec.SetField(gamesettings.FieldRenderWidth, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRenderWidth(); ok {
+		_spec.AddField(gamesettings.FieldRenderWidth, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.RenderHeight(); ok {
+		_spec.SetField(gamesettings.FieldRenderHeight, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRenderHeight(); ok {
+		_spec.AddField(gamesettings.FieldRenderHeight, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.GamepadTurnSpeed(); ok {
+		_spec.SetField(gamesettings.FieldGamepadTurnSpeed, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedGamepadTurnSpeed(); ok {
+		_spec.AddField(gamesettings.FieldGamepadTurnSpeed, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.GamepadDeadzone(); ok {
+		_spec.SetField(gamesettings.FieldGamepadDeadzone, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedGamepadDeadzone(); ok {
+		_spec.AddField(gamesettings.FieldGamepadDeadzone, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.GamepadCurve(); ok {
+		_spec.SetField(gamesettings.FieldGamepadCurve, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedGamepadCurve(); ok {
+		_spec.AddField(gamesettings.FieldGamepadCurve, field.TypeFloat64, value)
+	}
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(gamesettings.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -426,6 +1026,35 @@ func (_u *GameSettingsUpdateOne) sqlSave(ctx context.Context) (_node *GameSettin
 	if _u.mutation.UpdatedAtCleared() {
 		_spec.ClearField(gamesettings.FieldUpdatedAt, field.TypeTime)
 	}
+	if _u.mutation.ProfileCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   gamesettings.ProfileTable,
+			Columns: []string{gamesettings.ProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   gamesettings.ProfileTable,
+			Columns: []string{gamesettings.ProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &GameSettings{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
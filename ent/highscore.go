@@ -0,0 +1,237 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"doomlike/ent/highscore"
+	"doomlike/ent/profile"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// HighScore is the model entity for the HighScore schema.
+type HighScore struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Name the player entered for the score
+	Name string `json:"name,omitempty"`
+	// Final score of the run
+	Score int `json:"score,omitempty"`
+	// Run seed the levels were generated from
+	Seed int64 `json:"seed,omitempty"`
+	// Level the run ended on
+	Level int `json:"level,omitempty"`
+	// Number of levels in the run
+	TotalLevels int `json:"total_levels,omitempty"`
+	// Whether the run cleared its last level
+	Won bool `json:"won,omitempty"`
+	// Fire rate in effect for the run
+	FireRate float64 `json:"fire_rate,omitempty"`
+	// Bullet speed in effect for the run
+	BulletSpeed float64 `json:"bullet_speed,omitempty"`
+	// When the score was recorded
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the HighScoreQuery when eager-loading is set.
+	Edges               HighScoreEdges `json:"edges"`
+	profile_high_scores *int
+	selectValues        sql.SelectValues
+}
+
+// HighScoreEdges holds the relations/edges for other nodes in the graph.
+type HighScoreEdges struct {
+	// Profile that set the score
+	Profile *Profile `json:"profile,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ProfileOrErr returns the Profile value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e HighScoreEdges) ProfileOrErr() (*Profile, error) {
+	if e.Profile != nil {
+		return e.Profile, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: profile.Label}
+	}
+	return nil, &NotLoadedError{edge: "profile"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*HighScore) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case highscore.FieldWon:
+			values[i] = new(sql.NullBool)
+		case highscore.FieldFireRate, highscore.FieldBulletSpeed:
+			values[i] = new(sql.NullFloat64)
+		case highscore.FieldID, highscore.FieldScore, highscore.FieldSeed, highscore.FieldLevel, highscore.FieldTotalLevels:
+			values[i] = new(sql.NullInt64)
+		case highscore.FieldName:
+			values[i] = new(sql.NullString)
+		case highscore.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case highscore.ForeignKeys[0]: // profile_high_scores
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the HighScore fields.
+func (_m *HighScore) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case highscore.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case highscore.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case highscore.FieldScore:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field score", values[i])
+			} else if value.Valid {
+				_m.Score = int(value.Int64)
+			}
+		case highscore.FieldSeed:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field seed", values[i])
+			} else if value.Valid {
+				_m.Seed = value.Int64
+			}
+		case highscore.FieldLevel:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field level", values[i])
+			} else if value.Valid {
+				_m.Level = int(value.Int64)
+			}
+		case highscore.FieldTotalLevels:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field total_levels", values[i])
+			} else if value.Valid {
+				_m.TotalLevels = int(value.Int64)
+			}
+		case highscore.FieldWon:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field won", values[i])
+			} else if value.Valid {
+				_m.Won = value.Bool
+			}
+		case highscore.FieldFireRate:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field fire_rate", values[i])
+			} else if value.Valid {
+				_m.FireRate = value.Float64
+			}
+		case highscore.FieldBulletSpeed:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field bullet_speed", values[i])
+			} else if value.Valid {
+				_m.BulletSpeed = value.Float64
+			}
+		case highscore.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case highscore.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field profile_high_scores", value)
+			} else if value.Valid {
+				_m.profile_high_scores = new(int)
+				*_m.profile_high_scores = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the HighScore.
+// This includes values selected through modifiers, order, etc.
+func (_m *HighScore) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryProfile queries the "profile" edge of the HighScore entity.
+func (_m *HighScore) QueryProfile() *ProfileQuery {
+	return NewHighScoreClient(_m.config).QueryProfile(_m)
+}
+
+// Update returns a builder for updating this HighScore.
+// Note that you need to call HighScore.Unwrap() before calling this method if this HighScore
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *HighScore) Update() *HighScoreUpdateOne {
+	return NewHighScoreClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the HighScore entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *HighScore) Unwrap() *HighScore {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: HighScore is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *HighScore) String() string {
+	var builder strings.Builder
+	builder.WriteString("HighScore(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("score=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Score))
+	builder.WriteString(", ")
+	builder.WriteString("seed=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Seed))
+	builder.WriteString(", ")
+	builder.WriteString("level=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Level))
+	builder.WriteString(", ")
+	builder.WriteString("total_levels=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TotalLevels))
+	builder.WriteString(", ")
+	builder.WriteString("won=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Won))
+	builder.WriteString(", ")
+	builder.WriteString("fire_rate=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FireRate))
+	builder.WriteString(", ")
+	builder.WriteString("bullet_speed=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BulletSpeed))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// HighScores is a parsable slice of HighScore.
+type HighScores []*HighScore
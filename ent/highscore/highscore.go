@@ -0,0 +1,153 @@
+// Code generated by ent, DO NOT EDIT.
+
+package highscore
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the highscore type in the database.
+	Label = "high_score"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldScore holds the string denoting the score field in the database.
+	FieldScore = "score"
+	// FieldSeed holds the string denoting the seed field in the database.
+	FieldSeed = "seed"
+	// FieldLevel holds the string denoting the level field in the database.
+	FieldLevel = "level"
+	// FieldTotalLevels holds the string denoting the total_levels field in the database.
+	FieldTotalLevels = "total_levels"
+	// FieldWon holds the string denoting the won field in the database.
+	FieldWon = "won"
+	// FieldFireRate holds the string denoting the fire_rate field in the database.
+	FieldFireRate = "fire_rate"
+	// FieldBulletSpeed holds the string denoting the bullet_speed field in the database.
+	FieldBulletSpeed = "bullet_speed"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeProfile holds the string denoting the profile edge name in mutations.
+	EdgeProfile = "profile"
+	// Table holds the table name of the highscore in the database.
+	Table = "high_scores"
+	// ProfileTable is the table that holds the profile relation/edge.
+	ProfileTable = "high_scores"
+	// ProfileInverseTable is the table name for the Profile entity.
+	// It exists in this package in order to avoid circular dependency with the "profile" package.
+	ProfileInverseTable = "profiles"
+	// ProfileColumn is the table column denoting the profile relation/edge.
+	ProfileColumn = "profile_high_scores"
+)
+
+// Columns holds all SQL columns for highscore fields.
+var Columns = []string{
+	FieldID,
+	FieldName,
+	FieldScore,
+	FieldSeed,
+	FieldLevel,
+	FieldTotalLevels,
+	FieldWon,
+	FieldFireRate,
+	FieldBulletSpeed,
+	FieldCreatedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "high_scores"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"profile_high_scores",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// DefaultWon holds the default value on creation for the "won" field.
+	DefaultWon bool
+)
+
+// OrderOption defines the ordering options for the HighScore queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByScore orders the results by the score field.
+func ByScore(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScore, opts...).ToFunc()
+}
+
+// BySeed orders the results by the seed field.
+func BySeed(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSeed, opts...).ToFunc()
+}
+
+// ByLevel orders the results by the level field.
+func ByLevel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLevel, opts...).ToFunc()
+}
+
+// ByTotalLevels orders the results by the total_levels field.
+func ByTotalLevels(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotalLevels, opts...).ToFunc()
+}
+
+// ByWon orders the results by the won field.
+func ByWon(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWon, opts...).ToFunc()
+}
+
+// ByFireRate orders the results by the fire_rate field.
+func ByFireRate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFireRate, opts...).ToFunc()
+}
+
+// ByBulletSpeed orders the results by the bullet_speed field.
+func ByBulletSpeed(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBulletSpeed, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByProfileField orders the results by profile field.
+func ByProfileField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newProfileStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newProfileStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ProfileInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ProfileTable, ProfileColumn),
+	)
+}
@@ -0,0 +1,494 @@
+// Code generated by ent, DO NOT EDIT.
+
+package highscore
+
+import (
+	"doomlike/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.HighScore {
+	return predicate.HighScore(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.HighScore {
+	return predicate.HighScore(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.HighScore {
+	return predicate.HighScore(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.HighScore {
+	return predicate.HighScore(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.HighScore {
+	return predicate.HighScore(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.HighScore {
+	return predicate.HighScore(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.HighScore {
+	return predicate.HighScore(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.HighScore {
+	return predicate.HighScore(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.HighScore {
+	return predicate.HighScore(sql.FieldLTE(FieldID, id))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.HighScore {
+	return predicate.HighScore(sql.FieldEQ(FieldName, v))
+}
+
+// Score applies equality check predicate on the "score" field. It's identical to ScoreEQ.
+func Score(v int) predicate.HighScore {
+	return predicate.HighScore(sql.FieldEQ(FieldScore, v))
+}
+
+// Seed applies equality check predicate on the "seed" field. It's identical to SeedEQ.
+func Seed(v int64) predicate.HighScore {
+	return predicate.HighScore(sql.FieldEQ(FieldSeed, v))
+}
+
+// Level applies equality check predicate on the "level" field. It's identical to LevelEQ.
+func Level(v int) predicate.HighScore {
+	return predicate.HighScore(sql.FieldEQ(FieldLevel, v))
+}
+
+// TotalLevels applies equality check predicate on the "total_levels" field. It's identical to TotalLevelsEQ.
+func TotalLevels(v int) predicate.HighScore {
+	return predicate.HighScore(sql.FieldEQ(FieldTotalLevels, v))
+}
+
+// Won applies equality check predicate on the "won" field. It's identical to WonEQ.
+func Won(v bool) predicate.HighScore {
+	return predicate.HighScore(sql.FieldEQ(FieldWon, v))
+}
+
+// FireRate applies equality check predicate on the "fire_rate" field. It's identical to FireRateEQ.
+func FireRate(v float64) predicate.HighScore {
+	return predicate.HighScore(sql.FieldEQ(FieldFireRate, v))
+}
+
+// BulletSpeed applies equality check predicate on the "bullet_speed" field. It's identical to BulletSpeedEQ.
+func BulletSpeed(v float64) predicate.HighScore {
+	return predicate.HighScore(sql.FieldEQ(FieldBulletSpeed, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.HighScore {
+	return predicate.HighScore(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.HighScore {
+	return predicate.HighScore(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.HighScore {
+	return predicate.HighScore(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.HighScore {
+	return predicate.HighScore(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.HighScore {
+	return predicate.HighScore(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.HighScore {
+	return predicate.HighScore(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.HighScore {
+	return predicate.HighScore(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.HighScore {
+	return predicate.HighScore(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.HighScore {
+	return predicate.HighScore(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.HighScore {
+	return predicate.HighScore(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.HighScore {
+	return predicate.HighScore(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.HighScore {
+	return predicate.HighScore(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.HighScore {
+	return predicate.HighScore(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.HighScore {
+	return predicate.HighScore(sql.FieldContainsFold(FieldName, v))
+}
+
+// ScoreEQ applies the EQ predicate on the "score" field.
+func ScoreEQ(v int) predicate.HighScore {
+	return predicate.HighScore(sql.FieldEQ(FieldScore, v))
+}
+
+// ScoreNEQ applies the NEQ predicate on the "score" field.
+func ScoreNEQ(v int) predicate.HighScore {
+	return predicate.HighScore(sql.FieldNEQ(FieldScore, v))
+}
+
+// ScoreIn applies the In predicate on the "score" field.
+func ScoreIn(vs ...int) predicate.HighScore {
+	return predicate.HighScore(sql.FieldIn(FieldScore, vs...))
+}
+
+// ScoreNotIn applies the NotIn predicate on the "score" field.
+func ScoreNotIn(vs ...int) predicate.HighScore {
+	return predicate.HighScore(sql.FieldNotIn(FieldScore, vs...))
+}
+
+// ScoreGT applies the GT predicate on the "score" field.
+func ScoreGT(v int) predicate.HighScore {
+	return predicate.HighScore(sql.FieldGT(FieldScore, v))
+}
+
+// ScoreGTE applies the GTE predicate on the "score" field.
+func ScoreGTE(v int) predicate.HighScore {
+	return predicate.HighScore(sql.FieldGTE(FieldScore, v))
+}
+
+// ScoreLT applies the LT predicate on the "score" field.
+func ScoreLT(v int) predicate.HighScore {
+	return predicate.HighScore(sql.FieldLT(FieldScore, v))
+}
+
+// ScoreLTE applies the LTE predicate on the "score" field.
+func ScoreLTE(v int) predicate.HighScore {
+	return predicate.HighScore(sql.FieldLTE(FieldScore, v))
+}
+
+// SeedEQ applies the EQ predicate on the "seed" field.
+func SeedEQ(v int64) predicate.HighScore {
+	return predicate.HighScore(sql.FieldEQ(FieldSeed, v))
+}
+
+// SeedNEQ applies the NEQ predicate on the "seed" field.
+func SeedNEQ(v int64) predicate.HighScore {
+	return predicate.HighScore(sql.FieldNEQ(FieldSeed, v))
+}
+
+// SeedIn applies the In predicate on the "seed" field.
+func SeedIn(vs ...int64) predicate.HighScore {
+	return predicate.HighScore(sql.FieldIn(FieldSeed, vs...))
+}
+
+// SeedNotIn applies the NotIn predicate on the "seed" field.
+func SeedNotIn(vs ...int64) predicate.HighScore {
+	return predicate.HighScore(sql.FieldNotIn(FieldSeed, vs...))
+}
+
+// SeedGT applies the GT predicate on the "seed" field.
+func SeedGT(v int64) predicate.HighScore {
+	return predicate.HighScore(sql.FieldGT(FieldSeed, v))
+}
+
+// SeedGTE applies the GTE predicate on the "seed" field.
+func SeedGTE(v int64) predicate.HighScore {
+	return predicate.HighScore(sql.FieldGTE(FieldSeed, v))
+}
+
+// SeedLT applies the LT predicate on the "seed" field.
+func SeedLT(v int64) predicate.HighScore {
+	return predicate.HighScore(sql.FieldLT(FieldSeed, v))
+}
+
+// SeedLTE applies the LTE predicate on the "seed" field.
+func SeedLTE(v int64) predicate.HighScore {
+	return predicate.HighScore(sql.FieldLTE(FieldSeed, v))
+}
+
+// LevelEQ applies the EQ predicate on the "level" field.
+func LevelEQ(v int) predicate.HighScore {
+	return predicate.HighScore(sql.FieldEQ(FieldLevel, v))
+}
+
+// LevelNEQ applies the NEQ predicate on the "level" field.
+func LevelNEQ(v int) predicate.HighScore {
+	return predicate.HighScore(sql.FieldNEQ(FieldLevel, v))
+}
+
+// LevelIn applies the In predicate on the "level" field.
+func LevelIn(vs ...int) predicate.HighScore {
+	return predicate.HighScore(sql.FieldIn(FieldLevel, vs...))
+}
+
+// LevelNotIn applies the NotIn predicate on the "level" field.
+func LevelNotIn(vs ...int) predicate.HighScore {
+	return predicate.HighScore(sql.FieldNotIn(FieldLevel, vs...))
+}
+
+// LevelGT applies the GT predicate on the "level" field.
+func LevelGT(v int) predicate.HighScore {
+	return predicate.HighScore(sql.FieldGT(FieldLevel, v))
+}
+
+// LevelGTE applies the GTE predicate on the "level" field.
+func LevelGTE(v int) predicate.HighScore {
+	return predicate.HighScore(sql.FieldGTE(FieldLevel, v))
+}
+
+// LevelLT applies the LT predicate on the "level" field.
+func LevelLT(v int) predicate.HighScore {
+	return predicate.HighScore(sql.FieldLT(FieldLevel, v))
+}
+
+// LevelLTE applies the LTE predicate on the "level" field.
+func LevelLTE(v int) predicate.HighScore {
+	return predicate.HighScore(sql.FieldLTE(FieldLevel, v))
+}
+
+// TotalLevelsEQ applies the EQ predicate on the "total_levels" field.
+func TotalLevelsEQ(v int) predicate.HighScore {
+	return predicate.HighScore(sql.FieldEQ(FieldTotalLevels, v))
+}
+
+// TotalLevelsNEQ applies the NEQ predicate on the "total_levels" field.
+func TotalLevelsNEQ(v int) predicate.HighScore {
+	return predicate.HighScore(sql.FieldNEQ(FieldTotalLevels, v))
+}
+
+// TotalLevelsIn applies the In predicate on the "total_levels" field.
+func TotalLevelsIn(vs ...int) predicate.HighScore {
+	return predicate.HighScore(sql.FieldIn(FieldTotalLevels, vs...))
+}
+
+// TotalLevelsNotIn applies the NotIn predicate on the "total_levels" field.
+func TotalLevelsNotIn(vs ...int) predicate.HighScore {
+	return predicate.HighScore(sql.FieldNotIn(FieldTotalLevels, vs...))
+}
+
+// TotalLevelsGT applies the GT predicate on the "total_levels" field.
+func TotalLevelsGT(v int) predicate.HighScore {
+	return predicate.HighScore(sql.FieldGT(FieldTotalLevels, v))
+}
+
+// TotalLevelsGTE applies the GTE predicate on the "total_levels" field.
+func TotalLevelsGTE(v int) predicate.HighScore {
+	return predicate.HighScore(sql.FieldGTE(FieldTotalLevels, v))
+}
+
+// TotalLevelsLT applies the LT predicate on the "total_levels" field.
+func TotalLevelsLT(v int) predicate.HighScore {
+	return predicate.HighScore(sql.FieldLT(FieldTotalLevels, v))
+}
+
+// TotalLevelsLTE applies the LTE predicate on the "total_levels" field.
+func TotalLevelsLTE(v int) predicate.HighScore {
+	return predicate.HighScore(sql.FieldLTE(FieldTotalLevels, v))
+}
+
+// WonEQ applies the EQ predicate on the "won" field.
+func WonEQ(v bool) predicate.HighScore {
+	return predicate.HighScore(sql.FieldEQ(FieldWon, v))
+}
+
+// WonNEQ applies the NEQ predicate on the "won" field.
+func WonNEQ(v bool) predicate.HighScore {
+	return predicate.HighScore(sql.FieldNEQ(FieldWon, v))
+}
+
+// FireRateEQ applies the EQ predicate on the "fire_rate" field.
+func FireRateEQ(v float64) predicate.HighScore {
+	return predicate.HighScore(sql.FieldEQ(FieldFireRate, v))
+}
+
+// FireRateNEQ applies the NEQ predicate on the "fire_rate" field.
+func FireRateNEQ(v float64) predicate.HighScore {
+	return predicate.HighScore(sql.FieldNEQ(FieldFireRate, v))
+}
+
+// FireRateIn applies the In predicate on the "fire_rate" field.
+func FireRateIn(vs ...float64) predicate.HighScore {
+	return predicate.HighScore(sql.FieldIn(FieldFireRate, vs...))
+}
+
+// FireRateNotIn applies the NotIn predicate on the "fire_rate" field.
+func FireRateNotIn(vs ...float64) predicate.HighScore {
+	return predicate.HighScore(sql.FieldNotIn(FieldFireRate, vs...))
+}
+
+// FireRateGT applies the GT predicate on the "fire_rate" field.
+func FireRateGT(v float64) predicate.HighScore {
+	return predicate.HighScore(sql.FieldGT(FieldFireRate, v))
+}
+
+// FireRateGTE applies the GTE predicate on the "fire_rate" field.
+func FireRateGTE(v float64) predicate.HighScore {
+	return predicate.HighScore(sql.FieldGTE(FieldFireRate, v))
+}
+
+// FireRateLT applies the LT predicate on the "fire_rate" field.
+func FireRateLT(v float64) predicate.HighScore {
+	return predicate.HighScore(sql.FieldLT(FieldFireRate, v))
+}
+
+// FireRateLTE applies the LTE predicate on the "fire_rate" field.
+func FireRateLTE(v float64) predicate.HighScore {
+	return predicate.HighScore(sql.FieldLTE(FieldFireRate, v))
+}
+
+// BulletSpeedEQ applies the EQ predicate on the "bullet_speed" field.
+func BulletSpeedEQ(v float64) predicate.HighScore {
+	return predicate.HighScore(sql.FieldEQ(FieldBulletSpeed, v))
+}
+
+// BulletSpeedNEQ applies the NEQ predicate on the "bullet_speed" field.
+func BulletSpeedNEQ(v float64) predicate.HighScore {
+	return predicate.HighScore(sql.FieldNEQ(FieldBulletSpeed, v))
+}
+
+// BulletSpeedIn applies the In predicate on the "bullet_speed" field.
+func BulletSpeedIn(vs ...float64) predicate.HighScore {
+	return predicate.HighScore(sql.FieldIn(FieldBulletSpeed, vs...))
+}
+
+// BulletSpeedNotIn applies the NotIn predicate on the "bullet_speed" field.
+func BulletSpeedNotIn(vs ...float64) predicate.HighScore {
+	return predicate.HighScore(sql.FieldNotIn(FieldBulletSpeed, vs...))
+}
+
+// BulletSpeedGT applies the GT predicate on the "bullet_speed" field.
+func BulletSpeedGT(v float64) predicate.HighScore {
+	return predicate.HighScore(sql.FieldGT(FieldBulletSpeed, v))
+}
+
+// BulletSpeedGTE applies the GTE predicate on the "bullet_speed" field.
+func BulletSpeedGTE(v float64) predicate.HighScore {
+	return predicate.HighScore(sql.FieldGTE(FieldBulletSpeed, v))
+}
+
+// BulletSpeedLT applies the LT predicate on the "bullet_speed" field.
+func BulletSpeedLT(v float64) predicate.HighScore {
+	return predicate.HighScore(sql.FieldLT(FieldBulletSpeed, v))
+}
+
+// BulletSpeedLTE applies the LTE predicate on the "bullet_speed" field.
+func BulletSpeedLTE(v float64) predicate.HighScore {
+	return predicate.HighScore(sql.FieldLTE(FieldBulletSpeed, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.HighScore {
+	return predicate.HighScore(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.HighScore {
+	return predicate.HighScore(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.HighScore {
+	return predicate.HighScore(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.HighScore {
+	return predicate.HighScore(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.HighScore {
+	return predicate.HighScore(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.HighScore {
+	return predicate.HighScore(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.HighScore {
+	return predicate.HighScore(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.HighScore {
+	return predicate.HighScore(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasProfile applies the HasEdge predicate on the "profile" edge.
+func HasProfile() predicate.HighScore {
+	return predicate.HighScore(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ProfileTable, ProfileColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasProfileWith applies the HasEdge predicate on the "profile" edge with a given conditions (other predicates).
+func HasProfileWith(preds ...predicate.Profile) predicate.HighScore {
+	return predicate.HighScore(func(s *sql.Selector) {
+		step := newProfileStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.HighScore) predicate.HighScore {
+	return predicate.HighScore(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.HighScore) predicate.HighScore {
+	return predicate.HighScore(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.HighScore) predicate.HighScore {
+	return predicate.HighScore(sql.NotPredicates(p))
+}
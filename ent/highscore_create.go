@@ -0,0 +1,348 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"doomlike/ent/highscore"
+	"doomlike/ent/profile"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// HighScoreCreate is the builder for creating a HighScore entity.
+type HighScoreCreate struct {
+	config
+	mutation *HighScoreMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (_c *HighScoreCreate) SetName(v string) *HighScoreCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetScore sets the "score" field.
+func (_c *HighScoreCreate) SetScore(v int) *HighScoreCreate {
+	_c.mutation.SetScore(v)
+	return _c
+}
+
+// SetSeed sets the "seed" field.
+func (_c *HighScoreCreate) SetSeed(v int64) *HighScoreCreate {
+	_c.mutation.SetSeed(v)
+	return _c
+}
+
+// SetLevel sets the "level" field.
+func (_c *HighScoreCreate) SetLevel(v int) *HighScoreCreate {
+	_c.mutation.SetLevel(v)
+	return _c
+}
+
+// SetTotalLevels sets the "total_levels" field.
+func (_c *HighScoreCreate) SetTotalLevels(v int) *HighScoreCreate {
+	_c.mutation.SetTotalLevels(v)
+	return _c
+}
+
+// SetWon sets the "won" field.
+func (_c *HighScoreCreate) SetWon(v bool) *HighScoreCreate {
+	_c.mutation.SetWon(v)
+	return _c
+}
+
+// SetNillableWon sets the "won" field if the given value is not nil.
+func (_c *HighScoreCreate) SetNillableWon(v *bool) *HighScoreCreate {
+	if v != nil {
+		_c.SetWon(*v)
+	}
+	return _c
+}
+
+// SetFireRate sets the "fire_rate" field.
+func (_c *HighScoreCreate) SetFireRate(v float64) *HighScoreCreate {
+	_c.mutation.SetFireRate(v)
+	return _c
+}
+
+// SetBulletSpeed sets the "bullet_speed" field.
+func (_c *HighScoreCreate) SetBulletSpeed(v float64) *HighScoreCreate {
+	_c.mutation.SetBulletSpeed(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *HighScoreCreate) SetCreatedAt(v time.Time) *HighScoreCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetProfileID sets the "profile" edge to the Profile entity by ID.
+func (_c *HighScoreCreate) SetProfileID(id int) *HighScoreCreate {
+	_c.mutation.SetProfileID(id)
+	return _c
+}
+
+// SetNillableProfileID sets the "profile" edge to the Profile entity by ID if the given value is not nil.
+func (_c *HighScoreCreate) SetNillableProfileID(id *int) *HighScoreCreate {
+	if id != nil {
+		_c = _c.SetProfileID(*id)
+	}
+	return _c
+}
+
+// SetProfile sets the "profile" edge to the Profile entity.
+func (_c *HighScoreCreate) SetProfile(v *Profile) *HighScoreCreate {
+	return _c.SetProfileID(v.ID)
+}
+
+// Mutation returns the HighScoreMutation object of the builder.
+func (_c *HighScoreCreate) Mutation() *HighScoreMutation {
+	return _c.mutation
+}
+
+// Save creates the HighScore in the database.
+func (_c *HighScoreCreate) Save(ctx context.Context) (*HighScore, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *HighScoreCreate) SaveX(ctx context.Context) *HighScore {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *HighScoreCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *HighScoreCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *HighScoreCreate) defaults() {
+	if _, ok := _c.mutation.Won(); !ok {
+		v := highscore.DefaultWon
+		_c.mutation.SetWon(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *HighScoreCreate) check() error {
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "HighScore.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := highscore.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "HighScore.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Score(); !ok {
+		return &ValidationError{Name: "score", err: errors.New(`ent: missing required field "HighScore.score"`)}
+	}
+	if _, ok := _c.mutation.Seed(); !ok {
+		return &ValidationError{Name: "seed", err: errors.New(`ent: missing required field "HighScore.seed"`)}
+	}
+	if _, ok := _c.mutation.Level(); !ok {
+		return &ValidationError{Name: "level", err: errors.New(`ent: missing required field "HighScore.level"`)}
+	}
+	if _, ok := _c.mutation.TotalLevels(); !ok {
+		return &ValidationError{Name: "total_levels", err: errors.New(`ent: missing required field "HighScore.total_levels"`)}
+	}
+	if _, ok := _c.mutation.Won(); !ok {
+		return &ValidationError{Name: "won", err: errors.New(`ent: missing required field "HighScore.won"`)}
+	}
+	if _, ok := _c.mutation.FireRate(); !ok {
+		return &ValidationError{Name: "fire_rate", err: errors.New(`ent: missing required field "HighScore.fire_rate"`)}
+	}
+	if _, ok := _c.mutation.BulletSpeed(); !ok {
+		return &ValidationError{Name: "bullet_speed", err: errors.New(`ent: missing required field "HighScore.bullet_speed"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "HighScore.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *HighScoreCreate) sqlSave(ctx context.Context) (*HighScore, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *HighScoreCreate) createSpec() (*HighScore, *sqlgraph.CreateSpec) {
+	var (
+		_node = &HighScore{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(highscore.Table, sqlgraph.NewFieldSpec(highscore.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(highscore.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Score(); ok {
+		_spec.SetField(highscore.FieldScore, field.TypeInt, value)
+		_node.Score = value
+	}
+	if value, ok := _c.mutation.Seed(); ok {
+		_spec.SetField(highscore.FieldSeed, field.TypeInt64, value)
+		_node.Seed = value
+	}
+	if value, ok := _c.mutation.Level(); ok {
+		_spec.SetField(highscore.FieldLevel, field.TypeInt, value)
+		_node.Level = value
+	}
+	if value, ok := _c.mutation.TotalLevels(); ok {
+		_spec.SetField(highscore.FieldTotalLevels, field.TypeInt, value)
+		_node.TotalLevels = value
+	}
+	if value, ok := _c.mutation.Won(); ok {
+		_spec.SetField(highscore.FieldWon, field.TypeBool, value)
+		_node.Won = value
+	}
+	if value, ok := _c.mutation.FireRate(); ok {
+		_spec.SetField(highscore.FieldFireRate, field.TypeFloat64, value)
+		_node.FireRate = value
+	}
+	if value, ok := _c.mutation.BulletSpeed(); ok {
+		_spec.SetField(highscore.FieldBulletSpeed, field.TypeFloat64, value)
+		_node.BulletSpeed = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(highscore.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.ProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   highscore.ProfileTable,
+			Columns: []string{highscore.ProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.profile_high_scores = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// HighScoreCreateBulk is the builder for creating many HighScore entities in bulk.
+type HighScoreCreateBulk struct {
+	config
+	err      error
+	builders []*HighScoreCreate
+}
+
+// Save creates the HighScore entities in the database.
+func (_c *HighScoreCreateBulk) Save(ctx context.Context) ([]*HighScore, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*HighScore, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*HighScoreMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *HighScoreCreateBulk) SaveX(ctx context.Context) []*HighScore {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *HighScoreCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *HighScoreCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
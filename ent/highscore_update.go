@@ -0,0 +1,737 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"doomlike/ent/highscore"
+	"doomlike/ent/predicate"
+	"doomlike/ent/profile"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// HighScoreUpdate is the builder for updating HighScore entities.
+type HighScoreUpdate struct {
+	config
+	hooks    []Hook
+	mutation *HighScoreMutation
+}
+
+// Where appends a list predicates to the HighScoreUpdate builder.
+func (_u *HighScoreUpdate) Where(ps ...predicate.HighScore) *HighScoreUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *HighScoreUpdate) SetName(v string) *HighScoreUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *HighScoreUpdate) SetNillableName(v *string) *HighScoreUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetScore sets the "score" field.
+func (_u *HighScoreUpdate) SetScore(v int) *HighScoreUpdate {
+	_u.mutation.ResetScore()
+	_u.mutation.SetScore(v)
+	return _u
+}
+
+// SetNillableScore sets the "score" field if the given value is not nil.
+func (_u *HighScoreUpdate) SetNillableScore(v *int) *HighScoreUpdate {
+	if v != nil {
+		_u.SetScore(*v)
+	}
+	return _u
+}
+
+// AddScore adds value to the "score" field.
+func (_u *HighScoreUpdate) AddScore(v int) *HighScoreUpdate {
+	_u.mutation.AddScore(v)
+	return _u
+}
+
+// SetSeed sets the "seed" field.
+func (_u *HighScoreUpdate) SetSeed(v int64) *HighScoreUpdate {
+	_u.mutation.ResetSeed()
+	_u.mutation.SetSeed(v)
+	return _u
+}
+
+// SetNillableSeed sets the "seed" field if the given value is not nil.
+func (_u *HighScoreUpdate) SetNillableSeed(v *int64) *HighScoreUpdate {
+	if v != nil {
+		_u.SetSeed(*v)
+	}
+	return _u
+}
+
+// AddSeed adds value to the "seed" field.
+func (_u *HighScoreUpdate) AddSeed(v int64) *HighScoreUpdate {
+	_u.mutation.AddSeed(v)
+	return _u
+}
+
+// SetLevel sets the "level" field.
+func (_u *HighScoreUpdate) SetLevel(v int) *HighScoreUpdate {
+	_u.mutation.ResetLevel()
+	_u.mutation.SetLevel(v)
+	return _u
+}
+
+// SetNillableLevel sets the "level" field if the given value is not nil.
+func (_u *HighScoreUpdate) SetNillableLevel(v *int) *HighScoreUpdate {
+	if v != nil {
+		_u.SetLevel(*v)
+	}
+	return _u
+}
+
+// AddLevel adds value to the "level" field.
+func (_u *HighScoreUpdate) AddLevel(v int) *HighScoreUpdate {
+	_u.mutation.AddLevel(v)
+	return _u
+}
+
+// SetTotalLevels sets the "total_levels" field.
+func (_u *HighScoreUpdate) SetTotalLevels(v int) *HighScoreUpdate {
+	_u.mutation.ResetTotalLevels()
+	_u.mutation.SetTotalLevels(v)
+	return _u
+}
+
+// SetNillableTotalLevels sets the "total_levels" field if the given value is not nil.
+func (_u *HighScoreUpdate) SetNillableTotalLevels(v *int) *HighScoreUpdate {
+	if v != nil {
+		_u.SetTotalLevels(*v)
+	}
+	return _u
+}
+
+// AddTotalLevels adds value to the "total_levels" field.
+func (_u *HighScoreUpdate) AddTotalLevels(v int) *HighScoreUpdate {
+	_u.mutation.AddTotalLevels(v)
+	return _u
+}
+
+// SetWon sets the "won" field.
+func (_u *HighScoreUpdate) SetWon(v bool) *HighScoreUpdate {
+	_u.mutation.SetWon(v)
+	return _u
+}
+
+// SetNillableWon sets the "won" field if the given value is not nil.
+func (_u *HighScoreUpdate) SetNillableWon(v *bool) *HighScoreUpdate {
+	if v != nil {
+		_u.SetWon(*v)
+	}
+	return _u
+}
+
+// SetFireRate sets the "fire_rate" field.
+func (_u *HighScoreUpdate) SetFireRate(v float64) *HighScoreUpdate {
+	_u.mutation.ResetFireRate()
+	_u.mutation.SetFireRate(v)
+	return _u
+}
+
+// SetNillableFireRate sets the "fire_rate" field if the given value is not nil.
+func (_u *HighScoreUpdate) SetNillableFireRate(v *float64) *HighScoreUpdate {
+	if v != nil {
+		_u.SetFireRate(*v)
+	}
+	return _u
+}
+
+// AddFireRate adds value to the "fire_rate" field.
+func (_u *HighScoreUpdate) AddFireRate(v float64) *HighScoreUpdate {
+	_u.mutation.AddFireRate(v)
+	return _u
+}
+
+// SetBulletSpeed sets the "bullet_speed" field.
+func (_u *HighScoreUpdate) SetBulletSpeed(v float64) *HighScoreUpdate {
+	_u.mutation.ResetBulletSpeed()
+	_u.mutation.SetBulletSpeed(v)
+	return _u
+}
+
+// SetNillableBulletSpeed sets the "bullet_speed" field if the given value is not nil.
+func (_u *HighScoreUpdate) SetNillableBulletSpeed(v *float64) *HighScoreUpdate {
+	if v != nil {
+		_u.SetBulletSpeed(*v)
+	}
+	return _u
+}
+
+// AddBulletSpeed adds value to the "bullet_speed" field.
+func (_u *HighScoreUpdate) AddBulletSpeed(v float64) *HighScoreUpdate {
+	_u.mutation.AddBulletSpeed(v)
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *HighScoreUpdate) SetCreatedAt(v time.Time) *HighScoreUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *HighScoreUpdate) SetNillableCreatedAt(v *time.Time) *HighScoreUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetProfileID sets the "profile" edge to the Profile entity by ID.
+func (_u *HighScoreUpdate) SetProfileID(id int) *HighScoreUpdate {
+	_u.mutation.SetProfileID(id)
+	return _u
+}
+
+// SetNillableProfileID sets the "profile" edge to the Profile entity by ID if the given value is not nil.
+func (_u *HighScoreUpdate) SetNillableProfileID(id *int) *HighScoreUpdate {
+	if id != nil {
+		_u = _u.SetProfileID(*id)
+	}
+	return _u
+}
+
+// SetProfile sets the "profile" edge to the Profile entity.
+func (_u *HighScoreUpdate) SetProfile(v *Profile) *HighScoreUpdate {
+	return _u.SetProfileID(v.ID)
+}
+
+// Mutation returns the HighScoreMutation object of the builder.
+func (_u *HighScoreUpdate) Mutation() *HighScoreMutation {
+	return _u.mutation
+}
+
+// ClearProfile clears the "profile" edge to the Profile entity.
+func (_u *HighScoreUpdate) ClearProfile() *HighScoreUpdate {
+	_u.mutation.ClearProfile()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *HighScoreUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *HighScoreUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *HighScoreUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *HighScoreUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *HighScoreUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := highscore.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "HighScore.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *HighScoreUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(highscore.Table, highscore.Columns, sqlgraph.NewFieldSpec(highscore.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(highscore.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Score(); ok {
+		_spec.SetField(highscore.FieldScore, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedScore(); ok {
+		_spec.AddField(highscore.FieldScore, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Seed(); ok {
+		_spec.SetField(highscore.FieldSeed, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSeed(); ok {
+		_spec.AddField(highscore.FieldSeed, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Level(); ok {
+		_spec.SetField(highscore.FieldLevel, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLevel(); ok {
+		_spec.AddField(highscore.FieldLevel, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TotalLevels(); ok {
+		_spec.SetField(highscore.FieldTotalLevels, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTotalLevels(); ok {
+		_spec.AddField(highscore.FieldTotalLevels, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Won(); ok {
+		_spec.SetField(highscore.FieldWon, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.FireRate(); ok {
+		_spec.SetField(highscore.FieldFireRate, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedFireRate(); ok {
+		_spec.AddField(highscore.FieldFireRate, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.BulletSpeed(); ok {
+		_spec.SetField(highscore.FieldBulletSpeed, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedBulletSpeed(); ok {
+		_spec.AddField(highscore.FieldBulletSpeed, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(highscore.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ProfileCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   highscore.ProfileTable,
+			Columns: []string{highscore.ProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   highscore.ProfileTable,
+			Columns: []string{highscore.ProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{highscore.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// HighScoreUpdateOne is the builder for updating a single HighScore entity.
+type HighScoreUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *HighScoreMutation
+}
+
+// SetName sets the "name" field.
+func (_u *HighScoreUpdateOne) SetName(v string) *HighScoreUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *HighScoreUpdateOne) SetNillableName(v *string) *HighScoreUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetScore sets the "score" field.
+func (_u *HighScoreUpdateOne) SetScore(v int) *HighScoreUpdateOne {
+	_u.mutation.ResetScore()
+	_u.mutation.SetScore(v)
+	return _u
+}
+
+// SetNillableScore sets the "score" field if the given value is not nil.
+func (_u *HighScoreUpdateOne) SetNillableScore(v *int) *HighScoreUpdateOne {
+	if v != nil {
+		_u.SetScore(*v)
+	}
+	return _u
+}
+
+// AddScore adds value to the "score" field.
+func (_u *HighScoreUpdateOne) AddScore(v int) *HighScoreUpdateOne {
+	_u.mutation.AddScore(v)
+	return _u
+}
+
+// SetSeed sets the "seed" field.
+func (_u *HighScoreUpdateOne) SetSeed(v int64) *HighScoreUpdateOne {
+	_u.mutation.ResetSeed()
+	_u.mutation.SetSeed(v)
+	return _u
+}
+
+// SetNillableSeed sets the "seed" field if the given value is not nil.
+func (_u *HighScoreUpdateOne) SetNillableSeed(v *int64) *HighScoreUpdateOne {
+	if v != nil {
+		_u.SetSeed(*v)
+	}
+	return _u
+}
+
+// AddSeed adds value to the "seed" field.
+func (_u *HighScoreUpdateOne) AddSeed(v int64) *HighScoreUpdateOne {
+	_u.mutation.AddSeed(v)
+	return _u
+}
+
+// SetLevel sets the "level" field.
+func (_u *HighScoreUpdateOne) SetLevel(v int) *HighScoreUpdateOne {
+	_u.mutation.ResetLevel()
+	_u.mutation.SetLevel(v)
+	return _u
+}
+
+// SetNillableLevel sets the "level" field if the given value is not nil.
+func (_u *HighScoreUpdateOne) SetNillableLevel(v *int) *HighScoreUpdateOne {
+	if v != nil {
+		_u.SetLevel(*v)
+	}
+	return _u
+}
+
+// AddLevel adds value to the "level" field.
+func (_u *HighScoreUpdateOne) AddLevel(v int) *HighScoreUpdateOne {
+	_u.mutation.AddLevel(v)
+	return _u
+}
+
+// SetTotalLevels sets the "total_levels" field.
+func (_u *HighScoreUpdateOne) SetTotalLevels(v int) *HighScoreUpdateOne {
+	_u.mutation.ResetTotalLevels()
+	_u.mutation.SetTotalLevels(v)
+	return _u
+}
+
+// SetNillableTotalLevels sets the "total_levels" field if the given value is not nil.
+func (_u *HighScoreUpdateOne) SetNillableTotalLevels(v *int) *HighScoreUpdateOne {
+	if v != nil {
+		_u.SetTotalLevels(*v)
+	}
+	return _u
+}
+
+// AddTotalLevels adds value to the "total_levels" field.
+func (_u *HighScoreUpdateOne) AddTotalLevels(v int) *HighScoreUpdateOne {
+	_u.mutation.AddTotalLevels(v)
+	return _u
+}
+
+// SetWon sets the "won" field.
+func (_u *HighScoreUpdateOne) SetWon(v bool) *HighScoreUpdateOne {
+	_u.mutation.SetWon(v)
+	return _u
+}
+
+// SetNillableWon sets the "won" field if the given value is not nil.
+func (_u *HighScoreUpdateOne) SetNillableWon(v *bool) *HighScoreUpdateOne {
+	if v != nil {
+		_u.SetWon(*v)
+	}
+	return _u
+}
+
+// SetFireRate sets the "fire_rate" field.
+func (_u *HighScoreUpdateOne) SetFireRate(v float64) *HighScoreUpdateOne {
+	_u.mutation.ResetFireRate()
+	_u.mutation.SetFireRate(v)
+	return _u
+}
+
+// SetNillableFireRate sets the "fire_rate" field if the given value is not nil.
+func (_u *HighScoreUpdateOne) SetNillableFireRate(v *float64) *HighScoreUpdateOne {
+	if v != nil {
+		_u.SetFireRate(*v)
+	}
+	return _u
+}
+
+// AddFireRate adds value to the "fire_rate" field.
+func (_u *HighScoreUpdateOne) AddFireRate(v float64) *HighScoreUpdateOne {
+	_u.mutation.AddFireRate(v)
+	return _u
+}
+
+// SetBulletSpeed sets the "bullet_speed" field.
+func (_u *HighScoreUpdateOne) SetBulletSpeed(v float64) *HighScoreUpdateOne {
+	_u.mutation.ResetBulletSpeed()
+	_u.mutation.SetBulletSpeed(v)
+	return _u
+}
+
+// SetNillableBulletSpeed sets the "bullet_speed" field if the given value is not nil.
+func (_u *HighScoreUpdateOne) SetNillableBulletSpeed(v *float64) *HighScoreUpdateOne {
+	if v != nil {
+		_u.SetBulletSpeed(*v)
+	}
+	return _u
+}
+
+// AddBulletSpeed adds value to the "bullet_speed" field.
+func (_u *HighScoreUpdateOne) AddBulletSpeed(v float64) *HighScoreUpdateOne {
+	_u.mutation.AddBulletSpeed(v)
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *HighScoreUpdateOne) SetCreatedAt(v time.Time) *HighScoreUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *HighScoreUpdateOne) SetNillableCreatedAt(v *time.Time) *HighScoreUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetProfileID sets the "profile" edge to the Profile entity by ID.
+func (_u *HighScoreUpdateOne) SetProfileID(id int) *HighScoreUpdateOne {
+	_u.mutation.SetProfileID(id)
+	return _u
+}
+
+// SetNillableProfileID sets the "profile" edge to the Profile entity by ID if the given value is not nil.
+func (_u *HighScoreUpdateOne) SetNillableProfileID(id *int) *HighScoreUpdateOne {
+	if id != nil {
+		_u = _u.SetProfileID(*id)
+	}
+	return _u
+}
+
+// SetProfile sets the "profile" edge to the Profile entity.
+func (_u *HighScoreUpdateOne) SetProfile(v *Profile) *HighScoreUpdateOne {
+	return _u.SetProfileID(v.ID)
+}
+
+// Mutation returns the HighScoreMutation object of the builder.
+func (_u *HighScoreUpdateOne) Mutation() *HighScoreMutation {
+	return _u.mutation
+}
+
+// ClearProfile clears the "profile" edge to the Profile entity.
+func (_u *HighScoreUpdateOne) ClearProfile() *HighScoreUpdateOne {
+	_u.mutation.ClearProfile()
+	return _u
+}
+
+// Where appends a list predicates to the HighScoreUpdate builder.
+func (_u *HighScoreUpdateOne) Where(ps ...predicate.HighScore) *HighScoreUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *HighScoreUpdateOne) Select(field string, fields ...string) *HighScoreUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated HighScore entity.
+func (_u *HighScoreUpdateOne) Save(ctx context.Context) (*HighScore, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *HighScoreUpdateOne) SaveX(ctx context.Context) *HighScore {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *HighScoreUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *HighScoreUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *HighScoreUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := highscore.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "HighScore.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *HighScoreUpdateOne) sqlSave(ctx context.Context) (_node *HighScore, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(highscore.Table, highscore.Columns, sqlgraph.NewFieldSpec(highscore.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "HighScore.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, highscore.FieldID)
+		for _, f := range fields {
+			if !highscore.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != highscore.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(highscore.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Score(); ok {
+		_spec.SetField(highscore.FieldScore, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedScore(); ok {
+		_spec.AddField(highscore.FieldScore, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Seed(); ok {
+		_spec.SetField(highscore.FieldSeed, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSeed(); ok {
+		_spec.AddField(highscore.FieldSeed, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Level(); ok {
+		_spec.SetField(highscore.FieldLevel, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLevel(); ok {
+		_spec.AddField(highscore.FieldLevel, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TotalLevels(); ok {
+		_spec.SetField(highscore.FieldTotalLevels, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTotalLevels(); ok {
+		_spec.AddField(highscore.FieldTotalLevels, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Won(); ok {
+		_spec.SetField(highscore.FieldWon, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.FireRate(); ok {
+		_spec.SetField(highscore.FieldFireRate, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedFireRate(); ok {
+		_spec.AddField(highscore.FieldFireRate, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.BulletSpeed(); ok {
+		_spec.SetField(highscore.FieldBulletSpeed, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedBulletSpeed(); ok {
+		_spec.AddField(highscore.FieldBulletSpeed, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(highscore.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ProfileCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   highscore.ProfileTable,
+			Columns: []string{highscore.ProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   highscore.ProfileTable,
+			Columns: []string{highscore.ProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &HighScore{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{highscore.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
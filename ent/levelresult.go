@@ -0,0 +1,283 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"doomlike/ent/levelresult"
+	"doomlike/ent/run"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// LevelResult is the model entity for the LevelResult schema.
+type LevelResult struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Level number within the run
+	Level int `json:"level,omitempty"`
+	// Name of the authored map, empty for generated levels
+	MapName string `json:"map_name,omitempty"`
+	// Whether the level was cleared rather than died on or quit
+	Cleared bool `json:"cleared,omitempty"`
+	// Enemies killed by type name
+	Kills map[string]int `json:"kills,omitempty"`
+	// Enemies the level started with
+	EnemyTotal int `json:"enemy_total,omitempty"`
+	// Rounds the player fired
+	Shots int `json:"shots,omitempty"`
+	// Rounds that struck an enemy
+	Hits int `json:"hits,omitempty"`
+	// Damage done to enemies
+	DamageDealt int `json:"damage_dealt,omitempty"`
+	// Health the player lost
+	DamageTaken int `json:"damage_taken,omitempty"`
+	// Pickups collected, keycards and enemy drops included
+	Pickups int `json:"pickups,omitempty"`
+	// Seconds spent on the level
+	Time float64 `json:"time,omitempty"`
+	// Par time of the level in seconds
+	Par float64 `json:"par,omitempty"`
+	// Run score when the level ended
+	Score int `json:"score,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the LevelResultQuery when eager-loading is set.
+	Edges        LevelResultEdges `json:"edges"`
+	run_levels   *int
+	selectValues sql.SelectValues
+}
+
+// LevelResultEdges holds the relations/edges for other nodes in the graph.
+type LevelResultEdges struct {
+	// Run the level was played in
+	Run *Run `json:"run,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// RunOrErr returns the Run value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LevelResultEdges) RunOrErr() (*Run, error) {
+	if e.Run != nil {
+		return e.Run, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: run.Label}
+	}
+	return nil, &NotLoadedError{edge: "run"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*LevelResult) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case levelresult.FieldKills:
+			values[i] = new([]byte)
+		case levelresult.FieldCleared:
+			values[i] = new(sql.NullBool)
+		case levelresult.FieldTime, levelresult.FieldPar:
+			values[i] = new(sql.NullFloat64)
+		case levelresult.FieldID, levelresult.FieldLevel, levelresult.FieldEnemyTotal, levelresult.FieldShots, levelresult.FieldHits, levelresult.FieldDamageDealt, levelresult.FieldDamageTaken, levelresult.FieldPickups, levelresult.FieldScore:
+			values[i] = new(sql.NullInt64)
+		case levelresult.FieldMapName:
+			values[i] = new(sql.NullString)
+		case levelresult.ForeignKeys[0]: // run_levels
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the LevelResult fields.
+func (_m *LevelResult) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case levelresult.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case levelresult.FieldLevel:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field level", values[i])
+			} else if value.Valid {
+				_m.Level = int(value.Int64)
+			}
+		case levelresult.FieldMapName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field map_name", values[i])
+			} else if value.Valid {
+				_m.MapName = value.String
+			}
+		case levelresult.FieldCleared:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field cleared", values[i])
+			} else if value.Valid {
+				_m.Cleared = value.Bool
+			}
+		case levelresult.FieldKills:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field kills", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Kills); err != nil {
+					return fmt.Errorf("unmarshal field kills: %w", err)
+				}
+			}
+		case levelresult.FieldEnemyTotal:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field enemy_total", values[i])
+			} else if value.Valid {
+				_m.EnemyTotal = int(value.Int64)
+			}
+		case levelresult.FieldShots:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field shots", values[i])
+			} else if value.Valid {
+				_m.Shots = int(value.Int64)
+			}
+		case levelresult.FieldHits:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field hits", values[i])
+			} else if value.Valid {
+				_m.Hits = int(value.Int64)
+			}
+		case levelresult.FieldDamageDealt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field damage_dealt", values[i])
+			} else if value.Valid {
+				_m.DamageDealt = int(value.Int64)
+			}
+		case levelresult.FieldDamageTaken:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field damage_taken", values[i])
+			} else if value.Valid {
+				_m.DamageTaken = int(value.Int64)
+			}
+		case levelresult.FieldPickups:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field pickups", values[i])
+			} else if value.Valid {
+				_m.Pickups = int(value.Int64)
+			}
+		case levelresult.FieldTime:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field time", values[i])
+			} else if value.Valid {
+				_m.Time = value.Float64
+			}
+		case levelresult.FieldPar:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field par", values[i])
+			} else if value.Valid {
+				_m.Par = value.Float64
+			}
+		case levelresult.FieldScore:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field score", values[i])
+			} else if value.Valid {
+				_m.Score = int(value.Int64)
+			}
+		case levelresult.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field run_levels", value)
+			} else if value.Valid {
+				_m.run_levels = new(int)
+				*_m.run_levels = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the LevelResult.
+// This includes values selected through modifiers, order, etc.
+func (_m *LevelResult) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryRun queries the "run" edge of the LevelResult entity.
+func (_m *LevelResult) QueryRun() *RunQuery {
+	return NewLevelResultClient(_m.config).QueryRun(_m)
+}
+
+// Update returns a builder for updating this LevelResult.
+// Note that you need to call LevelResult.Unwrap() before calling this method if this LevelResult
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *LevelResult) Update() *LevelResultUpdateOne {
+	return NewLevelResultClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the LevelResult entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *LevelResult) Unwrap() *LevelResult {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: LevelResult is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *LevelResult) String() string {
+	var builder strings.Builder
+	builder.WriteString("LevelResult(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("level=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Level))
+	builder.WriteString(", ")
+	builder.WriteString("map_name=")
+	builder.WriteString(_m.MapName)
+	builder.WriteString(", ")
+	builder.WriteString("cleared=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Cleared))
+	builder.WriteString(", ")
+	builder.WriteString("kills=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Kills))
+	builder.WriteString(", ")
+	builder.WriteString("enemy_total=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EnemyTotal))
+	builder.WriteString(", ")
+	builder.WriteString("shots=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Shots))
+	builder.WriteString(", ")
+	builder.WriteString("hits=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Hits))
+	builder.WriteString(", ")
+	builder.WriteString("damage_dealt=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DamageDealt))
+	builder.WriteString(", ")
+	builder.WriteString("damage_taken=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DamageTaken))
+	builder.WriteString(", ")
+	builder.WriteString("pickups=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Pickups))
+	builder.WriteString(", ")
+	builder.WriteString("time=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Time))
+	builder.WriteString(", ")
+	builder.WriteString("par=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Par))
+	builder.WriteString(", ")
+	builder.WriteString("score=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Score))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// LevelResults is a parsable slice of LevelResult.
+type LevelResults []*LevelResult
@@ -0,0 +1,180 @@
+// Code generated by ent, DO NOT EDIT.
+
+package levelresult
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the levelresult type in the database.
+	Label = "level_result"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldLevel holds the string denoting the level field in the database.
+	FieldLevel = "level"
+	// FieldMapName holds the string denoting the map_name field in the database.
+	FieldMapName = "map_name"
+	// FieldCleared holds the string denoting the cleared field in the database.
+	FieldCleared = "cleared"
+	// FieldKills holds the string denoting the kills field in the database.
+	FieldKills = "kills"
+	// FieldEnemyTotal holds the string denoting the enemy_total field in the database.
+	FieldEnemyTotal = "enemy_total"
+	// FieldShots holds the string denoting the shots field in the database.
+	FieldShots = "shots"
+	// FieldHits holds the string denoting the hits field in the database.
+	FieldHits = "hits"
+	// FieldDamageDealt holds the string denoting the damage_dealt field in the database.
+	FieldDamageDealt = "damage_dealt"
+	// FieldDamageTaken holds the string denoting the damage_taken field in the database.
+	FieldDamageTaken = "damage_taken"
+	// FieldPickups holds the string denoting the pickups field in the database.
+	FieldPickups = "pickups"
+	// FieldTime holds the string denoting the time field in the database.
+	FieldTime = "time"
+	// FieldPar holds the string denoting the par field in the database.
+	FieldPar = "par"
+	// FieldScore holds the string denoting the score field in the database.
+	FieldScore = "score"
+	// EdgeRun holds the string denoting the run edge name in mutations.
+	EdgeRun = "run"
+	// Table holds the table name of the levelresult in the database.
+	Table = "level_results"
+	// RunTable is the table that holds the run relation/edge.
+	RunTable = "level_results"
+	// RunInverseTable is the table name for the Run entity.
+	// It exists in this package in order to avoid circular dependency with the "run" package.
+	RunInverseTable = "runs"
+	// RunColumn is the table column denoting the run relation/edge.
+	RunColumn = "run_levels"
+)
+
+// Columns holds all SQL columns for levelresult fields.
+var Columns = []string{
+	FieldID,
+	FieldLevel,
+	FieldMapName,
+	FieldCleared,
+	FieldKills,
+	FieldEnemyTotal,
+	FieldShots,
+	FieldHits,
+	FieldDamageDealt,
+	FieldDamageTaken,
+	FieldPickups,
+	FieldTime,
+	FieldPar,
+	FieldScore,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "level_results"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"run_levels",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultMapName holds the default value on creation for the "map_name" field.
+	DefaultMapName string
+	// DefaultPar holds the default value on creation for the "par" field.
+	DefaultPar float64
+)
+
+// OrderOption defines the ordering options for the LevelResult queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByLevel orders the results by the level field.
+func ByLevel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLevel, opts...).ToFunc()
+}
+
+// ByMapName orders the results by the map_name field.
+func ByMapName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMapName, opts...).ToFunc()
+}
+
+// ByCleared orders the results by the cleared field.
+func ByCleared(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCleared, opts...).ToFunc()
+}
+
+// ByEnemyTotal orders the results by the enemy_total field.
+func ByEnemyTotal(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnemyTotal, opts...).ToFunc()
+}
+
+// ByShots orders the results by the shots field.
+func ByShots(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldShots, opts...).ToFunc()
+}
+
+// ByHits orders the results by the hits field.
+func ByHits(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHits, opts...).ToFunc()
+}
+
+// ByDamageDealt orders the results by the damage_dealt field.
+func ByDamageDealt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDamageDealt, opts...).ToFunc()
+}
+
+// ByDamageTaken orders the results by the damage_taken field.
+func ByDamageTaken(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDamageTaken, opts...).ToFunc()
+}
+
+// ByPickups orders the results by the pickups field.
+func ByPickups(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPickups, opts...).ToFunc()
+}
+
+// ByTime orders the results by the time field.
+func ByTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTime, opts...).ToFunc()
+}
+
+// ByPar orders the results by the par field.
+func ByPar(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPar, opts...).ToFunc()
+}
+
+// ByScore orders the results by the score field.
+func ByScore(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScore, opts...).ToFunc()
+}
+
+// ByRunField orders the results by run field.
+func ByRunField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRunStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newRunStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RunInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, RunTable, RunColumn),
+	)
+}
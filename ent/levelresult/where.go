@@ -0,0 +1,628 @@
+// Code generated by ent, DO NOT EDIT.
+
+package levelresult
+
+import (
+	"doomlike/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldLTE(FieldID, id))
+}
+
+// Level applies equality check predicate on the "level" field. It's identical to LevelEQ.
+func Level(v int) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldEQ(FieldLevel, v))
+}
+
+// MapName applies equality check predicate on the "map_name" field. It's identical to MapNameEQ.
+func MapName(v string) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldEQ(FieldMapName, v))
+}
+
+// Cleared applies equality check predicate on the "cleared" field. It's identical to ClearedEQ.
+func Cleared(v bool) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldEQ(FieldCleared, v))
+}
+
+// EnemyTotal applies equality check predicate on the "enemy_total" field. It's identical to EnemyTotalEQ.
+func EnemyTotal(v int) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldEQ(FieldEnemyTotal, v))
+}
+
+// Shots applies equality check predicate on the "shots" field. It's identical to ShotsEQ.
+func Shots(v int) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldEQ(FieldShots, v))
+}
+
+// Hits applies equality check predicate on the "hits" field. It's identical to HitsEQ.
+func Hits(v int) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldEQ(FieldHits, v))
+}
+
+// DamageDealt applies equality check predicate on the "damage_dealt" field. It's identical to DamageDealtEQ.
+func DamageDealt(v int) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldEQ(FieldDamageDealt, v))
+}
+
+// DamageTaken applies equality check predicate on the "damage_taken" field. It's identical to DamageTakenEQ.
+func DamageTaken(v int) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldEQ(FieldDamageTaken, v))
+}
+
+// Pickups applies equality check predicate on the "pickups" field. It's identical to PickupsEQ.
+func Pickups(v int) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldEQ(FieldPickups, v))
+}
+
+// Time applies equality check predicate on the "time" field. It's identical to TimeEQ.
+func Time(v float64) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldEQ(FieldTime, v))
+}
+
+// Par applies equality check predicate on the "par" field. It's identical to ParEQ.
+func Par(v float64) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldEQ(FieldPar, v))
+}
+
+// Score applies equality check predicate on the "score" field. It's identical to ScoreEQ.
+func Score(v int) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldEQ(FieldScore, v))
+}
+
+// LevelEQ applies the EQ predicate on the "level" field.
+func LevelEQ(v int) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldEQ(FieldLevel, v))
+}
+
+// LevelNEQ applies the NEQ predicate on the "level" field.
+func LevelNEQ(v int) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldNEQ(FieldLevel, v))
+}
+
+// LevelIn applies the In predicate on the "level" field.
+func LevelIn(vs ...int) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldIn(FieldLevel, vs...))
+}
+
+// LevelNotIn applies the NotIn predicate on the "level" field.
+func LevelNotIn(vs ...int) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldNotIn(FieldLevel, vs...))
+}
+
+// LevelGT applies the GT predicate on the "level" field.
+func LevelGT(v int) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldGT(FieldLevel, v))
+}
+
+// LevelGTE applies the GTE predicate on the "level" field.
+func LevelGTE(v int) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldGTE(FieldLevel, v))
+}
+
+// LevelLT applies the LT predicate on the "level" field.
+func LevelLT(v int) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldLT(FieldLevel, v))
+}
+
+// LevelLTE applies the LTE predicate on the "level" field.
+func LevelLTE(v int) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldLTE(FieldLevel, v))
+}
+
+// MapNameEQ applies the EQ predicate on the "map_name" field.
+func MapNameEQ(v string) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldEQ(FieldMapName, v))
+}
+
+// MapNameNEQ applies the NEQ predicate on the "map_name" field.
+func MapNameNEQ(v string) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldNEQ(FieldMapName, v))
+}
+
+// MapNameIn applies the In predicate on the "map_name" field.
+func MapNameIn(vs ...string) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldIn(FieldMapName, vs...))
+}
+
+// MapNameNotIn applies the NotIn predicate on the "map_name" field.
+func MapNameNotIn(vs ...string) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldNotIn(FieldMapName, vs...))
+}
+
+// MapNameGT applies the GT predicate on the "map_name" field.
+func MapNameGT(v string) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldGT(FieldMapName, v))
+}
+
+// MapNameGTE applies the GTE predicate on the "map_name" field.
+func MapNameGTE(v string) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldGTE(FieldMapName, v))
+}
+
+// MapNameLT applies the LT predicate on the "map_name" field.
+func MapNameLT(v string) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldLT(FieldMapName, v))
+}
+
+// MapNameLTE applies the LTE predicate on the "map_name" field.
+func MapNameLTE(v string) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldLTE(FieldMapName, v))
+}
+
+// MapNameContains applies the Contains predicate on the "map_name" field.
+func MapNameContains(v string) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldContains(FieldMapName, v))
+}
+
+// MapNameHasPrefix applies the HasPrefix predicate on the "map_name" field.
+func MapNameHasPrefix(v string) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldHasPrefix(FieldMapName, v))
+}
+
+// MapNameHasSuffix applies the HasSuffix predicate on the "map_name" field.
+func MapNameHasSuffix(v string) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldHasSuffix(FieldMapName, v))
+}
+
+// MapNameEqualFold applies the EqualFold predicate on the "map_name" field.
+func MapNameEqualFold(v string) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldEqualFold(FieldMapName, v))
+}
+
+// MapNameContainsFold applies the ContainsFold predicate on the "map_name" field.
+func MapNameContainsFold(v string) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldContainsFold(FieldMapName, v))
+}
+
+// ClearedEQ applies the EQ predicate on the "cleared" field.
+func ClearedEQ(v bool) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldEQ(FieldCleared, v))
+}
+
+// ClearedNEQ applies the NEQ predicate on the "cleared" field.
+func ClearedNEQ(v bool) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldNEQ(FieldCleared, v))
+}
+
+// EnemyTotalEQ applies the EQ predicate on the "enemy_total" field.
+func EnemyTotalEQ(v int) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldEQ(FieldEnemyTotal, v))
+}
+
+// EnemyTotalNEQ applies the NEQ predicate on the "enemy_total" field.
+func EnemyTotalNEQ(v int) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldNEQ(FieldEnemyTotal, v))
+}
+
+// EnemyTotalIn applies the In predicate on the "enemy_total" field.
+func EnemyTotalIn(vs ...int) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldIn(FieldEnemyTotal, vs...))
+}
+
+// EnemyTotalNotIn applies the NotIn predicate on the "enemy_total" field.
+func EnemyTotalNotIn(vs ...int) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldNotIn(FieldEnemyTotal, vs...))
+}
+
+// EnemyTotalGT applies the GT predicate on the "enemy_total" field.
+func EnemyTotalGT(v int) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldGT(FieldEnemyTotal, v))
+}
+
+// EnemyTotalGTE applies the GTE predicate on the "enemy_total" field.
+func EnemyTotalGTE(v int) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldGTE(FieldEnemyTotal, v))
+}
+
+// EnemyTotalLT applies the LT predicate on the "enemy_total" field.
+func EnemyTotalLT(v int) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldLT(FieldEnemyTotal, v))
+}
+
+// EnemyTotalLTE applies the LTE predicate on the "enemy_total" field.
+func EnemyTotalLTE(v int) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldLTE(FieldEnemyTotal, v))
+}
+
+// ShotsEQ applies the EQ predicate on the "shots" field.
+func ShotsEQ(v int) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldEQ(FieldShots, v))
+}
+
+// ShotsNEQ applies the NEQ predicate on the "shots" field.
+func ShotsNEQ(v int) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldNEQ(FieldShots, v))
+}
+
+// ShotsIn applies the In predicate on the "shots" field.
+func ShotsIn(vs ...int) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldIn(FieldShots, vs...))
+}
+
+// ShotsNotIn applies the NotIn predicate on the "shots" field.
+func ShotsNotIn(vs ...int) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldNotIn(FieldShots, vs...))
+}
+
+// ShotsGT applies the GT predicate on the "shots" field.
+func ShotsGT(v int) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldGT(FieldShots, v))
+}
+
+// ShotsGTE applies the GTE predicate on the "shots" field.
+func ShotsGTE(v int) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldGTE(FieldShots, v))
+}
+
+// ShotsLT applies the LT predicate on the "shots" field.
+func ShotsLT(v int) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldLT(FieldShots, v))
+}
+
+// ShotsLTE applies the LTE predicate on the "shots" field.
+func ShotsLTE(v int) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldLTE(FieldShots, v))
+}
+
+// HitsEQ applies the EQ predicate on the "hits" field.
+func HitsEQ(v int) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldEQ(FieldHits, v))
+}
+
+// HitsNEQ applies the NEQ predicate on the "hits" field.
+func HitsNEQ(v int) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldNEQ(FieldHits, v))
+}
+
+// HitsIn applies the In predicate on the "hits" field.
+func HitsIn(vs ...int) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldIn(FieldHits, vs...))
+}
+
+// HitsNotIn applies the NotIn predicate on the "hits" field.
+func HitsNotIn(vs ...int) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldNotIn(FieldHits, vs...))
+}
+
+// HitsGT applies the GT predicate on the "hits" field.
+func HitsGT(v int) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldGT(FieldHits, v))
+}
+
+// HitsGTE applies the GTE predicate on the "hits" field.
+func HitsGTE(v int) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldGTE(FieldHits, v))
+}
+
+// HitsLT applies the LT predicate on the "hits" field.
+func HitsLT(v int) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldLT(FieldHits, v))
+}
+
+// HitsLTE applies the LTE predicate on the "hits" field.
+func HitsLTE(v int) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldLTE(FieldHits, v))
+}
+
+// DamageDealtEQ applies the EQ predicate on the "damage_dealt" field.
+func DamageDealtEQ(v int) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldEQ(FieldDamageDealt, v))
+}
+
+// DamageDealtNEQ applies the NEQ predicate on the "damage_dealt" field.
+func DamageDealtNEQ(v int) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldNEQ(FieldDamageDealt, v))
+}
+
+// DamageDealtIn applies the In predicate on the "damage_dealt" field.
+func DamageDealtIn(vs ...int) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldIn(FieldDamageDealt, vs...))
+}
+
+// DamageDealtNotIn applies the NotIn predicate on the "damage_dealt" field.
+func DamageDealtNotIn(vs ...int) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldNotIn(FieldDamageDealt, vs...))
+}
+
+// DamageDealtGT applies the GT predicate on the "damage_dealt" field.
+func DamageDealtGT(v int) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldGT(FieldDamageDealt, v))
+}
+
+// DamageDealtGTE applies the GTE predicate on the "damage_dealt" field.
+func DamageDealtGTE(v int) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldGTE(FieldDamageDealt, v))
+}
+
+// DamageDealtLT applies the LT predicate on the "damage_dealt" field.
+func DamageDealtLT(v int) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldLT(FieldDamageDealt, v))
+}
+
+// DamageDealtLTE applies the LTE predicate on the "damage_dealt" field.
+func DamageDealtLTE(v int) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldLTE(FieldDamageDealt, v))
+}
+
+// DamageTakenEQ applies the EQ predicate on the "damage_taken" field.
+func DamageTakenEQ(v int) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldEQ(FieldDamageTaken, v))
+}
+
+// DamageTakenNEQ applies the NEQ predicate on the "damage_taken" field.
+func DamageTakenNEQ(v int) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldNEQ(FieldDamageTaken, v))
+}
+
+// DamageTakenIn applies the In predicate on the "damage_taken" field.
+func DamageTakenIn(vs ...int) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldIn(FieldDamageTaken, vs...))
+}
+
+// DamageTakenNotIn applies the NotIn predicate on the "damage_taken" field.
+func DamageTakenNotIn(vs ...int) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldNotIn(FieldDamageTaken, vs...))
+}
+
+// DamageTakenGT applies the GT predicate on the "damage_taken" field.
+func DamageTakenGT(v int) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldGT(FieldDamageTaken, v))
+}
+
+// DamageTakenGTE applies the GTE predicate on the "damage_taken" field.
+func DamageTakenGTE(v int) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldGTE(FieldDamageTaken, v))
+}
+
+// DamageTakenLT applies the LT predicate on the "damage_taken" field.
+func DamageTakenLT(v int) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldLT(FieldDamageTaken, v))
+}
+
+// DamageTakenLTE applies the LTE predicate on the "damage_taken" field.
+func DamageTakenLTE(v int) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldLTE(FieldDamageTaken, v))
+}
+
+// PickupsEQ applies the EQ predicate on the "pickups" field.
+func PickupsEQ(v int) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldEQ(FieldPickups, v))
+}
+
+// PickupsNEQ applies the NEQ predicate on the "pickups" field.
+func PickupsNEQ(v int) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldNEQ(FieldPickups, v))
+}
+
+// PickupsIn applies the In predicate on the "pickups" field.
+func PickupsIn(vs ...int) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldIn(FieldPickups, vs...))
+}
+
+// PickupsNotIn applies the NotIn predicate on the "pickups" field.
+func PickupsNotIn(vs ...int) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldNotIn(FieldPickups, vs...))
+}
+
+// PickupsGT applies the GT predicate on the "pickups" field.
+func PickupsGT(v int) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldGT(FieldPickups, v))
+}
+
+// PickupsGTE applies the GTE predicate on the "pickups" field.
+func PickupsGTE(v int) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldGTE(FieldPickups, v))
+}
+
+// PickupsLT applies the LT predicate on the "pickups" field.
+func PickupsLT(v int) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldLT(FieldPickups, v))
+}
+
+// PickupsLTE applies the LTE predicate on the "pickups" field.
+func PickupsLTE(v int) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldLTE(FieldPickups, v))
+}
+
+// TimeEQ applies the EQ predicate on the "time" field.
+func TimeEQ(v float64) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldEQ(FieldTime, v))
+}
+
+// TimeNEQ applies the NEQ predicate on the "time" field.
+func TimeNEQ(v float64) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldNEQ(FieldTime, v))
+}
+
+// TimeIn applies the In predicate on the "time" field.
+func TimeIn(vs ...float64) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldIn(FieldTime, vs...))
+}
+
+// TimeNotIn applies the NotIn predicate on the "time" field.
+func TimeNotIn(vs ...float64) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldNotIn(FieldTime, vs...))
+}
+
+// TimeGT applies the GT predicate on the "time" field.
+func TimeGT(v float64) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldGT(FieldTime, v))
+}
+
+// TimeGTE applies the GTE predicate on the "time" field.
+func TimeGTE(v float64) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldGTE(FieldTime, v))
+}
+
+// TimeLT applies the LT predicate on the "time" field.
+func TimeLT(v float64) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldLT(FieldTime, v))
+}
+
+// TimeLTE applies the LTE predicate on the "time" field.
+func TimeLTE(v float64) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldLTE(FieldTime, v))
+}
+
+// ParEQ applies the EQ predicate on the "par" field.
+func ParEQ(v float64) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldEQ(FieldPar, v))
+}
+
+// ParNEQ applies the NEQ predicate on the "par" field.
+func ParNEQ(v float64) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldNEQ(FieldPar, v))
+}
+
+// ParIn applies the In predicate on the "par" field.
+func ParIn(vs ...float64) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldIn(FieldPar, vs...))
+}
+
+// ParNotIn applies the NotIn predicate on the "par" field.
+func ParNotIn(vs ...float64) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldNotIn(FieldPar, vs...))
+}
+
+// ParGT applies the GT predicate on the "par" field.
+func ParGT(v float64) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldGT(FieldPar, v))
+}
+
+// ParGTE applies the GTE predicate on the "par" field.
+func ParGTE(v float64) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldGTE(FieldPar, v))
+}
+
+// ParLT applies the LT predicate on the "par" field.
+func ParLT(v float64) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldLT(FieldPar, v))
+}
+
+// ParLTE applies the LTE predicate on the "par" field.
+func ParLTE(v float64) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldLTE(FieldPar, v))
+}
+
+// ScoreEQ applies the EQ predicate on the "score" field.
+func ScoreEQ(v int) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldEQ(FieldScore, v))
+}
+
+// ScoreNEQ applies the NEQ predicate on the "score" field.
+func ScoreNEQ(v int) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldNEQ(FieldScore, v))
+}
+
+// ScoreIn applies the In predicate on the "score" field.
+func ScoreIn(vs ...int) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldIn(FieldScore, vs...))
+}
+
+// ScoreNotIn applies the NotIn predicate on the "score" field.
+func ScoreNotIn(vs ...int) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldNotIn(FieldScore, vs...))
+}
+
+// ScoreGT applies the GT predicate on the "score" field.
+func ScoreGT(v int) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldGT(FieldScore, v))
+}
+
+// ScoreGTE applies the GTE predicate on the "score" field.
+func ScoreGTE(v int) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldGTE(FieldScore, v))
+}
+
+// ScoreLT applies the LT predicate on the "score" field.
+func ScoreLT(v int) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldLT(FieldScore, v))
+}
+
+// ScoreLTE applies the LTE predicate on the "score" field.
+func ScoreLTE(v int) predicate.LevelResult {
+	return predicate.LevelResult(sql.FieldLTE(FieldScore, v))
+}
+
+// HasRun applies the HasEdge predicate on the "run" edge.
+func HasRun() predicate.LevelResult {
+	return predicate.LevelResult(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, RunTable, RunColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRunWith applies the HasEdge predicate on the "run" edge with a given conditions (other predicates).
+func HasRunWith(preds ...predicate.Run) predicate.LevelResult {
+	return predicate.LevelResult(func(s *sql.Selector) {
+		step := newRunStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.LevelResult) predicate.LevelResult {
+	return predicate.LevelResult(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.LevelResult) predicate.LevelResult {
+	return predicate.LevelResult(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.LevelResult) predicate.LevelResult {
+	return predicate.LevelResult(sql.NotPredicates(p))
+}
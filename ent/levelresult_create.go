@@ -0,0 +1,401 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"doomlike/ent/levelresult"
+	"doomlike/ent/run"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// LevelResultCreate is the builder for creating a LevelResult entity.
+type LevelResultCreate struct {
+	config
+	mutation *LevelResultMutation
+	hooks    []Hook
+}
+
+// SetLevel sets the "level" field.
+func (_c *LevelResultCreate) SetLevel(v int) *LevelResultCreate {
+	_c.mutation.SetLevel(v)
+	return _c
+}
+
+// SetMapName sets the "map_name" field.
+func (_c *LevelResultCreate) SetMapName(v string) *LevelResultCreate {
+	_c.mutation.SetMapName(v)
+	return _c
+}
+
+// SetNillableMapName sets the "map_name" field if the given value is not nil.
+func (_c *LevelResultCreate) SetNillableMapName(v *string) *LevelResultCreate {
+	if v != nil {
+		_c.SetMapName(*v)
+	}
+	return _c
+}
+
+// SetCleared sets the "cleared" field.
+func (_c *LevelResultCreate) SetCleared(v bool) *LevelResultCreate {
+	_c.mutation.SetCleared(v)
+	return _c
+}
+
+// SetKills sets the "kills" field.
+func (_c *LevelResultCreate) SetKills(v map[string]int) *LevelResultCreate {
+	_c.mutation.SetKills(v)
+	return _c
+}
+
+// SetEnemyTotal sets the "enemy_total" field.
+func (_c *LevelResultCreate) SetEnemyTotal(v int) *LevelResultCreate {
+	_c.mutation.SetEnemyTotal(v)
+	return _c
+}
+
+// SetShots sets the "shots" field.
+func (_c *LevelResultCreate) SetShots(v int) *LevelResultCreate {
+	_c.mutation.SetShots(v)
+	return _c
+}
+
+// SetHits sets the "hits" field.
+func (_c *LevelResultCreate) SetHits(v int) *LevelResultCreate {
+	_c.mutation.SetHits(v)
+	return _c
+}
+
+// SetDamageDealt sets the "damage_dealt" field.
+func (_c *LevelResultCreate) SetDamageDealt(v int) *LevelResultCreate {
+	_c.mutation.SetDamageDealt(v)
+	return _c
+}
+
+// SetDamageTaken sets the "damage_taken" field.
+func (_c *LevelResultCreate) SetDamageTaken(v int) *LevelResultCreate {
+	_c.mutation.SetDamageTaken(v)
+	return _c
+}
+
+// SetPickups sets the "pickups" field.
+func (_c *LevelResultCreate) SetPickups(v int) *LevelResultCreate {
+	_c.mutation.SetPickups(v)
+	return _c
+}
+
+// SetTime sets the "time" field.
+func (_c *LevelResultCreate) SetTime(v float64) *LevelResultCreate {
+	_c.mutation.SetTime(v)
+	return _c
+}
+
+// SetPar sets the "par" field.
+func (_c *LevelResultCreate) SetPar(v float64) *LevelResultCreate {
+	_c.mutation.SetPar(v)
+	return _c
+}
+
+// SetNillablePar sets the "par" field if the given value is not nil.
+func (_c *LevelResultCreate) SetNillablePar(v *float64) *LevelResultCreate {
+	if v != nil {
+		_c.SetPar(*v)
+	}
+	return _c
+}
+
+// SetScore sets the "score" field.
+func (_c *LevelResultCreate) SetScore(v int) *LevelResultCreate {
+	_c.mutation.SetScore(v)
+	return _c
+}
+
+// SetRunID sets the "run" edge to the Run entity by ID.
+func (_c *LevelResultCreate) SetRunID(id int) *LevelResultCreate {
+	_c.mutation.SetRunID(id)
+	return _c
+}
+
+// SetRun sets the "run" edge to the Run entity.
+func (_c *LevelResultCreate) SetRun(v *Run) *LevelResultCreate {
+	return _c.SetRunID(v.ID)
+}
+
+// Mutation returns the LevelResultMutation object of the builder.
+func (_c *LevelResultCreate) Mutation() *LevelResultMutation {
+	return _c.mutation
+}
+
+// Save creates the LevelResult in the database.
+func (_c *LevelResultCreate) Save(ctx context.Context) (*LevelResult, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *LevelResultCreate) SaveX(ctx context.Context) *LevelResult {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LevelResultCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LevelResultCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *LevelResultCreate) defaults() {
+	if _, ok := _c.mutation.MapName(); !ok {
+		v := levelresult.DefaultMapName
+		_c.mutation.SetMapName(v)
+	}
+	if _, ok := _c.mutation.Par(); !ok {
+		v := levelresult.DefaultPar
+		_c.mutation.SetPar(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *LevelResultCreate) check() error {
+	if _, ok := _c.mutation.Level(); !ok {
+		return &ValidationError{Name: "level", err: errors.New(`ent: missing required field "LevelResult.level"`)}
+	}
+	if _, ok := _c.mutation.MapName(); !ok {
+		return &ValidationError{Name: "map_name", err: errors.New(`ent: missing required field "LevelResult.map_name"`)}
+	}
+	if _, ok := _c.mutation.Cleared(); !ok {
+		return &ValidationError{Name: "cleared", err: errors.New(`ent: missing required field "LevelResult.cleared"`)}
+	}
+	if _, ok := _c.mutation.Kills(); !ok {
+		return &ValidationError{Name: "kills", err: errors.New(`ent: missing required field "LevelResult.kills"`)}
+	}
+	if _, ok := _c.mutation.EnemyTotal(); !ok {
+		return &ValidationError{Name: "enemy_total", err: errors.New(`ent: missing required field "LevelResult.enemy_total"`)}
+	}
+	if _, ok := _c.mutation.Shots(); !ok {
+		return &ValidationError{Name: "shots", err: errors.New(`ent: missing required field "LevelResult.shots"`)}
+	}
+	if _, ok := _c.mutation.Hits(); !ok {
+		return &ValidationError{Name: "hits", err: errors.New(`ent: missing required field "LevelResult.hits"`)}
+	}
+	if _, ok := _c.mutation.DamageDealt(); !ok {
+		return &ValidationError{Name: "damage_dealt", err: errors.New(`ent: missing required field "LevelResult.damage_dealt"`)}
+	}
+	if _, ok := _c.mutation.DamageTaken(); !ok {
+		return &ValidationError{Name: "damage_taken", err: errors.New(`ent: missing required field "LevelResult.damage_taken"`)}
+	}
+	if _, ok := _c.mutation.Pickups(); !ok {
+		return &ValidationError{Name: "pickups", err: errors.New(`ent: missing required field "LevelResult.pickups"`)}
+	}
+	if _, ok := _c.mutation.Time(); !ok {
+		return &ValidationError{Name: "time", err: errors.New(`ent: missing required field "LevelResult.time"`)}
+	}
+	if _, ok := _c.mutation.Par(); !ok {
+		return &ValidationError{Name: "par", err: errors.New(`ent: missing required field "LevelResult.par"`)}
+	}
+	if _, ok := _c.mutation.Score(); !ok {
+		return &ValidationError{Name: "score", err: errors.New(`ent: missing required field "LevelResult.score"`)}
+	}
+	if len(_c.mutation.RunIDs()) == 0 {
+		return &ValidationError{Name: "run", err: errors.New(`ent: missing required edge "LevelResult.run"`)}
+	}
+	return nil
+}
+
+func (_c *LevelResultCreate) sqlSave(ctx context.Context) (*LevelResult, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *LevelResultCreate) createSpec() (*LevelResult, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LevelResult{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(levelresult.Table, sqlgraph.NewFieldSpec(levelresult.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Level(); ok {
+		_spec.SetField(levelresult.FieldLevel, field.TypeInt, value)
+		_node.Level = value
+	}
+	if value, ok := _c.mutation.MapName(); ok {
+		_spec.SetField(levelresult.FieldMapName, field.TypeString, value)
+		_node.MapName = value
+	}
+	if value, ok := _c.mutation.Cleared(); ok {
+		_spec.SetField(levelresult.FieldCleared, field.TypeBool, value)
+		_node.Cleared = value
+	}
+	if value, ok := _c.mutation.Kills(); ok {
+		_spec.SetField(levelresult.FieldKills, field.TypeJSON, value)
+		_node.Kills = value
+	}
+	if value, ok := _c.mutation.EnemyTotal(); ok {
+		_spec.SetField(levelresult.FieldEnemyTotal, field.TypeInt, value)
+		_node.EnemyTotal = value
+	}
+	if value, ok := _c.mutation.Shots(); ok {
+		_spec.SetField(levelresult.FieldShots, field.TypeInt, value)
+		_node.Shots = value
+	}
+	if value, ok := _c.mutation.Hits(); ok {
+		_spec.SetField(levelresult.FieldHits, field.TypeInt, value)
+		_node.Hits = value
+	}
+	if value, ok := _c.mutation.DamageDealt(); ok {
+		_spec.SetField(levelresult.FieldDamageDealt, field.TypeInt, value)
+		_node.DamageDealt = value
+	}
+	if value, ok := _c.mutation.DamageTaken(); ok {
+		_spec.SetField(levelresult.FieldDamageTaken, field.TypeInt, value)
+		_node.DamageTaken = value
+	}
+	if value, ok := _c.mutation.Pickups(); ok {
+		_spec.SetField(levelresult.FieldPickups, field.TypeInt, value)
+		_node.Pickups = value
+	}
+	if value, ok := _c.mutation.Time(); ok {
+		_spec.SetField(levelresult.FieldTime, field.TypeFloat64, value)
+		_node.Time = value
+	}
+	if value, ok := _c.mutation.Par(); ok {
+		_spec.SetField(levelresult.FieldPar, field.TypeFloat64, value)
+		_node.Par = value
+	}
+	if value, ok := _c.mutation.Score(); ok {
+		_spec.SetField(levelresult.FieldScore, field.TypeInt, value)
+		_node.Score = value
+	}
+	if nodes := _c.mutation.RunIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   levelresult.RunTable,
+			Columns: []string{levelresult.RunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(run.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.run_levels = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// LevelResultCreateBulk is the builder for creating many LevelResult entities in bulk.
+type LevelResultCreateBulk struct {
+	config
+	err      error
+	builders []*LevelResultCreate
+}
+
+// Save creates the LevelResult entities in the database.
+func (_c *LevelResultCreateBulk) Save(ctx context.Context) ([]*LevelResult, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*LevelResult, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LevelResultMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *LevelResultCreateBulk) SaveX(ctx context.Context) []*LevelResult {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LevelResultCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LevelResultCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
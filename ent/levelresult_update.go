@@ -0,0 +1,916 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"doomlike/ent/levelresult"
+	"doomlike/ent/predicate"
+	"doomlike/ent/run"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// LevelResultUpdate is the builder for updating LevelResult entities.
+type LevelResultUpdate struct {
+	config
+	hooks    []Hook
+	mutation *LevelResultMutation
+}
+
+// Where appends a list predicates to the LevelResultUpdate builder.
+func (_u *LevelResultUpdate) Where(ps ...predicate.LevelResult) *LevelResultUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetLevel sets the "level" field.
+func (_u *LevelResultUpdate) SetLevel(v int) *LevelResultUpdate {
+	_u.mutation.ResetLevel()
+	_u.mutation.SetLevel(v)
+	return _u
+}
+
+// SetNillableLevel sets the "level" field if the given value is not nil.
+func (_u *LevelResultUpdate) SetNillableLevel(v *int) *LevelResultUpdate {
+	if v != nil {
+		_u.SetLevel(*v)
+	}
+	return _u
+}
+
+// AddLevel adds value to the "level" field.
+func (_u *LevelResultUpdate) AddLevel(v int) *LevelResultUpdate {
+	_u.mutation.AddLevel(v)
+	return _u
+}
+
+// SetMapName sets the "map_name" field.
+func (_u *LevelResultUpdate) SetMapName(v string) *LevelResultUpdate {
+	_u.mutation.SetMapName(v)
+	return _u
+}
+
+// SetNillableMapName sets the "map_name" field if the given value is not nil.
+func (_u *LevelResultUpdate) SetNillableMapName(v *string) *LevelResultUpdate {
+	if v != nil {
+		_u.SetMapName(*v)
+	}
+	return _u
+}
+
+// SetCleared sets the "cleared" field.
+func (_u *LevelResultUpdate) SetCleared(v bool) *LevelResultUpdate {
+	_u.mutation.SetCleared(v)
+	return _u
+}
+
+// SetNillableCleared sets the "cleared" field if the given value is not nil.
+func (_u *LevelResultUpdate) SetNillableCleared(v *bool) *LevelResultUpdate {
+	if v != nil {
+		_u.SetCleared(*v)
+	}
+	return _u
+}
+
+// SetKills sets the "kills" field.
+func (_u *LevelResultUpdate) SetKills(v map[string]int) *LevelResultUpdate {
+	_u.mutation.SetKills(v)
+	return _u
+}
+
+// SetEnemyTotal sets the "enemy_total" field.
+func (_u *LevelResultUpdate) SetEnemyTotal(v int) *LevelResultUpdate {
+	_u.mutation.ResetEnemyTotal()
+	_u.mutation.SetEnemyTotal(v)
+	return _u
+}
+
+// SetNillableEnemyTotal sets the "enemy_total" field if the given value is not nil.
+func (_u *LevelResultUpdate) SetNillableEnemyTotal(v *int) *LevelResultUpdate {
+	if v != nil {
+		_u.SetEnemyTotal(*v)
+	}
+	return _u
+}
+
+// AddEnemyTotal adds value to the "enemy_total" field.
+func (_u *LevelResultUpdate) AddEnemyTotal(v int) *LevelResultUpdate {
+	_u.mutation.AddEnemyTotal(v)
+	return _u
+}
+
+// SetShots sets the "shots" field.
+func (_u *LevelResultUpdate) SetShots(v int) *LevelResultUpdate {
+	_u.mutation.ResetShots()
+	_u.mutation.SetShots(v)
+	return _u
+}
+
+// SetNillableShots sets the "shots" field if the given value is not nil.
+func (_u *LevelResultUpdate) SetNillableShots(v *int) *LevelResultUpdate {
+	if v != nil {
+		_u.SetShots(*v)
+	}
+	return _u
+}
+
+// AddShots adds value to the "shots" field.
+func (_u *LevelResultUpdate) AddShots(v int) *LevelResultUpdate {
+	_u.mutation.AddShots(v)
+	return _u
+}
+
+// SetHits sets the "hits" field.
+func (_u *LevelResultUpdate) SetHits(v int) *LevelResultUpdate {
+	_u.mutation.ResetHits()
+	_u.mutation.SetHits(v)
+	return _u
+}
+
+// SetNillableHits sets the "hits" field if the given value is not nil.
+func (_u *LevelResultUpdate) SetNillableHits(v *int) *LevelResultUpdate {
+	if v != nil {
+		_u.SetHits(*v)
+	}
+	return _u
+}
+
+// AddHits adds value to the "hits" field.
+func (_u *LevelResultUpdate) AddHits(v int) *LevelResultUpdate {
+	_u.mutation.AddHits(v)
+	return _u
+}
+
+// SetDamageDealt sets the "damage_dealt" field.
+func (_u *LevelResultUpdate) SetDamageDealt(v int) *LevelResultUpdate {
+	_u.mutation.ResetDamageDealt()
+	_u.mutation.SetDamageDealt(v)
+	return _u
+}
+
+// SetNillableDamageDealt sets the "damage_dealt" field if the given value is not nil.
+func (_u *LevelResultUpdate) SetNillableDamageDealt(v *int) *LevelResultUpdate {
+	if v != nil {
+		_u.SetDamageDealt(*v)
+	}
+	return _u
+}
+
+// AddDamageDealt adds value to the "damage_dealt" field.
+func (_u *LevelResultUpdate) AddDamageDealt(v int) *LevelResultUpdate {
+	_u.mutation.AddDamageDealt(v)
+	return _u
+}
+
+// SetDamageTaken sets the "damage_taken" field.
+func (_u *LevelResultUpdate) SetDamageTaken(v int) *LevelResultUpdate {
+	_u.mutation.ResetDamageTaken()
+	_u.mutation.SetDamageTaken(v)
+	return _u
+}
+
+// SetNillableDamageTaken sets the "damage_taken" field if the given value is not nil.
+func (_u *LevelResultUpdate) SetNillableDamageTaken(v *int) *LevelResultUpdate {
+	if v != nil {
+		_u.SetDamageTaken(*v)
+	}
+	return _u
+}
+
+// AddDamageTaken adds value to the "damage_taken" field.
+func (_u *LevelResultUpdate) AddDamageTaken(v int) *LevelResultUpdate {
+	_u.mutation.AddDamageTaken(v)
+	return _u
+}
+
+// SetPickups sets the "pickups" field.
+func (_u *LevelResultUpdate) SetPickups(v int) *LevelResultUpdate {
+	_u.mutation.ResetPickups()
+	_u.mutation.SetPickups(v)
+	return _u
+}
+
+// SetNillablePickups sets the "pickups" field if the given value is not nil.
+func (_u *LevelResultUpdate) SetNillablePickups(v *int) *LevelResultUpdate {
+	if v != nil {
+		_u.SetPickups(*v)
+	}
+	return _u
+}
+
+// AddPickups adds value to the "pickups" field.
+func (_u *LevelResultUpdate) AddPickups(v int) *LevelResultUpdate {
+	_u.mutation.AddPickups(v)
+	return _u
+}
+
+// SetTime sets the "time" field.
+func (_u *LevelResultUpdate) SetTime(v float64) *LevelResultUpdate {
+	_u.mutation.ResetTime()
+	_u.mutation.SetTime(v)
+	return _u
+}
+
+// SetNillableTime sets the "time" field if the given value is not nil.
+func (_u *LevelResultUpdate) SetNillableTime(v *float64) *LevelResultUpdate {
+	if v != nil {
+		_u.SetTime(*v)
+	}
+	return _u
+}
+
+// AddTime adds value to the "time" field.
+func (_u *LevelResultUpdate) AddTime(v float64) *LevelResultUpdate {
+	_u.mutation.AddTime(v)
+	return _u
+}
+
+// SetPar sets the "par" field.
+func (_u *LevelResultUpdate) SetPar(v float64) *LevelResultUpdate {
+	_u.mutation.ResetPar()
+	_u.mutation.SetPar(v)
+	return _u
+}
+
+// SetNillablePar sets the "par" field if the given value is not nil.
+func (_u *LevelResultUpdate) SetNillablePar(v *float64) *LevelResultUpdate {
+	if v != nil {
+		_u.SetPar(*v)
+	}
+	return _u
+}
+
+// AddPar adds value to the "par" field.
+func (_u *LevelResultUpdate) AddPar(v float64) *LevelResultUpdate {
+	_u.mutation.AddPar(v)
+	return _u
+}
+
+// SetScore sets the "score" field.
+func (_u *LevelResultUpdate) SetScore(v int) *LevelResultUpdate {
+	_u.mutation.ResetScore()
+	_u.mutation.SetScore(v)
+	return _u
+}
+
+// SetNillableScore sets the "score" field if the given value is not nil.
+func (_u *LevelResultUpdate) SetNillableScore(v *int) *LevelResultUpdate {
+	if v != nil {
+		_u.SetScore(*v)
+	}
+	return _u
+}
+
+// AddScore adds value to the "score" field.
+func (_u *LevelResultUpdate) AddScore(v int) *LevelResultUpdate {
+	_u.mutation.AddScore(v)
+	return _u
+}
+
+// SetRunID sets the "run" edge to the Run entity by ID.
+func (_u *LevelResultUpdate) SetRunID(id int) *LevelResultUpdate {
+	_u.mutation.SetRunID(id)
+	return _u
+}
+
+// SetRun sets the "run" edge to the Run entity.
+func (_u *LevelResultUpdate) SetRun(v *Run) *LevelResultUpdate {
+	return _u.SetRunID(v.ID)
+}
+
+// Mutation returns the LevelResultMutation object of the builder.
+func (_u *LevelResultUpdate) Mutation() *LevelResultMutation {
+	return _u.mutation
+}
+
+// ClearRun clears the "run" edge to the Run entity.
+func (_u *LevelResultUpdate) ClearRun() *LevelResultUpdate {
+	_u.mutation.ClearRun()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *LevelResultUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LevelResultUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *LevelResultUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LevelResultUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LevelResultUpdate) check() error {
+	if _u.mutation.RunCleared() && len(_u.mutation.RunIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LevelResult.run"`)
+	}
+	return nil
+}
+
+func (_u *LevelResultUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(levelresult.Table, levelresult.Columns, sqlgraph.NewFieldSpec(levelresult.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Level(); ok {
+		_spec.SetField(levelresult.FieldLevel, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLevel(); ok {
+		_spec.AddField(levelresult.FieldLevel, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.MapName(); ok {
+		_spec.SetField(levelresult.FieldMapName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Cleared(); ok {
+		_spec.SetField(levelresult.FieldCleared, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Kills(); ok {
+		_spec.SetField(levelresult.FieldKills, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.EnemyTotal(); ok {
+		_spec.SetField(levelresult.FieldEnemyTotal, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEnemyTotal(); ok {
+		_spec.AddField(levelresult.FieldEnemyTotal, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Shots(); ok {
+		_spec.SetField(levelresult.FieldShots, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedShots(); ok {
+		_spec.AddField(levelresult.FieldShots, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Hits(); ok {
+		_spec.SetField(levelresult.FieldHits, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedHits(); ok {
+		_spec.AddField(levelresult.FieldHits, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.DamageDealt(); ok {
+		_spec.SetField(levelresult.FieldDamageDealt, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDamageDealt(); ok {
+		_spec.AddField(levelresult.FieldDamageDealt, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.DamageTaken(); ok {
+		_spec.SetField(levelresult.FieldDamageTaken, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDamageTaken(); ok {
+		_spec.AddField(levelresult.FieldDamageTaken, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Pickups(); ok {
+		_spec.SetField(levelresult.FieldPickups, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPickups(); ok {
+		_spec.AddField(levelresult.FieldPickups, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Time(); ok {
+		_spec.SetField(levelresult.FieldTime, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedTime(); ok {
+		_spec.AddField(levelresult.FieldTime, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Par(); ok {
+		_spec.SetField(levelresult.FieldPar, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedPar(); ok {
+		_spec.AddField(levelresult.FieldPar, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Score(); ok {
+		_spec.SetField(levelresult.FieldScore, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedScore(); ok {
+		_spec.AddField(levelresult.FieldScore, field.TypeInt, value)
+	}
+	if _u.mutation.RunCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   levelresult.RunTable,
+			Columns: []string{levelresult.RunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(run.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RunIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   levelresult.RunTable,
+			Columns: []string{levelresult.RunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(run.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{levelresult.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// LevelResultUpdateOne is the builder for updating a single LevelResult entity.
+type LevelResultUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *LevelResultMutation
+}
+
+// SetLevel sets the "level" field.
+func (_u *LevelResultUpdateOne) SetLevel(v int) *LevelResultUpdateOne {
+	_u.mutation.ResetLevel()
+	_u.mutation.SetLevel(v)
+	return _u
+}
+
+// SetNillableLevel sets the "level" field if the given value is not nil.
+func (_u *LevelResultUpdateOne) SetNillableLevel(v *int) *LevelResultUpdateOne {
+	if v != nil {
+		_u.SetLevel(*v)
+	}
+	return _u
+}
+
+// AddLevel adds value to the "level" field.
+func (_u *LevelResultUpdateOne) AddLevel(v int) *LevelResultUpdateOne {
+	_u.mutation.AddLevel(v)
+	return _u
+}
+
+// SetMapName sets the "map_name" field.
+func (_u *LevelResultUpdateOne) SetMapName(v string) *LevelResultUpdateOne {
+	_u.mutation.SetMapName(v)
+	return _u
+}
+
+// SetNillableMapName sets the "map_name" field if the given value is not nil.
+func (_u *LevelResultUpdateOne) SetNillableMapName(v *string) *LevelResultUpdateOne {
+	if v != nil {
+		_u.SetMapName(*v)
+	}
+	return _u
+}
+
+// SetCleared sets the "cleared" field.
+func (_u *LevelResultUpdateOne) SetCleared(v bool) *LevelResultUpdateOne {
+	_u.mutation.SetCleared(v)
+	return _u
+}
+
+// SetNillableCleared sets the "cleared" field if the given value is not nil.
+func (_u *LevelResultUpdateOne) SetNillableCleared(v *bool) *LevelResultUpdateOne {
+	if v != nil {
+		_u.SetCleared(*v)
+	}
+	return _u
+}
+
+// SetKills sets the "kills" field.
+func (_u *LevelResultUpdateOne) SetKills(v map[string]int) *LevelResultUpdateOne {
+	_u.mutation.SetKills(v)
+	return _u
+}
+
+// SetEnemyTotal sets the "enemy_total" field.
+func (_u *LevelResultUpdateOne) SetEnemyTotal(v int) *LevelResultUpdateOne {
+	_u.mutation.ResetEnemyTotal()
+	_u.mutation.SetEnemyTotal(v)
+	return _u
+}
+
+// SetNillableEnemyTotal sets the "enemy_total" field if the given value is not nil.
+func (_u *LevelResultUpdateOne) SetNillableEnemyTotal(v *int) *LevelResultUpdateOne {
+	if v != nil {
+		_u.SetEnemyTotal(*v)
+	}
+	return _u
+}
+
+// AddEnemyTotal adds value to the "enemy_total" field.
+func (_u *LevelResultUpdateOne) AddEnemyTotal(v int) *LevelResultUpdateOne {
+	_u.mutation.AddEnemyTotal(v)
+	return _u
+}
+
+// SetShots sets the "shots" field.
+func (_u *LevelResultUpdateOne) SetShots(v int) *LevelResultUpdateOne {
+	_u.mutation.ResetShots()
+	_u.mutation.SetShots(v)
+	return _u
+}
+
+// SetNillableShots sets the "shots" field if the given value is not nil.
+func (_u *LevelResultUpdateOne) SetNillableShots(v *int) *LevelResultUpdateOne {
+	if v != nil {
+		_u.SetShots(*v)
+	}
+	return _u
+}
+
+// AddShots adds value to the "shots" field.
+func (_u *LevelResultUpdateOne) AddShots(v int) *LevelResultUpdateOne {
+	_u.mutation.AddShots(v)
+	return _u
+}
+
+// SetHits sets the "hits" field.
+func (_u *LevelResultUpdateOne) SetHits(v int) *LevelResultUpdateOne {
+	_u.mutation.ResetHits()
+	_u.mutation.SetHits(v)
+	return _u
+}
+
+// SetNillableHits sets the "hits" field if the given value is not nil.
+func (_u *LevelResultUpdateOne) SetNillableHits(v *int) *LevelResultUpdateOne {
+	if v != nil {
+		_u.SetHits(*v)
+	}
+	return _u
+}
+
+// AddHits adds value to the "hits" field.
+func (_u *LevelResultUpdateOne) AddHits(v int) *LevelResultUpdateOne {
+	_u.mutation.AddHits(v)
+	return _u
+}
+
+// SetDamageDealt sets the "damage_dealt" field.
+func (_u *LevelResultUpdateOne) SetDamageDealt(v int) *LevelResultUpdateOne {
+	_u.mutation.ResetDamageDealt()
+	_u.mutation.SetDamageDealt(v)
+	return _u
+}
+
+// SetNillableDamageDealt sets the "damage_dealt" field if the given value is not nil.
+func (_u *LevelResultUpdateOne) SetNillableDamageDealt(v *int) *LevelResultUpdateOne {
+	if v != nil {
+		_u.SetDamageDealt(*v)
+	}
+	return _u
+}
+
+// AddDamageDealt adds value to the "damage_dealt" field.
+func (_u *LevelResultUpdateOne) AddDamageDealt(v int) *LevelResultUpdateOne {
+	_u.mutation.AddDamageDealt(v)
+	return _u
+}
+
+// SetDamageTaken sets the "damage_taken" field.
+func (_u *LevelResultUpdateOne) SetDamageTaken(v int) *LevelResultUpdateOne {
+	_u.mutation.ResetDamageTaken()
+	_u.mutation.SetDamageTaken(v)
+	return _u
+}
+
+// SetNillableDamageTaken sets the "damage_taken" field if the given value is not nil.
+func (_u *LevelResultUpdateOne) SetNillableDamageTaken(v *int) *LevelResultUpdateOne {
+	if v != nil {
+		_u.SetDamageTaken(*v)
+	}
+	return _u
+}
+
+// AddDamageTaken adds value to the "damage_taken" field.
+func (_u *LevelResultUpdateOne) AddDamageTaken(v int) *LevelResultUpdateOne {
+	_u.mutation.AddDamageTaken(v)
+	return _u
+}
+
+// SetPickups sets the "pickups" field.
+func (_u *LevelResultUpdateOne) SetPickups(v int) *LevelResultUpdateOne {
+	_u.mutation.ResetPickups()
+	_u.mutation.SetPickups(v)
+	return _u
+}
+
+// SetNillablePickups sets the "pickups" field if the given value is not nil.
+func (_u *LevelResultUpdateOne) SetNillablePickups(v *int) *LevelResultUpdateOne {
+	if v != nil {
+		_u.SetPickups(*v)
+	}
+	return _u
+}
+
+// AddPickups adds value to the "pickups" field.
+func (_u *LevelResultUpdateOne) AddPickups(v int) *LevelResultUpdateOne {
+	_u.mutation.AddPickups(v)
+	return _u
+}
+
+// SetTime sets the "time" field.
+func (_u *LevelResultUpdateOne) SetTime(v float64) *LevelResultUpdateOne {
+	_u.mutation.ResetTime()
+	_u.mutation.SetTime(v)
+	return _u
+}
+
+// SetNillableTime sets the "time" field if the given value is not nil.
+func (_u *LevelResultUpdateOne) SetNillableTime(v *float64) *LevelResultUpdateOne {
+	if v != nil {
+		_u.SetTime(*v)
+	}
+	return _u
+}
+
+// AddTime adds value to the "time" field.
+func (_u *LevelResultUpdateOne) AddTime(v float64) *LevelResultUpdateOne {
+	_u.mutation.AddTime(v)
+	return _u
+}
+
+// SetPar sets the "par" field.
+func (_u *LevelResultUpdateOne) SetPar(v float64) *LevelResultUpdateOne {
+	_u.mutation.ResetPar()
+	_u.mutation.SetPar(v)
+	return _u
+}
+
+// SetNillablePar sets the "par" field if the given value is not nil.
+func (_u *LevelResultUpdateOne) SetNillablePar(v *float64) *LevelResultUpdateOne {
+	if v != nil {
+		_u.SetPar(*v)
+	}
+	return _u
+}
+
+// AddPar adds value to the "par" field.
+func (_u *LevelResultUpdateOne) AddPar(v float64) *LevelResultUpdateOne {
+	_u.mutation.AddPar(v)
+	return _u
+}
+
+// SetScore sets the "score" field.
+func (_u *LevelResultUpdateOne) SetScore(v int) *LevelResultUpdateOne {
+	_u.mutation.ResetScore()
+	_u.mutation.SetScore(v)
+	return _u
+}
+
+// SetNillableScore sets the "score" field if the given value is not nil.
+func (_u *LevelResultUpdateOne) SetNillableScore(v *int) *LevelResultUpdateOne {
+	if v != nil {
+		_u.SetScore(*v)
+	}
+	return _u
+}
+
+// AddScore adds value to the "score" field.
+func (_u *LevelResultUpdateOne) AddScore(v int) *LevelResultUpdateOne {
+	_u.mutation.AddScore(v)
+	return _u
+}
+
+// SetRunID sets the "run" edge to the Run entity by ID.
+func (_u *LevelResultUpdateOne) SetRunID(id int) *LevelResultUpdateOne {
+	_u.mutation.SetRunID(id)
+	return _u
+}
+
+// SetRun sets the "run" edge to the Run entity.
+func (_u *LevelResultUpdateOne) SetRun(v *Run) *LevelResultUpdateOne {
+	return _u.SetRunID(v.ID)
+}
+
+// Mutation returns the LevelResultMutation object of the builder.
+func (_u *LevelResultUpdateOne) Mutation() *LevelResultMutation {
+	return _u.mutation
+}
+
+// ClearRun clears the "run" edge to the Run entity.
+func (_u *LevelResultUpdateOne) ClearRun() *LevelResultUpdateOne {
+	_u.mutation.ClearRun()
+	return _u
+}
+
+// Where appends a list predicates to the LevelResultUpdate builder.
+func (_u *LevelResultUpdateOne) Where(ps ...predicate.LevelResult) *LevelResultUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *LevelResultUpdateOne) Select(field string, fields ...string) *LevelResultUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated LevelResult entity.
+func (_u *LevelResultUpdateOne) Save(ctx context.Context) (*LevelResult, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LevelResultUpdateOne) SaveX(ctx context.Context) *LevelResult {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *LevelResultUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LevelResultUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LevelResultUpdateOne) check() error {
+	if _u.mutation.RunCleared() && len(_u.mutation.RunIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LevelResult.run"`)
+	}
+	return nil
+}
+
+func (_u *LevelResultUpdateOne) sqlSave(ctx context.Context) (_node *LevelResult, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(levelresult.Table, levelresult.Columns, sqlgraph.NewFieldSpec(levelresult.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "LevelResult.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, levelresult.FieldID)
+		for _, f := range fields {
+			if !levelresult.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != levelresult.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Level(); ok {
+		_spec.SetField(levelresult.FieldLevel, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLevel(); ok {
+		_spec.AddField(levelresult.FieldLevel, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.MapName(); ok {
+		_spec.SetField(levelresult.FieldMapName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Cleared(); ok {
+		_spec.SetField(levelresult.FieldCleared, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Kills(); ok {
+		_spec.SetField(levelresult.FieldKills, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.EnemyTotal(); ok {
+		_spec.SetField(levelresult.FieldEnemyTotal, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEnemyTotal(); ok {
+		_spec.AddField(levelresult.FieldEnemyTotal, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Shots(); ok {
+		_spec.SetField(levelresult.FieldShots, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedShots(); ok {
+		_spec.AddField(levelresult.FieldShots, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Hits(); ok {
+		_spec.SetField(levelresult.FieldHits, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedHits(); ok {
+		_spec.AddField(levelresult.FieldHits, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.DamageDealt(); ok {
+		_spec.SetField(levelresult.FieldDamageDealt, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDamageDealt(); ok {
+		_spec.AddField(levelresult.FieldDamageDealt, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.DamageTaken(); ok {
+		_spec.SetField(levelresult.FieldDamageTaken, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDamageTaken(); ok {
+		_spec.AddField(levelresult.FieldDamageTaken, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Pickups(); ok {
+		_spec.SetField(levelresult.FieldPickups, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPickups(); ok {
+		_spec.AddField(levelresult.FieldPickups, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Time(); ok {
+		_spec.SetField(levelresult.FieldTime, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedTime(); ok {
+		_spec.AddField(levelresult.FieldTime, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Par(); ok {
+		_spec.SetField(levelresult.FieldPar, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedPar(); ok {
+		_spec.AddField(levelresult.FieldPar, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Score(); ok {
+		_spec.SetField(levelresult.FieldScore, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedScore(); ok {
+		_spec.AddField(levelresult.FieldScore, field.TypeInt, value)
+	}
+	if _u.mutation.RunCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   levelresult.RunTable,
+			Columns: []string{levelresult.RunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(run.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RunIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   levelresult.RunTable,
+			Columns: []string{levelresult.RunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(run.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &LevelResult{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{levelresult.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
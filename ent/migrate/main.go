@@ -0,0 +1,57 @@
+//go:build ignore
+
+// Command main writes the next versioned migration for the schema in
+// ent/schema, by replaying the existing migrations into an in-memory SQLite
+// database and diffing it against the generated tables:
+//
+//	go run -mod=mod ent/migrate/main.go <name>
+//
+// Run `go generate ./ent` first so the tables are up to date. Data changes
+// that go with a migration are added to internal/engine/database_migrate.go.
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+
+	"doomlike/ent/migrate"
+
+	atlas "ariga.io/atlas/sql/migrate"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/schema"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func main() {
+	if len(os.Args) != 2 {
+		log.Fatalln("usage: go run -mod=mod ent/migrate/main.go <name>")
+	}
+	ctx := context.Background()
+
+	dir, err := atlas.NewLocalDir("ent/migrate/migrations")
+	if err != nil {
+		log.Fatalf("failed to open migration directory: %v", err)
+	}
+	drv, err := sql.Open(dialect.SQLite, "file:dev?mode=memory&_fk=1")
+	if err != nil {
+		log.Fatalf("failed to open dev database: %v", err)
+	}
+	defer drv.Close()
+
+	m, err := schema.NewMigrate(drv,
+		schema.WithDir(dir),
+		schema.WithMigrationMode(schema.ModeReplay),
+		schema.WithDialect(dialect.SQLite),
+		schema.WithFormatter(atlas.DefaultFormatter),
+		schema.WithDropColumn(true),
+		schema.WithDropIndex(true),
+	)
+	if err != nil {
+		log.Fatalf("failed to set up migration: %v", err)
+	}
+	if err := m.NamedDiff(ctx, os.Args[1], migrate.Tables...); err != nil {
+		log.Fatalf("failed to generate migration: %v", err)
+	}
+}
@@ -0,0 +1,24 @@
+-- Create "game_settings" table
+CREATE TABLE `game_settings` (`id` text NOT NULL, `fire_rate` real NOT NULL DEFAULT (0.08), `bullet_speed` real NOT NULL DEFAULT (22), `level_count` integer NOT NULL DEFAULT (5), `seed` integer NOT NULL DEFAULT (0), `created_at` datetime NULL, `updated_at` datetime NULL, `profile_settings` integer NULL, PRIMARY KEY (`id`), CONSTRAINT `game_settings_profiles_settings` FOREIGN KEY (`profile_settings`) REFERENCES `profiles` (`id`) ON DELETE SET NULL);
+-- Create index "game_settings_profile_settings_key" to table: "game_settings"
+CREATE UNIQUE INDEX `game_settings_profile_settings_key` ON `game_settings` (`profile_settings`);
+-- Create index "gamesettings_id" to table: "game_settings"
+CREATE UNIQUE INDEX `gamesettings_id` ON `game_settings` (`id`);
+-- Create "high_scores" table
+CREATE TABLE `high_scores` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `name` text NOT NULL, `score` integer NOT NULL, `seed` integer NOT NULL, `level` integer NOT NULL, `total_levels` integer NOT NULL, `won` bool NOT NULL DEFAULT (false), `fire_rate` real NOT NULL, `bullet_speed` real NOT NULL, `created_at` datetime NOT NULL, `profile_high_scores` integer NULL, CONSTRAINT `high_scores_profiles_high_scores` FOREIGN KEY (`profile_high_scores`) REFERENCES `profiles` (`id`) ON DELETE SET NULL);
+-- Create index "highscore_score" to table: "high_scores"
+CREATE INDEX `highscore_score` ON `high_scores` (`score`);
+-- Create "level_results" table
+CREATE TABLE `level_results` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `level` integer NOT NULL, `map_name` text NOT NULL DEFAULT (''), `cleared` bool NOT NULL, `kills` json NOT NULL, `enemy_total` integer NOT NULL, `shots` integer NOT NULL, `hits` integer NOT NULL, `damage_dealt` integer NOT NULL, `damage_taken` integer NOT NULL, `pickups` integer NOT NULL, `time` real NOT NULL, `par` real NOT NULL DEFAULT (0), `score` integer NOT NULL, `run_levels` integer NOT NULL, CONSTRAINT `level_results_runs_levels` FOREIGN KEY (`run_levels`) REFERENCES `runs` (`id`) ON DELETE NO ACTION);
+-- Create "profiles" table
+CREATE TABLE `profiles` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `name` text NOT NULL, `created_at` datetime NOT NULL, `last_used_at` datetime NOT NULL);
+-- Create index "profiles_name_key" to table: "profiles"
+CREATE UNIQUE INDEX `profiles_name_key` ON `profiles` (`name`);
+-- Create "runs" table
+CREATE TABLE `runs` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `started_at` datetime NOT NULL, `ended_at` datetime NULL, `seed` integer NOT NULL, `total_levels` integer NOT NULL, `fire_rate` real NOT NULL, `bullet_speed` real NOT NULL, `campaign` text NOT NULL DEFAULT (''), `resumed` bool NOT NULL DEFAULT (false), `outcome` text NOT NULL DEFAULT ('playing'), `score` integer NOT NULL DEFAULT (0), `cleared_levels` integer NOT NULL DEFAULT (0), `profile_runs` integer NULL, CONSTRAINT `runs_profiles_runs` FOREIGN KEY (`profile_runs`) REFERENCES `profiles` (`id`) ON DELETE SET NULL);
+-- Create index "run_started_at" to table: "runs"
+CREATE INDEX `run_started_at` ON `runs` (`started_at`);
+-- Create "save_slots" table
+CREATE TABLE `save_slots` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `slot` integer NOT NULL, `level` integer NOT NULL, `total_levels` integer NOT NULL, `seed` integer NOT NULL, `play_time` real NOT NULL DEFAULT (0), `map_width` integer NOT NULL, `map_height` integer NOT NULL, `grid` blob NOT NULL, `player` json NOT NULL, `enemies` json NOT NULL, `pickups` json NOT NULL, `projectiles` json NOT NULL, `doors` json NULL, `secrets` json NULL, `barrels` json NULL, `defeated` integer NOT NULL DEFAULT (0), `level_enemy_total` integer NOT NULL DEFAULT (0), `level_time` real NOT NULL DEFAULT (0), `completion` integer NOT NULL DEFAULT (0), `fire_rate` real NOT NULL, `bullet_speed` real NOT NULL, `rng_state` integer NOT NULL, `campaign` text NOT NULL DEFAULT (''), `map_name` text NOT NULL DEFAULT (''), `par` real NOT NULL DEFAULT (0), `thumbnail` blob NULL, `created_at` datetime NULL, `updated_at` datetime NULL);
+-- Create index "saveslot_slot" to table: "save_slots"
+CREATE UNIQUE INDEX `saveslot_slot` ON `save_slots` (`slot`);
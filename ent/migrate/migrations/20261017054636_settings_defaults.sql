@@ -0,0 +1,16 @@
+-- Disable the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = off;
+-- Create "new_game_settings" table
+CREATE TABLE `new_game_settings` (`id` text NOT NULL, `fire_rate` real NOT NULL DEFAULT (0.275), `bullet_speed` real NOT NULL DEFAULT (22), `level_count` integer NOT NULL DEFAULT (5), `seed` integer NOT NULL DEFAULT (0), `created_at` datetime NULL, `updated_at` datetime NULL, `profile_settings` integer NULL, PRIMARY KEY (`id`), CONSTRAINT `game_settings_profiles_settings` FOREIGN KEY (`profile_settings`) REFERENCES `profiles` (`id`) ON DELETE SET NULL);
+-- Copy rows from old table "game_settings" to new temporary table "new_game_settings"
+INSERT INTO `new_game_settings` (`id`, `fire_rate`, `bullet_speed`, `level_count`, `seed`, `created_at`, `updated_at`, `profile_settings`) SELECT `id`, IFNULL(`fire_rate`, (0.275)) AS `fire_rate`, `bullet_speed`, `level_count`, `seed`, `created_at`, `updated_at`, `profile_settings` FROM `game_settings`;
+-- Drop "game_settings" table after copying rows
+DROP TABLE `game_settings`;
+-- Rename temporary table "new_game_settings" to "game_settings"
+ALTER TABLE `new_game_settings` RENAME TO `game_settings`;
+-- Create index "game_settings_profile_settings_key" to table: "game_settings"
+CREATE UNIQUE INDEX `game_settings_profile_settings_key` ON `game_settings` (`profile_settings`);
+-- Create index "gamesettings_id" to table: "game_settings"
+CREATE UNIQUE INDEX `gamesettings_id` ON `game_settings` (`id`);
+-- Enable back the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = on;
@@ -0,0 +1,2 @@
+-- Add column "key_bindings" to table: "profiles"
+ALTER TABLE `profiles` ADD COLUMN `key_bindings` json NULL;
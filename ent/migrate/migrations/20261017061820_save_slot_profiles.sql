@@ -0,0 +1,14 @@
+-- Disable the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = off;
+-- Create "new_save_slots" table
+CREATE TABLE `new_save_slots` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `slot` integer NOT NULL, `level` integer NOT NULL, `total_levels` integer NOT NULL, `seed` integer NOT NULL, `play_time` real NOT NULL DEFAULT (0), `map_width` integer NOT NULL, `map_height` integer NOT NULL, `grid` blob NOT NULL, `player` json NOT NULL, `enemies` json NOT NULL, `pickups` json NOT NULL, `projectiles` json NOT NULL, `doors` json NULL, `secrets` json NULL, `barrels` json NULL, `defeated` integer NOT NULL DEFAULT (0), `level_enemy_total` integer NOT NULL DEFAULT (0), `level_time` real NOT NULL DEFAULT (0), `completion` integer NOT NULL DEFAULT (0), `fire_rate` real NOT NULL, `bullet_speed` real NOT NULL, `rng_state` integer NOT NULL, `campaign` text NOT NULL DEFAULT (''), `map_name` text NOT NULL DEFAULT (''), `par` real NOT NULL DEFAULT (0), `thumbnail` blob NULL, `created_at` datetime NULL, `updated_at` datetime NULL, `profile_saves` integer NULL, CONSTRAINT `save_slots_profiles_saves` FOREIGN KEY (`profile_saves`) REFERENCES `profiles` (`id`) ON DELETE SET NULL);
+-- Copy rows from old table "save_slots" to new temporary table "new_save_slots"
+INSERT INTO `new_save_slots` (`id`, `slot`, `level`, `total_levels`, `seed`, `play_time`, `map_width`, `map_height`, `grid`, `player`, `enemies`, `pickups`, `projectiles`, `doors`, `secrets`, `barrels`, `defeated`, `level_enemy_total`, `level_time`, `completion`, `fire_rate`, `bullet_speed`, `rng_state`, `campaign`, `map_name`, `par`, `thumbnail`, `created_at`, `updated_at`) SELECT `id`, `slot`, `level`, `total_levels`, `seed`, `play_time`, `map_width`, `map_height`, `grid`, `player`, `enemies`, `pickups`, `projectiles`, `doors`, `secrets`, `barrels`, `defeated`, `level_enemy_total`, `level_time`, `completion`, `fire_rate`, `bullet_speed`, `rng_state`, `campaign`, `map_name`, `par`, `thumbnail`, `created_at`, `updated_at` FROM `save_slots`;
+-- Drop "save_slots" table after copying rows
+DROP TABLE `save_slots`;
+-- Rename temporary table "new_save_slots" to "save_slots"
+ALTER TABLE `new_save_slots` RENAME TO `save_slots`;
+-- Create index "saveslot_slot_profile_saves" to table: "save_slots"
+CREATE UNIQUE INDEX `saveslot_slot_profile_saves` ON `save_slots` (`slot`, `profile_saves`);
+-- Enable back the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = on;
@@ -0,0 +1,2 @@
+-- Add column "enemy_types" to table: "save_slots"
+ALTER TABLE `save_slots` ADD COLUMN `enemy_types` json NULL;
@@ -0,0 +1,10 @@
+// Package migrations embeds the versioned schema migrations written by
+// ent/migrate/main.go, so the game can bring an older database up to date.
+package migrations
+
+import "embed"
+
+// FS holds the migration files and the atlas.sum that guards them.
+//
+//go:embed *.sql atlas.sum
+var FS embed.FS
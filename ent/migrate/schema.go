@@ -11,17 +11,36 @@ var (
 	// GameSettingsColumns holds the columns for the "game_settings" table.
 	GameSettingsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString},
-		{Name: "fire_rate", Type: field.TypeFloat64, Default: 0.08},
+		{Name: "fire_rate", Type: field.TypeFloat64, Default: 0.275},
 		{Name: "bullet_speed", Type: field.TypeFloat64, Default: 22},
 		{Name: "level_count", Type: field.TypeInt, Default: 5},
+		{Name: "seed", Type: field.TypeInt64, Default: 0},
+		{Name: "mouse_sensitivity", Type: field.TypeFloat64, Default: 0.002},
+		{Name: "invert_mouse", Type: field.TypeBool, Default: false},
+		{Name: "turn_speed", Type: field.TypeFloat64, Default: 2.6},
+		{Name: "fov", Type: field.TypeFloat64, Default: 75},
+		{Name: "render_width", Type: field.TypeInt, Default: 640},
+		{Name: "render_height", Type: field.TypeInt, Default: 400},
+		{Name: "gamepad_turn_speed", Type: field.TypeFloat64, Default: 3},
+		{Name: "gamepad_deadzone", Type: field.TypeFloat64, Default: 0.15},
+		{Name: "gamepad_curve", Type: field.TypeFloat64, Default: 1.5},
 		{Name: "created_at", Type: field.TypeTime, Nullable: true},
 		{Name: "updated_at", Type: field.TypeTime, Nullable: true},
+		{Name: "profile_settings", Type: field.TypeInt, Unique: true, Nullable: true},
 	}
 	// GameSettingsTable holds the schema information for the "game_settings" table.
 	GameSettingsTable = &schema.Table{
 		Name:       "game_settings",
 		Columns:    GameSettingsColumns,
 		PrimaryKey: []*schema.Column{GameSettingsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "game_settings_profiles_settings",
+				Columns:    []*schema.Column{GameSettingsColumns[16]},
+				RefColumns: []*schema.Column{ProfilesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
 		Indexes: []*schema.Index{
 			{
 				Name:    "gamesettings_id",
@@ -30,11 +49,194 @@ var (
 			},
 		},
 	}
+	// HighScoresColumns holds the columns for the "high_scores" table.
+	HighScoresColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString, Size: 16},
+		{Name: "score", Type: field.TypeInt},
+		{Name: "seed", Type: field.TypeInt64},
+		{Name: "level", Type: field.TypeInt},
+		{Name: "total_levels", Type: field.TypeInt},
+		{Name: "won", Type: field.TypeBool, Default: false},
+		{Name: "fire_rate", Type: field.TypeFloat64},
+		{Name: "bullet_speed", Type: field.TypeFloat64},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "profile_high_scores", Type: field.TypeInt, Nullable: true},
+	}
+	// HighScoresTable holds the schema information for the "high_scores" table.
+	HighScoresTable = &schema.Table{
+		Name:       "high_scores",
+		Columns:    HighScoresColumns,
+		PrimaryKey: []*schema.Column{HighScoresColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "high_scores_profiles_high_scores",
+				Columns:    []*schema.Column{HighScoresColumns[10]},
+				RefColumns: []*schema.Column{ProfilesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "highscore_score",
+				Unique:  false,
+				Columns: []*schema.Column{HighScoresColumns[2]},
+			},
+		},
+	}
+	// LevelResultsColumns holds the columns for the "level_results" table.
+	LevelResultsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "level", Type: field.TypeInt},
+		{Name: "map_name", Type: field.TypeString, Default: ""},
+		{Name: "cleared", Type: field.TypeBool},
+		{Name: "kills", Type: field.TypeJSON},
+		{Name: "enemy_total", Type: field.TypeInt},
+		{Name: "shots", Type: field.TypeInt},
+		{Name: "hits", Type: field.TypeInt},
+		{Name: "damage_dealt", Type: field.TypeInt},
+		{Name: "damage_taken", Type: field.TypeInt},
+		{Name: "pickups", Type: field.TypeInt},
+		{Name: "time", Type: field.TypeFloat64},
+		{Name: "par", Type: field.TypeFloat64, Default: 0},
+		{Name: "score", Type: field.TypeInt},
+		{Name: "run_levels", Type: field.TypeInt},
+	}
+	// LevelResultsTable holds the schema information for the "level_results" table.
+	LevelResultsTable = &schema.Table{
+		Name:       "level_results",
+		Columns:    LevelResultsColumns,
+		PrimaryKey: []*schema.Column{LevelResultsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "level_results_runs_levels",
+				Columns:    []*schema.Column{LevelResultsColumns[14]},
+				RefColumns: []*schema.Column{RunsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// ProfilesColumns holds the columns for the "profiles" table.
+	ProfilesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString, Unique: true, Size: 16},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "last_used_at", Type: field.TypeTime},
+		{Name: "key_bindings", Type: field.TypeJSON, Nullable: true},
+	}
+	// ProfilesTable holds the schema information for the "profiles" table.
+	ProfilesTable = &schema.Table{
+		Name:       "profiles",
+		Columns:    ProfilesColumns,
+		PrimaryKey: []*schema.Column{ProfilesColumns[0]},
+	}
+	// RunsColumns holds the columns for the "runs" table.
+	RunsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "started_at", Type: field.TypeTime},
+		{Name: "ended_at", Type: field.TypeTime, Nullable: true},
+		{Name: "seed", Type: field.TypeInt64},
+		{Name: "total_levels", Type: field.TypeInt},
+		{Name: "fire_rate", Type: field.TypeFloat64},
+		{Name: "bullet_speed", Type: field.TypeFloat64},
+		{Name: "campaign", Type: field.TypeString, Default: ""},
+		{Name: "resumed", Type: field.TypeBool, Default: false},
+		{Name: "outcome", Type: field.TypeEnum, Enums: []string{"playing", "win", "death", "quit"}, Default: "playing"},
+		{Name: "score", Type: field.TypeInt, Default: 0},
+		{Name: "cleared_levels", Type: field.TypeInt, Default: 0},
+		{Name: "profile_runs", Type: field.TypeInt, Nullable: true},
+	}
+	// RunsTable holds the schema information for the "runs" table.
+	RunsTable = &schema.Table{
+		Name:       "runs",
+		Columns:    RunsColumns,
+		PrimaryKey: []*schema.Column{RunsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "runs_profiles_runs",
+				Columns:    []*schema.Column{RunsColumns[12]},
+				RefColumns: []*schema.Column{ProfilesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "run_started_at",
+				Unique:  false,
+				Columns: []*schema.Column{RunsColumns[1]},
+			},
+		},
+	}
+	// SaveSlotsColumns holds the columns for the "save_slots" table.
+	SaveSlotsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "slot", Type: field.TypeInt},
+		{Name: "level", Type: field.TypeInt},
+		{Name: "total_levels", Type: field.TypeInt},
+		{Name: "seed", Type: field.TypeInt64},
+		{Name: "play_time", Type: field.TypeFloat64, Default: 0},
+		{Name: "map_width", Type: field.TypeInt},
+		{Name: "map_height", Type: field.TypeInt},
+		{Name: "grid", Type: field.TypeBytes},
+		{Name: "player", Type: field.TypeJSON},
+		{Name: "enemies", Type: field.TypeJSON},
+		{Name: "enemy_types", Type: field.TypeJSON, Nullable: true},
+		{Name: "pickups", Type: field.TypeJSON},
+		{Name: "projectiles", Type: field.TypeJSON},
+		{Name: "doors", Type: field.TypeJSON, Nullable: true},
+		{Name: "secrets", Type: field.TypeJSON, Nullable: true},
+		{Name: "barrels", Type: field.TypeJSON, Nullable: true},
+		{Name: "defeated", Type: field.TypeInt, Default: 0},
+		{Name: "level_enemy_total", Type: field.TypeInt, Default: 0},
+		{Name: "level_time", Type: field.TypeFloat64, Default: 0},
+		{Name: "completion", Type: field.TypeInt, Default: 0},
+		{Name: "fire_rate", Type: field.TypeFloat64},
+		{Name: "bullet_speed", Type: field.TypeFloat64},
+		{Name: "rng_state", Type: field.TypeInt64},
+		{Name: "campaign", Type: field.TypeString, Default: ""},
+		{Name: "map_name", Type: field.TypeString, Default: ""},
+		{Name: "par", Type: field.TypeFloat64, Default: 0},
+		{Name: "thumbnail", Type: field.TypeBytes, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true},
+		{Name: "updated_at", Type: field.TypeTime, Nullable: true},
+		{Name: "profile_saves", Type: field.TypeInt, Nullable: true},
+	}
+	// SaveSlotsTable holds the schema information for the "save_slots" table.
+	SaveSlotsTable = &schema.Table{
+		Name:       "save_slots",
+		Columns:    SaveSlotsColumns,
+		PrimaryKey: []*schema.Column{SaveSlotsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "save_slots_profiles_saves",
+				Columns:    []*schema.Column{SaveSlotsColumns[30]},
+				RefColumns: []*schema.Column{ProfilesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "saveslot_slot_profile_saves",
+				Unique:  true,
+				Columns: []*schema.Column{SaveSlotsColumns[1], SaveSlotsColumns[30]},
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		GameSettingsTable,
+		HighScoresTable,
+		LevelResultsTable,
+		ProfilesTable,
+		RunsTable,
+		SaveSlotsTable,
 	}
 )
 
 func init() {
+	GameSettingsTable.ForeignKeys[0].RefTable = ProfilesTable
+	HighScoresTable.ForeignKeys[0].RefTable = ProfilesTable
+	LevelResultsTable.ForeignKeys[0].RefTable = RunsTable
+	RunsTable.ForeignKeys[0].RefTable = ProfilesTable
+	SaveSlotsTable.ForeignKeys[0].RefTable = ProfilesTable
 }
@@ -5,7 +5,13 @@ package ent
 import (
 	"context"
 	"doomlike/ent/gamesettings"
+	"doomlike/ent/highscore"
+	"doomlike/ent/levelresult"
 	"doomlike/ent/predicate"
+	"doomlike/ent/profile"
+	"doomlike/ent/run"
+	"doomlike/ent/saveslot"
+	"doomlike/internal/sim"
 	"errors"
 	"fmt"
 	"sync"
@@ -25,26 +31,52 @@ const (
 
 	// Node types.
 	TypeGameSettings = "GameSettings"
+	TypeHighScore    = "HighScore"
+	TypeLevelResult  = "LevelResult"
+	TypeProfile      = "Profile"
+	TypeRun          = "Run"
+	TypeSaveSlot     = "SaveSlot"
 )
 
 // GameSettingsMutation represents an operation that mutates the GameSettings nodes in the graph.
 type GameSettingsMutation struct {
 	config
-	op              Op
-	typ             string
-	id              *string
-	fire_rate       *float64
-	addfire_rate    *float64
-	bullet_speed    *float64
-	addbullet_speed *float64
-	level_count     *int
-	addlevel_count  *int
-	created_at      *time.Time
-	updated_at      *time.Time
-	clearedFields   map[string]struct{}
-	done            bool
-	oldValue        func(context.Context) (*GameSettings, error)
-	predicates      []predicate.GameSettings
+	op                    Op
+	typ                   string
+	id                    *string
+	fire_rate             *float64
+	addfire_rate          *float64
+	bullet_speed          *float64
+	addbullet_speed       *float64
+	level_count           *int
+	addlevel_count        *int
+	seed                  *int64
+	addseed               *int64
+	mouse_sensitivity     *float64
+	addmouse_sensitivity  *float64
+	invert_mouse          *bool
+	turn_speed            *float64
+	addturn_speed         *float64
+	fov                   *float64
+	addfov                *float64
+	render_width          *int
+	addrender_width       *int
+	render_height         *int
+	addrender_height      *int
+	gamepad_turn_speed    *float64
+	addgamepad_turn_speed *float64
+	gamepad_deadzone      *float64
+	addgamepad_deadzone   *float64
+	gamepad_curve         *float64
+	addgamepad_curve      *float64
+	created_at            *time.Time
+	updated_at            *time.Time
+	clearedFields         map[string]struct{}
+	profile               *int
+	clearedprofile        bool
+	done                  bool
+	oldValue              func(context.Context) (*GameSettings, error)
+	predicates            []predicate.GameSettings
 }
 
 var _ ent.Mutation = (*GameSettingsMutation)(nil)
@@ -319,401 +351,8408 @@ func (m *GameSettingsMutation) ResetLevelCount() {
 	m.addlevel_count = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *GameSettingsMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetSeed sets the "seed" field.
+func (m *GameSettingsMutation) SetSeed(i int64) {
+	m.seed = &i
+	m.addseed = nil
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *GameSettingsMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// Seed returns the value of the "seed" field in the mutation.
+func (m *GameSettingsMutation) Seed() (r int64, exists bool) {
+	v := m.seed
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the GameSettings entity.
+// OldSeed returns the old "seed" field's value of the GameSettings entity.
 // If the GameSettings object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GameSettingsMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *GameSettingsMutation) OldSeed(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldSeed is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldSeed requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldSeed: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.Seed, nil
 }
 
-// ClearCreatedAt clears the value of the "created_at" field.
-func (m *GameSettingsMutation) ClearCreatedAt() {
-	m.created_at = nil
-	m.clearedFields[gamesettings.FieldCreatedAt] = struct{}{}
+// AddSeed adds i to the "seed" field.
+func (m *GameSettingsMutation) AddSeed(i int64) {
+	if m.addseed != nil {
+		*m.addseed += i
+	} else {
+		m.addseed = &i
+	}
 }
 
-// CreatedAtCleared returns if the "created_at" field was cleared in this mutation.
-func (m *GameSettingsMutation) CreatedAtCleared() bool {
-	_, ok := m.clearedFields[gamesettings.FieldCreatedAt]
-	return ok
+// AddedSeed returns the value that was added to the "seed" field in this mutation.
+func (m *GameSettingsMutation) AddedSeed() (r int64, exists bool) {
+	v := m.addseed
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *GameSettingsMutation) ResetCreatedAt() {
-	m.created_at = nil
-	delete(m.clearedFields, gamesettings.FieldCreatedAt)
+// ResetSeed resets all changes to the "seed" field.
+func (m *GameSettingsMutation) ResetSeed() {
+	m.seed = nil
+	m.addseed = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *GameSettingsMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetMouseSensitivity sets the "mouse_sensitivity" field.
+func (m *GameSettingsMutation) SetMouseSensitivity(f float64) {
+	m.mouse_sensitivity = &f
+	m.addmouse_sensitivity = nil
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *GameSettingsMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// MouseSensitivity returns the value of the "mouse_sensitivity" field in the mutation.
+func (m *GameSettingsMutation) MouseSensitivity() (r float64, exists bool) {
+	v := m.mouse_sensitivity
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the GameSettings entity.
+// OldMouseSensitivity returns the old "mouse_sensitivity" field's value of the GameSettings entity.
 // If the GameSettings object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GameSettingsMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *GameSettingsMutation) OldMouseSensitivity(ctx context.Context) (v float64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldMouseSensitivity is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldMouseSensitivity requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldMouseSensitivity: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.MouseSensitivity, nil
 }
 
-// ClearUpdatedAt clears the value of the "updated_at" field.
-func (m *GameSettingsMutation) ClearUpdatedAt() {
-	m.updated_at = nil
-	m.clearedFields[gamesettings.FieldUpdatedAt] = struct{}{}
+// AddMouseSensitivity adds f to the "mouse_sensitivity" field.
+func (m *GameSettingsMutation) AddMouseSensitivity(f float64) {
+	if m.addmouse_sensitivity != nil {
+		*m.addmouse_sensitivity += f
+	} else {
+		m.addmouse_sensitivity = &f
+	}
 }
 
-// UpdatedAtCleared returns if the "updated_at" field was cleared in this mutation.
-func (m *GameSettingsMutation) UpdatedAtCleared() bool {
-	_, ok := m.clearedFields[gamesettings.FieldUpdatedAt]
-	return ok
+// AddedMouseSensitivity returns the value that was added to the "mouse_sensitivity" field in this mutation.
+func (m *GameSettingsMutation) AddedMouseSensitivity() (r float64, exists bool) {
+	v := m.addmouse_sensitivity
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *GameSettingsMutation) ResetUpdatedAt() {
-	m.updated_at = nil
-	delete(m.clearedFields, gamesettings.FieldUpdatedAt)
+// ResetMouseSensitivity resets all changes to the "mouse_sensitivity" field.
+func (m *GameSettingsMutation) ResetMouseSensitivity() {
+	m.mouse_sensitivity = nil
+	m.addmouse_sensitivity = nil
 }
 
-// Where appends a list predicates to the GameSettingsMutation builder.
-func (m *GameSettingsMutation) Where(ps ...predicate.GameSettings) {
-	m.predicates = append(m.predicates, ps...)
+// SetInvertMouse sets the "invert_mouse" field.
+func (m *GameSettingsMutation) SetInvertMouse(b bool) {
+	m.invert_mouse = &b
 }
 
-// WhereP appends storage-level predicates to the GameSettingsMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *GameSettingsMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.GameSettings, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// InvertMouse returns the value of the "invert_mouse" field in the mutation.
+func (m *GameSettingsMutation) InvertMouse() (r bool, exists bool) {
+	v := m.invert_mouse
+	if v == nil {
+		return
 	}
-	m.Where(p...)
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *GameSettingsMutation) Op() Op {
-	return m.op
+// OldInvertMouse returns the old "invert_mouse" field's value of the GameSettings entity.
+// If the GameSettings object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GameSettingsMutation) OldInvertMouse(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInvertMouse is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInvertMouse requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInvertMouse: %w", err)
+	}
+	return oldValue.InvertMouse, nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *GameSettingsMutation) SetOp(op Op) {
-	m.op = op
+// ResetInvertMouse resets all changes to the "invert_mouse" field.
+func (m *GameSettingsMutation) ResetInvertMouse() {
+	m.invert_mouse = nil
 }
 
-// Type returns the node type of this mutation (GameSettings).
-func (m *GameSettingsMutation) Type() string {
-	return m.typ
+// SetTurnSpeed sets the "turn_speed" field.
+func (m *GameSettingsMutation) SetTurnSpeed(f float64) {
+	m.turn_speed = &f
+	m.addturn_speed = nil
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *GameSettingsMutation) Fields() []string {
-	fields := make([]string, 0, 5)
-	if m.fire_rate != nil {
-		fields = append(fields, gamesettings.FieldFireRate)
-	}
-	if m.bullet_speed != nil {
-		fields = append(fields, gamesettings.FieldBulletSpeed)
+// TurnSpeed returns the value of the "turn_speed" field in the mutation.
+func (m *GameSettingsMutation) TurnSpeed() (r float64, exists bool) {
+	v := m.turn_speed
+	if v == nil {
+		return
 	}
-	if m.level_count != nil {
-		fields = append(fields, gamesettings.FieldLevelCount)
+	return *v, true
+}
+
+// OldTurnSpeed returns the old "turn_speed" field's value of the GameSettings entity.
+// If the GameSettings object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GameSettingsMutation) OldTurnSpeed(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTurnSpeed is only allowed on UpdateOne operations")
 	}
-	if m.created_at != nil {
-		fields = append(fields, gamesettings.FieldCreatedAt)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTurnSpeed requires an ID field in the mutation")
 	}
-	if m.updated_at != nil {
-		fields = append(fields, gamesettings.FieldUpdatedAt)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTurnSpeed: %w", err)
 	}
-	return fields
+	return oldValue.TurnSpeed, nil
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *GameSettingsMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case gamesettings.FieldFireRate:
-		return m.FireRate()
-	case gamesettings.FieldBulletSpeed:
-		return m.BulletSpeed()
-	case gamesettings.FieldLevelCount:
-		return m.LevelCount()
-	case gamesettings.FieldCreatedAt:
-		return m.CreatedAt()
-	case gamesettings.FieldUpdatedAt:
-		return m.UpdatedAt()
+// AddTurnSpeed adds f to the "turn_speed" field.
+func (m *GameSettingsMutation) AddTurnSpeed(f float64) {
+	if m.addturn_speed != nil {
+		*m.addturn_speed += f
+	} else {
+		m.addturn_speed = &f
 	}
-	return nil, false
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *GameSettingsMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case gamesettings.FieldFireRate:
-		return m.OldFireRate(ctx)
-	case gamesettings.FieldBulletSpeed:
-		return m.OldBulletSpeed(ctx)
-	case gamesettings.FieldLevelCount:
-		return m.OldLevelCount(ctx)
-	case gamesettings.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case gamesettings.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
+// AddedTurnSpeed returns the value that was added to the "turn_speed" field in this mutation.
+func (m *GameSettingsMutation) AddedTurnSpeed() (r float64, exists bool) {
+	v := m.addturn_speed
+	if v == nil {
+		return
 	}
-	return nil, fmt.Errorf("unknown GameSettings field %s", name)
+	return *v, true
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *GameSettingsMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case gamesettings.FieldFireRate:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetFireRate(v)
-		return nil
-	case gamesettings.FieldBulletSpeed:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetBulletSpeed(v)
-		return nil
-	case gamesettings.FieldLevelCount:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLevelCount(v)
-		return nil
-	case gamesettings.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case gamesettings.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
+// ResetTurnSpeed resets all changes to the "turn_speed" field.
+func (m *GameSettingsMutation) ResetTurnSpeed() {
+	m.turn_speed = nil
+	m.addturn_speed = nil
+}
+
+// SetFov sets the "fov" field.
+func (m *GameSettingsMutation) SetFov(f float64) {
+	m.fov = &f
+	m.addfov = nil
+}
+
+// Fov returns the value of the "fov" field in the mutation.
+func (m *GameSettingsMutation) Fov() (r float64, exists bool) {
+	v := m.fov
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown GameSettings field %s", name)
+	return *v, true
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *GameSettingsMutation) AddedFields() []string {
-	var fields []string
-	if m.addfire_rate != nil {
-		fields = append(fields, gamesettings.FieldFireRate)
+// OldFov returns the old "fov" field's value of the GameSettings entity.
+// If the GameSettings object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GameSettingsMutation) OldFov(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFov is only allowed on UpdateOne operations")
 	}
-	if m.addbullet_speed != nil {
-		fields = append(fields, gamesettings.FieldBulletSpeed)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFov requires an ID field in the mutation")
 	}
-	if m.addlevel_count != nil {
-		fields = append(fields, gamesettings.FieldLevelCount)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFov: %w", err)
 	}
-	return fields
+	return oldValue.Fov, nil
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *GameSettingsMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case gamesettings.FieldFireRate:
-		return m.AddedFireRate()
-	case gamesettings.FieldBulletSpeed:
-		return m.AddedBulletSpeed()
-	case gamesettings.FieldLevelCount:
-		return m.AddedLevelCount()
+// AddFov adds f to the "fov" field.
+func (m *GameSettingsMutation) AddFov(f float64) {
+	if m.addfov != nil {
+		*m.addfov += f
+	} else {
+		m.addfov = &f
 	}
-	return nil, false
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *GameSettingsMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	case gamesettings.FieldFireRate:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddFireRate(v)
-		return nil
-	case gamesettings.FieldBulletSpeed:
-		v, ok := value.(float64)
-		if !ok {
+// AddedFov returns the value that was added to the "fov" field in this mutation.
+func (m *GameSettingsMutation) AddedFov() (r float64, exists bool) {
+	v := m.addfov
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetFov resets all changes to the "fov" field.
+func (m *GameSettingsMutation) ResetFov() {
+	m.fov = nil
+	m.addfov = nil
+}
+
+// SetRenderWidth sets the "render_width" field.
+func (m *GameSettingsMutation) SetRenderWidth(i int) {
+	m.render_width = &i
+	m.addrender_width = nil
+}
+
+// RenderWidth returns the value of the "render_width" field in the mutation.
+func (m *GameSettingsMutation) RenderWidth() (r int, exists bool) {
+	v := m.render_width
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRenderWidth returns the old "render_width" field's value of the GameSettings entity.
+// If the GameSettings object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GameSettingsMutation) OldRenderWidth(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRenderWidth is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRenderWidth requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRenderWidth: %w", err)
+	}
+	return oldValue.RenderWidth, nil
+}
+
+// AddRenderWidth adds i to the "render_width" field.
+func (m *GameSettingsMutation) AddRenderWidth(i int) {
+	if m.addrender_width != nil {
+		*m.addrender_width += i
+	} else {
+		m.addrender_width = &i
+	}
+}
+
+// AddedRenderWidth returns the value that was added to the "render_width" field in this mutation.
+func (m *GameSettingsMutation) AddedRenderWidth() (r int, exists bool) {
+	v := m.addrender_width
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRenderWidth resets all changes to the "render_width" field.
+func (m *GameSettingsMutation) ResetRenderWidth() {
+	m.render_width = nil
+	m.addrender_width = nil
+}
+
+// SetRenderHeight sets the "render_height" field.
+func (m *GameSettingsMutation) SetRenderHeight(i int) {
+	m.render_height = &i
+	m.addrender_height = nil
+}
+
+// RenderHeight returns the value of the "render_height" field in the mutation.
+func (m *GameSettingsMutation) RenderHeight() (r int, exists bool) {
+	v := m.render_height
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRenderHeight returns the old "render_height" field's value of the GameSettings entity.
+// If the GameSettings object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GameSettingsMutation) OldRenderHeight(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRenderHeight is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRenderHeight requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRenderHeight: %w", err)
+	}
+	return oldValue.RenderHeight, nil
+}
+
+// AddRenderHeight adds i to the "render_height" field.
+func (m *GameSettingsMutation) AddRenderHeight(i int) {
+	if m.addrender_height != nil {
+		*m.addrender_height += i
+	} else {
+		m.addrender_height = &i
+	}
+}
+
+// AddedRenderHeight returns the value that was added to the "render_height" field in this mutation.
+func (m *GameSettingsMutation) AddedRenderHeight() (r int, exists bool) {
+	v := m.addrender_height
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRenderHeight resets all changes to the "render_height" field.
+func (m *GameSettingsMutation) ResetRenderHeight() {
+	m.render_height = nil
+	m.addrender_height = nil
+}
+
+// SetGamepadTurnSpeed sets the "gamepad_turn_speed" field.
+func (m *GameSettingsMutation) SetGamepadTurnSpeed(f float64) {
+	m.gamepad_turn_speed = &f
+	m.addgamepad_turn_speed = nil
+}
+
+// GamepadTurnSpeed returns the value of the "gamepad_turn_speed" field in the mutation.
+func (m *GameSettingsMutation) GamepadTurnSpeed() (r float64, exists bool) {
+	v := m.gamepad_turn_speed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGamepadTurnSpeed returns the old "gamepad_turn_speed" field's value of the GameSettings entity.
+// If the GameSettings object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GameSettingsMutation) OldGamepadTurnSpeed(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGamepadTurnSpeed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGamepadTurnSpeed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGamepadTurnSpeed: %w", err)
+	}
+	return oldValue.GamepadTurnSpeed, nil
+}
+
+// AddGamepadTurnSpeed adds f to the "gamepad_turn_speed" field.
+func (m *GameSettingsMutation) AddGamepadTurnSpeed(f float64) {
+	if m.addgamepad_turn_speed != nil {
+		*m.addgamepad_turn_speed += f
+	} else {
+		m.addgamepad_turn_speed = &f
+	}
+}
+
+// AddedGamepadTurnSpeed returns the value that was added to the "gamepad_turn_speed" field in this mutation.
+func (m *GameSettingsMutation) AddedGamepadTurnSpeed() (r float64, exists bool) {
+	v := m.addgamepad_turn_speed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetGamepadTurnSpeed resets all changes to the "gamepad_turn_speed" field.
+func (m *GameSettingsMutation) ResetGamepadTurnSpeed() {
+	m.gamepad_turn_speed = nil
+	m.addgamepad_turn_speed = nil
+}
+
+// SetGamepadDeadzone sets the "gamepad_deadzone" field.
+func (m *GameSettingsMutation) SetGamepadDeadzone(f float64) {
+	m.gamepad_deadzone = &f
+	m.addgamepad_deadzone = nil
+}
+
+// GamepadDeadzone returns the value of the "gamepad_deadzone" field in the mutation.
+func (m *GameSettingsMutation) GamepadDeadzone() (r float64, exists bool) {
+	v := m.gamepad_deadzone
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGamepadDeadzone returns the old "gamepad_deadzone" field's value of the GameSettings entity.
+// If the GameSettings object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GameSettingsMutation) OldGamepadDeadzone(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGamepadDeadzone is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGamepadDeadzone requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGamepadDeadzone: %w", err)
+	}
+	return oldValue.GamepadDeadzone, nil
+}
+
+// AddGamepadDeadzone adds f to the "gamepad_deadzone" field.
+func (m *GameSettingsMutation) AddGamepadDeadzone(f float64) {
+	if m.addgamepad_deadzone != nil {
+		*m.addgamepad_deadzone += f
+	} else {
+		m.addgamepad_deadzone = &f
+	}
+}
+
+// AddedGamepadDeadzone returns the value that was added to the "gamepad_deadzone" field in this mutation.
+func (m *GameSettingsMutation) AddedGamepadDeadzone() (r float64, exists bool) {
+	v := m.addgamepad_deadzone
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetGamepadDeadzone resets all changes to the "gamepad_deadzone" field.
+func (m *GameSettingsMutation) ResetGamepadDeadzone() {
+	m.gamepad_deadzone = nil
+	m.addgamepad_deadzone = nil
+}
+
+// SetGamepadCurve sets the "gamepad_curve" field.
+func (m *GameSettingsMutation) SetGamepadCurve(f float64) {
+	m.gamepad_curve = &f
+	m.addgamepad_curve = nil
+}
+
+// GamepadCurve returns the value of the "gamepad_curve" field in the mutation.
+func (m *GameSettingsMutation) GamepadCurve() (r float64, exists bool) {
+	v := m.gamepad_curve
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGamepadCurve returns the old "gamepad_curve" field's value of the GameSettings entity.
+// If the GameSettings object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GameSettingsMutation) OldGamepadCurve(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGamepadCurve is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGamepadCurve requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGamepadCurve: %w", err)
+	}
+	return oldValue.GamepadCurve, nil
+}
+
+// AddGamepadCurve adds f to the "gamepad_curve" field.
+func (m *GameSettingsMutation) AddGamepadCurve(f float64) {
+	if m.addgamepad_curve != nil {
+		*m.addgamepad_curve += f
+	} else {
+		m.addgamepad_curve = &f
+	}
+}
+
+// AddedGamepadCurve returns the value that was added to the "gamepad_curve" field in this mutation.
+func (m *GameSettingsMutation) AddedGamepadCurve() (r float64, exists bool) {
+	v := m.addgamepad_curve
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetGamepadCurve resets all changes to the "gamepad_curve" field.
+func (m *GameSettingsMutation) ResetGamepadCurve() {
+	m.gamepad_curve = nil
+	m.addgamepad_curve = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *GameSettingsMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *GameSettingsMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the GameSettings entity.
+// If the GameSettings object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GameSettingsMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (m *GameSettingsMutation) ClearCreatedAt() {
+	m.created_at = nil
+	m.clearedFields[gamesettings.FieldCreatedAt] = struct{}{}
+}
+
+// CreatedAtCleared returns if the "created_at" field was cleared in this mutation.
+func (m *GameSettingsMutation) CreatedAtCleared() bool {
+	_, ok := m.clearedFields[gamesettings.FieldCreatedAt]
+	return ok
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *GameSettingsMutation) ResetCreatedAt() {
+	m.created_at = nil
+	delete(m.clearedFields, gamesettings.FieldCreatedAt)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *GameSettingsMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *GameSettingsMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the GameSettings entity.
+// If the GameSettings object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GameSettingsMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (m *GameSettingsMutation) ClearUpdatedAt() {
+	m.updated_at = nil
+	m.clearedFields[gamesettings.FieldUpdatedAt] = struct{}{}
+}
+
+// UpdatedAtCleared returns if the "updated_at" field was cleared in this mutation.
+func (m *GameSettingsMutation) UpdatedAtCleared() bool {
+	_, ok := m.clearedFields[gamesettings.FieldUpdatedAt]
+	return ok
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *GameSettingsMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+	delete(m.clearedFields, gamesettings.FieldUpdatedAt)
+}
+
+// SetProfileID sets the "profile" edge to the Profile entity by id.
+func (m *GameSettingsMutation) SetProfileID(id int) {
+	m.profile = &id
+}
+
+// ClearProfile clears the "profile" edge to the Profile entity.
+func (m *GameSettingsMutation) ClearProfile() {
+	m.clearedprofile = true
+}
+
+// ProfileCleared reports if the "profile" edge to the Profile entity was cleared.
+func (m *GameSettingsMutation) ProfileCleared() bool {
+	return m.clearedprofile
+}
+
+// ProfileID returns the "profile" edge ID in the mutation.
+func (m *GameSettingsMutation) ProfileID() (id int, exists bool) {
+	if m.profile != nil {
+		return *m.profile, true
+	}
+	return
+}
+
+// ProfileIDs returns the "profile" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ProfileID instead. It exists only for internal usage by the builders.
+func (m *GameSettingsMutation) ProfileIDs() (ids []int) {
+	if id := m.profile; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetProfile resets all changes to the "profile" edge.
+func (m *GameSettingsMutation) ResetProfile() {
+	m.profile = nil
+	m.clearedprofile = false
+}
+
+// Where appends a list predicates to the GameSettingsMutation builder.
+func (m *GameSettingsMutation) Where(ps ...predicate.GameSettings) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the GameSettingsMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *GameSettingsMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.GameSettings, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *GameSettingsMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *GameSettingsMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (GameSettings).
+func (m *GameSettingsMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *GameSettingsMutation) Fields() []string {
+	fields := make([]string, 0, 15)
+	if m.fire_rate != nil {
+		fields = append(fields, gamesettings.FieldFireRate)
+	}
+	if m.bullet_speed != nil {
+		fields = append(fields, gamesettings.FieldBulletSpeed)
+	}
+	if m.level_count != nil {
+		fields = append(fields, gamesettings.FieldLevelCount)
+	}
+	if m.seed != nil {
+		fields = append(fields, gamesettings.FieldSeed)
+	}
+	if m.mouse_sensitivity != nil {
+		fields = append(fields, gamesettings.FieldMouseSensitivity)
+	}
+	if m.invert_mouse != nil {
+		fields = append(fields, gamesettings.FieldInvertMouse)
+	}
+	if m.turn_speed != nil {
+		fields = append(fields, gamesettings.FieldTurnSpeed)
+	}
+	if m.fov != nil {
+		fields = append(fields, gamesettings.FieldFov)
+	}
+	if m.render_width != nil {
+		fields = append(fields, gamesettings.FieldRenderWidth)
+	}
+	if m.render_height != nil {
+		fields = append(fields, gamesettings.FieldRenderHeight)
+	}
+	if m.gamepad_turn_speed != nil {
+		fields = append(fields, gamesettings.FieldGamepadTurnSpeed)
+	}
+	if m.gamepad_deadzone != nil {
+		fields = append(fields, gamesettings.FieldGamepadDeadzone)
+	}
+	if m.gamepad_curve != nil {
+		fields = append(fields, gamesettings.FieldGamepadCurve)
+	}
+	if m.created_at != nil {
+		fields = append(fields, gamesettings.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, gamesettings.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *GameSettingsMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case gamesettings.FieldFireRate:
+		return m.FireRate()
+	case gamesettings.FieldBulletSpeed:
+		return m.BulletSpeed()
+	case gamesettings.FieldLevelCount:
+		return m.LevelCount()
+	case gamesettings.FieldSeed:
+		return m.Seed()
+	case gamesettings.FieldMouseSensitivity:
+		return m.MouseSensitivity()
+	case gamesettings.FieldInvertMouse:
+		return m.InvertMouse()
+	case gamesettings.FieldTurnSpeed:
+		return m.TurnSpeed()
+	case gamesettings.FieldFov:
+		return m.Fov()
+	case gamesettings.FieldRenderWidth:
+		return m.RenderWidth()
+	case gamesettings.FieldRenderHeight:
+		return m.RenderHeight()
+	case gamesettings.FieldGamepadTurnSpeed:
+		return m.GamepadTurnSpeed()
+	case gamesettings.FieldGamepadDeadzone:
+		return m.GamepadDeadzone()
+	case gamesettings.FieldGamepadCurve:
+		return m.GamepadCurve()
+	case gamesettings.FieldCreatedAt:
+		return m.CreatedAt()
+	case gamesettings.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *GameSettingsMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case gamesettings.FieldFireRate:
+		return m.OldFireRate(ctx)
+	case gamesettings.FieldBulletSpeed:
+		return m.OldBulletSpeed(ctx)
+	case gamesettings.FieldLevelCount:
+		return m.OldLevelCount(ctx)
+	case gamesettings.FieldSeed:
+		return m.OldSeed(ctx)
+	case gamesettings.FieldMouseSensitivity:
+		return m.OldMouseSensitivity(ctx)
+	case gamesettings.FieldInvertMouse:
+		return m.OldInvertMouse(ctx)
+	case gamesettings.FieldTurnSpeed:
+		return m.OldTurnSpeed(ctx)
+	case gamesettings.FieldFov:
+		return m.OldFov(ctx)
+	case gamesettings.FieldRenderWidth:
+		return m.OldRenderWidth(ctx)
+	case gamesettings.FieldRenderHeight:
+		return m.OldRenderHeight(ctx)
+	case gamesettings.FieldGamepadTurnSpeed:
+		return m.OldGamepadTurnSpeed(ctx)
+	case gamesettings.FieldGamepadDeadzone:
+		return m.OldGamepadDeadzone(ctx)
+	case gamesettings.FieldGamepadCurve:
+		return m.OldGamepadCurve(ctx)
+	case gamesettings.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case gamesettings.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown GameSettings field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GameSettingsMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case gamesettings.FieldFireRate:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFireRate(v)
+		return nil
+	case gamesettings.FieldBulletSpeed:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBulletSpeed(v)
+		return nil
+	case gamesettings.FieldLevelCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLevelCount(v)
+		return nil
+	case gamesettings.FieldSeed:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSeed(v)
+		return nil
+	case gamesettings.FieldMouseSensitivity:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMouseSensitivity(v)
+		return nil
+	case gamesettings.FieldInvertMouse:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInvertMouse(v)
+		return nil
+	case gamesettings.FieldTurnSpeed:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTurnSpeed(v)
+		return nil
+	case gamesettings.FieldFov:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFov(v)
+		return nil
+	case gamesettings.FieldRenderWidth:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRenderWidth(v)
+		return nil
+	case gamesettings.FieldRenderHeight:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRenderHeight(v)
+		return nil
+	case gamesettings.FieldGamepadTurnSpeed:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGamepadTurnSpeed(v)
+		return nil
+	case gamesettings.FieldGamepadDeadzone:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGamepadDeadzone(v)
+		return nil
+	case gamesettings.FieldGamepadCurve:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGamepadCurve(v)
+		return nil
+	case gamesettings.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case gamesettings.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown GameSettings field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *GameSettingsMutation) AddedFields() []string {
+	var fields []string
+	if m.addfire_rate != nil {
+		fields = append(fields, gamesettings.FieldFireRate)
+	}
+	if m.addbullet_speed != nil {
+		fields = append(fields, gamesettings.FieldBulletSpeed)
+	}
+	if m.addlevel_count != nil {
+		fields = append(fields, gamesettings.FieldLevelCount)
+	}
+	if m.addseed != nil {
+		fields = append(fields, gamesettings.FieldSeed)
+	}
+	if m.addmouse_sensitivity != nil {
+		fields = append(fields, gamesettings.FieldMouseSensitivity)
+	}
+	if m.addturn_speed != nil {
+		fields = append(fields, gamesettings.FieldTurnSpeed)
+	}
+	if m.addfov != nil {
+		fields = append(fields, gamesettings.FieldFov)
+	}
+	if m.addrender_width != nil {
+		fields = append(fields, gamesettings.FieldRenderWidth)
+	}
+	if m.addrender_height != nil {
+		fields = append(fields, gamesettings.FieldRenderHeight)
+	}
+	if m.addgamepad_turn_speed != nil {
+		fields = append(fields, gamesettings.FieldGamepadTurnSpeed)
+	}
+	if m.addgamepad_deadzone != nil {
+		fields = append(fields, gamesettings.FieldGamepadDeadzone)
+	}
+	if m.addgamepad_curve != nil {
+		fields = append(fields, gamesettings.FieldGamepadCurve)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *GameSettingsMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case gamesettings.FieldFireRate:
+		return m.AddedFireRate()
+	case gamesettings.FieldBulletSpeed:
+		return m.AddedBulletSpeed()
+	case gamesettings.FieldLevelCount:
+		return m.AddedLevelCount()
+	case gamesettings.FieldSeed:
+		return m.AddedSeed()
+	case gamesettings.FieldMouseSensitivity:
+		return m.AddedMouseSensitivity()
+	case gamesettings.FieldTurnSpeed:
+		return m.AddedTurnSpeed()
+	case gamesettings.FieldFov:
+		return m.AddedFov()
+	case gamesettings.FieldRenderWidth:
+		return m.AddedRenderWidth()
+	case gamesettings.FieldRenderHeight:
+		return m.AddedRenderHeight()
+	case gamesettings.FieldGamepadTurnSpeed:
+		return m.AddedGamepadTurnSpeed()
+	case gamesettings.FieldGamepadDeadzone:
+		return m.AddedGamepadDeadzone()
+	case gamesettings.FieldGamepadCurve:
+		return m.AddedGamepadCurve()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GameSettingsMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case gamesettings.FieldFireRate:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFireRate(v)
+		return nil
+	case gamesettings.FieldBulletSpeed:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBulletSpeed(v)
+		return nil
+	case gamesettings.FieldLevelCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLevelCount(v)
+		return nil
+	case gamesettings.FieldSeed:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSeed(v)
+		return nil
+	case gamesettings.FieldMouseSensitivity:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMouseSensitivity(v)
+		return nil
+	case gamesettings.FieldTurnSpeed:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTurnSpeed(v)
+		return nil
+	case gamesettings.FieldFov:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFov(v)
+		return nil
+	case gamesettings.FieldRenderWidth:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRenderWidth(v)
+		return nil
+	case gamesettings.FieldRenderHeight:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRenderHeight(v)
+		return nil
+	case gamesettings.FieldGamepadTurnSpeed:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddGamepadTurnSpeed(v)
+		return nil
+	case gamesettings.FieldGamepadDeadzone:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddGamepadDeadzone(v)
+		return nil
+	case gamesettings.FieldGamepadCurve:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddGamepadCurve(v)
+		return nil
+	}
+	return fmt.Errorf("unknown GameSettings numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *GameSettingsMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(gamesettings.FieldCreatedAt) {
+		fields = append(fields, gamesettings.FieldCreatedAt)
+	}
+	if m.FieldCleared(gamesettings.FieldUpdatedAt) {
+		fields = append(fields, gamesettings.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *GameSettingsMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *GameSettingsMutation) ClearField(name string) error {
+	switch name {
+	case gamesettings.FieldCreatedAt:
+		m.ClearCreatedAt()
+		return nil
+	case gamesettings.FieldUpdatedAt:
+		m.ClearUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown GameSettings nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *GameSettingsMutation) ResetField(name string) error {
+	switch name {
+	case gamesettings.FieldFireRate:
+		m.ResetFireRate()
+		return nil
+	case gamesettings.FieldBulletSpeed:
+		m.ResetBulletSpeed()
+		return nil
+	case gamesettings.FieldLevelCount:
+		m.ResetLevelCount()
+		return nil
+	case gamesettings.FieldSeed:
+		m.ResetSeed()
+		return nil
+	case gamesettings.FieldMouseSensitivity:
+		m.ResetMouseSensitivity()
+		return nil
+	case gamesettings.FieldInvertMouse:
+		m.ResetInvertMouse()
+		return nil
+	case gamesettings.FieldTurnSpeed:
+		m.ResetTurnSpeed()
+		return nil
+	case gamesettings.FieldFov:
+		m.ResetFov()
+		return nil
+	case gamesettings.FieldRenderWidth:
+		m.ResetRenderWidth()
+		return nil
+	case gamesettings.FieldRenderHeight:
+		m.ResetRenderHeight()
+		return nil
+	case gamesettings.FieldGamepadTurnSpeed:
+		m.ResetGamepadTurnSpeed()
+		return nil
+	case gamesettings.FieldGamepadDeadzone:
+		m.ResetGamepadDeadzone()
+		return nil
+	case gamesettings.FieldGamepadCurve:
+		m.ResetGamepadCurve()
+		return nil
+	case gamesettings.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case gamesettings.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown GameSettings field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *GameSettingsMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.profile != nil {
+		edges = append(edges, gamesettings.EdgeProfile)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *GameSettingsMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case gamesettings.EdgeProfile:
+		if id := m.profile; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *GameSettingsMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *GameSettingsMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *GameSettingsMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedprofile {
+		edges = append(edges, gamesettings.EdgeProfile)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *GameSettingsMutation) EdgeCleared(name string) bool {
+	switch name {
+	case gamesettings.EdgeProfile:
+		return m.clearedprofile
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *GameSettingsMutation) ClearEdge(name string) error {
+	switch name {
+	case gamesettings.EdgeProfile:
+		m.ClearProfile()
+		return nil
+	}
+	return fmt.Errorf("unknown GameSettings unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *GameSettingsMutation) ResetEdge(name string) error {
+	switch name {
+	case gamesettings.EdgeProfile:
+		m.ResetProfile()
+		return nil
+	}
+	return fmt.Errorf("unknown GameSettings edge %s", name)
+}
+
+// HighScoreMutation represents an operation that mutates the HighScore nodes in the graph.
+type HighScoreMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int
+	name            *string
+	score           *int
+	addscore        *int
+	seed            *int64
+	addseed         *int64
+	level           *int
+	addlevel        *int
+	total_levels    *int
+	addtotal_levels *int
+	won             *bool
+	fire_rate       *float64
+	addfire_rate    *float64
+	bullet_speed    *float64
+	addbullet_speed *float64
+	created_at      *time.Time
+	clearedFields   map[string]struct{}
+	profile         *int
+	clearedprofile  bool
+	done            bool
+	oldValue        func(context.Context) (*HighScore, error)
+	predicates      []predicate.HighScore
+}
+
+var _ ent.Mutation = (*HighScoreMutation)(nil)
+
+// highscoreOption allows management of the mutation configuration using functional options.
+type highscoreOption func(*HighScoreMutation)
+
+// newHighScoreMutation creates new mutation for the HighScore entity.
+func newHighScoreMutation(c config, op Op, opts ...highscoreOption) *HighScoreMutation {
+	m := &HighScoreMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeHighScore,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withHighScoreID sets the ID field of the mutation.
+func withHighScoreID(id int) highscoreOption {
+	return func(m *HighScoreMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *HighScore
+		)
+		m.oldValue = func(ctx context.Context) (*HighScore, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().HighScore.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withHighScore sets the old HighScore of the mutation.
+func withHighScore(node *HighScore) highscoreOption {
+	return func(m *HighScoreMutation) {
+		m.oldValue = func(context.Context) (*HighScore, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m HighScoreMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m HighScoreMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *HighScoreMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *HighScoreMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().HighScore.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *HighScoreMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *HighScoreMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the HighScore entity.
+// If the HighScore object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HighScoreMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *HighScoreMutation) ResetName() {
+	m.name = nil
+}
+
+// SetScore sets the "score" field.
+func (m *HighScoreMutation) SetScore(i int) {
+	m.score = &i
+	m.addscore = nil
+}
+
+// Score returns the value of the "score" field in the mutation.
+func (m *HighScoreMutation) Score() (r int, exists bool) {
+	v := m.score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScore returns the old "score" field's value of the HighScore entity.
+// If the HighScore object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HighScoreMutation) OldScore(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScore is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScore requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScore: %w", err)
+	}
+	return oldValue.Score, nil
+}
+
+// AddScore adds i to the "score" field.
+func (m *HighScoreMutation) AddScore(i int) {
+	if m.addscore != nil {
+		*m.addscore += i
+	} else {
+		m.addscore = &i
+	}
+}
+
+// AddedScore returns the value that was added to the "score" field in this mutation.
+func (m *HighScoreMutation) AddedScore() (r int, exists bool) {
+	v := m.addscore
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetScore resets all changes to the "score" field.
+func (m *HighScoreMutation) ResetScore() {
+	m.score = nil
+	m.addscore = nil
+}
+
+// SetSeed sets the "seed" field.
+func (m *HighScoreMutation) SetSeed(i int64) {
+	m.seed = &i
+	m.addseed = nil
+}
+
+// Seed returns the value of the "seed" field in the mutation.
+func (m *HighScoreMutation) Seed() (r int64, exists bool) {
+	v := m.seed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSeed returns the old "seed" field's value of the HighScore entity.
+// If the HighScore object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HighScoreMutation) OldSeed(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSeed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSeed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSeed: %w", err)
+	}
+	return oldValue.Seed, nil
+}
+
+// AddSeed adds i to the "seed" field.
+func (m *HighScoreMutation) AddSeed(i int64) {
+	if m.addseed != nil {
+		*m.addseed += i
+	} else {
+		m.addseed = &i
+	}
+}
+
+// AddedSeed returns the value that was added to the "seed" field in this mutation.
+func (m *HighScoreMutation) AddedSeed() (r int64, exists bool) {
+	v := m.addseed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSeed resets all changes to the "seed" field.
+func (m *HighScoreMutation) ResetSeed() {
+	m.seed = nil
+	m.addseed = nil
+}
+
+// SetLevel sets the "level" field.
+func (m *HighScoreMutation) SetLevel(i int) {
+	m.level = &i
+	m.addlevel = nil
+}
+
+// Level returns the value of the "level" field in the mutation.
+func (m *HighScoreMutation) Level() (r int, exists bool) {
+	v := m.level
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLevel returns the old "level" field's value of the HighScore entity.
+// If the HighScore object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HighScoreMutation) OldLevel(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLevel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLevel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLevel: %w", err)
+	}
+	return oldValue.Level, nil
+}
+
+// AddLevel adds i to the "level" field.
+func (m *HighScoreMutation) AddLevel(i int) {
+	if m.addlevel != nil {
+		*m.addlevel += i
+	} else {
+		m.addlevel = &i
+	}
+}
+
+// AddedLevel returns the value that was added to the "level" field in this mutation.
+func (m *HighScoreMutation) AddedLevel() (r int, exists bool) {
+	v := m.addlevel
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLevel resets all changes to the "level" field.
+func (m *HighScoreMutation) ResetLevel() {
+	m.level = nil
+	m.addlevel = nil
+}
+
+// SetTotalLevels sets the "total_levels" field.
+func (m *HighScoreMutation) SetTotalLevels(i int) {
+	m.total_levels = &i
+	m.addtotal_levels = nil
+}
+
+// TotalLevels returns the value of the "total_levels" field in the mutation.
+func (m *HighScoreMutation) TotalLevels() (r int, exists bool) {
+	v := m.total_levels
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTotalLevels returns the old "total_levels" field's value of the HighScore entity.
+// If the HighScore object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HighScoreMutation) OldTotalLevels(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTotalLevels is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTotalLevels requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTotalLevels: %w", err)
+	}
+	return oldValue.TotalLevels, nil
+}
+
+// AddTotalLevels adds i to the "total_levels" field.
+func (m *HighScoreMutation) AddTotalLevels(i int) {
+	if m.addtotal_levels != nil {
+		*m.addtotal_levels += i
+	} else {
+		m.addtotal_levels = &i
+	}
+}
+
+// AddedTotalLevels returns the value that was added to the "total_levels" field in this mutation.
+func (m *HighScoreMutation) AddedTotalLevels() (r int, exists bool) {
+	v := m.addtotal_levels
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTotalLevels resets all changes to the "total_levels" field.
+func (m *HighScoreMutation) ResetTotalLevels() {
+	m.total_levels = nil
+	m.addtotal_levels = nil
+}
+
+// SetWon sets the "won" field.
+func (m *HighScoreMutation) SetWon(b bool) {
+	m.won = &b
+}
+
+// Won returns the value of the "won" field in the mutation.
+func (m *HighScoreMutation) Won() (r bool, exists bool) {
+	v := m.won
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWon returns the old "won" field's value of the HighScore entity.
+// If the HighScore object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HighScoreMutation) OldWon(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWon is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWon requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWon: %w", err)
+	}
+	return oldValue.Won, nil
+}
+
+// ResetWon resets all changes to the "won" field.
+func (m *HighScoreMutation) ResetWon() {
+	m.won = nil
+}
+
+// SetFireRate sets the "fire_rate" field.
+func (m *HighScoreMutation) SetFireRate(f float64) {
+	m.fire_rate = &f
+	m.addfire_rate = nil
+}
+
+// FireRate returns the value of the "fire_rate" field in the mutation.
+func (m *HighScoreMutation) FireRate() (r float64, exists bool) {
+	v := m.fire_rate
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFireRate returns the old "fire_rate" field's value of the HighScore entity.
+// If the HighScore object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HighScoreMutation) OldFireRate(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFireRate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFireRate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFireRate: %w", err)
+	}
+	return oldValue.FireRate, nil
+}
+
+// AddFireRate adds f to the "fire_rate" field.
+func (m *HighScoreMutation) AddFireRate(f float64) {
+	if m.addfire_rate != nil {
+		*m.addfire_rate += f
+	} else {
+		m.addfire_rate = &f
+	}
+}
+
+// AddedFireRate returns the value that was added to the "fire_rate" field in this mutation.
+func (m *HighScoreMutation) AddedFireRate() (r float64, exists bool) {
+	v := m.addfire_rate
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetFireRate resets all changes to the "fire_rate" field.
+func (m *HighScoreMutation) ResetFireRate() {
+	m.fire_rate = nil
+	m.addfire_rate = nil
+}
+
+// SetBulletSpeed sets the "bullet_speed" field.
+func (m *HighScoreMutation) SetBulletSpeed(f float64) {
+	m.bullet_speed = &f
+	m.addbullet_speed = nil
+}
+
+// BulletSpeed returns the value of the "bullet_speed" field in the mutation.
+func (m *HighScoreMutation) BulletSpeed() (r float64, exists bool) {
+	v := m.bullet_speed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBulletSpeed returns the old "bullet_speed" field's value of the HighScore entity.
+// If the HighScore object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HighScoreMutation) OldBulletSpeed(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBulletSpeed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBulletSpeed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBulletSpeed: %w", err)
+	}
+	return oldValue.BulletSpeed, nil
+}
+
+// AddBulletSpeed adds f to the "bullet_speed" field.
+func (m *HighScoreMutation) AddBulletSpeed(f float64) {
+	if m.addbullet_speed != nil {
+		*m.addbullet_speed += f
+	} else {
+		m.addbullet_speed = &f
+	}
+}
+
+// AddedBulletSpeed returns the value that was added to the "bullet_speed" field in this mutation.
+func (m *HighScoreMutation) AddedBulletSpeed() (r float64, exists bool) {
+	v := m.addbullet_speed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetBulletSpeed resets all changes to the "bullet_speed" field.
+func (m *HighScoreMutation) ResetBulletSpeed() {
+	m.bullet_speed = nil
+	m.addbullet_speed = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *HighScoreMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *HighScoreMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the HighScore entity.
+// If the HighScore object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HighScoreMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *HighScoreMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetProfileID sets the "profile" edge to the Profile entity by id.
+func (m *HighScoreMutation) SetProfileID(id int) {
+	m.profile = &id
+}
+
+// ClearProfile clears the "profile" edge to the Profile entity.
+func (m *HighScoreMutation) ClearProfile() {
+	m.clearedprofile = true
+}
+
+// ProfileCleared reports if the "profile" edge to the Profile entity was cleared.
+func (m *HighScoreMutation) ProfileCleared() bool {
+	return m.clearedprofile
+}
+
+// ProfileID returns the "profile" edge ID in the mutation.
+func (m *HighScoreMutation) ProfileID() (id int, exists bool) {
+	if m.profile != nil {
+		return *m.profile, true
+	}
+	return
+}
+
+// ProfileIDs returns the "profile" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ProfileID instead. It exists only for internal usage by the builders.
+func (m *HighScoreMutation) ProfileIDs() (ids []int) {
+	if id := m.profile; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetProfile resets all changes to the "profile" edge.
+func (m *HighScoreMutation) ResetProfile() {
+	m.profile = nil
+	m.clearedprofile = false
+}
+
+// Where appends a list predicates to the HighScoreMutation builder.
+func (m *HighScoreMutation) Where(ps ...predicate.HighScore) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the HighScoreMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *HighScoreMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.HighScore, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *HighScoreMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *HighScoreMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (HighScore).
+func (m *HighScoreMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *HighScoreMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.name != nil {
+		fields = append(fields, highscore.FieldName)
+	}
+	if m.score != nil {
+		fields = append(fields, highscore.FieldScore)
+	}
+	if m.seed != nil {
+		fields = append(fields, highscore.FieldSeed)
+	}
+	if m.level != nil {
+		fields = append(fields, highscore.FieldLevel)
+	}
+	if m.total_levels != nil {
+		fields = append(fields, highscore.FieldTotalLevels)
+	}
+	if m.won != nil {
+		fields = append(fields, highscore.FieldWon)
+	}
+	if m.fire_rate != nil {
+		fields = append(fields, highscore.FieldFireRate)
+	}
+	if m.bullet_speed != nil {
+		fields = append(fields, highscore.FieldBulletSpeed)
+	}
+	if m.created_at != nil {
+		fields = append(fields, highscore.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *HighScoreMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case highscore.FieldName:
+		return m.Name()
+	case highscore.FieldScore:
+		return m.Score()
+	case highscore.FieldSeed:
+		return m.Seed()
+	case highscore.FieldLevel:
+		return m.Level()
+	case highscore.FieldTotalLevels:
+		return m.TotalLevels()
+	case highscore.FieldWon:
+		return m.Won()
+	case highscore.FieldFireRate:
+		return m.FireRate()
+	case highscore.FieldBulletSpeed:
+		return m.BulletSpeed()
+	case highscore.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *HighScoreMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case highscore.FieldName:
+		return m.OldName(ctx)
+	case highscore.FieldScore:
+		return m.OldScore(ctx)
+	case highscore.FieldSeed:
+		return m.OldSeed(ctx)
+	case highscore.FieldLevel:
+		return m.OldLevel(ctx)
+	case highscore.FieldTotalLevels:
+		return m.OldTotalLevels(ctx)
+	case highscore.FieldWon:
+		return m.OldWon(ctx)
+	case highscore.FieldFireRate:
+		return m.OldFireRate(ctx)
+	case highscore.FieldBulletSpeed:
+		return m.OldBulletSpeed(ctx)
+	case highscore.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown HighScore field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *HighScoreMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case highscore.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case highscore.FieldScore:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScore(v)
+		return nil
+	case highscore.FieldSeed:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSeed(v)
+		return nil
+	case highscore.FieldLevel:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLevel(v)
+		return nil
+	case highscore.FieldTotalLevels:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotalLevels(v)
+		return nil
+	case highscore.FieldWon:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWon(v)
+		return nil
+	case highscore.FieldFireRate:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFireRate(v)
+		return nil
+	case highscore.FieldBulletSpeed:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBulletSpeed(v)
+		return nil
+	case highscore.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown HighScore field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *HighScoreMutation) AddedFields() []string {
+	var fields []string
+	if m.addscore != nil {
+		fields = append(fields, highscore.FieldScore)
+	}
+	if m.addseed != nil {
+		fields = append(fields, highscore.FieldSeed)
+	}
+	if m.addlevel != nil {
+		fields = append(fields, highscore.FieldLevel)
+	}
+	if m.addtotal_levels != nil {
+		fields = append(fields, highscore.FieldTotalLevels)
+	}
+	if m.addfire_rate != nil {
+		fields = append(fields, highscore.FieldFireRate)
+	}
+	if m.addbullet_speed != nil {
+		fields = append(fields, highscore.FieldBulletSpeed)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *HighScoreMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case highscore.FieldScore:
+		return m.AddedScore()
+	case highscore.FieldSeed:
+		return m.AddedSeed()
+	case highscore.FieldLevel:
+		return m.AddedLevel()
+	case highscore.FieldTotalLevels:
+		return m.AddedTotalLevels()
+	case highscore.FieldFireRate:
+		return m.AddedFireRate()
+	case highscore.FieldBulletSpeed:
+		return m.AddedBulletSpeed()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *HighScoreMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case highscore.FieldScore:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddScore(v)
+		return nil
+	case highscore.FieldSeed:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSeed(v)
+		return nil
+	case highscore.FieldLevel:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLevel(v)
+		return nil
+	case highscore.FieldTotalLevels:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTotalLevels(v)
+		return nil
+	case highscore.FieldFireRate:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFireRate(v)
+		return nil
+	case highscore.FieldBulletSpeed:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBulletSpeed(v)
+		return nil
+	}
+	return fmt.Errorf("unknown HighScore numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *HighScoreMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *HighScoreMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *HighScoreMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown HighScore nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *HighScoreMutation) ResetField(name string) error {
+	switch name {
+	case highscore.FieldName:
+		m.ResetName()
+		return nil
+	case highscore.FieldScore:
+		m.ResetScore()
+		return nil
+	case highscore.FieldSeed:
+		m.ResetSeed()
+		return nil
+	case highscore.FieldLevel:
+		m.ResetLevel()
+		return nil
+	case highscore.FieldTotalLevels:
+		m.ResetTotalLevels()
+		return nil
+	case highscore.FieldWon:
+		m.ResetWon()
+		return nil
+	case highscore.FieldFireRate:
+		m.ResetFireRate()
+		return nil
+	case highscore.FieldBulletSpeed:
+		m.ResetBulletSpeed()
+		return nil
+	case highscore.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown HighScore field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *HighScoreMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.profile != nil {
+		edges = append(edges, highscore.EdgeProfile)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *HighScoreMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case highscore.EdgeProfile:
+		if id := m.profile; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *HighScoreMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *HighScoreMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *HighScoreMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedprofile {
+		edges = append(edges, highscore.EdgeProfile)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *HighScoreMutation) EdgeCleared(name string) bool {
+	switch name {
+	case highscore.EdgeProfile:
+		return m.clearedprofile
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *HighScoreMutation) ClearEdge(name string) error {
+	switch name {
+	case highscore.EdgeProfile:
+		m.ClearProfile()
+		return nil
+	}
+	return fmt.Errorf("unknown HighScore unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *HighScoreMutation) ResetEdge(name string) error {
+	switch name {
+	case highscore.EdgeProfile:
+		m.ResetProfile()
+		return nil
+	}
+	return fmt.Errorf("unknown HighScore edge %s", name)
+}
+
+// LevelResultMutation represents an operation that mutates the LevelResult nodes in the graph.
+type LevelResultMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int
+	level           *int
+	addlevel        *int
+	map_name        *string
+	cleared         *bool
+	kills           *map[string]int
+	enemy_total     *int
+	addenemy_total  *int
+	shots           *int
+	addshots        *int
+	hits            *int
+	addhits         *int
+	damage_dealt    *int
+	adddamage_dealt *int
+	damage_taken    *int
+	adddamage_taken *int
+	pickups         *int
+	addpickups      *int
+	time            *float64
+	addtime         *float64
+	par             *float64
+	addpar          *float64
+	score           *int
+	addscore        *int
+	clearedFields   map[string]struct{}
+	run             *int
+	clearedrun      bool
+	done            bool
+	oldValue        func(context.Context) (*LevelResult, error)
+	predicates      []predicate.LevelResult
+}
+
+var _ ent.Mutation = (*LevelResultMutation)(nil)
+
+// levelresultOption allows management of the mutation configuration using functional options.
+type levelresultOption func(*LevelResultMutation)
+
+// newLevelResultMutation creates new mutation for the LevelResult entity.
+func newLevelResultMutation(c config, op Op, opts ...levelresultOption) *LevelResultMutation {
+	m := &LevelResultMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeLevelResult,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withLevelResultID sets the ID field of the mutation.
+func withLevelResultID(id int) levelresultOption {
+	return func(m *LevelResultMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *LevelResult
+		)
+		m.oldValue = func(ctx context.Context) (*LevelResult, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().LevelResult.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withLevelResult sets the old LevelResult of the mutation.
+func withLevelResult(node *LevelResult) levelresultOption {
+	return func(m *LevelResultMutation) {
+		m.oldValue = func(context.Context) (*LevelResult, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m LevelResultMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m LevelResultMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *LevelResultMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *LevelResultMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().LevelResult.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetLevel sets the "level" field.
+func (m *LevelResultMutation) SetLevel(i int) {
+	m.level = &i
+	m.addlevel = nil
+}
+
+// Level returns the value of the "level" field in the mutation.
+func (m *LevelResultMutation) Level() (r int, exists bool) {
+	v := m.level
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLevel returns the old "level" field's value of the LevelResult entity.
+// If the LevelResult object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LevelResultMutation) OldLevel(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLevel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLevel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLevel: %w", err)
+	}
+	return oldValue.Level, nil
+}
+
+// AddLevel adds i to the "level" field.
+func (m *LevelResultMutation) AddLevel(i int) {
+	if m.addlevel != nil {
+		*m.addlevel += i
+	} else {
+		m.addlevel = &i
+	}
+}
+
+// AddedLevel returns the value that was added to the "level" field in this mutation.
+func (m *LevelResultMutation) AddedLevel() (r int, exists bool) {
+	v := m.addlevel
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLevel resets all changes to the "level" field.
+func (m *LevelResultMutation) ResetLevel() {
+	m.level = nil
+	m.addlevel = nil
+}
+
+// SetMapName sets the "map_name" field.
+func (m *LevelResultMutation) SetMapName(s string) {
+	m.map_name = &s
+}
+
+// MapName returns the value of the "map_name" field in the mutation.
+func (m *LevelResultMutation) MapName() (r string, exists bool) {
+	v := m.map_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMapName returns the old "map_name" field's value of the LevelResult entity.
+// If the LevelResult object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LevelResultMutation) OldMapName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMapName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMapName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMapName: %w", err)
+	}
+	return oldValue.MapName, nil
+}
+
+// ResetMapName resets all changes to the "map_name" field.
+func (m *LevelResultMutation) ResetMapName() {
+	m.map_name = nil
+}
+
+// SetCleared sets the "cleared" field.
+func (m *LevelResultMutation) SetCleared(b bool) {
+	m.cleared = &b
+}
+
+// Cleared returns the value of the "cleared" field in the mutation.
+func (m *LevelResultMutation) Cleared() (r bool, exists bool) {
+	v := m.cleared
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCleared returns the old "cleared" field's value of the LevelResult entity.
+// If the LevelResult object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LevelResultMutation) OldCleared(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCleared is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCleared requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCleared: %w", err)
+	}
+	return oldValue.Cleared, nil
+}
+
+// ResetCleared resets all changes to the "cleared" field.
+func (m *LevelResultMutation) ResetCleared() {
+	m.cleared = nil
+}
+
+// SetKills sets the "kills" field.
+func (m *LevelResultMutation) SetKills(value map[string]int) {
+	m.kills = &value
+}
+
+// Kills returns the value of the "kills" field in the mutation.
+func (m *LevelResultMutation) Kills() (r map[string]int, exists bool) {
+	v := m.kills
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKills returns the old "kills" field's value of the LevelResult entity.
+// If the LevelResult object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LevelResultMutation) OldKills(ctx context.Context) (v map[string]int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKills is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKills requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKills: %w", err)
+	}
+	return oldValue.Kills, nil
+}
+
+// ResetKills resets all changes to the "kills" field.
+func (m *LevelResultMutation) ResetKills() {
+	m.kills = nil
+}
+
+// SetEnemyTotal sets the "enemy_total" field.
+func (m *LevelResultMutation) SetEnemyTotal(i int) {
+	m.enemy_total = &i
+	m.addenemy_total = nil
+}
+
+// EnemyTotal returns the value of the "enemy_total" field in the mutation.
+func (m *LevelResultMutation) EnemyTotal() (r int, exists bool) {
+	v := m.enemy_total
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnemyTotal returns the old "enemy_total" field's value of the LevelResult entity.
+// If the LevelResult object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LevelResultMutation) OldEnemyTotal(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnemyTotal is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnemyTotal requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnemyTotal: %w", err)
+	}
+	return oldValue.EnemyTotal, nil
+}
+
+// AddEnemyTotal adds i to the "enemy_total" field.
+func (m *LevelResultMutation) AddEnemyTotal(i int) {
+	if m.addenemy_total != nil {
+		*m.addenemy_total += i
+	} else {
+		m.addenemy_total = &i
+	}
+}
+
+// AddedEnemyTotal returns the value that was added to the "enemy_total" field in this mutation.
+func (m *LevelResultMutation) AddedEnemyTotal() (r int, exists bool) {
+	v := m.addenemy_total
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetEnemyTotal resets all changes to the "enemy_total" field.
+func (m *LevelResultMutation) ResetEnemyTotal() {
+	m.enemy_total = nil
+	m.addenemy_total = nil
+}
+
+// SetShots sets the "shots" field.
+func (m *LevelResultMutation) SetShots(i int) {
+	m.shots = &i
+	m.addshots = nil
+}
+
+// Shots returns the value of the "shots" field in the mutation.
+func (m *LevelResultMutation) Shots() (r int, exists bool) {
+	v := m.shots
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldShots returns the old "shots" field's value of the LevelResult entity.
+// If the LevelResult object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LevelResultMutation) OldShots(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldShots is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldShots requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldShots: %w", err)
+	}
+	return oldValue.Shots, nil
+}
+
+// AddShots adds i to the "shots" field.
+func (m *LevelResultMutation) AddShots(i int) {
+	if m.addshots != nil {
+		*m.addshots += i
+	} else {
+		m.addshots = &i
+	}
+}
+
+// AddedShots returns the value that was added to the "shots" field in this mutation.
+func (m *LevelResultMutation) AddedShots() (r int, exists bool) {
+	v := m.addshots
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetShots resets all changes to the "shots" field.
+func (m *LevelResultMutation) ResetShots() {
+	m.shots = nil
+	m.addshots = nil
+}
+
+// SetHits sets the "hits" field.
+func (m *LevelResultMutation) SetHits(i int) {
+	m.hits = &i
+	m.addhits = nil
+}
+
+// Hits returns the value of the "hits" field in the mutation.
+func (m *LevelResultMutation) Hits() (r int, exists bool) {
+	v := m.hits
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHits returns the old "hits" field's value of the LevelResult entity.
+// If the LevelResult object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LevelResultMutation) OldHits(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHits is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHits requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHits: %w", err)
+	}
+	return oldValue.Hits, nil
+}
+
+// AddHits adds i to the "hits" field.
+func (m *LevelResultMutation) AddHits(i int) {
+	if m.addhits != nil {
+		*m.addhits += i
+	} else {
+		m.addhits = &i
+	}
+}
+
+// AddedHits returns the value that was added to the "hits" field in this mutation.
+func (m *LevelResultMutation) AddedHits() (r int, exists bool) {
+	v := m.addhits
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetHits resets all changes to the "hits" field.
+func (m *LevelResultMutation) ResetHits() {
+	m.hits = nil
+	m.addhits = nil
+}
+
+// SetDamageDealt sets the "damage_dealt" field.
+func (m *LevelResultMutation) SetDamageDealt(i int) {
+	m.damage_dealt = &i
+	m.adddamage_dealt = nil
+}
+
+// DamageDealt returns the value of the "damage_dealt" field in the mutation.
+func (m *LevelResultMutation) DamageDealt() (r int, exists bool) {
+	v := m.damage_dealt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDamageDealt returns the old "damage_dealt" field's value of the LevelResult entity.
+// If the LevelResult object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LevelResultMutation) OldDamageDealt(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDamageDealt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDamageDealt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDamageDealt: %w", err)
+	}
+	return oldValue.DamageDealt, nil
+}
+
+// AddDamageDealt adds i to the "damage_dealt" field.
+func (m *LevelResultMutation) AddDamageDealt(i int) {
+	if m.adddamage_dealt != nil {
+		*m.adddamage_dealt += i
+	} else {
+		m.adddamage_dealt = &i
+	}
+}
+
+// AddedDamageDealt returns the value that was added to the "damage_dealt" field in this mutation.
+func (m *LevelResultMutation) AddedDamageDealt() (r int, exists bool) {
+	v := m.adddamage_dealt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDamageDealt resets all changes to the "damage_dealt" field.
+func (m *LevelResultMutation) ResetDamageDealt() {
+	m.damage_dealt = nil
+	m.adddamage_dealt = nil
+}
+
+// SetDamageTaken sets the "damage_taken" field.
+func (m *LevelResultMutation) SetDamageTaken(i int) {
+	m.damage_taken = &i
+	m.adddamage_taken = nil
+}
+
+// DamageTaken returns the value of the "damage_taken" field in the mutation.
+func (m *LevelResultMutation) DamageTaken() (r int, exists bool) {
+	v := m.damage_taken
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDamageTaken returns the old "damage_taken" field's value of the LevelResult entity.
+// If the LevelResult object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LevelResultMutation) OldDamageTaken(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDamageTaken is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDamageTaken requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDamageTaken: %w", err)
+	}
+	return oldValue.DamageTaken, nil
+}
+
+// AddDamageTaken adds i to the "damage_taken" field.
+func (m *LevelResultMutation) AddDamageTaken(i int) {
+	if m.adddamage_taken != nil {
+		*m.adddamage_taken += i
+	} else {
+		m.adddamage_taken = &i
+	}
+}
+
+// AddedDamageTaken returns the value that was added to the "damage_taken" field in this mutation.
+func (m *LevelResultMutation) AddedDamageTaken() (r int, exists bool) {
+	v := m.adddamage_taken
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDamageTaken resets all changes to the "damage_taken" field.
+func (m *LevelResultMutation) ResetDamageTaken() {
+	m.damage_taken = nil
+	m.adddamage_taken = nil
+}
+
+// SetPickups sets the "pickups" field.
+func (m *LevelResultMutation) SetPickups(i int) {
+	m.pickups = &i
+	m.addpickups = nil
+}
+
+// Pickups returns the value of the "pickups" field in the mutation.
+func (m *LevelResultMutation) Pickups() (r int, exists bool) {
+	v := m.pickups
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPickups returns the old "pickups" field's value of the LevelResult entity.
+// If the LevelResult object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LevelResultMutation) OldPickups(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPickups is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPickups requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPickups: %w", err)
+	}
+	return oldValue.Pickups, nil
+}
+
+// AddPickups adds i to the "pickups" field.
+func (m *LevelResultMutation) AddPickups(i int) {
+	if m.addpickups != nil {
+		*m.addpickups += i
+	} else {
+		m.addpickups = &i
+	}
+}
+
+// AddedPickups returns the value that was added to the "pickups" field in this mutation.
+func (m *LevelResultMutation) AddedPickups() (r int, exists bool) {
+	v := m.addpickups
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPickups resets all changes to the "pickups" field.
+func (m *LevelResultMutation) ResetPickups() {
+	m.pickups = nil
+	m.addpickups = nil
+}
+
+// SetTime sets the "time" field.
+func (m *LevelResultMutation) SetTime(f float64) {
+	m.time = &f
+	m.addtime = nil
+}
+
+// Time returns the value of the "time" field in the mutation.
+func (m *LevelResultMutation) Time() (r float64, exists bool) {
+	v := m.time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTime returns the old "time" field's value of the LevelResult entity.
+// If the LevelResult object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LevelResultMutation) OldTime(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTime: %w", err)
+	}
+	return oldValue.Time, nil
+}
+
+// AddTime adds f to the "time" field.
+func (m *LevelResultMutation) AddTime(f float64) {
+	if m.addtime != nil {
+		*m.addtime += f
+	} else {
+		m.addtime = &f
+	}
+}
+
+// AddedTime returns the value that was added to the "time" field in this mutation.
+func (m *LevelResultMutation) AddedTime() (r float64, exists bool) {
+	v := m.addtime
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTime resets all changes to the "time" field.
+func (m *LevelResultMutation) ResetTime() {
+	m.time = nil
+	m.addtime = nil
+}
+
+// SetPar sets the "par" field.
+func (m *LevelResultMutation) SetPar(f float64) {
+	m.par = &f
+	m.addpar = nil
+}
+
+// Par returns the value of the "par" field in the mutation.
+func (m *LevelResultMutation) Par() (r float64, exists bool) {
+	v := m.par
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPar returns the old "par" field's value of the LevelResult entity.
+// If the LevelResult object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LevelResultMutation) OldPar(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPar is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPar requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPar: %w", err)
+	}
+	return oldValue.Par, nil
+}
+
+// AddPar adds f to the "par" field.
+func (m *LevelResultMutation) AddPar(f float64) {
+	if m.addpar != nil {
+		*m.addpar += f
+	} else {
+		m.addpar = &f
+	}
+}
+
+// AddedPar returns the value that was added to the "par" field in this mutation.
+func (m *LevelResultMutation) AddedPar() (r float64, exists bool) {
+	v := m.addpar
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPar resets all changes to the "par" field.
+func (m *LevelResultMutation) ResetPar() {
+	m.par = nil
+	m.addpar = nil
+}
+
+// SetScore sets the "score" field.
+func (m *LevelResultMutation) SetScore(i int) {
+	m.score = &i
+	m.addscore = nil
+}
+
+// Score returns the value of the "score" field in the mutation.
+func (m *LevelResultMutation) Score() (r int, exists bool) {
+	v := m.score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScore returns the old "score" field's value of the LevelResult entity.
+// If the LevelResult object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LevelResultMutation) OldScore(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScore is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScore requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScore: %w", err)
+	}
+	return oldValue.Score, nil
+}
+
+// AddScore adds i to the "score" field.
+func (m *LevelResultMutation) AddScore(i int) {
+	if m.addscore != nil {
+		*m.addscore += i
+	} else {
+		m.addscore = &i
+	}
+}
+
+// AddedScore returns the value that was added to the "score" field in this mutation.
+func (m *LevelResultMutation) AddedScore() (r int, exists bool) {
+	v := m.addscore
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetScore resets all changes to the "score" field.
+func (m *LevelResultMutation) ResetScore() {
+	m.score = nil
+	m.addscore = nil
+}
+
+// SetRunID sets the "run" edge to the Run entity by id.
+func (m *LevelResultMutation) SetRunID(id int) {
+	m.run = &id
+}
+
+// ClearRun clears the "run" edge to the Run entity.
+func (m *LevelResultMutation) ClearRun() {
+	m.clearedrun = true
+}
+
+// RunCleared reports if the "run" edge to the Run entity was cleared.
+func (m *LevelResultMutation) RunCleared() bool {
+	return m.clearedrun
+}
+
+// RunID returns the "run" edge ID in the mutation.
+func (m *LevelResultMutation) RunID() (id int, exists bool) {
+	if m.run != nil {
+		return *m.run, true
+	}
+	return
+}
+
+// RunIDs returns the "run" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// RunID instead. It exists only for internal usage by the builders.
+func (m *LevelResultMutation) RunIDs() (ids []int) {
+	if id := m.run; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetRun resets all changes to the "run" edge.
+func (m *LevelResultMutation) ResetRun() {
+	m.run = nil
+	m.clearedrun = false
+}
+
+// Where appends a list predicates to the LevelResultMutation builder.
+func (m *LevelResultMutation) Where(ps ...predicate.LevelResult) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the LevelResultMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *LevelResultMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.LevelResult, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *LevelResultMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *LevelResultMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (LevelResult).
+func (m *LevelResultMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *LevelResultMutation) Fields() []string {
+	fields := make([]string, 0, 13)
+	if m.level != nil {
+		fields = append(fields, levelresult.FieldLevel)
+	}
+	if m.map_name != nil {
+		fields = append(fields, levelresult.FieldMapName)
+	}
+	if m.cleared != nil {
+		fields = append(fields, levelresult.FieldCleared)
+	}
+	if m.kills != nil {
+		fields = append(fields, levelresult.FieldKills)
+	}
+	if m.enemy_total != nil {
+		fields = append(fields, levelresult.FieldEnemyTotal)
+	}
+	if m.shots != nil {
+		fields = append(fields, levelresult.FieldShots)
+	}
+	if m.hits != nil {
+		fields = append(fields, levelresult.FieldHits)
+	}
+	if m.damage_dealt != nil {
+		fields = append(fields, levelresult.FieldDamageDealt)
+	}
+	if m.damage_taken != nil {
+		fields = append(fields, levelresult.FieldDamageTaken)
+	}
+	if m.pickups != nil {
+		fields = append(fields, levelresult.FieldPickups)
+	}
+	if m.time != nil {
+		fields = append(fields, levelresult.FieldTime)
+	}
+	if m.par != nil {
+		fields = append(fields, levelresult.FieldPar)
+	}
+	if m.score != nil {
+		fields = append(fields, levelresult.FieldScore)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *LevelResultMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case levelresult.FieldLevel:
+		return m.Level()
+	case levelresult.FieldMapName:
+		return m.MapName()
+	case levelresult.FieldCleared:
+		return m.Cleared()
+	case levelresult.FieldKills:
+		return m.Kills()
+	case levelresult.FieldEnemyTotal:
+		return m.EnemyTotal()
+	case levelresult.FieldShots:
+		return m.Shots()
+	case levelresult.FieldHits:
+		return m.Hits()
+	case levelresult.FieldDamageDealt:
+		return m.DamageDealt()
+	case levelresult.FieldDamageTaken:
+		return m.DamageTaken()
+	case levelresult.FieldPickups:
+		return m.Pickups()
+	case levelresult.FieldTime:
+		return m.Time()
+	case levelresult.FieldPar:
+		return m.Par()
+	case levelresult.FieldScore:
+		return m.Score()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *LevelResultMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case levelresult.FieldLevel:
+		return m.OldLevel(ctx)
+	case levelresult.FieldMapName:
+		return m.OldMapName(ctx)
+	case levelresult.FieldCleared:
+		return m.OldCleared(ctx)
+	case levelresult.FieldKills:
+		return m.OldKills(ctx)
+	case levelresult.FieldEnemyTotal:
+		return m.OldEnemyTotal(ctx)
+	case levelresult.FieldShots:
+		return m.OldShots(ctx)
+	case levelresult.FieldHits:
+		return m.OldHits(ctx)
+	case levelresult.FieldDamageDealt:
+		return m.OldDamageDealt(ctx)
+	case levelresult.FieldDamageTaken:
+		return m.OldDamageTaken(ctx)
+	case levelresult.FieldPickups:
+		return m.OldPickups(ctx)
+	case levelresult.FieldTime:
+		return m.OldTime(ctx)
+	case levelresult.FieldPar:
+		return m.OldPar(ctx)
+	case levelresult.FieldScore:
+		return m.OldScore(ctx)
+	}
+	return nil, fmt.Errorf("unknown LevelResult field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LevelResultMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case levelresult.FieldLevel:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLevel(v)
+		return nil
+	case levelresult.FieldMapName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMapName(v)
+		return nil
+	case levelresult.FieldCleared:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCleared(v)
+		return nil
+	case levelresult.FieldKills:
+		v, ok := value.(map[string]int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKills(v)
+		return nil
+	case levelresult.FieldEnemyTotal:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnemyTotal(v)
+		return nil
+	case levelresult.FieldShots:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetShots(v)
+		return nil
+	case levelresult.FieldHits:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHits(v)
+		return nil
+	case levelresult.FieldDamageDealt:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDamageDealt(v)
+		return nil
+	case levelresult.FieldDamageTaken:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDamageTaken(v)
+		return nil
+	case levelresult.FieldPickups:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPickups(v)
+		return nil
+	case levelresult.FieldTime:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTime(v)
+		return nil
+	case levelresult.FieldPar:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPar(v)
+		return nil
+	case levelresult.FieldScore:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScore(v)
+		return nil
+	}
+	return fmt.Errorf("unknown LevelResult field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *LevelResultMutation) AddedFields() []string {
+	var fields []string
+	if m.addlevel != nil {
+		fields = append(fields, levelresult.FieldLevel)
+	}
+	if m.addenemy_total != nil {
+		fields = append(fields, levelresult.FieldEnemyTotal)
+	}
+	if m.addshots != nil {
+		fields = append(fields, levelresult.FieldShots)
+	}
+	if m.addhits != nil {
+		fields = append(fields, levelresult.FieldHits)
+	}
+	if m.adddamage_dealt != nil {
+		fields = append(fields, levelresult.FieldDamageDealt)
+	}
+	if m.adddamage_taken != nil {
+		fields = append(fields, levelresult.FieldDamageTaken)
+	}
+	if m.addpickups != nil {
+		fields = append(fields, levelresult.FieldPickups)
+	}
+	if m.addtime != nil {
+		fields = append(fields, levelresult.FieldTime)
+	}
+	if m.addpar != nil {
+		fields = append(fields, levelresult.FieldPar)
+	}
+	if m.addscore != nil {
+		fields = append(fields, levelresult.FieldScore)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *LevelResultMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case levelresult.FieldLevel:
+		return m.AddedLevel()
+	case levelresult.FieldEnemyTotal:
+		return m.AddedEnemyTotal()
+	case levelresult.FieldShots:
+		return m.AddedShots()
+	case levelresult.FieldHits:
+		return m.AddedHits()
+	case levelresult.FieldDamageDealt:
+		return m.AddedDamageDealt()
+	case levelresult.FieldDamageTaken:
+		return m.AddedDamageTaken()
+	case levelresult.FieldPickups:
+		return m.AddedPickups()
+	case levelresult.FieldTime:
+		return m.AddedTime()
+	case levelresult.FieldPar:
+		return m.AddedPar()
+	case levelresult.FieldScore:
+		return m.AddedScore()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LevelResultMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case levelresult.FieldLevel:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLevel(v)
+		return nil
+	case levelresult.FieldEnemyTotal:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEnemyTotal(v)
+		return nil
+	case levelresult.FieldShots:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddShots(v)
+		return nil
+	case levelresult.FieldHits:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddHits(v)
+		return nil
+	case levelresult.FieldDamageDealt:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDamageDealt(v)
+		return nil
+	case levelresult.FieldDamageTaken:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDamageTaken(v)
+		return nil
+	case levelresult.FieldPickups:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPickups(v)
+		return nil
+	case levelresult.FieldTime:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTime(v)
+		return nil
+	case levelresult.FieldPar:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPar(v)
+		return nil
+	case levelresult.FieldScore:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddScore(v)
+		return nil
+	}
+	return fmt.Errorf("unknown LevelResult numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *LevelResultMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *LevelResultMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *LevelResultMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown LevelResult nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *LevelResultMutation) ResetField(name string) error {
+	switch name {
+	case levelresult.FieldLevel:
+		m.ResetLevel()
+		return nil
+	case levelresult.FieldMapName:
+		m.ResetMapName()
+		return nil
+	case levelresult.FieldCleared:
+		m.ResetCleared()
+		return nil
+	case levelresult.FieldKills:
+		m.ResetKills()
+		return nil
+	case levelresult.FieldEnemyTotal:
+		m.ResetEnemyTotal()
+		return nil
+	case levelresult.FieldShots:
+		m.ResetShots()
+		return nil
+	case levelresult.FieldHits:
+		m.ResetHits()
+		return nil
+	case levelresult.FieldDamageDealt:
+		m.ResetDamageDealt()
+		return nil
+	case levelresult.FieldDamageTaken:
+		m.ResetDamageTaken()
+		return nil
+	case levelresult.FieldPickups:
+		m.ResetPickups()
+		return nil
+	case levelresult.FieldTime:
+		m.ResetTime()
+		return nil
+	case levelresult.FieldPar:
+		m.ResetPar()
+		return nil
+	case levelresult.FieldScore:
+		m.ResetScore()
+		return nil
+	}
+	return fmt.Errorf("unknown LevelResult field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *LevelResultMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.run != nil {
+		edges = append(edges, levelresult.EdgeRun)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *LevelResultMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case levelresult.EdgeRun:
+		if id := m.run; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *LevelResultMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *LevelResultMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *LevelResultMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedrun {
+		edges = append(edges, levelresult.EdgeRun)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *LevelResultMutation) EdgeCleared(name string) bool {
+	switch name {
+	case levelresult.EdgeRun:
+		return m.clearedrun
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *LevelResultMutation) ClearEdge(name string) error {
+	switch name {
+	case levelresult.EdgeRun:
+		m.ClearRun()
+		return nil
+	}
+	return fmt.Errorf("unknown LevelResult unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *LevelResultMutation) ResetEdge(name string) error {
+	switch name {
+	case levelresult.EdgeRun:
+		m.ResetRun()
+		return nil
+	}
+	return fmt.Errorf("unknown LevelResult edge %s", name)
+}
+
+// ProfileMutation represents an operation that mutates the Profile nodes in the graph.
+type ProfileMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *int
+	name               *string
+	created_at         *time.Time
+	last_used_at       *time.Time
+	key_bindings       *map[string][]string
+	clearedFields      map[string]struct{}
+	settings           *string
+	clearedsettings    bool
+	high_scores        map[int]struct{}
+	removedhigh_scores map[int]struct{}
+	clearedhigh_scores bool
+	runs               map[int]struct{}
+	removedruns        map[int]struct{}
+	clearedruns        bool
+	saves              map[int]struct{}
+	removedsaves       map[int]struct{}
+	clearedsaves       bool
+	done               bool
+	oldValue           func(context.Context) (*Profile, error)
+	predicates         []predicate.Profile
+}
+
+var _ ent.Mutation = (*ProfileMutation)(nil)
+
+// profileOption allows management of the mutation configuration using functional options.
+type profileOption func(*ProfileMutation)
+
+// newProfileMutation creates new mutation for the Profile entity.
+func newProfileMutation(c config, op Op, opts ...profileOption) *ProfileMutation {
+	m := &ProfileMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeProfile,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withProfileID sets the ID field of the mutation.
+func withProfileID(id int) profileOption {
+	return func(m *ProfileMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Profile
+		)
+		m.oldValue = func(ctx context.Context) (*Profile, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Profile.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withProfile sets the old Profile of the mutation.
+func withProfile(node *Profile) profileOption {
+	return func(m *ProfileMutation) {
+		m.oldValue = func(context.Context) (*Profile, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ProfileMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ProfileMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ProfileMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ProfileMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Profile.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *ProfileMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *ProfileMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Profile entity.
+// If the Profile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfileMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *ProfileMutation) ResetName() {
+	m.name = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ProfileMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ProfileMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Profile entity.
+// If the Profile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfileMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ProfileMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (m *ProfileMutation) SetLastUsedAt(t time.Time) {
+	m.last_used_at = &t
+}
+
+// LastUsedAt returns the value of the "last_used_at" field in the mutation.
+func (m *ProfileMutation) LastUsedAt() (r time.Time, exists bool) {
+	v := m.last_used_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastUsedAt returns the old "last_used_at" field's value of the Profile entity.
+// If the Profile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfileMutation) OldLastUsedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastUsedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastUsedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastUsedAt: %w", err)
+	}
+	return oldValue.LastUsedAt, nil
+}
+
+// ResetLastUsedAt resets all changes to the "last_used_at" field.
+func (m *ProfileMutation) ResetLastUsedAt() {
+	m.last_used_at = nil
+}
+
+// SetKeyBindings sets the "key_bindings" field.
+func (m *ProfileMutation) SetKeyBindings(value map[string][]string) {
+	m.key_bindings = &value
+}
+
+// KeyBindings returns the value of the "key_bindings" field in the mutation.
+func (m *ProfileMutation) KeyBindings() (r map[string][]string, exists bool) {
+	v := m.key_bindings
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKeyBindings returns the old "key_bindings" field's value of the Profile entity.
+// If the Profile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfileMutation) OldKeyBindings(ctx context.Context) (v map[string][]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKeyBindings is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKeyBindings requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKeyBindings: %w", err)
+	}
+	return oldValue.KeyBindings, nil
+}
+
+// ClearKeyBindings clears the value of the "key_bindings" field.
+func (m *ProfileMutation) ClearKeyBindings() {
+	m.key_bindings = nil
+	m.clearedFields[profile.FieldKeyBindings] = struct{}{}
+}
+
+// KeyBindingsCleared returns if the "key_bindings" field was cleared in this mutation.
+func (m *ProfileMutation) KeyBindingsCleared() bool {
+	_, ok := m.clearedFields[profile.FieldKeyBindings]
+	return ok
+}
+
+// ResetKeyBindings resets all changes to the "key_bindings" field.
+func (m *ProfileMutation) ResetKeyBindings() {
+	m.key_bindings = nil
+	delete(m.clearedFields, profile.FieldKeyBindings)
+}
+
+// SetSettingsID sets the "settings" edge to the GameSettings entity by id.
+func (m *ProfileMutation) SetSettingsID(id string) {
+	m.settings = &id
+}
+
+// ClearSettings clears the "settings" edge to the GameSettings entity.
+func (m *ProfileMutation) ClearSettings() {
+	m.clearedsettings = true
+}
+
+// SettingsCleared reports if the "settings" edge to the GameSettings entity was cleared.
+func (m *ProfileMutation) SettingsCleared() bool {
+	return m.clearedsettings
+}
+
+// SettingsID returns the "settings" edge ID in the mutation.
+func (m *ProfileMutation) SettingsID() (id string, exists bool) {
+	if m.settings != nil {
+		return *m.settings, true
+	}
+	return
+}
+
+// SettingsIDs returns the "settings" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SettingsID instead. It exists only for internal usage by the builders.
+func (m *ProfileMutation) SettingsIDs() (ids []string) {
+	if id := m.settings; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSettings resets all changes to the "settings" edge.
+func (m *ProfileMutation) ResetSettings() {
+	m.settings = nil
+	m.clearedsettings = false
+}
+
+// AddHighScoreIDs adds the "high_scores" edge to the HighScore entity by ids.
+func (m *ProfileMutation) AddHighScoreIDs(ids ...int) {
+	if m.high_scores == nil {
+		m.high_scores = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.high_scores[ids[i]] = struct{}{}
+	}
+}
+
+// ClearHighScores clears the "high_scores" edge to the HighScore entity.
+func (m *ProfileMutation) ClearHighScores() {
+	m.clearedhigh_scores = true
+}
+
+// HighScoresCleared reports if the "high_scores" edge to the HighScore entity was cleared.
+func (m *ProfileMutation) HighScoresCleared() bool {
+	return m.clearedhigh_scores
+}
+
+// RemoveHighScoreIDs removes the "high_scores" edge to the HighScore entity by IDs.
+func (m *ProfileMutation) RemoveHighScoreIDs(ids ...int) {
+	if m.removedhigh_scores == nil {
+		m.removedhigh_scores = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.high_scores, ids[i])
+		m.removedhigh_scores[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedHighScores returns the removed IDs of the "high_scores" edge to the HighScore entity.
+func (m *ProfileMutation) RemovedHighScoresIDs() (ids []int) {
+	for id := range m.removedhigh_scores {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// HighScoresIDs returns the "high_scores" edge IDs in the mutation.
+func (m *ProfileMutation) HighScoresIDs() (ids []int) {
+	for id := range m.high_scores {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetHighScores resets all changes to the "high_scores" edge.
+func (m *ProfileMutation) ResetHighScores() {
+	m.high_scores = nil
+	m.clearedhigh_scores = false
+	m.removedhigh_scores = nil
+}
+
+// AddRunIDs adds the "runs" edge to the Run entity by ids.
+func (m *ProfileMutation) AddRunIDs(ids ...int) {
+	if m.runs == nil {
+		m.runs = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.runs[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRuns clears the "runs" edge to the Run entity.
+func (m *ProfileMutation) ClearRuns() {
+	m.clearedruns = true
+}
+
+// RunsCleared reports if the "runs" edge to the Run entity was cleared.
+func (m *ProfileMutation) RunsCleared() bool {
+	return m.clearedruns
+}
+
+// RemoveRunIDs removes the "runs" edge to the Run entity by IDs.
+func (m *ProfileMutation) RemoveRunIDs(ids ...int) {
+	if m.removedruns == nil {
+		m.removedruns = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.runs, ids[i])
+		m.removedruns[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRuns returns the removed IDs of the "runs" edge to the Run entity.
+func (m *ProfileMutation) RemovedRunsIDs() (ids []int) {
+	for id := range m.removedruns {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RunsIDs returns the "runs" edge IDs in the mutation.
+func (m *ProfileMutation) RunsIDs() (ids []int) {
+	for id := range m.runs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRuns resets all changes to the "runs" edge.
+func (m *ProfileMutation) ResetRuns() {
+	m.runs = nil
+	m.clearedruns = false
+	m.removedruns = nil
+}
+
+// AddSafeIDs adds the "saves" edge to the SaveSlot entity by ids.
+func (m *ProfileMutation) AddSafeIDs(ids ...int) {
+	if m.saves == nil {
+		m.saves = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.saves[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSaves clears the "saves" edge to the SaveSlot entity.
+func (m *ProfileMutation) ClearSaves() {
+	m.clearedsaves = true
+}
+
+// SavesCleared reports if the "saves" edge to the SaveSlot entity was cleared.
+func (m *ProfileMutation) SavesCleared() bool {
+	return m.clearedsaves
+}
+
+// RemoveSafeIDs removes the "saves" edge to the SaveSlot entity by IDs.
+func (m *ProfileMutation) RemoveSafeIDs(ids ...int) {
+	if m.removedsaves == nil {
+		m.removedsaves = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.saves, ids[i])
+		m.removedsaves[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSaves returns the removed IDs of the "saves" edge to the SaveSlot entity.
+func (m *ProfileMutation) RemovedSavesIDs() (ids []int) {
+	for id := range m.removedsaves {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SavesIDs returns the "saves" edge IDs in the mutation.
+func (m *ProfileMutation) SavesIDs() (ids []int) {
+	for id := range m.saves {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSaves resets all changes to the "saves" edge.
+func (m *ProfileMutation) ResetSaves() {
+	m.saves = nil
+	m.clearedsaves = false
+	m.removedsaves = nil
+}
+
+// Where appends a list predicates to the ProfileMutation builder.
+func (m *ProfileMutation) Where(ps ...predicate.Profile) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ProfileMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ProfileMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Profile, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ProfileMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ProfileMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Profile).
+func (m *ProfileMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ProfileMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.name != nil {
+		fields = append(fields, profile.FieldName)
+	}
+	if m.created_at != nil {
+		fields = append(fields, profile.FieldCreatedAt)
+	}
+	if m.last_used_at != nil {
+		fields = append(fields, profile.FieldLastUsedAt)
+	}
+	if m.key_bindings != nil {
+		fields = append(fields, profile.FieldKeyBindings)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ProfileMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case profile.FieldName:
+		return m.Name()
+	case profile.FieldCreatedAt:
+		return m.CreatedAt()
+	case profile.FieldLastUsedAt:
+		return m.LastUsedAt()
+	case profile.FieldKeyBindings:
+		return m.KeyBindings()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ProfileMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case profile.FieldName:
+		return m.OldName(ctx)
+	case profile.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case profile.FieldLastUsedAt:
+		return m.OldLastUsedAt(ctx)
+	case profile.FieldKeyBindings:
+		return m.OldKeyBindings(ctx)
+	}
+	return nil, fmt.Errorf("unknown Profile field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ProfileMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case profile.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case profile.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case profile.FieldLastUsedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastUsedAt(v)
+		return nil
+	case profile.FieldKeyBindings:
+		v, ok := value.(map[string][]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKeyBindings(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Profile field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ProfileMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ProfileMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ProfileMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Profile numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ProfileMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(profile.FieldKeyBindings) {
+		fields = append(fields, profile.FieldKeyBindings)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ProfileMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ProfileMutation) ClearField(name string) error {
+	switch name {
+	case profile.FieldKeyBindings:
+		m.ClearKeyBindings()
+		return nil
+	}
+	return fmt.Errorf("unknown Profile nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ProfileMutation) ResetField(name string) error {
+	switch name {
+	case profile.FieldName:
+		m.ResetName()
+		return nil
+	case profile.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case profile.FieldLastUsedAt:
+		m.ResetLastUsedAt()
+		return nil
+	case profile.FieldKeyBindings:
+		m.ResetKeyBindings()
+		return nil
+	}
+	return fmt.Errorf("unknown Profile field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ProfileMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.settings != nil {
+		edges = append(edges, profile.EdgeSettings)
+	}
+	if m.high_scores != nil {
+		edges = append(edges, profile.EdgeHighScores)
+	}
+	if m.runs != nil {
+		edges = append(edges, profile.EdgeRuns)
+	}
+	if m.saves != nil {
+		edges = append(edges, profile.EdgeSaves)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ProfileMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case profile.EdgeSettings:
+		if id := m.settings; id != nil {
+			return []ent.Value{*id}
+		}
+	case profile.EdgeHighScores:
+		ids := make([]ent.Value, 0, len(m.high_scores))
+		for id := range m.high_scores {
+			ids = append(ids, id)
+		}
+		return ids
+	case profile.EdgeRuns:
+		ids := make([]ent.Value, 0, len(m.runs))
+		for id := range m.runs {
+			ids = append(ids, id)
+		}
+		return ids
+	case profile.EdgeSaves:
+		ids := make([]ent.Value, 0, len(m.saves))
+		for id := range m.saves {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ProfileMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.removedhigh_scores != nil {
+		edges = append(edges, profile.EdgeHighScores)
+	}
+	if m.removedruns != nil {
+		edges = append(edges, profile.EdgeRuns)
+	}
+	if m.removedsaves != nil {
+		edges = append(edges, profile.EdgeSaves)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ProfileMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case profile.EdgeHighScores:
+		ids := make([]ent.Value, 0, len(m.removedhigh_scores))
+		for id := range m.removedhigh_scores {
+			ids = append(ids, id)
+		}
+		return ids
+	case profile.EdgeRuns:
+		ids := make([]ent.Value, 0, len(m.removedruns))
+		for id := range m.removedruns {
+			ids = append(ids, id)
+		}
+		return ids
+	case profile.EdgeSaves:
+		ids := make([]ent.Value, 0, len(m.removedsaves))
+		for id := range m.removedsaves {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ProfileMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.clearedsettings {
+		edges = append(edges, profile.EdgeSettings)
+	}
+	if m.clearedhigh_scores {
+		edges = append(edges, profile.EdgeHighScores)
+	}
+	if m.clearedruns {
+		edges = append(edges, profile.EdgeRuns)
+	}
+	if m.clearedsaves {
+		edges = append(edges, profile.EdgeSaves)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ProfileMutation) EdgeCleared(name string) bool {
+	switch name {
+	case profile.EdgeSettings:
+		return m.clearedsettings
+	case profile.EdgeHighScores:
+		return m.clearedhigh_scores
+	case profile.EdgeRuns:
+		return m.clearedruns
+	case profile.EdgeSaves:
+		return m.clearedsaves
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ProfileMutation) ClearEdge(name string) error {
+	switch name {
+	case profile.EdgeSettings:
+		m.ClearSettings()
+		return nil
+	}
+	return fmt.Errorf("unknown Profile unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ProfileMutation) ResetEdge(name string) error {
+	switch name {
+	case profile.EdgeSettings:
+		m.ResetSettings()
+		return nil
+	case profile.EdgeHighScores:
+		m.ResetHighScores()
+		return nil
+	case profile.EdgeRuns:
+		m.ResetRuns()
+		return nil
+	case profile.EdgeSaves:
+		m.ResetSaves()
+		return nil
+	}
+	return fmt.Errorf("unknown Profile edge %s", name)
+}
+
+// RunMutation represents an operation that mutates the Run nodes in the graph.
+type RunMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int
+	started_at        *time.Time
+	ended_at          *time.Time
+	seed              *int64
+	addseed           *int64
+	total_levels      *int
+	addtotal_levels   *int
+	fire_rate         *float64
+	addfire_rate      *float64
+	bullet_speed      *float64
+	addbullet_speed   *float64
+	campaign          *string
+	resumed           *bool
+	outcome           *run.Outcome
+	score             *int
+	addscore          *int
+	cleared_levels    *int
+	addcleared_levels *int
+	clearedFields     map[string]struct{}
+	levels            map[int]struct{}
+	removedlevels     map[int]struct{}
+	clearedlevels     bool
+	profile           *int
+	clearedprofile    bool
+	done              bool
+	oldValue          func(context.Context) (*Run, error)
+	predicates        []predicate.Run
+}
+
+var _ ent.Mutation = (*RunMutation)(nil)
+
+// runOption allows management of the mutation configuration using functional options.
+type runOption func(*RunMutation)
+
+// newRunMutation creates new mutation for the Run entity.
+func newRunMutation(c config, op Op, opts ...runOption) *RunMutation {
+	m := &RunMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRun,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRunID sets the ID field of the mutation.
+func withRunID(id int) runOption {
+	return func(m *RunMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Run
+		)
+		m.oldValue = func(ctx context.Context) (*Run, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Run.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRun sets the old Run of the mutation.
+func withRun(node *Run) runOption {
+	return func(m *RunMutation) {
+		m.oldValue = func(context.Context) (*Run, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RunMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RunMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RunMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RunMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Run.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetStartedAt sets the "started_at" field.
+func (m *RunMutation) SetStartedAt(t time.Time) {
+	m.started_at = &t
+}
+
+// StartedAt returns the value of the "started_at" field in the mutation.
+func (m *RunMutation) StartedAt() (r time.Time, exists bool) {
+	v := m.started_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartedAt returns the old "started_at" field's value of the Run entity.
+// If the Run object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RunMutation) OldStartedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
+	}
+	return oldValue.StartedAt, nil
+}
+
+// ResetStartedAt resets all changes to the "started_at" field.
+func (m *RunMutation) ResetStartedAt() {
+	m.started_at = nil
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (m *RunMutation) SetEndedAt(t time.Time) {
+	m.ended_at = &t
+}
+
+// EndedAt returns the value of the "ended_at" field in the mutation.
+func (m *RunMutation) EndedAt() (r time.Time, exists bool) {
+	v := m.ended_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEndedAt returns the old "ended_at" field's value of the Run entity.
+// If the Run object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RunMutation) OldEndedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndedAt: %w", err)
+	}
+	return oldValue.EndedAt, nil
+}
+
+// ClearEndedAt clears the value of the "ended_at" field.
+func (m *RunMutation) ClearEndedAt() {
+	m.ended_at = nil
+	m.clearedFields[run.FieldEndedAt] = struct{}{}
+}
+
+// EndedAtCleared returns if the "ended_at" field was cleared in this mutation.
+func (m *RunMutation) EndedAtCleared() bool {
+	_, ok := m.clearedFields[run.FieldEndedAt]
+	return ok
+}
+
+// ResetEndedAt resets all changes to the "ended_at" field.
+func (m *RunMutation) ResetEndedAt() {
+	m.ended_at = nil
+	delete(m.clearedFields, run.FieldEndedAt)
+}
+
+// SetSeed sets the "seed" field.
+func (m *RunMutation) SetSeed(i int64) {
+	m.seed = &i
+	m.addseed = nil
+}
+
+// Seed returns the value of the "seed" field in the mutation.
+func (m *RunMutation) Seed() (r int64, exists bool) {
+	v := m.seed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSeed returns the old "seed" field's value of the Run entity.
+// If the Run object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RunMutation) OldSeed(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSeed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSeed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSeed: %w", err)
+	}
+	return oldValue.Seed, nil
+}
+
+// AddSeed adds i to the "seed" field.
+func (m *RunMutation) AddSeed(i int64) {
+	if m.addseed != nil {
+		*m.addseed += i
+	} else {
+		m.addseed = &i
+	}
+}
+
+// AddedSeed returns the value that was added to the "seed" field in this mutation.
+func (m *RunMutation) AddedSeed() (r int64, exists bool) {
+	v := m.addseed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSeed resets all changes to the "seed" field.
+func (m *RunMutation) ResetSeed() {
+	m.seed = nil
+	m.addseed = nil
+}
+
+// SetTotalLevels sets the "total_levels" field.
+func (m *RunMutation) SetTotalLevels(i int) {
+	m.total_levels = &i
+	m.addtotal_levels = nil
+}
+
+// TotalLevels returns the value of the "total_levels" field in the mutation.
+func (m *RunMutation) TotalLevels() (r int, exists bool) {
+	v := m.total_levels
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTotalLevels returns the old "total_levels" field's value of the Run entity.
+// If the Run object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RunMutation) OldTotalLevels(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTotalLevels is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTotalLevels requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTotalLevels: %w", err)
+	}
+	return oldValue.TotalLevels, nil
+}
+
+// AddTotalLevels adds i to the "total_levels" field.
+func (m *RunMutation) AddTotalLevels(i int) {
+	if m.addtotal_levels != nil {
+		*m.addtotal_levels += i
+	} else {
+		m.addtotal_levels = &i
+	}
+}
+
+// AddedTotalLevels returns the value that was added to the "total_levels" field in this mutation.
+func (m *RunMutation) AddedTotalLevels() (r int, exists bool) {
+	v := m.addtotal_levels
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTotalLevels resets all changes to the "total_levels" field.
+func (m *RunMutation) ResetTotalLevels() {
+	m.total_levels = nil
+	m.addtotal_levels = nil
+}
+
+// SetFireRate sets the "fire_rate" field.
+func (m *RunMutation) SetFireRate(f float64) {
+	m.fire_rate = &f
+	m.addfire_rate = nil
+}
+
+// FireRate returns the value of the "fire_rate" field in the mutation.
+func (m *RunMutation) FireRate() (r float64, exists bool) {
+	v := m.fire_rate
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFireRate returns the old "fire_rate" field's value of the Run entity.
+// If the Run object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RunMutation) OldFireRate(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFireRate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFireRate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFireRate: %w", err)
+	}
+	return oldValue.FireRate, nil
+}
+
+// AddFireRate adds f to the "fire_rate" field.
+func (m *RunMutation) AddFireRate(f float64) {
+	if m.addfire_rate != nil {
+		*m.addfire_rate += f
+	} else {
+		m.addfire_rate = &f
+	}
+}
+
+// AddedFireRate returns the value that was added to the "fire_rate" field in this mutation.
+func (m *RunMutation) AddedFireRate() (r float64, exists bool) {
+	v := m.addfire_rate
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetFireRate resets all changes to the "fire_rate" field.
+func (m *RunMutation) ResetFireRate() {
+	m.fire_rate = nil
+	m.addfire_rate = nil
+}
+
+// SetBulletSpeed sets the "bullet_speed" field.
+func (m *RunMutation) SetBulletSpeed(f float64) {
+	m.bullet_speed = &f
+	m.addbullet_speed = nil
+}
+
+// BulletSpeed returns the value of the "bullet_speed" field in the mutation.
+func (m *RunMutation) BulletSpeed() (r float64, exists bool) {
+	v := m.bullet_speed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBulletSpeed returns the old "bullet_speed" field's value of the Run entity.
+// If the Run object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RunMutation) OldBulletSpeed(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBulletSpeed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBulletSpeed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBulletSpeed: %w", err)
+	}
+	return oldValue.BulletSpeed, nil
+}
+
+// AddBulletSpeed adds f to the "bullet_speed" field.
+func (m *RunMutation) AddBulletSpeed(f float64) {
+	if m.addbullet_speed != nil {
+		*m.addbullet_speed += f
+	} else {
+		m.addbullet_speed = &f
+	}
+}
+
+// AddedBulletSpeed returns the value that was added to the "bullet_speed" field in this mutation.
+func (m *RunMutation) AddedBulletSpeed() (r float64, exists bool) {
+	v := m.addbullet_speed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetBulletSpeed resets all changes to the "bullet_speed" field.
+func (m *RunMutation) ResetBulletSpeed() {
+	m.bullet_speed = nil
+	m.addbullet_speed = nil
+}
+
+// SetCampaign sets the "campaign" field.
+func (m *RunMutation) SetCampaign(s string) {
+	m.campaign = &s
+}
+
+// Campaign returns the value of the "campaign" field in the mutation.
+func (m *RunMutation) Campaign() (r string, exists bool) {
+	v := m.campaign
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCampaign returns the old "campaign" field's value of the Run entity.
+// If the Run object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RunMutation) OldCampaign(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCampaign is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCampaign requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCampaign: %w", err)
+	}
+	return oldValue.Campaign, nil
+}
+
+// ResetCampaign resets all changes to the "campaign" field.
+func (m *RunMutation) ResetCampaign() {
+	m.campaign = nil
+}
+
+// SetResumed sets the "resumed" field.
+func (m *RunMutation) SetResumed(b bool) {
+	m.resumed = &b
+}
+
+// Resumed returns the value of the "resumed" field in the mutation.
+func (m *RunMutation) Resumed() (r bool, exists bool) {
+	v := m.resumed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResumed returns the old "resumed" field's value of the Run entity.
+// If the Run object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RunMutation) OldResumed(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResumed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResumed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResumed: %w", err)
+	}
+	return oldValue.Resumed, nil
+}
+
+// ResetResumed resets all changes to the "resumed" field.
+func (m *RunMutation) ResetResumed() {
+	m.resumed = nil
+}
+
+// SetOutcome sets the "outcome" field.
+func (m *RunMutation) SetOutcome(r run.Outcome) {
+	m.outcome = &r
+}
+
+// Outcome returns the value of the "outcome" field in the mutation.
+func (m *RunMutation) Outcome() (r run.Outcome, exists bool) {
+	v := m.outcome
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOutcome returns the old "outcome" field's value of the Run entity.
+// If the Run object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RunMutation) OldOutcome(ctx context.Context) (v run.Outcome, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOutcome is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOutcome requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOutcome: %w", err)
+	}
+	return oldValue.Outcome, nil
+}
+
+// ResetOutcome resets all changes to the "outcome" field.
+func (m *RunMutation) ResetOutcome() {
+	m.outcome = nil
+}
+
+// SetScore sets the "score" field.
+func (m *RunMutation) SetScore(i int) {
+	m.score = &i
+	m.addscore = nil
+}
+
+// Score returns the value of the "score" field in the mutation.
+func (m *RunMutation) Score() (r int, exists bool) {
+	v := m.score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScore returns the old "score" field's value of the Run entity.
+// If the Run object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RunMutation) OldScore(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScore is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScore requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScore: %w", err)
+	}
+	return oldValue.Score, nil
+}
+
+// AddScore adds i to the "score" field.
+func (m *RunMutation) AddScore(i int) {
+	if m.addscore != nil {
+		*m.addscore += i
+	} else {
+		m.addscore = &i
+	}
+}
+
+// AddedScore returns the value that was added to the "score" field in this mutation.
+func (m *RunMutation) AddedScore() (r int, exists bool) {
+	v := m.addscore
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetScore resets all changes to the "score" field.
+func (m *RunMutation) ResetScore() {
+	m.score = nil
+	m.addscore = nil
+}
+
+// SetClearedLevels sets the "cleared_levels" field.
+func (m *RunMutation) SetClearedLevels(i int) {
+	m.cleared_levels = &i
+	m.addcleared_levels = nil
+}
+
+// ClearedLevels returns the value of the "cleared_levels" field in the mutation.
+func (m *RunMutation) ClearedLevels() (r int, exists bool) {
+	v := m.cleared_levels
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClearedLevels returns the old "cleared_levels" field's value of the Run entity.
+// If the Run object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RunMutation) OldClearedLevels(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClearedLevels is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClearedLevels requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClearedLevels: %w", err)
+	}
+	return oldValue.ClearedLevels, nil
+}
+
+// AddClearedLevels adds i to the "cleared_levels" field.
+func (m *RunMutation) AddClearedLevels(i int) {
+	if m.addcleared_levels != nil {
+		*m.addcleared_levels += i
+	} else {
+		m.addcleared_levels = &i
+	}
+}
+
+// AddedClearedLevels returns the value that was added to the "cleared_levels" field in this mutation.
+func (m *RunMutation) AddedClearedLevels() (r int, exists bool) {
+	v := m.addcleared_levels
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetClearedLevels resets all changes to the "cleared_levels" field.
+func (m *RunMutation) ResetClearedLevels() {
+	m.cleared_levels = nil
+	m.addcleared_levels = nil
+}
+
+// AddLevelIDs adds the "levels" edge to the LevelResult entity by ids.
+func (m *RunMutation) AddLevelIDs(ids ...int) {
+	if m.levels == nil {
+		m.levels = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.levels[ids[i]] = struct{}{}
+	}
+}
+
+// ClearLevels clears the "levels" edge to the LevelResult entity.
+func (m *RunMutation) ClearLevels() {
+	m.clearedlevels = true
+}
+
+// LevelsCleared reports if the "levels" edge to the LevelResult entity was cleared.
+func (m *RunMutation) LevelsCleared() bool {
+	return m.clearedlevels
+}
+
+// RemoveLevelIDs removes the "levels" edge to the LevelResult entity by IDs.
+func (m *RunMutation) RemoveLevelIDs(ids ...int) {
+	if m.removedlevels == nil {
+		m.removedlevels = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.levels, ids[i])
+		m.removedlevels[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedLevels returns the removed IDs of the "levels" edge to the LevelResult entity.
+func (m *RunMutation) RemovedLevelsIDs() (ids []int) {
+	for id := range m.removedlevels {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// LevelsIDs returns the "levels" edge IDs in the mutation.
+func (m *RunMutation) LevelsIDs() (ids []int) {
+	for id := range m.levels {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetLevels resets all changes to the "levels" edge.
+func (m *RunMutation) ResetLevels() {
+	m.levels = nil
+	m.clearedlevels = false
+	m.removedlevels = nil
+}
+
+// SetProfileID sets the "profile" edge to the Profile entity by id.
+func (m *RunMutation) SetProfileID(id int) {
+	m.profile = &id
+}
+
+// ClearProfile clears the "profile" edge to the Profile entity.
+func (m *RunMutation) ClearProfile() {
+	m.clearedprofile = true
+}
+
+// ProfileCleared reports if the "profile" edge to the Profile entity was cleared.
+func (m *RunMutation) ProfileCleared() bool {
+	return m.clearedprofile
+}
+
+// ProfileID returns the "profile" edge ID in the mutation.
+func (m *RunMutation) ProfileID() (id int, exists bool) {
+	if m.profile != nil {
+		return *m.profile, true
+	}
+	return
+}
+
+// ProfileIDs returns the "profile" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ProfileID instead. It exists only for internal usage by the builders.
+func (m *RunMutation) ProfileIDs() (ids []int) {
+	if id := m.profile; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetProfile resets all changes to the "profile" edge.
+func (m *RunMutation) ResetProfile() {
+	m.profile = nil
+	m.clearedprofile = false
+}
+
+// Where appends a list predicates to the RunMutation builder.
+func (m *RunMutation) Where(ps ...predicate.Run) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RunMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RunMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Run, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RunMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RunMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Run).
+func (m *RunMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RunMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.started_at != nil {
+		fields = append(fields, run.FieldStartedAt)
+	}
+	if m.ended_at != nil {
+		fields = append(fields, run.FieldEndedAt)
+	}
+	if m.seed != nil {
+		fields = append(fields, run.FieldSeed)
+	}
+	if m.total_levels != nil {
+		fields = append(fields, run.FieldTotalLevels)
+	}
+	if m.fire_rate != nil {
+		fields = append(fields, run.FieldFireRate)
+	}
+	if m.bullet_speed != nil {
+		fields = append(fields, run.FieldBulletSpeed)
+	}
+	if m.campaign != nil {
+		fields = append(fields, run.FieldCampaign)
+	}
+	if m.resumed != nil {
+		fields = append(fields, run.FieldResumed)
+	}
+	if m.outcome != nil {
+		fields = append(fields, run.FieldOutcome)
+	}
+	if m.score != nil {
+		fields = append(fields, run.FieldScore)
+	}
+	if m.cleared_levels != nil {
+		fields = append(fields, run.FieldClearedLevels)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RunMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case run.FieldStartedAt:
+		return m.StartedAt()
+	case run.FieldEndedAt:
+		return m.EndedAt()
+	case run.FieldSeed:
+		return m.Seed()
+	case run.FieldTotalLevels:
+		return m.TotalLevels()
+	case run.FieldFireRate:
+		return m.FireRate()
+	case run.FieldBulletSpeed:
+		return m.BulletSpeed()
+	case run.FieldCampaign:
+		return m.Campaign()
+	case run.FieldResumed:
+		return m.Resumed()
+	case run.FieldOutcome:
+		return m.Outcome()
+	case run.FieldScore:
+		return m.Score()
+	case run.FieldClearedLevels:
+		return m.ClearedLevels()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RunMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case run.FieldStartedAt:
+		return m.OldStartedAt(ctx)
+	case run.FieldEndedAt:
+		return m.OldEndedAt(ctx)
+	case run.FieldSeed:
+		return m.OldSeed(ctx)
+	case run.FieldTotalLevels:
+		return m.OldTotalLevels(ctx)
+	case run.FieldFireRate:
+		return m.OldFireRate(ctx)
+	case run.FieldBulletSpeed:
+		return m.OldBulletSpeed(ctx)
+	case run.FieldCampaign:
+		return m.OldCampaign(ctx)
+	case run.FieldResumed:
+		return m.OldResumed(ctx)
+	case run.FieldOutcome:
+		return m.OldOutcome(ctx)
+	case run.FieldScore:
+		return m.OldScore(ctx)
+	case run.FieldClearedLevels:
+		return m.OldClearedLevels(ctx)
+	}
+	return nil, fmt.Errorf("unknown Run field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RunMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case run.FieldStartedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartedAt(v)
+		return nil
+	case run.FieldEndedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndedAt(v)
+		return nil
+	case run.FieldSeed:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSeed(v)
+		return nil
+	case run.FieldTotalLevels:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotalLevels(v)
+		return nil
+	case run.FieldFireRate:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFireRate(v)
+		return nil
+	case run.FieldBulletSpeed:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBulletSpeed(v)
+		return nil
+	case run.FieldCampaign:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCampaign(v)
+		return nil
+	case run.FieldResumed:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResumed(v)
+		return nil
+	case run.FieldOutcome:
+		v, ok := value.(run.Outcome)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOutcome(v)
+		return nil
+	case run.FieldScore:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScore(v)
+		return nil
+	case run.FieldClearedLevels:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClearedLevels(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Run field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RunMutation) AddedFields() []string {
+	var fields []string
+	if m.addseed != nil {
+		fields = append(fields, run.FieldSeed)
+	}
+	if m.addtotal_levels != nil {
+		fields = append(fields, run.FieldTotalLevels)
+	}
+	if m.addfire_rate != nil {
+		fields = append(fields, run.FieldFireRate)
+	}
+	if m.addbullet_speed != nil {
+		fields = append(fields, run.FieldBulletSpeed)
+	}
+	if m.addscore != nil {
+		fields = append(fields, run.FieldScore)
+	}
+	if m.addcleared_levels != nil {
+		fields = append(fields, run.FieldClearedLevels)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RunMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case run.FieldSeed:
+		return m.AddedSeed()
+	case run.FieldTotalLevels:
+		return m.AddedTotalLevels()
+	case run.FieldFireRate:
+		return m.AddedFireRate()
+	case run.FieldBulletSpeed:
+		return m.AddedBulletSpeed()
+	case run.FieldScore:
+		return m.AddedScore()
+	case run.FieldClearedLevels:
+		return m.AddedClearedLevels()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RunMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case run.FieldSeed:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSeed(v)
+		return nil
+	case run.FieldTotalLevels:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTotalLevels(v)
+		return nil
+	case run.FieldFireRate:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFireRate(v)
+		return nil
+	case run.FieldBulletSpeed:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBulletSpeed(v)
+		return nil
+	case run.FieldScore:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddScore(v)
+		return nil
+	case run.FieldClearedLevels:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddClearedLevels(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Run numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RunMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(run.FieldEndedAt) {
+		fields = append(fields, run.FieldEndedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RunMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RunMutation) ClearField(name string) error {
+	switch name {
+	case run.FieldEndedAt:
+		m.ClearEndedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Run nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RunMutation) ResetField(name string) error {
+	switch name {
+	case run.FieldStartedAt:
+		m.ResetStartedAt()
+		return nil
+	case run.FieldEndedAt:
+		m.ResetEndedAt()
+		return nil
+	case run.FieldSeed:
+		m.ResetSeed()
+		return nil
+	case run.FieldTotalLevels:
+		m.ResetTotalLevels()
+		return nil
+	case run.FieldFireRate:
+		m.ResetFireRate()
+		return nil
+	case run.FieldBulletSpeed:
+		m.ResetBulletSpeed()
+		return nil
+	case run.FieldCampaign:
+		m.ResetCampaign()
+		return nil
+	case run.FieldResumed:
+		m.ResetResumed()
+		return nil
+	case run.FieldOutcome:
+		m.ResetOutcome()
+		return nil
+	case run.FieldScore:
+		m.ResetScore()
+		return nil
+	case run.FieldClearedLevels:
+		m.ResetClearedLevels()
+		return nil
+	}
+	return fmt.Errorf("unknown Run field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RunMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.levels != nil {
+		edges = append(edges, run.EdgeLevels)
+	}
+	if m.profile != nil {
+		edges = append(edges, run.EdgeProfile)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RunMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case run.EdgeLevels:
+		ids := make([]ent.Value, 0, len(m.levels))
+		for id := range m.levels {
+			ids = append(ids, id)
+		}
+		return ids
+	case run.EdgeProfile:
+		if id := m.profile; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RunMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedlevels != nil {
+		edges = append(edges, run.EdgeLevels)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RunMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case run.EdgeLevels:
+		ids := make([]ent.Value, 0, len(m.removedlevels))
+		for id := range m.removedlevels {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RunMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedlevels {
+		edges = append(edges, run.EdgeLevels)
+	}
+	if m.clearedprofile {
+		edges = append(edges, run.EdgeProfile)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RunMutation) EdgeCleared(name string) bool {
+	switch name {
+	case run.EdgeLevels:
+		return m.clearedlevels
+	case run.EdgeProfile:
+		return m.clearedprofile
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RunMutation) ClearEdge(name string) error {
+	switch name {
+	case run.EdgeProfile:
+		m.ClearProfile()
+		return nil
+	}
+	return fmt.Errorf("unknown Run unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RunMutation) ResetEdge(name string) error {
+	switch name {
+	case run.EdgeLevels:
+		m.ResetLevels()
+		return nil
+	case run.EdgeProfile:
+		m.ResetProfile()
+		return nil
+	}
+	return fmt.Errorf("unknown Run edge %s", name)
+}
+
+// SaveSlotMutation represents an operation that mutates the SaveSlot nodes in the graph.
+type SaveSlotMutation struct {
+	config
+	op                   Op
+	typ                  string
+	id                   *int
+	slot                 *int
+	addslot              *int
+	level                *int
+	addlevel             *int
+	total_levels         *int
+	addtotal_levels      *int
+	seed                 *int64
+	addseed              *int64
+	play_time            *float64
+	addplay_time         *float64
+	map_width            *int
+	addmap_width         *int
+	map_height           *int
+	addmap_height        *int
+	grid                 *[]byte
+	player               *sim.Player
+	enemies              *[]sim.Enemy
+	appendenemies        []sim.Enemy
+	enemy_types          *[]string
+	appendenemy_types    []string
+	pickups              *[]sim.Pickup
+	appendpickups        []sim.Pickup
+	projectiles          *[]sim.Projectile
+	appendprojectiles    []sim.Projectile
+	doors                *[]sim.Door
+	appenddoors          []sim.Door
+	secrets              *[]sim.Secret
+	appendsecrets        []sim.Secret
+	barrels              *[]sim.Barrel
+	appendbarrels        []sim.Barrel
+	defeated             *int
+	adddefeated          *int
+	level_enemy_total    *int
+	addlevel_enemy_total *int
+	level_time           *float64
+	addlevel_time        *float64
+	completion           *int
+	addcompletion        *int
+	fire_rate            *float64
+	addfire_rate         *float64
+	bullet_speed         *float64
+	addbullet_speed      *float64
+	rng_state            *int64
+	addrng_state         *int64
+	campaign             *string
+	map_name             *string
+	par                  *float64
+	addpar               *float64
+	thumbnail            *[]byte
+	created_at           *time.Time
+	updated_at           *time.Time
+	clearedFields        map[string]struct{}
+	profile              *int
+	clearedprofile       bool
+	done                 bool
+	oldValue             func(context.Context) (*SaveSlot, error)
+	predicates           []predicate.SaveSlot
+}
+
+var _ ent.Mutation = (*SaveSlotMutation)(nil)
+
+// saveslotOption allows management of the mutation configuration using functional options.
+type saveslotOption func(*SaveSlotMutation)
+
+// newSaveSlotMutation creates new mutation for the SaveSlot entity.
+func newSaveSlotMutation(c config, op Op, opts ...saveslotOption) *SaveSlotMutation {
+	m := &SaveSlotMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSaveSlot,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSaveSlotID sets the ID field of the mutation.
+func withSaveSlotID(id int) saveslotOption {
+	return func(m *SaveSlotMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SaveSlot
+		)
+		m.oldValue = func(ctx context.Context) (*SaveSlot, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SaveSlot.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSaveSlot sets the old SaveSlot of the mutation.
+func withSaveSlot(node *SaveSlot) saveslotOption {
+	return func(m *SaveSlotMutation) {
+		m.oldValue = func(context.Context) (*SaveSlot, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SaveSlotMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SaveSlotMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SaveSlotMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SaveSlotMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SaveSlot.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetSlot sets the "slot" field.
+func (m *SaveSlotMutation) SetSlot(i int) {
+	m.slot = &i
+	m.addslot = nil
+}
+
+// Slot returns the value of the "slot" field in the mutation.
+func (m *SaveSlotMutation) Slot() (r int, exists bool) {
+	v := m.slot
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSlot returns the old "slot" field's value of the SaveSlot entity.
+// If the SaveSlot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SaveSlotMutation) OldSlot(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSlot is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSlot requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSlot: %w", err)
+	}
+	return oldValue.Slot, nil
+}
+
+// AddSlot adds i to the "slot" field.
+func (m *SaveSlotMutation) AddSlot(i int) {
+	if m.addslot != nil {
+		*m.addslot += i
+	} else {
+		m.addslot = &i
+	}
+}
+
+// AddedSlot returns the value that was added to the "slot" field in this mutation.
+func (m *SaveSlotMutation) AddedSlot() (r int, exists bool) {
+	v := m.addslot
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSlot resets all changes to the "slot" field.
+func (m *SaveSlotMutation) ResetSlot() {
+	m.slot = nil
+	m.addslot = nil
+}
+
+// SetLevel sets the "level" field.
+func (m *SaveSlotMutation) SetLevel(i int) {
+	m.level = &i
+	m.addlevel = nil
+}
+
+// Level returns the value of the "level" field in the mutation.
+func (m *SaveSlotMutation) Level() (r int, exists bool) {
+	v := m.level
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLevel returns the old "level" field's value of the SaveSlot entity.
+// If the SaveSlot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SaveSlotMutation) OldLevel(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLevel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLevel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLevel: %w", err)
+	}
+	return oldValue.Level, nil
+}
+
+// AddLevel adds i to the "level" field.
+func (m *SaveSlotMutation) AddLevel(i int) {
+	if m.addlevel != nil {
+		*m.addlevel += i
+	} else {
+		m.addlevel = &i
+	}
+}
+
+// AddedLevel returns the value that was added to the "level" field in this mutation.
+func (m *SaveSlotMutation) AddedLevel() (r int, exists bool) {
+	v := m.addlevel
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLevel resets all changes to the "level" field.
+func (m *SaveSlotMutation) ResetLevel() {
+	m.level = nil
+	m.addlevel = nil
+}
+
+// SetTotalLevels sets the "total_levels" field.
+func (m *SaveSlotMutation) SetTotalLevels(i int) {
+	m.total_levels = &i
+	m.addtotal_levels = nil
+}
+
+// TotalLevels returns the value of the "total_levels" field in the mutation.
+func (m *SaveSlotMutation) TotalLevels() (r int, exists bool) {
+	v := m.total_levels
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTotalLevels returns the old "total_levels" field's value of the SaveSlot entity.
+// If the SaveSlot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SaveSlotMutation) OldTotalLevels(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTotalLevels is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTotalLevels requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTotalLevels: %w", err)
+	}
+	return oldValue.TotalLevels, nil
+}
+
+// AddTotalLevels adds i to the "total_levels" field.
+func (m *SaveSlotMutation) AddTotalLevels(i int) {
+	if m.addtotal_levels != nil {
+		*m.addtotal_levels += i
+	} else {
+		m.addtotal_levels = &i
+	}
+}
+
+// AddedTotalLevels returns the value that was added to the "total_levels" field in this mutation.
+func (m *SaveSlotMutation) AddedTotalLevels() (r int, exists bool) {
+	v := m.addtotal_levels
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTotalLevels resets all changes to the "total_levels" field.
+func (m *SaveSlotMutation) ResetTotalLevels() {
+	m.total_levels = nil
+	m.addtotal_levels = nil
+}
+
+// SetSeed sets the "seed" field.
+func (m *SaveSlotMutation) SetSeed(i int64) {
+	m.seed = &i
+	m.addseed = nil
+}
+
+// Seed returns the value of the "seed" field in the mutation.
+func (m *SaveSlotMutation) Seed() (r int64, exists bool) {
+	v := m.seed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSeed returns the old "seed" field's value of the SaveSlot entity.
+// If the SaveSlot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SaveSlotMutation) OldSeed(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSeed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSeed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSeed: %w", err)
+	}
+	return oldValue.Seed, nil
+}
+
+// AddSeed adds i to the "seed" field.
+func (m *SaveSlotMutation) AddSeed(i int64) {
+	if m.addseed != nil {
+		*m.addseed += i
+	} else {
+		m.addseed = &i
+	}
+}
+
+// AddedSeed returns the value that was added to the "seed" field in this mutation.
+func (m *SaveSlotMutation) AddedSeed() (r int64, exists bool) {
+	v := m.addseed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSeed resets all changes to the "seed" field.
+func (m *SaveSlotMutation) ResetSeed() {
+	m.seed = nil
+	m.addseed = nil
+}
+
+// SetPlayTime sets the "play_time" field.
+func (m *SaveSlotMutation) SetPlayTime(f float64) {
+	m.play_time = &f
+	m.addplay_time = nil
+}
+
+// PlayTime returns the value of the "play_time" field in the mutation.
+func (m *SaveSlotMutation) PlayTime() (r float64, exists bool) {
+	v := m.play_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPlayTime returns the old "play_time" field's value of the SaveSlot entity.
+// If the SaveSlot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SaveSlotMutation) OldPlayTime(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPlayTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPlayTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPlayTime: %w", err)
+	}
+	return oldValue.PlayTime, nil
+}
+
+// AddPlayTime adds f to the "play_time" field.
+func (m *SaveSlotMutation) AddPlayTime(f float64) {
+	if m.addplay_time != nil {
+		*m.addplay_time += f
+	} else {
+		m.addplay_time = &f
+	}
+}
+
+// AddedPlayTime returns the value that was added to the "play_time" field in this mutation.
+func (m *SaveSlotMutation) AddedPlayTime() (r float64, exists bool) {
+	v := m.addplay_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPlayTime resets all changes to the "play_time" field.
+func (m *SaveSlotMutation) ResetPlayTime() {
+	m.play_time = nil
+	m.addplay_time = nil
+}
+
+// SetMapWidth sets the "map_width" field.
+func (m *SaveSlotMutation) SetMapWidth(i int) {
+	m.map_width = &i
+	m.addmap_width = nil
+}
+
+// MapWidth returns the value of the "map_width" field in the mutation.
+func (m *SaveSlotMutation) MapWidth() (r int, exists bool) {
+	v := m.map_width
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMapWidth returns the old "map_width" field's value of the SaveSlot entity.
+// If the SaveSlot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SaveSlotMutation) OldMapWidth(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMapWidth is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMapWidth requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMapWidth: %w", err)
+	}
+	return oldValue.MapWidth, nil
+}
+
+// AddMapWidth adds i to the "map_width" field.
+func (m *SaveSlotMutation) AddMapWidth(i int) {
+	if m.addmap_width != nil {
+		*m.addmap_width += i
+	} else {
+		m.addmap_width = &i
+	}
+}
+
+// AddedMapWidth returns the value that was added to the "map_width" field in this mutation.
+func (m *SaveSlotMutation) AddedMapWidth() (r int, exists bool) {
+	v := m.addmap_width
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMapWidth resets all changes to the "map_width" field.
+func (m *SaveSlotMutation) ResetMapWidth() {
+	m.map_width = nil
+	m.addmap_width = nil
+}
+
+// SetMapHeight sets the "map_height" field.
+func (m *SaveSlotMutation) SetMapHeight(i int) {
+	m.map_height = &i
+	m.addmap_height = nil
+}
+
+// MapHeight returns the value of the "map_height" field in the mutation.
+func (m *SaveSlotMutation) MapHeight() (r int, exists bool) {
+	v := m.map_height
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMapHeight returns the old "map_height" field's value of the SaveSlot entity.
+// If the SaveSlot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SaveSlotMutation) OldMapHeight(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMapHeight is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMapHeight requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMapHeight: %w", err)
+	}
+	return oldValue.MapHeight, nil
+}
+
+// AddMapHeight adds i to the "map_height" field.
+func (m *SaveSlotMutation) AddMapHeight(i int) {
+	if m.addmap_height != nil {
+		*m.addmap_height += i
+	} else {
+		m.addmap_height = &i
+	}
+}
+
+// AddedMapHeight returns the value that was added to the "map_height" field in this mutation.
+func (m *SaveSlotMutation) AddedMapHeight() (r int, exists bool) {
+	v := m.addmap_height
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMapHeight resets all changes to the "map_height" field.
+func (m *SaveSlotMutation) ResetMapHeight() {
+	m.map_height = nil
+	m.addmap_height = nil
+}
+
+// SetGrid sets the "grid" field.
+func (m *SaveSlotMutation) SetGrid(b []byte) {
+	m.grid = &b
+}
+
+// Grid returns the value of the "grid" field in the mutation.
+func (m *SaveSlotMutation) Grid() (r []byte, exists bool) {
+	v := m.grid
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGrid returns the old "grid" field's value of the SaveSlot entity.
+// If the SaveSlot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SaveSlotMutation) OldGrid(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGrid is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGrid requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGrid: %w", err)
+	}
+	return oldValue.Grid, nil
+}
+
+// ResetGrid resets all changes to the "grid" field.
+func (m *SaveSlotMutation) ResetGrid() {
+	m.grid = nil
+}
+
+// SetPlayer sets the "player" field.
+func (m *SaveSlotMutation) SetPlayer(s sim.Player) {
+	m.player = &s
+}
+
+// Player returns the value of the "player" field in the mutation.
+func (m *SaveSlotMutation) Player() (r sim.Player, exists bool) {
+	v := m.player
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPlayer returns the old "player" field's value of the SaveSlot entity.
+// If the SaveSlot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SaveSlotMutation) OldPlayer(ctx context.Context) (v sim.Player, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPlayer is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPlayer requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPlayer: %w", err)
+	}
+	return oldValue.Player, nil
+}
+
+// ResetPlayer resets all changes to the "player" field.
+func (m *SaveSlotMutation) ResetPlayer() {
+	m.player = nil
+}
+
+// SetEnemies sets the "enemies" field.
+func (m *SaveSlotMutation) SetEnemies(s []sim.Enemy) {
+	m.enemies = &s
+	m.appendenemies = nil
+}
+
+// Enemies returns the value of the "enemies" field in the mutation.
+func (m *SaveSlotMutation) Enemies() (r []sim.Enemy, exists bool) {
+	v := m.enemies
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnemies returns the old "enemies" field's value of the SaveSlot entity.
+// If the SaveSlot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SaveSlotMutation) OldEnemies(ctx context.Context) (v []sim.Enemy, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnemies is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnemies requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnemies: %w", err)
+	}
+	return oldValue.Enemies, nil
+}
+
+// AppendEnemies adds s to the "enemies" field.
+func (m *SaveSlotMutation) AppendEnemies(s []sim.Enemy) {
+	m.appendenemies = append(m.appendenemies, s...)
+}
+
+// AppendedEnemies returns the list of values that were appended to the "enemies" field in this mutation.
+func (m *SaveSlotMutation) AppendedEnemies() ([]sim.Enemy, bool) {
+	if len(m.appendenemies) == 0 {
+		return nil, false
+	}
+	return m.appendenemies, true
+}
+
+// ResetEnemies resets all changes to the "enemies" field.
+func (m *SaveSlotMutation) ResetEnemies() {
+	m.enemies = nil
+	m.appendenemies = nil
+}
+
+// SetEnemyTypes sets the "enemy_types" field.
+func (m *SaveSlotMutation) SetEnemyTypes(s []string) {
+	m.enemy_types = &s
+	m.appendenemy_types = nil
+}
+
+// EnemyTypes returns the value of the "enemy_types" field in the mutation.
+func (m *SaveSlotMutation) EnemyTypes() (r []string, exists bool) {
+	v := m.enemy_types
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnemyTypes returns the old "enemy_types" field's value of the SaveSlot entity.
+// If the SaveSlot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SaveSlotMutation) OldEnemyTypes(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnemyTypes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnemyTypes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnemyTypes: %w", err)
+	}
+	return oldValue.EnemyTypes, nil
+}
+
+// AppendEnemyTypes adds s to the "enemy_types" field.
+func (m *SaveSlotMutation) AppendEnemyTypes(s []string) {
+	m.appendenemy_types = append(m.appendenemy_types, s...)
+}
+
+// AppendedEnemyTypes returns the list of values that were appended to the "enemy_types" field in this mutation.
+func (m *SaveSlotMutation) AppendedEnemyTypes() ([]string, bool) {
+	if len(m.appendenemy_types) == 0 {
+		return nil, false
+	}
+	return m.appendenemy_types, true
+}
+
+// ClearEnemyTypes clears the value of the "enemy_types" field.
+func (m *SaveSlotMutation) ClearEnemyTypes() {
+	m.enemy_types = nil
+	m.appendenemy_types = nil
+	m.clearedFields[saveslot.FieldEnemyTypes] = struct{}{}
+}
+
+// EnemyTypesCleared returns if the "enemy_types" field was cleared in this mutation.
+func (m *SaveSlotMutation) EnemyTypesCleared() bool {
+	_, ok := m.clearedFields[saveslot.FieldEnemyTypes]
+	return ok
+}
+
+// ResetEnemyTypes resets all changes to the "enemy_types" field.
+func (m *SaveSlotMutation) ResetEnemyTypes() {
+	m.enemy_types = nil
+	m.appendenemy_types = nil
+	delete(m.clearedFields, saveslot.FieldEnemyTypes)
+}
+
+// SetPickups sets the "pickups" field.
+func (m *SaveSlotMutation) SetPickups(s []sim.Pickup) {
+	m.pickups = &s
+	m.appendpickups = nil
+}
+
+// Pickups returns the value of the "pickups" field in the mutation.
+func (m *SaveSlotMutation) Pickups() (r []sim.Pickup, exists bool) {
+	v := m.pickups
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPickups returns the old "pickups" field's value of the SaveSlot entity.
+// If the SaveSlot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SaveSlotMutation) OldPickups(ctx context.Context) (v []sim.Pickup, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPickups is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPickups requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPickups: %w", err)
+	}
+	return oldValue.Pickups, nil
+}
+
+// AppendPickups adds s to the "pickups" field.
+func (m *SaveSlotMutation) AppendPickups(s []sim.Pickup) {
+	m.appendpickups = append(m.appendpickups, s...)
+}
+
+// AppendedPickups returns the list of values that were appended to the "pickups" field in this mutation.
+func (m *SaveSlotMutation) AppendedPickups() ([]sim.Pickup, bool) {
+	if len(m.appendpickups) == 0 {
+		return nil, false
+	}
+	return m.appendpickups, true
+}
+
+// ResetPickups resets all changes to the "pickups" field.
+func (m *SaveSlotMutation) ResetPickups() {
+	m.pickups = nil
+	m.appendpickups = nil
+}
+
+// SetProjectiles sets the "projectiles" field.
+func (m *SaveSlotMutation) SetProjectiles(s []sim.Projectile) {
+	m.projectiles = &s
+	m.appendprojectiles = nil
+}
+
+// Projectiles returns the value of the "projectiles" field in the mutation.
+func (m *SaveSlotMutation) Projectiles() (r []sim.Projectile, exists bool) {
+	v := m.projectiles
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProjectiles returns the old "projectiles" field's value of the SaveSlot entity.
+// If the SaveSlot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SaveSlotMutation) OldProjectiles(ctx context.Context) (v []sim.Projectile, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProjectiles is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProjectiles requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProjectiles: %w", err)
+	}
+	return oldValue.Projectiles, nil
+}
+
+// AppendProjectiles adds s to the "projectiles" field.
+func (m *SaveSlotMutation) AppendProjectiles(s []sim.Projectile) {
+	m.appendprojectiles = append(m.appendprojectiles, s...)
+}
+
+// AppendedProjectiles returns the list of values that were appended to the "projectiles" field in this mutation.
+func (m *SaveSlotMutation) AppendedProjectiles() ([]sim.Projectile, bool) {
+	if len(m.appendprojectiles) == 0 {
+		return nil, false
+	}
+	return m.appendprojectiles, true
+}
+
+// ResetProjectiles resets all changes to the "projectiles" field.
+func (m *SaveSlotMutation) ResetProjectiles() {
+	m.projectiles = nil
+	m.appendprojectiles = nil
+}
+
+// SetDoors sets the "doors" field.
+func (m *SaveSlotMutation) SetDoors(s []sim.Door) {
+	m.doors = &s
+	m.appenddoors = nil
+}
+
+// Doors returns the value of the "doors" field in the mutation.
+func (m *SaveSlotMutation) Doors() (r []sim.Door, exists bool) {
+	v := m.doors
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDoors returns the old "doors" field's value of the SaveSlot entity.
+// If the SaveSlot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SaveSlotMutation) OldDoors(ctx context.Context) (v []sim.Door, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDoors is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDoors requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDoors: %w", err)
+	}
+	return oldValue.Doors, nil
+}
+
+// AppendDoors adds s to the "doors" field.
+func (m *SaveSlotMutation) AppendDoors(s []sim.Door) {
+	m.appenddoors = append(m.appenddoors, s...)
+}
+
+// AppendedDoors returns the list of values that were appended to the "doors" field in this mutation.
+func (m *SaveSlotMutation) AppendedDoors() ([]sim.Door, bool) {
+	if len(m.appenddoors) == 0 {
+		return nil, false
+	}
+	return m.appenddoors, true
+}
+
+// ClearDoors clears the value of the "doors" field.
+func (m *SaveSlotMutation) ClearDoors() {
+	m.doors = nil
+	m.appenddoors = nil
+	m.clearedFields[saveslot.FieldDoors] = struct{}{}
+}
+
+// DoorsCleared returns if the "doors" field was cleared in this mutation.
+func (m *SaveSlotMutation) DoorsCleared() bool {
+	_, ok := m.clearedFields[saveslot.FieldDoors]
+	return ok
+}
+
+// ResetDoors resets all changes to the "doors" field.
+func (m *SaveSlotMutation) ResetDoors() {
+	m.doors = nil
+	m.appenddoors = nil
+	delete(m.clearedFields, saveslot.FieldDoors)
+}
+
+// SetSecrets sets the "secrets" field.
+func (m *SaveSlotMutation) SetSecrets(s []sim.Secret) {
+	m.secrets = &s
+	m.appendsecrets = nil
+}
+
+// Secrets returns the value of the "secrets" field in the mutation.
+func (m *SaveSlotMutation) Secrets() (r []sim.Secret, exists bool) {
+	v := m.secrets
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSecrets returns the old "secrets" field's value of the SaveSlot entity.
+// If the SaveSlot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SaveSlotMutation) OldSecrets(ctx context.Context) (v []sim.Secret, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSecrets is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSecrets requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSecrets: %w", err)
+	}
+	return oldValue.Secrets, nil
+}
+
+// AppendSecrets adds s to the "secrets" field.
+func (m *SaveSlotMutation) AppendSecrets(s []sim.Secret) {
+	m.appendsecrets = append(m.appendsecrets, s...)
+}
+
+// AppendedSecrets returns the list of values that were appended to the "secrets" field in this mutation.
+func (m *SaveSlotMutation) AppendedSecrets() ([]sim.Secret, bool) {
+	if len(m.appendsecrets) == 0 {
+		return nil, false
+	}
+	return m.appendsecrets, true
+}
+
+// ClearSecrets clears the value of the "secrets" field.
+func (m *SaveSlotMutation) ClearSecrets() {
+	m.secrets = nil
+	m.appendsecrets = nil
+	m.clearedFields[saveslot.FieldSecrets] = struct{}{}
+}
+
+// SecretsCleared returns if the "secrets" field was cleared in this mutation.
+func (m *SaveSlotMutation) SecretsCleared() bool {
+	_, ok := m.clearedFields[saveslot.FieldSecrets]
+	return ok
+}
+
+// ResetSecrets resets all changes to the "secrets" field.
+func (m *SaveSlotMutation) ResetSecrets() {
+	m.secrets = nil
+	m.appendsecrets = nil
+	delete(m.clearedFields, saveslot.FieldSecrets)
+}
+
+// SetBarrels sets the "barrels" field.
+func (m *SaveSlotMutation) SetBarrels(s []sim.Barrel) {
+	m.barrels = &s
+	m.appendbarrels = nil
+}
+
+// Barrels returns the value of the "barrels" field in the mutation.
+func (m *SaveSlotMutation) Barrels() (r []sim.Barrel, exists bool) {
+	v := m.barrels
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBarrels returns the old "barrels" field's value of the SaveSlot entity.
+// If the SaveSlot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SaveSlotMutation) OldBarrels(ctx context.Context) (v []sim.Barrel, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBarrels is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBarrels requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBarrels: %w", err)
+	}
+	return oldValue.Barrels, nil
+}
+
+// AppendBarrels adds s to the "barrels" field.
+func (m *SaveSlotMutation) AppendBarrels(s []sim.Barrel) {
+	m.appendbarrels = append(m.appendbarrels, s...)
+}
+
+// AppendedBarrels returns the list of values that were appended to the "barrels" field in this mutation.
+func (m *SaveSlotMutation) AppendedBarrels() ([]sim.Barrel, bool) {
+	if len(m.appendbarrels) == 0 {
+		return nil, false
+	}
+	return m.appendbarrels, true
+}
+
+// ClearBarrels clears the value of the "barrels" field.
+func (m *SaveSlotMutation) ClearBarrels() {
+	m.barrels = nil
+	m.appendbarrels = nil
+	m.clearedFields[saveslot.FieldBarrels] = struct{}{}
+}
+
+// BarrelsCleared returns if the "barrels" field was cleared in this mutation.
+func (m *SaveSlotMutation) BarrelsCleared() bool {
+	_, ok := m.clearedFields[saveslot.FieldBarrels]
+	return ok
+}
+
+// ResetBarrels resets all changes to the "barrels" field.
+func (m *SaveSlotMutation) ResetBarrels() {
+	m.barrels = nil
+	m.appendbarrels = nil
+	delete(m.clearedFields, saveslot.FieldBarrels)
+}
+
+// SetDefeated sets the "defeated" field.
+func (m *SaveSlotMutation) SetDefeated(i int) {
+	m.defeated = &i
+	m.adddefeated = nil
+}
+
+// Defeated returns the value of the "defeated" field in the mutation.
+func (m *SaveSlotMutation) Defeated() (r int, exists bool) {
+	v := m.defeated
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDefeated returns the old "defeated" field's value of the SaveSlot entity.
+// If the SaveSlot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SaveSlotMutation) OldDefeated(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDefeated is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDefeated requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDefeated: %w", err)
+	}
+	return oldValue.Defeated, nil
+}
+
+// AddDefeated adds i to the "defeated" field.
+func (m *SaveSlotMutation) AddDefeated(i int) {
+	if m.adddefeated != nil {
+		*m.adddefeated += i
+	} else {
+		m.adddefeated = &i
+	}
+}
+
+// AddedDefeated returns the value that was added to the "defeated" field in this mutation.
+func (m *SaveSlotMutation) AddedDefeated() (r int, exists bool) {
+	v := m.adddefeated
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDefeated resets all changes to the "defeated" field.
+func (m *SaveSlotMutation) ResetDefeated() {
+	m.defeated = nil
+	m.adddefeated = nil
+}
+
+// SetLevelEnemyTotal sets the "level_enemy_total" field.
+func (m *SaveSlotMutation) SetLevelEnemyTotal(i int) {
+	m.level_enemy_total = &i
+	m.addlevel_enemy_total = nil
+}
+
+// LevelEnemyTotal returns the value of the "level_enemy_total" field in the mutation.
+func (m *SaveSlotMutation) LevelEnemyTotal() (r int, exists bool) {
+	v := m.level_enemy_total
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLevelEnemyTotal returns the old "level_enemy_total" field's value of the SaveSlot entity.
+// If the SaveSlot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SaveSlotMutation) OldLevelEnemyTotal(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLevelEnemyTotal is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLevelEnemyTotal requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLevelEnemyTotal: %w", err)
+	}
+	return oldValue.LevelEnemyTotal, nil
+}
+
+// AddLevelEnemyTotal adds i to the "level_enemy_total" field.
+func (m *SaveSlotMutation) AddLevelEnemyTotal(i int) {
+	if m.addlevel_enemy_total != nil {
+		*m.addlevel_enemy_total += i
+	} else {
+		m.addlevel_enemy_total = &i
+	}
+}
+
+// AddedLevelEnemyTotal returns the value that was added to the "level_enemy_total" field in this mutation.
+func (m *SaveSlotMutation) AddedLevelEnemyTotal() (r int, exists bool) {
+	v := m.addlevel_enemy_total
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLevelEnemyTotal resets all changes to the "level_enemy_total" field.
+func (m *SaveSlotMutation) ResetLevelEnemyTotal() {
+	m.level_enemy_total = nil
+	m.addlevel_enemy_total = nil
+}
+
+// SetLevelTime sets the "level_time" field.
+func (m *SaveSlotMutation) SetLevelTime(f float64) {
+	m.level_time = &f
+	m.addlevel_time = nil
+}
+
+// LevelTime returns the value of the "level_time" field in the mutation.
+func (m *SaveSlotMutation) LevelTime() (r float64, exists bool) {
+	v := m.level_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLevelTime returns the old "level_time" field's value of the SaveSlot entity.
+// If the SaveSlot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SaveSlotMutation) OldLevelTime(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLevelTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLevelTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLevelTime: %w", err)
+	}
+	return oldValue.LevelTime, nil
+}
+
+// AddLevelTime adds f to the "level_time" field.
+func (m *SaveSlotMutation) AddLevelTime(f float64) {
+	if m.addlevel_time != nil {
+		*m.addlevel_time += f
+	} else {
+		m.addlevel_time = &f
+	}
+}
+
+// AddedLevelTime returns the value that was added to the "level_time" field in this mutation.
+func (m *SaveSlotMutation) AddedLevelTime() (r float64, exists bool) {
+	v := m.addlevel_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLevelTime resets all changes to the "level_time" field.
+func (m *SaveSlotMutation) ResetLevelTime() {
+	m.level_time = nil
+	m.addlevel_time = nil
+}
+
+// SetCompletion sets the "completion" field.
+func (m *SaveSlotMutation) SetCompletion(i int) {
+	m.completion = &i
+	m.addcompletion = nil
+}
+
+// Completion returns the value of the "completion" field in the mutation.
+func (m *SaveSlotMutation) Completion() (r int, exists bool) {
+	v := m.completion
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCompletion returns the old "completion" field's value of the SaveSlot entity.
+// If the SaveSlot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SaveSlotMutation) OldCompletion(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCompletion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCompletion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCompletion: %w", err)
+	}
+	return oldValue.Completion, nil
+}
+
+// AddCompletion adds i to the "completion" field.
+func (m *SaveSlotMutation) AddCompletion(i int) {
+	if m.addcompletion != nil {
+		*m.addcompletion += i
+	} else {
+		m.addcompletion = &i
+	}
+}
+
+// AddedCompletion returns the value that was added to the "completion" field in this mutation.
+func (m *SaveSlotMutation) AddedCompletion() (r int, exists bool) {
+	v := m.addcompletion
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCompletion resets all changes to the "completion" field.
+func (m *SaveSlotMutation) ResetCompletion() {
+	m.completion = nil
+	m.addcompletion = nil
+}
+
+// SetFireRate sets the "fire_rate" field.
+func (m *SaveSlotMutation) SetFireRate(f float64) {
+	m.fire_rate = &f
+	m.addfire_rate = nil
+}
+
+// FireRate returns the value of the "fire_rate" field in the mutation.
+func (m *SaveSlotMutation) FireRate() (r float64, exists bool) {
+	v := m.fire_rate
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFireRate returns the old "fire_rate" field's value of the SaveSlot entity.
+// If the SaveSlot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SaveSlotMutation) OldFireRate(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFireRate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFireRate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFireRate: %w", err)
+	}
+	return oldValue.FireRate, nil
+}
+
+// AddFireRate adds f to the "fire_rate" field.
+func (m *SaveSlotMutation) AddFireRate(f float64) {
+	if m.addfire_rate != nil {
+		*m.addfire_rate += f
+	} else {
+		m.addfire_rate = &f
+	}
+}
+
+// AddedFireRate returns the value that was added to the "fire_rate" field in this mutation.
+func (m *SaveSlotMutation) AddedFireRate() (r float64, exists bool) {
+	v := m.addfire_rate
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetFireRate resets all changes to the "fire_rate" field.
+func (m *SaveSlotMutation) ResetFireRate() {
+	m.fire_rate = nil
+	m.addfire_rate = nil
+}
+
+// SetBulletSpeed sets the "bullet_speed" field.
+func (m *SaveSlotMutation) SetBulletSpeed(f float64) {
+	m.bullet_speed = &f
+	m.addbullet_speed = nil
+}
+
+// BulletSpeed returns the value of the "bullet_speed" field in the mutation.
+func (m *SaveSlotMutation) BulletSpeed() (r float64, exists bool) {
+	v := m.bullet_speed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBulletSpeed returns the old "bullet_speed" field's value of the SaveSlot entity.
+// If the SaveSlot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SaveSlotMutation) OldBulletSpeed(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBulletSpeed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBulletSpeed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBulletSpeed: %w", err)
+	}
+	return oldValue.BulletSpeed, nil
+}
+
+// AddBulletSpeed adds f to the "bullet_speed" field.
+func (m *SaveSlotMutation) AddBulletSpeed(f float64) {
+	if m.addbullet_speed != nil {
+		*m.addbullet_speed += f
+	} else {
+		m.addbullet_speed = &f
+	}
+}
+
+// AddedBulletSpeed returns the value that was added to the "bullet_speed" field in this mutation.
+func (m *SaveSlotMutation) AddedBulletSpeed() (r float64, exists bool) {
+	v := m.addbullet_speed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetBulletSpeed resets all changes to the "bullet_speed" field.
+func (m *SaveSlotMutation) ResetBulletSpeed() {
+	m.bullet_speed = nil
+	m.addbullet_speed = nil
+}
+
+// SetRngState sets the "rng_state" field.
+func (m *SaveSlotMutation) SetRngState(i int64) {
+	m.rng_state = &i
+	m.addrng_state = nil
+}
+
+// RngState returns the value of the "rng_state" field in the mutation.
+func (m *SaveSlotMutation) RngState() (r int64, exists bool) {
+	v := m.rng_state
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRngState returns the old "rng_state" field's value of the SaveSlot entity.
+// If the SaveSlot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SaveSlotMutation) OldRngState(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRngState is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRngState requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRngState: %w", err)
+	}
+	return oldValue.RngState, nil
+}
+
+// AddRngState adds i to the "rng_state" field.
+func (m *SaveSlotMutation) AddRngState(i int64) {
+	if m.addrng_state != nil {
+		*m.addrng_state += i
+	} else {
+		m.addrng_state = &i
+	}
+}
+
+// AddedRngState returns the value that was added to the "rng_state" field in this mutation.
+func (m *SaveSlotMutation) AddedRngState() (r int64, exists bool) {
+	v := m.addrng_state
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRngState resets all changes to the "rng_state" field.
+func (m *SaveSlotMutation) ResetRngState() {
+	m.rng_state = nil
+	m.addrng_state = nil
+}
+
+// SetCampaign sets the "campaign" field.
+func (m *SaveSlotMutation) SetCampaign(s string) {
+	m.campaign = &s
+}
+
+// Campaign returns the value of the "campaign" field in the mutation.
+func (m *SaveSlotMutation) Campaign() (r string, exists bool) {
+	v := m.campaign
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCampaign returns the old "campaign" field's value of the SaveSlot entity.
+// If the SaveSlot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SaveSlotMutation) OldCampaign(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCampaign is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCampaign requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCampaign: %w", err)
+	}
+	return oldValue.Campaign, nil
+}
+
+// ResetCampaign resets all changes to the "campaign" field.
+func (m *SaveSlotMutation) ResetCampaign() {
+	m.campaign = nil
+}
+
+// SetMapName sets the "map_name" field.
+func (m *SaveSlotMutation) SetMapName(s string) {
+	m.map_name = &s
+}
+
+// MapName returns the value of the "map_name" field in the mutation.
+func (m *SaveSlotMutation) MapName() (r string, exists bool) {
+	v := m.map_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMapName returns the old "map_name" field's value of the SaveSlot entity.
+// If the SaveSlot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SaveSlotMutation) OldMapName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMapName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMapName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMapName: %w", err)
+	}
+	return oldValue.MapName, nil
+}
+
+// ResetMapName resets all changes to the "map_name" field.
+func (m *SaveSlotMutation) ResetMapName() {
+	m.map_name = nil
+}
+
+// SetPar sets the "par" field.
+func (m *SaveSlotMutation) SetPar(f float64) {
+	m.par = &f
+	m.addpar = nil
+}
+
+// Par returns the value of the "par" field in the mutation.
+func (m *SaveSlotMutation) Par() (r float64, exists bool) {
+	v := m.par
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPar returns the old "par" field's value of the SaveSlot entity.
+// If the SaveSlot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SaveSlotMutation) OldPar(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPar is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPar requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPar: %w", err)
+	}
+	return oldValue.Par, nil
+}
+
+// AddPar adds f to the "par" field.
+func (m *SaveSlotMutation) AddPar(f float64) {
+	if m.addpar != nil {
+		*m.addpar += f
+	} else {
+		m.addpar = &f
+	}
+}
+
+// AddedPar returns the value that was added to the "par" field in this mutation.
+func (m *SaveSlotMutation) AddedPar() (r float64, exists bool) {
+	v := m.addpar
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPar resets all changes to the "par" field.
+func (m *SaveSlotMutation) ResetPar() {
+	m.par = nil
+	m.addpar = nil
+}
+
+// SetThumbnail sets the "thumbnail" field.
+func (m *SaveSlotMutation) SetThumbnail(b []byte) {
+	m.thumbnail = &b
+}
+
+// Thumbnail returns the value of the "thumbnail" field in the mutation.
+func (m *SaveSlotMutation) Thumbnail() (r []byte, exists bool) {
+	v := m.thumbnail
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldThumbnail returns the old "thumbnail" field's value of the SaveSlot entity.
+// If the SaveSlot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SaveSlotMutation) OldThumbnail(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldThumbnail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldThumbnail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldThumbnail: %w", err)
+	}
+	return oldValue.Thumbnail, nil
+}
+
+// ClearThumbnail clears the value of the "thumbnail" field.
+func (m *SaveSlotMutation) ClearThumbnail() {
+	m.thumbnail = nil
+	m.clearedFields[saveslot.FieldThumbnail] = struct{}{}
+}
+
+// ThumbnailCleared returns if the "thumbnail" field was cleared in this mutation.
+func (m *SaveSlotMutation) ThumbnailCleared() bool {
+	_, ok := m.clearedFields[saveslot.FieldThumbnail]
+	return ok
+}
+
+// ResetThumbnail resets all changes to the "thumbnail" field.
+func (m *SaveSlotMutation) ResetThumbnail() {
+	m.thumbnail = nil
+	delete(m.clearedFields, saveslot.FieldThumbnail)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *SaveSlotMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SaveSlotMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the SaveSlot entity.
+// If the SaveSlot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SaveSlotMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (m *SaveSlotMutation) ClearCreatedAt() {
+	m.created_at = nil
+	m.clearedFields[saveslot.FieldCreatedAt] = struct{}{}
+}
+
+// CreatedAtCleared returns if the "created_at" field was cleared in this mutation.
+func (m *SaveSlotMutation) CreatedAtCleared() bool {
+	_, ok := m.clearedFields[saveslot.FieldCreatedAt]
+	return ok
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SaveSlotMutation) ResetCreatedAt() {
+	m.created_at = nil
+	delete(m.clearedFields, saveslot.FieldCreatedAt)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *SaveSlotMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *SaveSlotMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the SaveSlot entity.
+// If the SaveSlot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SaveSlotMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (m *SaveSlotMutation) ClearUpdatedAt() {
+	m.updated_at = nil
+	m.clearedFields[saveslot.FieldUpdatedAt] = struct{}{}
+}
+
+// UpdatedAtCleared returns if the "updated_at" field was cleared in this mutation.
+func (m *SaveSlotMutation) UpdatedAtCleared() bool {
+	_, ok := m.clearedFields[saveslot.FieldUpdatedAt]
+	return ok
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *SaveSlotMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+	delete(m.clearedFields, saveslot.FieldUpdatedAt)
+}
+
+// SetProfileID sets the "profile" edge to the Profile entity by id.
+func (m *SaveSlotMutation) SetProfileID(id int) {
+	m.profile = &id
+}
+
+// ClearProfile clears the "profile" edge to the Profile entity.
+func (m *SaveSlotMutation) ClearProfile() {
+	m.clearedprofile = true
+}
+
+// ProfileCleared reports if the "profile" edge to the Profile entity was cleared.
+func (m *SaveSlotMutation) ProfileCleared() bool {
+	return m.clearedprofile
+}
+
+// ProfileID returns the "profile" edge ID in the mutation.
+func (m *SaveSlotMutation) ProfileID() (id int, exists bool) {
+	if m.profile != nil {
+		return *m.profile, true
+	}
+	return
+}
+
+// ProfileIDs returns the "profile" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ProfileID instead. It exists only for internal usage by the builders.
+func (m *SaveSlotMutation) ProfileIDs() (ids []int) {
+	if id := m.profile; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetProfile resets all changes to the "profile" edge.
+func (m *SaveSlotMutation) ResetProfile() {
+	m.profile = nil
+	m.clearedprofile = false
+}
+
+// Where appends a list predicates to the SaveSlotMutation builder.
+func (m *SaveSlotMutation) Where(ps ...predicate.SaveSlot) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SaveSlotMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SaveSlotMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SaveSlot, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SaveSlotMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SaveSlotMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (SaveSlot).
+func (m *SaveSlotMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SaveSlotMutation) Fields() []string {
+	fields := make([]string, 0, 29)
+	if m.slot != nil {
+		fields = append(fields, saveslot.FieldSlot)
+	}
+	if m.level != nil {
+		fields = append(fields, saveslot.FieldLevel)
+	}
+	if m.total_levels != nil {
+		fields = append(fields, saveslot.FieldTotalLevels)
+	}
+	if m.seed != nil {
+		fields = append(fields, saveslot.FieldSeed)
+	}
+	if m.play_time != nil {
+		fields = append(fields, saveslot.FieldPlayTime)
+	}
+	if m.map_width != nil {
+		fields = append(fields, saveslot.FieldMapWidth)
+	}
+	if m.map_height != nil {
+		fields = append(fields, saveslot.FieldMapHeight)
+	}
+	if m.grid != nil {
+		fields = append(fields, saveslot.FieldGrid)
+	}
+	if m.player != nil {
+		fields = append(fields, saveslot.FieldPlayer)
+	}
+	if m.enemies != nil {
+		fields = append(fields, saveslot.FieldEnemies)
+	}
+	if m.enemy_types != nil {
+		fields = append(fields, saveslot.FieldEnemyTypes)
+	}
+	if m.pickups != nil {
+		fields = append(fields, saveslot.FieldPickups)
+	}
+	if m.projectiles != nil {
+		fields = append(fields, saveslot.FieldProjectiles)
+	}
+	if m.doors != nil {
+		fields = append(fields, saveslot.FieldDoors)
+	}
+	if m.secrets != nil {
+		fields = append(fields, saveslot.FieldSecrets)
+	}
+	if m.barrels != nil {
+		fields = append(fields, saveslot.FieldBarrels)
+	}
+	if m.defeated != nil {
+		fields = append(fields, saveslot.FieldDefeated)
+	}
+	if m.level_enemy_total != nil {
+		fields = append(fields, saveslot.FieldLevelEnemyTotal)
+	}
+	if m.level_time != nil {
+		fields = append(fields, saveslot.FieldLevelTime)
+	}
+	if m.completion != nil {
+		fields = append(fields, saveslot.FieldCompletion)
+	}
+	if m.fire_rate != nil {
+		fields = append(fields, saveslot.FieldFireRate)
+	}
+	if m.bullet_speed != nil {
+		fields = append(fields, saveslot.FieldBulletSpeed)
+	}
+	if m.rng_state != nil {
+		fields = append(fields, saveslot.FieldRngState)
+	}
+	if m.campaign != nil {
+		fields = append(fields, saveslot.FieldCampaign)
+	}
+	if m.map_name != nil {
+		fields = append(fields, saveslot.FieldMapName)
+	}
+	if m.par != nil {
+		fields = append(fields, saveslot.FieldPar)
+	}
+	if m.thumbnail != nil {
+		fields = append(fields, saveslot.FieldThumbnail)
+	}
+	if m.created_at != nil {
+		fields = append(fields, saveslot.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, saveslot.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SaveSlotMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case saveslot.FieldSlot:
+		return m.Slot()
+	case saveslot.FieldLevel:
+		return m.Level()
+	case saveslot.FieldTotalLevels:
+		return m.TotalLevels()
+	case saveslot.FieldSeed:
+		return m.Seed()
+	case saveslot.FieldPlayTime:
+		return m.PlayTime()
+	case saveslot.FieldMapWidth:
+		return m.MapWidth()
+	case saveslot.FieldMapHeight:
+		return m.MapHeight()
+	case saveslot.FieldGrid:
+		return m.Grid()
+	case saveslot.FieldPlayer:
+		return m.Player()
+	case saveslot.FieldEnemies:
+		return m.Enemies()
+	case saveslot.FieldEnemyTypes:
+		return m.EnemyTypes()
+	case saveslot.FieldPickups:
+		return m.Pickups()
+	case saveslot.FieldProjectiles:
+		return m.Projectiles()
+	case saveslot.FieldDoors:
+		return m.Doors()
+	case saveslot.FieldSecrets:
+		return m.Secrets()
+	case saveslot.FieldBarrels:
+		return m.Barrels()
+	case saveslot.FieldDefeated:
+		return m.Defeated()
+	case saveslot.FieldLevelEnemyTotal:
+		return m.LevelEnemyTotal()
+	case saveslot.FieldLevelTime:
+		return m.LevelTime()
+	case saveslot.FieldCompletion:
+		return m.Completion()
+	case saveslot.FieldFireRate:
+		return m.FireRate()
+	case saveslot.FieldBulletSpeed:
+		return m.BulletSpeed()
+	case saveslot.FieldRngState:
+		return m.RngState()
+	case saveslot.FieldCampaign:
+		return m.Campaign()
+	case saveslot.FieldMapName:
+		return m.MapName()
+	case saveslot.FieldPar:
+		return m.Par()
+	case saveslot.FieldThumbnail:
+		return m.Thumbnail()
+	case saveslot.FieldCreatedAt:
+		return m.CreatedAt()
+	case saveslot.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SaveSlotMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case saveslot.FieldSlot:
+		return m.OldSlot(ctx)
+	case saveslot.FieldLevel:
+		return m.OldLevel(ctx)
+	case saveslot.FieldTotalLevels:
+		return m.OldTotalLevels(ctx)
+	case saveslot.FieldSeed:
+		return m.OldSeed(ctx)
+	case saveslot.FieldPlayTime:
+		return m.OldPlayTime(ctx)
+	case saveslot.FieldMapWidth:
+		return m.OldMapWidth(ctx)
+	case saveslot.FieldMapHeight:
+		return m.OldMapHeight(ctx)
+	case saveslot.FieldGrid:
+		return m.OldGrid(ctx)
+	case saveslot.FieldPlayer:
+		return m.OldPlayer(ctx)
+	case saveslot.FieldEnemies:
+		return m.OldEnemies(ctx)
+	case saveslot.FieldEnemyTypes:
+		return m.OldEnemyTypes(ctx)
+	case saveslot.FieldPickups:
+		return m.OldPickups(ctx)
+	case saveslot.FieldProjectiles:
+		return m.OldProjectiles(ctx)
+	case saveslot.FieldDoors:
+		return m.OldDoors(ctx)
+	case saveslot.FieldSecrets:
+		return m.OldSecrets(ctx)
+	case saveslot.FieldBarrels:
+		return m.OldBarrels(ctx)
+	case saveslot.FieldDefeated:
+		return m.OldDefeated(ctx)
+	case saveslot.FieldLevelEnemyTotal:
+		return m.OldLevelEnemyTotal(ctx)
+	case saveslot.FieldLevelTime:
+		return m.OldLevelTime(ctx)
+	case saveslot.FieldCompletion:
+		return m.OldCompletion(ctx)
+	case saveslot.FieldFireRate:
+		return m.OldFireRate(ctx)
+	case saveslot.FieldBulletSpeed:
+		return m.OldBulletSpeed(ctx)
+	case saveslot.FieldRngState:
+		return m.OldRngState(ctx)
+	case saveslot.FieldCampaign:
+		return m.OldCampaign(ctx)
+	case saveslot.FieldMapName:
+		return m.OldMapName(ctx)
+	case saveslot.FieldPar:
+		return m.OldPar(ctx)
+	case saveslot.FieldThumbnail:
+		return m.OldThumbnail(ctx)
+	case saveslot.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case saveslot.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown SaveSlot field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SaveSlotMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case saveslot.FieldSlot:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSlot(v)
+		return nil
+	case saveslot.FieldLevel:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLevel(v)
+		return nil
+	case saveslot.FieldTotalLevels:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotalLevels(v)
+		return nil
+	case saveslot.FieldSeed:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSeed(v)
+		return nil
+	case saveslot.FieldPlayTime:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPlayTime(v)
+		return nil
+	case saveslot.FieldMapWidth:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMapWidth(v)
+		return nil
+	case saveslot.FieldMapHeight:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMapHeight(v)
+		return nil
+	case saveslot.FieldGrid:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGrid(v)
+		return nil
+	case saveslot.FieldPlayer:
+		v, ok := value.(sim.Player)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPlayer(v)
+		return nil
+	case saveslot.FieldEnemies:
+		v, ok := value.([]sim.Enemy)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnemies(v)
+		return nil
+	case saveslot.FieldEnemyTypes:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnemyTypes(v)
+		return nil
+	case saveslot.FieldPickups:
+		v, ok := value.([]sim.Pickup)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPickups(v)
+		return nil
+	case saveslot.FieldProjectiles:
+		v, ok := value.([]sim.Projectile)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProjectiles(v)
+		return nil
+	case saveslot.FieldDoors:
+		v, ok := value.([]sim.Door)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDoors(v)
+		return nil
+	case saveslot.FieldSecrets:
+		v, ok := value.([]sim.Secret)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSecrets(v)
+		return nil
+	case saveslot.FieldBarrels:
+		v, ok := value.([]sim.Barrel)
+		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddBulletSpeed(v)
+		m.SetBarrels(v)
 		return nil
-	case gamesettings.FieldLevelCount:
+	case saveslot.FieldDefeated:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddLevelCount(v)
+		m.SetDefeated(v)
+		return nil
+	case saveslot.FieldLevelEnemyTotal:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLevelEnemyTotal(v)
+		return nil
+	case saveslot.FieldLevelTime:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLevelTime(v)
+		return nil
+	case saveslot.FieldCompletion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCompletion(v)
+		return nil
+	case saveslot.FieldFireRate:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFireRate(v)
+		return nil
+	case saveslot.FieldBulletSpeed:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBulletSpeed(v)
+		return nil
+	case saveslot.FieldRngState:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRngState(v)
+		return nil
+	case saveslot.FieldCampaign:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCampaign(v)
+		return nil
+	case saveslot.FieldMapName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMapName(v)
+		return nil
+	case saveslot.FieldPar:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPar(v)
+		return nil
+	case saveslot.FieldThumbnail:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetThumbnail(v)
+		return nil
+	case saveslot.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case saveslot.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown GameSettings numeric field %s", name)
+	return fmt.Errorf("unknown SaveSlot field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SaveSlotMutation) AddedFields() []string {
+	var fields []string
+	if m.addslot != nil {
+		fields = append(fields, saveslot.FieldSlot)
+	}
+	if m.addlevel != nil {
+		fields = append(fields, saveslot.FieldLevel)
+	}
+	if m.addtotal_levels != nil {
+		fields = append(fields, saveslot.FieldTotalLevels)
+	}
+	if m.addseed != nil {
+		fields = append(fields, saveslot.FieldSeed)
+	}
+	if m.addplay_time != nil {
+		fields = append(fields, saveslot.FieldPlayTime)
+	}
+	if m.addmap_width != nil {
+		fields = append(fields, saveslot.FieldMapWidth)
+	}
+	if m.addmap_height != nil {
+		fields = append(fields, saveslot.FieldMapHeight)
+	}
+	if m.adddefeated != nil {
+		fields = append(fields, saveslot.FieldDefeated)
+	}
+	if m.addlevel_enemy_total != nil {
+		fields = append(fields, saveslot.FieldLevelEnemyTotal)
+	}
+	if m.addlevel_time != nil {
+		fields = append(fields, saveslot.FieldLevelTime)
+	}
+	if m.addcompletion != nil {
+		fields = append(fields, saveslot.FieldCompletion)
+	}
+	if m.addfire_rate != nil {
+		fields = append(fields, saveslot.FieldFireRate)
+	}
+	if m.addbullet_speed != nil {
+		fields = append(fields, saveslot.FieldBulletSpeed)
+	}
+	if m.addrng_state != nil {
+		fields = append(fields, saveslot.FieldRngState)
+	}
+	if m.addpar != nil {
+		fields = append(fields, saveslot.FieldPar)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SaveSlotMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case saveslot.FieldSlot:
+		return m.AddedSlot()
+	case saveslot.FieldLevel:
+		return m.AddedLevel()
+	case saveslot.FieldTotalLevels:
+		return m.AddedTotalLevels()
+	case saveslot.FieldSeed:
+		return m.AddedSeed()
+	case saveslot.FieldPlayTime:
+		return m.AddedPlayTime()
+	case saveslot.FieldMapWidth:
+		return m.AddedMapWidth()
+	case saveslot.FieldMapHeight:
+		return m.AddedMapHeight()
+	case saveslot.FieldDefeated:
+		return m.AddedDefeated()
+	case saveslot.FieldLevelEnemyTotal:
+		return m.AddedLevelEnemyTotal()
+	case saveslot.FieldLevelTime:
+		return m.AddedLevelTime()
+	case saveslot.FieldCompletion:
+		return m.AddedCompletion()
+	case saveslot.FieldFireRate:
+		return m.AddedFireRate()
+	case saveslot.FieldBulletSpeed:
+		return m.AddedBulletSpeed()
+	case saveslot.FieldRngState:
+		return m.AddedRngState()
+	case saveslot.FieldPar:
+		return m.AddedPar()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SaveSlotMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case saveslot.FieldSlot:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSlot(v)
+		return nil
+	case saveslot.FieldLevel:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLevel(v)
+		return nil
+	case saveslot.FieldTotalLevels:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTotalLevels(v)
+		return nil
+	case saveslot.FieldSeed:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSeed(v)
+		return nil
+	case saveslot.FieldPlayTime:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPlayTime(v)
+		return nil
+	case saveslot.FieldMapWidth:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMapWidth(v)
+		return nil
+	case saveslot.FieldMapHeight:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMapHeight(v)
+		return nil
+	case saveslot.FieldDefeated:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDefeated(v)
+		return nil
+	case saveslot.FieldLevelEnemyTotal:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLevelEnemyTotal(v)
+		return nil
+	case saveslot.FieldLevelTime:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLevelTime(v)
+		return nil
+	case saveslot.FieldCompletion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCompletion(v)
+		return nil
+	case saveslot.FieldFireRate:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFireRate(v)
+		return nil
+	case saveslot.FieldBulletSpeed:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBulletSpeed(v)
+		return nil
+	case saveslot.FieldRngState:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRngState(v)
+		return nil
+	case saveslot.FieldPar:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPar(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SaveSlot numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *GameSettingsMutation) ClearedFields() []string {
+func (m *SaveSlotMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(gamesettings.FieldCreatedAt) {
-		fields = append(fields, gamesettings.FieldCreatedAt)
+	if m.FieldCleared(saveslot.FieldEnemyTypes) {
+		fields = append(fields, saveslot.FieldEnemyTypes)
 	}
-	if m.FieldCleared(gamesettings.FieldUpdatedAt) {
-		fields = append(fields, gamesettings.FieldUpdatedAt)
+	if m.FieldCleared(saveslot.FieldDoors) {
+		fields = append(fields, saveslot.FieldDoors)
+	}
+	if m.FieldCleared(saveslot.FieldSecrets) {
+		fields = append(fields, saveslot.FieldSecrets)
+	}
+	if m.FieldCleared(saveslot.FieldBarrels) {
+		fields = append(fields, saveslot.FieldBarrels)
+	}
+	if m.FieldCleared(saveslot.FieldThumbnail) {
+		fields = append(fields, saveslot.FieldThumbnail)
+	}
+	if m.FieldCleared(saveslot.FieldCreatedAt) {
+		fields = append(fields, saveslot.FieldCreatedAt)
+	}
+	if m.FieldCleared(saveslot.FieldUpdatedAt) {
+		fields = append(fields, saveslot.FieldUpdatedAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *GameSettingsMutation) FieldCleared(name string) bool {
+func (m *SaveSlotMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *GameSettingsMutation) ClearField(name string) error {
+func (m *SaveSlotMutation) ClearField(name string) error {
 	switch name {
-	case gamesettings.FieldCreatedAt:
+	case saveslot.FieldEnemyTypes:
+		m.ClearEnemyTypes()
+		return nil
+	case saveslot.FieldDoors:
+		m.ClearDoors()
+		return nil
+	case saveslot.FieldSecrets:
+		m.ClearSecrets()
+		return nil
+	case saveslot.FieldBarrels:
+		m.ClearBarrels()
+		return nil
+	case saveslot.FieldThumbnail:
+		m.ClearThumbnail()
+		return nil
+	case saveslot.FieldCreatedAt:
 		m.ClearCreatedAt()
 		return nil
-	case gamesettings.FieldUpdatedAt:
+	case saveslot.FieldUpdatedAt:
 		m.ClearUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown GameSettings nullable field %s", name)
+	return fmt.Errorf("unknown SaveSlot nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *GameSettingsMutation) ResetField(name string) error {
+func (m *SaveSlotMutation) ResetField(name string) error {
 	switch name {
-	case gamesettings.FieldFireRate:
+	case saveslot.FieldSlot:
+		m.ResetSlot()
+		return nil
+	case saveslot.FieldLevel:
+		m.ResetLevel()
+		return nil
+	case saveslot.FieldTotalLevels:
+		m.ResetTotalLevels()
+		return nil
+	case saveslot.FieldSeed:
+		m.ResetSeed()
+		return nil
+	case saveslot.FieldPlayTime:
+		m.ResetPlayTime()
+		return nil
+	case saveslot.FieldMapWidth:
+		m.ResetMapWidth()
+		return nil
+	case saveslot.FieldMapHeight:
+		m.ResetMapHeight()
+		return nil
+	case saveslot.FieldGrid:
+		m.ResetGrid()
+		return nil
+	case saveslot.FieldPlayer:
+		m.ResetPlayer()
+		return nil
+	case saveslot.FieldEnemies:
+		m.ResetEnemies()
+		return nil
+	case saveslot.FieldEnemyTypes:
+		m.ResetEnemyTypes()
+		return nil
+	case saveslot.FieldPickups:
+		m.ResetPickups()
+		return nil
+	case saveslot.FieldProjectiles:
+		m.ResetProjectiles()
+		return nil
+	case saveslot.FieldDoors:
+		m.ResetDoors()
+		return nil
+	case saveslot.FieldSecrets:
+		m.ResetSecrets()
+		return nil
+	case saveslot.FieldBarrels:
+		m.ResetBarrels()
+		return nil
+	case saveslot.FieldDefeated:
+		m.ResetDefeated()
+		return nil
+	case saveslot.FieldLevelEnemyTotal:
+		m.ResetLevelEnemyTotal()
+		return nil
+	case saveslot.FieldLevelTime:
+		m.ResetLevelTime()
+		return nil
+	case saveslot.FieldCompletion:
+		m.ResetCompletion()
+		return nil
+	case saveslot.FieldFireRate:
 		m.ResetFireRate()
 		return nil
-	case gamesettings.FieldBulletSpeed:
+	case saveslot.FieldBulletSpeed:
 		m.ResetBulletSpeed()
 		return nil
-	case gamesettings.FieldLevelCount:
-		m.ResetLevelCount()
+	case saveslot.FieldRngState:
+		m.ResetRngState()
 		return nil
-	case gamesettings.FieldCreatedAt:
+	case saveslot.FieldCampaign:
+		m.ResetCampaign()
+		return nil
+	case saveslot.FieldMapName:
+		m.ResetMapName()
+		return nil
+	case saveslot.FieldPar:
+		m.ResetPar()
+		return nil
+	case saveslot.FieldThumbnail:
+		m.ResetThumbnail()
+		return nil
+	case saveslot.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case gamesettings.FieldUpdatedAt:
+	case saveslot.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown GameSettings field %s", name)
+	return fmt.Errorf("unknown SaveSlot field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *GameSettingsMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *SaveSlotMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.profile != nil {
+		edges = append(edges, saveslot.EdgeProfile)
+	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *GameSettingsMutation) AddedIDs(name string) []ent.Value {
+func (m *SaveSlotMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case saveslot.EdgeProfile:
+		if id := m.profile; id != nil {
+			return []ent.Value{*id}
+		}
+	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *GameSettingsMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *SaveSlotMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *GameSettingsMutation) RemovedIDs(name string) []ent.Value {
+func (m *SaveSlotMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *GameSettingsMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *SaveSlotMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedprofile {
+		edges = append(edges, saveslot.EdgeProfile)
+	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *GameSettingsMutation) EdgeCleared(name string) bool {
+func (m *SaveSlotMutation) EdgeCleared(name string) bool {
+	switch name {
+	case saveslot.EdgeProfile:
+		return m.clearedprofile
+	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *GameSettingsMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown GameSettings unique edge %s", name)
+func (m *SaveSlotMutation) ClearEdge(name string) error {
+	switch name {
+	case saveslot.EdgeProfile:
+		m.ClearProfile()
+		return nil
+	}
+	return fmt.Errorf("unknown SaveSlot unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *GameSettingsMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown GameSettings edge %s", name)
+func (m *SaveSlotMutation) ResetEdge(name string) error {
+	switch name {
+	case saveslot.EdgeProfile:
+		m.ResetProfile()
+		return nil
+	}
+	return fmt.Errorf("unknown SaveSlot edge %s", name)
 }
@@ -8,3 +8,18 @@ import (
 
 // GameSettings is the predicate function for gamesettings builders.
 type GameSettings func(*sql.Selector)
+
+// HighScore is the predicate function for highscore builders.
+type HighScore func(*sql.Selector)
+
+// LevelResult is the predicate function for levelresult builders.
+type LevelResult func(*sql.Selector)
+
+// Profile is the predicate function for profile builders.
+type Profile func(*sql.Selector)
+
+// Run is the predicate function for run builders.
+type Run func(*sql.Selector)
+
+// SaveSlot is the predicate function for saveslot builders.
+type SaveSlot func(*sql.Selector)
@@ -0,0 +1,221 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"doomlike/ent/gamesettings"
+	"doomlike/ent/profile"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Profile is the model entity for the Profile schema.
+type Profile struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Name shown on the profile screen
+	Name string `json:"name,omitempty"`
+	// When the profile was created
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// When the profile was last picked; the latest is used on startup
+	LastUsedAt time.Time `json:"last_used_at,omitempty"`
+	// Key and mouse button names by action; actions left out use their defaults
+	KeyBindings map[string][]string `json:"key_bindings,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ProfileQuery when eager-loading is set.
+	Edges        ProfileEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ProfileEdges holds the relations/edges for other nodes in the graph.
+type ProfileEdges struct {
+	// Game settings of the profile
+	Settings *GameSettings `json:"settings,omitempty"`
+	// High scores set by the profile
+	HighScores []*HighScore `json:"high_scores,omitempty"`
+	// Runs played by the profile
+	Runs []*Run `json:"runs,omitempty"`
+	// Save slots of the profile
+	Saves []*SaveSlot `json:"saves,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [4]bool
+}
+
+// SettingsOrErr returns the Settings value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ProfileEdges) SettingsOrErr() (*GameSettings, error) {
+	if e.Settings != nil {
+		return e.Settings, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: gamesettings.Label}
+	}
+	return nil, &NotLoadedError{edge: "settings"}
+}
+
+// HighScoresOrErr returns the HighScores value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProfileEdges) HighScoresOrErr() ([]*HighScore, error) {
+	if e.loadedTypes[1] {
+		return e.HighScores, nil
+	}
+	return nil, &NotLoadedError{edge: "high_scores"}
+}
+
+// RunsOrErr returns the Runs value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProfileEdges) RunsOrErr() ([]*Run, error) {
+	if e.loadedTypes[2] {
+		return e.Runs, nil
+	}
+	return nil, &NotLoadedError{edge: "runs"}
+}
+
+// SavesOrErr returns the Saves value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProfileEdges) SavesOrErr() ([]*SaveSlot, error) {
+	if e.loadedTypes[3] {
+		return e.Saves, nil
+	}
+	return nil, &NotLoadedError{edge: "saves"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Profile) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case profile.FieldKeyBindings:
+			values[i] = new([]byte)
+		case profile.FieldID:
+			values[i] = new(sql.NullInt64)
+		case profile.FieldName:
+			values[i] = new(sql.NullString)
+		case profile.FieldCreatedAt, profile.FieldLastUsedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Profile fields.
+func (_m *Profile) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case profile.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case profile.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case profile.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case profile.FieldLastUsedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_used_at", values[i])
+			} else if value.Valid {
+				_m.LastUsedAt = value.Time
+			}
+		case profile.FieldKeyBindings:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field key_bindings", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.KeyBindings); err != nil {
+					return fmt.Errorf("unmarshal field key_bindings: %w", err)
+				}
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Profile.
+// This includes values selected through modifiers, order, etc.
+func (_m *Profile) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QuerySettings queries the "settings" edge of the Profile entity.
+func (_m *Profile) QuerySettings() *GameSettingsQuery {
+	return NewProfileClient(_m.config).QuerySettings(_m)
+}
+
+// QueryHighScores queries the "high_scores" edge of the Profile entity.
+func (_m *Profile) QueryHighScores() *HighScoreQuery {
+	return NewProfileClient(_m.config).QueryHighScores(_m)
+}
+
+// QueryRuns queries the "runs" edge of the Profile entity.
+func (_m *Profile) QueryRuns() *RunQuery {
+	return NewProfileClient(_m.config).QueryRuns(_m)
+}
+
+// QuerySaves queries the "saves" edge of the Profile entity.
+func (_m *Profile) QuerySaves() *SaveSlotQuery {
+	return NewProfileClient(_m.config).QuerySaves(_m)
+}
+
+// Update returns a builder for updating this Profile.
+// Note that you need to call Profile.Unwrap() before calling this method if this Profile
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Profile) Update() *ProfileUpdateOne {
+	return NewProfileClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Profile entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Profile) Unwrap() *Profile {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Profile is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Profile) String() string {
+	var builder strings.Builder
+	builder.WriteString("Profile(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("last_used_at=")
+	builder.WriteString(_m.LastUsedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("key_bindings=")
+	builder.WriteString(fmt.Sprintf("%v", _m.KeyBindings))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Profiles is a parsable slice of Profile.
+type Profiles []*Profile
@@ -0,0 +1,185 @@
+// Code generated by ent, DO NOT EDIT.
+
+package profile
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the profile type in the database.
+	Label = "profile"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldLastUsedAt holds the string denoting the last_used_at field in the database.
+	FieldLastUsedAt = "last_used_at"
+	// FieldKeyBindings holds the string denoting the key_bindings field in the database.
+	FieldKeyBindings = "key_bindings"
+	// EdgeSettings holds the string denoting the settings edge name in mutations.
+	EdgeSettings = "settings"
+	// EdgeHighScores holds the string denoting the high_scores edge name in mutations.
+	EdgeHighScores = "high_scores"
+	// EdgeRuns holds the string denoting the runs edge name in mutations.
+	EdgeRuns = "runs"
+	// EdgeSaves holds the string denoting the saves edge name in mutations.
+	EdgeSaves = "saves"
+	// Table holds the table name of the profile in the database.
+	Table = "profiles"
+	// SettingsTable is the table that holds the settings relation/edge.
+	SettingsTable = "game_settings"
+	// SettingsInverseTable is the table name for the GameSettings entity.
+	// It exists in this package in order to avoid circular dependency with the "gamesettings" package.
+	SettingsInverseTable = "game_settings"
+	// SettingsColumn is the table column denoting the settings relation/edge.
+	SettingsColumn = "profile_settings"
+	// HighScoresTable is the table that holds the high_scores relation/edge.
+	HighScoresTable = "high_scores"
+	// HighScoresInverseTable is the table name for the HighScore entity.
+	// It exists in this package in order to avoid circular dependency with the "highscore" package.
+	HighScoresInverseTable = "high_scores"
+	// HighScoresColumn is the table column denoting the high_scores relation/edge.
+	HighScoresColumn = "profile_high_scores"
+	// RunsTable is the table that holds the runs relation/edge.
+	RunsTable = "runs"
+	// RunsInverseTable is the table name for the Run entity.
+	// It exists in this package in order to avoid circular dependency with the "run" package.
+	RunsInverseTable = "runs"
+	// RunsColumn is the table column denoting the runs relation/edge.
+	RunsColumn = "profile_runs"
+	// SavesTable is the table that holds the saves relation/edge.
+	SavesTable = "save_slots"
+	// SavesInverseTable is the table name for the SaveSlot entity.
+	// It exists in this package in order to avoid circular dependency with the "saveslot" package.
+	SavesInverseTable = "save_slots"
+	// SavesColumn is the table column denoting the saves relation/edge.
+	SavesColumn = "profile_saves"
+)
+
+// Columns holds all SQL columns for profile fields.
+var Columns = []string{
+	FieldID,
+	FieldName,
+	FieldCreatedAt,
+	FieldLastUsedAt,
+	FieldKeyBindings,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the Profile queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByLastUsedAt orders the results by the last_used_at field.
+func ByLastUsedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastUsedAt, opts...).ToFunc()
+}
+
+// BySettingsField orders the results by settings field.
+func BySettingsField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSettingsStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByHighScoresCount orders the results by high_scores count.
+func ByHighScoresCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newHighScoresStep(), opts...)
+	}
+}
+
+// ByHighScores orders the results by high_scores terms.
+func ByHighScores(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newHighScoresStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByRunsCount orders the results by runs count.
+func ByRunsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRunsStep(), opts...)
+	}
+}
+
+// ByRuns orders the results by runs terms.
+func ByRuns(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRunsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySavesCount orders the results by saves count.
+func BySavesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSavesStep(), opts...)
+	}
+}
+
+// BySaves orders the results by saves terms.
+func BySaves(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSavesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newSettingsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SettingsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, false, SettingsTable, SettingsColumn),
+	)
+}
+func newHighScoresStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(HighScoresInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, HighScoresTable, HighScoresColumn),
+	)
+}
+func newRunsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RunsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RunsTable, RunsColumn),
+	)
+}
+func newSavesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SavesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SavesTable, SavesColumn),
+	)
+}
@@ -0,0 +1,357 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"doomlike/ent/gamesettings"
+	"doomlike/ent/highscore"
+	"doomlike/ent/profile"
+	"doomlike/ent/run"
+	"doomlike/ent/saveslot"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ProfileCreate is the builder for creating a Profile entity.
+type ProfileCreate struct {
+	config
+	mutation *ProfileMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (_c *ProfileCreate) SetName(v string) *ProfileCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ProfileCreate) SetCreatedAt(v time.Time) *ProfileCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (_c *ProfileCreate) SetLastUsedAt(v time.Time) *ProfileCreate {
+	_c.mutation.SetLastUsedAt(v)
+	return _c
+}
+
+// SetKeyBindings sets the "key_bindings" field.
+func (_c *ProfileCreate) SetKeyBindings(v map[string][]string) *ProfileCreate {
+	_c.mutation.SetKeyBindings(v)
+	return _c
+}
+
+// SetSettingsID sets the "settings" edge to the GameSettings entity by ID.
+func (_c *ProfileCreate) SetSettingsID(id string) *ProfileCreate {
+	_c.mutation.SetSettingsID(id)
+	return _c
+}
+
+// SetNillableSettingsID sets the "settings" edge to the GameSettings entity by ID if the given value is not nil.
+func (_c *ProfileCreate) SetNillableSettingsID(id *string) *ProfileCreate {
+	if id != nil {
+		_c = _c.SetSettingsID(*id)
+	}
+	return _c
+}
+
+// SetSettings sets the "settings" edge to the GameSettings entity.
+func (_c *ProfileCreate) SetSettings(v *GameSettings) *ProfileCreate {
+	return _c.SetSettingsID(v.ID)
+}
+
+// AddHighScoreIDs adds the "high_scores" edge to the HighScore entity by IDs.
+func (_c *ProfileCreate) AddHighScoreIDs(ids ...int) *ProfileCreate {
+	_c.mutation.AddHighScoreIDs(ids...)
+	return _c
+}
+
+// AddHighScores adds the "high_scores" edges to the HighScore entity.
+func (_c *ProfileCreate) AddHighScores(v ...*HighScore) *ProfileCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddHighScoreIDs(ids...)
+}
+
+// AddRunIDs adds the "runs" edge to the Run entity by IDs.
+func (_c *ProfileCreate) AddRunIDs(ids ...int) *ProfileCreate {
+	_c.mutation.AddRunIDs(ids...)
+	return _c
+}
+
+// AddRuns adds the "runs" edges to the Run entity.
+func (_c *ProfileCreate) AddRuns(v ...*Run) *ProfileCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddRunIDs(ids...)
+}
+
+// AddSafeIDs adds the "saves" edge to the SaveSlot entity by IDs.
+func (_c *ProfileCreate) AddSafeIDs(ids ...int) *ProfileCreate {
+	_c.mutation.AddSafeIDs(ids...)
+	return _c
+}
+
+// AddSaves adds the "saves" edges to the SaveSlot entity.
+func (_c *ProfileCreate) AddSaves(v ...*SaveSlot) *ProfileCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddSafeIDs(ids...)
+}
+
+// Mutation returns the ProfileMutation object of the builder.
+func (_c *ProfileCreate) Mutation() *ProfileMutation {
+	return _c.mutation
+}
+
+// Save creates the Profile in the database.
+func (_c *ProfileCreate) Save(ctx context.Context) (*Profile, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ProfileCreate) SaveX(ctx context.Context) *Profile {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ProfileCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ProfileCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ProfileCreate) check() error {
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Profile.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := profile.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Profile.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Profile.created_at"`)}
+	}
+	if _, ok := _c.mutation.LastUsedAt(); !ok {
+		return &ValidationError{Name: "last_used_at", err: errors.New(`ent: missing required field "Profile.last_used_at"`)}
+	}
+	return nil
+}
+
+func (_c *ProfileCreate) sqlSave(ctx context.Context) (*Profile, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ProfileCreate) createSpec() (*Profile, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Profile{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(profile.Table, sqlgraph.NewFieldSpec(profile.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(profile.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(profile.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.LastUsedAt(); ok {
+		_spec.SetField(profile.FieldLastUsedAt, field.TypeTime, value)
+		_node.LastUsedAt = value
+	}
+	if value, ok := _c.mutation.KeyBindings(); ok {
+		_spec.SetField(profile.FieldKeyBindings, field.TypeJSON, value)
+		_node.KeyBindings = value
+	}
+	if nodes := _c.mutation.SettingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   profile.SettingsTable,
+			Columns: []string{profile.SettingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gamesettings.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.HighScoresIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.HighScoresTable,
+			Columns: []string{profile.HighScoresColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(highscore.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.RunsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.RunsTable,
+			Columns: []string{profile.RunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(run.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.SavesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.SavesTable,
+			Columns: []string{profile.SavesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(saveslot.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ProfileCreateBulk is the builder for creating many Profile entities in bulk.
+type ProfileCreateBulk struct {
+	config
+	err      error
+	builders []*ProfileCreate
+}
+
+// Save creates the Profile entities in the database.
+func (_c *ProfileCreateBulk) Save(ctx context.Context) ([]*Profile, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Profile, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ProfileMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ProfileCreateBulk) SaveX(ctx context.Context) []*Profile {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ProfileCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ProfileCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,938 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"doomlike/ent/gamesettings"
+	"doomlike/ent/highscore"
+	"doomlike/ent/predicate"
+	"doomlike/ent/profile"
+	"doomlike/ent/run"
+	"doomlike/ent/saveslot"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ProfileUpdate is the builder for updating Profile entities.
+type ProfileUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ProfileMutation
+}
+
+// Where appends a list predicates to the ProfileUpdate builder.
+func (_u *ProfileUpdate) Where(ps ...predicate.Profile) *ProfileUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *ProfileUpdate) SetName(v string) *ProfileUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *ProfileUpdate) SetNillableName(v *string) *ProfileUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *ProfileUpdate) SetCreatedAt(v time.Time) *ProfileUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *ProfileUpdate) SetNillableCreatedAt(v *time.Time) *ProfileUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (_u *ProfileUpdate) SetLastUsedAt(v time.Time) *ProfileUpdate {
+	_u.mutation.SetLastUsedAt(v)
+	return _u
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (_u *ProfileUpdate) SetNillableLastUsedAt(v *time.Time) *ProfileUpdate {
+	if v != nil {
+		_u.SetLastUsedAt(*v)
+	}
+	return _u
+}
+
+// SetKeyBindings sets the "key_bindings" field.
+func (_u *ProfileUpdate) SetKeyBindings(v map[string][]string) *ProfileUpdate {
+	_u.mutation.SetKeyBindings(v)
+	return _u
+}
+
+// ClearKeyBindings clears the value of the "key_bindings" field.
+func (_u *ProfileUpdate) ClearKeyBindings() *ProfileUpdate {
+	_u.mutation.ClearKeyBindings()
+	return _u
+}
+
+// SetSettingsID sets the "settings" edge to the GameSettings entity by ID.
+func (_u *ProfileUpdate) SetSettingsID(id string) *ProfileUpdate {
+	_u.mutation.SetSettingsID(id)
+	return _u
+}
+
+// SetNillableSettingsID sets the "settings" edge to the GameSettings entity by ID if the given value is not nil.
+func (_u *ProfileUpdate) SetNillableSettingsID(id *string) *ProfileUpdate {
+	if id != nil {
+		_u = _u.SetSettingsID(*id)
+	}
+	return _u
+}
+
+// SetSettings sets the "settings" edge to the GameSettings entity.
+func (_u *ProfileUpdate) SetSettings(v *GameSettings) *ProfileUpdate {
+	return _u.SetSettingsID(v.ID)
+}
+
+// AddHighScoreIDs adds the "high_scores" edge to the HighScore entity by IDs.
+func (_u *ProfileUpdate) AddHighScoreIDs(ids ...int) *ProfileUpdate {
+	_u.mutation.AddHighScoreIDs(ids...)
+	return _u
+}
+
+// AddHighScores adds the "high_scores" edges to the HighScore entity.
+func (_u *ProfileUpdate) AddHighScores(v ...*HighScore) *ProfileUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddHighScoreIDs(ids...)
+}
+
+// AddRunIDs adds the "runs" edge to the Run entity by IDs.
+func (_u *ProfileUpdate) AddRunIDs(ids ...int) *ProfileUpdate {
+	_u.mutation.AddRunIDs(ids...)
+	return _u
+}
+
+// AddRuns adds the "runs" edges to the Run entity.
+func (_u *ProfileUpdate) AddRuns(v ...*Run) *ProfileUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRunIDs(ids...)
+}
+
+// AddSafeIDs adds the "saves" edge to the SaveSlot entity by IDs.
+func (_u *ProfileUpdate) AddSafeIDs(ids ...int) *ProfileUpdate {
+	_u.mutation.AddSafeIDs(ids...)
+	return _u
+}
+
+// AddSaves adds the "saves" edges to the SaveSlot entity.
+func (_u *ProfileUpdate) AddSaves(v ...*SaveSlot) *ProfileUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSafeIDs(ids...)
+}
+
+// Mutation returns the ProfileMutation object of the builder.
+func (_u *ProfileUpdate) Mutation() *ProfileMutation {
+	return _u.mutation
+}
+
+// ClearSettings clears the "settings" edge to the GameSettings entity.
+func (_u *ProfileUpdate) ClearSettings() *ProfileUpdate {
+	_u.mutation.ClearSettings()
+	return _u
+}
+
+// ClearHighScores clears all "high_scores" edges to the HighScore entity.
+func (_u *ProfileUpdate) ClearHighScores() *ProfileUpdate {
+	_u.mutation.ClearHighScores()
+	return _u
+}
+
+// RemoveHighScoreIDs removes the "high_scores" edge to HighScore entities by IDs.
+func (_u *ProfileUpdate) RemoveHighScoreIDs(ids ...int) *ProfileUpdate {
+	_u.mutation.RemoveHighScoreIDs(ids...)
+	return _u
+}
+
+// RemoveHighScores removes "high_scores" edges to HighScore entities.
+func (_u *ProfileUpdate) RemoveHighScores(v ...*HighScore) *ProfileUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveHighScoreIDs(ids...)
+}
+
+// ClearRuns clears all "runs" edges to the Run entity.
+func (_u *ProfileUpdate) ClearRuns() *ProfileUpdate {
+	_u.mutation.ClearRuns()
+	return _u
+}
+
+// RemoveRunIDs removes the "runs" edge to Run entities by IDs.
+func (_u *ProfileUpdate) RemoveRunIDs(ids ...int) *ProfileUpdate {
+	_u.mutation.RemoveRunIDs(ids...)
+	return _u
+}
+
+// RemoveRuns removes "runs" edges to Run entities.
+func (_u *ProfileUpdate) RemoveRuns(v ...*Run) *ProfileUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRunIDs(ids...)
+}
+
+// ClearSaves clears all "saves" edges to the SaveSlot entity.
+func (_u *ProfileUpdate) ClearSaves() *ProfileUpdate {
+	_u.mutation.ClearSaves()
+	return _u
+}
+
+// RemoveSafeIDs removes the "saves" edge to SaveSlot entities by IDs.
+func (_u *ProfileUpdate) RemoveSafeIDs(ids ...int) *ProfileUpdate {
+	_u.mutation.RemoveSafeIDs(ids...)
+	return _u
+}
+
+// RemoveSaves removes "saves" edges to SaveSlot entities.
+func (_u *ProfileUpdate) RemoveSaves(v ...*SaveSlot) *ProfileUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSafeIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ProfileUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ProfileUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ProfileUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ProfileUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ProfileUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := profile.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Profile.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ProfileUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(profile.Table, profile.Columns, sqlgraph.NewFieldSpec(profile.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(profile.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(profile.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.LastUsedAt(); ok {
+		_spec.SetField(profile.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.KeyBindings(); ok {
+		_spec.SetField(profile.FieldKeyBindings, field.TypeJSON, value)
+	}
+	if _u.mutation.KeyBindingsCleared() {
+		_spec.ClearField(profile.FieldKeyBindings, field.TypeJSON)
+	}
+	if _u.mutation.SettingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   profile.SettingsTable,
+			Columns: []string{profile.SettingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gamesettings.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SettingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   profile.SettingsTable,
+			Columns: []string{profile.SettingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gamesettings.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.HighScoresCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.HighScoresTable,
+			Columns: []string{profile.HighScoresColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(highscore.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedHighScoresIDs(); len(nodes) > 0 && !_u.mutation.HighScoresCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.HighScoresTable,
+			Columns: []string{profile.HighScoresColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(highscore.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.HighScoresIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.HighScoresTable,
+			Columns: []string{profile.HighScoresColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(highscore.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RunsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.RunsTable,
+			Columns: []string{profile.RunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(run.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRunsIDs(); len(nodes) > 0 && !_u.mutation.RunsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.RunsTable,
+			Columns: []string{profile.RunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(run.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RunsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.RunsTable,
+			Columns: []string{profile.RunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(run.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SavesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.SavesTable,
+			Columns: []string{profile.SavesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(saveslot.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSavesIDs(); len(nodes) > 0 && !_u.mutation.SavesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.SavesTable,
+			Columns: []string{profile.SavesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(saveslot.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SavesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.SavesTable,
+			Columns: []string{profile.SavesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(saveslot.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{profile.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ProfileUpdateOne is the builder for updating a single Profile entity.
+type ProfileUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ProfileMutation
+}
+
+// SetName sets the "name" field.
+func (_u *ProfileUpdateOne) SetName(v string) *ProfileUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *ProfileUpdateOne) SetNillableName(v *string) *ProfileUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *ProfileUpdateOne) SetCreatedAt(v time.Time) *ProfileUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *ProfileUpdateOne) SetNillableCreatedAt(v *time.Time) *ProfileUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (_u *ProfileUpdateOne) SetLastUsedAt(v time.Time) *ProfileUpdateOne {
+	_u.mutation.SetLastUsedAt(v)
+	return _u
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (_u *ProfileUpdateOne) SetNillableLastUsedAt(v *time.Time) *ProfileUpdateOne {
+	if v != nil {
+		_u.SetLastUsedAt(*v)
+	}
+	return _u
+}
+
+// SetKeyBindings sets the "key_bindings" field.
+func (_u *ProfileUpdateOne) SetKeyBindings(v map[string][]string) *ProfileUpdateOne {
+	_u.mutation.SetKeyBindings(v)
+	return _u
+}
+
+// ClearKeyBindings clears the value of the "key_bindings" field.
+func (_u *ProfileUpdateOne) ClearKeyBindings() *ProfileUpdateOne {
+	_u.mutation.ClearKeyBindings()
+	return _u
+}
+
+// SetSettingsID sets the "settings" edge to the GameSettings entity by ID.
+func (_u *ProfileUpdateOne) SetSettingsID(id string) *ProfileUpdateOne {
+	_u.mutation.SetSettingsID(id)
+	return _u
+}
+
+// SetNillableSettingsID sets the "settings" edge to the GameSettings entity by ID if the given value is not nil.
+func (_u *ProfileUpdateOne) SetNillableSettingsID(id *string) *ProfileUpdateOne {
+	if id != nil {
+		_u = _u.SetSettingsID(*id)
+	}
+	return _u
+}
+
+// SetSettings sets the "settings" edge to the GameSettings entity.
+func (_u *ProfileUpdateOne) SetSettings(v *GameSettings) *ProfileUpdateOne {
+	return _u.SetSettingsID(v.ID)
+}
+
+// AddHighScoreIDs adds the "high_scores" edge to the HighScore entity by IDs.
+func (_u *ProfileUpdateOne) AddHighScoreIDs(ids ...int) *ProfileUpdateOne {
+	_u.mutation.AddHighScoreIDs(ids...)
+	return _u
+}
+
+// AddHighScores adds the "high_scores" edges to the HighScore entity.
+func (_u *ProfileUpdateOne) AddHighScores(v ...*HighScore) *ProfileUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddHighScoreIDs(ids...)
+}
+
+// AddRunIDs adds the "runs" edge to the Run entity by IDs.
+func (_u *ProfileUpdateOne) AddRunIDs(ids ...int) *ProfileUpdateOne {
+	_u.mutation.AddRunIDs(ids...)
+	return _u
+}
+
+// AddRuns adds the "runs" edges to the Run entity.
+func (_u *ProfileUpdateOne) AddRuns(v ...*Run) *ProfileUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRunIDs(ids...)
+}
+
+// AddSafeIDs adds the "saves" edge to the SaveSlot entity by IDs.
+func (_u *ProfileUpdateOne) AddSafeIDs(ids ...int) *ProfileUpdateOne {
+	_u.mutation.AddSafeIDs(ids...)
+	return _u
+}
+
+// AddSaves adds the "saves" edges to the SaveSlot entity.
+func (_u *ProfileUpdateOne) AddSaves(v ...*SaveSlot) *ProfileUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSafeIDs(ids...)
+}
+
+// Mutation returns the ProfileMutation object of the builder.
+func (_u *ProfileUpdateOne) Mutation() *ProfileMutation {
+	return _u.mutation
+}
+
+// ClearSettings clears the "settings" edge to the GameSettings entity.
+func (_u *ProfileUpdateOne) ClearSettings() *ProfileUpdateOne {
+	_u.mutation.ClearSettings()
+	return _u
+}
+
+// ClearHighScores clears all "high_scores" edges to the HighScore entity.
+func (_u *ProfileUpdateOne) ClearHighScores() *ProfileUpdateOne {
+	_u.mutation.ClearHighScores()
+	return _u
+}
+
+// RemoveHighScoreIDs removes the "high_scores" edge to HighScore entities by IDs.
+func (_u *ProfileUpdateOne) RemoveHighScoreIDs(ids ...int) *ProfileUpdateOne {
+	_u.mutation.RemoveHighScoreIDs(ids...)
+	return _u
+}
+
+// RemoveHighScores removes "high_scores" edges to HighScore entities.
+func (_u *ProfileUpdateOne) RemoveHighScores(v ...*HighScore) *ProfileUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveHighScoreIDs(ids...)
+}
+
+// ClearRuns clears all "runs" edges to the Run entity.
+func (_u *ProfileUpdateOne) ClearRuns() *ProfileUpdateOne {
+	_u.mutation.ClearRuns()
+	return _u
+}
+
+// RemoveRunIDs removes the "runs" edge to Run entities by IDs.
+func (_u *ProfileUpdateOne) RemoveRunIDs(ids ...int) *ProfileUpdateOne {
+	_u.mutation.RemoveRunIDs(ids...)
+	return _u
+}
+
+// RemoveRuns removes "runs" edges to Run entities.
+func (_u *ProfileUpdateOne) RemoveRuns(v ...*Run) *ProfileUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRunIDs(ids...)
+}
+
+// ClearSaves clears all "saves" edges to the SaveSlot entity.
+func (_u *ProfileUpdateOne) ClearSaves() *ProfileUpdateOne {
+	_u.mutation.ClearSaves()
+	return _u
+}
+
+// RemoveSafeIDs removes the "saves" edge to SaveSlot entities by IDs.
+func (_u *ProfileUpdateOne) RemoveSafeIDs(ids ...int) *ProfileUpdateOne {
+	_u.mutation.RemoveSafeIDs(ids...)
+	return _u
+}
+
+// RemoveSaves removes "saves" edges to SaveSlot entities.
+func (_u *ProfileUpdateOne) RemoveSaves(v ...*SaveSlot) *ProfileUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSafeIDs(ids...)
+}
+
+// Where appends a list predicates to the ProfileUpdate builder.
+func (_u *ProfileUpdateOne) Where(ps ...predicate.Profile) *ProfileUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ProfileUpdateOne) Select(field string, fields ...string) *ProfileUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Profile entity.
+func (_u *ProfileUpdateOne) Save(ctx context.Context) (*Profile, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ProfileUpdateOne) SaveX(ctx context.Context) *Profile {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ProfileUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ProfileUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ProfileUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := profile.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Profile.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ProfileUpdateOne) sqlSave(ctx context.Context) (_node *Profile, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(profile.Table, profile.Columns, sqlgraph.NewFieldSpec(profile.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Profile.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, profile.FieldID)
+		for _, f := range fields {
+			if !profile.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != profile.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(profile.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(profile.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.LastUsedAt(); ok {
+		_spec.SetField(profile.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.KeyBindings(); ok {
+		_spec.SetField(profile.FieldKeyBindings, field.TypeJSON, value)
+	}
+	if _u.mutation.KeyBindingsCleared() {
+		_spec.ClearField(profile.FieldKeyBindings, field.TypeJSON)
+	}
+	if _u.mutation.SettingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   profile.SettingsTable,
+			Columns: []string{profile.SettingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gamesettings.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SettingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   profile.SettingsTable,
+			Columns: []string{profile.SettingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gamesettings.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.HighScoresCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.HighScoresTable,
+			Columns: []string{profile.HighScoresColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(highscore.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedHighScoresIDs(); len(nodes) > 0 && !_u.mutation.HighScoresCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.HighScoresTable,
+			Columns: []string{profile.HighScoresColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(highscore.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.HighScoresIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.HighScoresTable,
+			Columns: []string{profile.HighScoresColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(highscore.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RunsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.RunsTable,
+			Columns: []string{profile.RunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(run.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRunsIDs(); len(nodes) > 0 && !_u.mutation.RunsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.RunsTable,
+			Columns: []string{profile.RunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(run.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RunsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.RunsTable,
+			Columns: []string{profile.RunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(run.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SavesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.SavesTable,
+			Columns: []string{profile.SavesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(saveslot.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSavesIDs(); len(nodes) > 0 && !_u.mutation.SavesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.SavesTable,
+			Columns: []string{profile.SavesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(saveslot.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SavesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.SavesTable,
+			Columns: []string{profile.SavesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(saveslot.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Profile{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{profile.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
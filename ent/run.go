@@ -0,0 +1,278 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"doomlike/ent/profile"
+	"doomlike/ent/run"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Run is the model entity for the Run schema.
+type Run struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// When the run began, or was picked up from a save
+	StartedAt time.Time `json:"started_at,omitempty"`
+	// When the run ended; unset while it is being played
+	EndedAt *time.Time `json:"ended_at,omitempty"`
+	// Run seed the levels are generated from
+	Seed int64 `json:"seed,omitempty"`
+	// Number of levels in the run
+	TotalLevels int `json:"total_levels,omitempty"`
+	// Fire rate in effect for the run
+	FireRate float64 `json:"fire_rate,omitempty"`
+	// Bullet speed in effect for the run
+	BulletSpeed float64 `json:"bullet_speed,omitempty"`
+	// Campaign manifest the run was started from, empty for procedural runs
+	Campaign string `json:"campaign,omitempty"`
+	// Whether the run was continued from a save slot
+	Resumed bool `json:"resumed,omitempty"`
+	// How the run ended
+	Outcome run.Outcome `json:"outcome,omitempty"`
+	// Score when the run ended
+	Score int `json:"score,omitempty"`
+	// Levels cleared during the run
+	ClearedLevels int `json:"cleared_levels,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the RunQuery when eager-loading is set.
+	Edges        RunEdges `json:"edges"`
+	profile_runs *int
+	selectValues sql.SelectValues
+}
+
+// RunEdges holds the relations/edges for other nodes in the graph.
+type RunEdges struct {
+	// One result for every level played, cleared or not
+	Levels []*LevelResult `json:"levels,omitempty"`
+	// Profile that played the run
+	Profile *Profile `json:"profile,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// LevelsOrErr returns the Levels value or an error if the edge
+// was not loaded in eager-loading.
+func (e RunEdges) LevelsOrErr() ([]*LevelResult, error) {
+	if e.loadedTypes[0] {
+		return e.Levels, nil
+	}
+	return nil, &NotLoadedError{edge: "levels"}
+}
+
+// ProfileOrErr returns the Profile value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RunEdges) ProfileOrErr() (*Profile, error) {
+	if e.Profile != nil {
+		return e.Profile, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: profile.Label}
+	}
+	return nil, &NotLoadedError{edge: "profile"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Run) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case run.FieldResumed:
+			values[i] = new(sql.NullBool)
+		case run.FieldFireRate, run.FieldBulletSpeed:
+			values[i] = new(sql.NullFloat64)
+		case run.FieldID, run.FieldSeed, run.FieldTotalLevels, run.FieldScore, run.FieldClearedLevels:
+			values[i] = new(sql.NullInt64)
+		case run.FieldCampaign, run.FieldOutcome:
+			values[i] = new(sql.NullString)
+		case run.FieldStartedAt, run.FieldEndedAt:
+			values[i] = new(sql.NullTime)
+		case run.ForeignKeys[0]: // profile_runs
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Run fields.
+func (_m *Run) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case run.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case run.FieldStartedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field started_at", values[i])
+			} else if value.Valid {
+				_m.StartedAt = value.Time
+			}
+		case run.FieldEndedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field ended_at", values[i])
+			} else if value.Valid {
+				_m.EndedAt = new(time.Time)
+				*_m.EndedAt = value.Time
+			}
+		case run.FieldSeed:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field seed", values[i])
+			} else if value.Valid {
+				_m.Seed = value.Int64
+			}
+		case run.FieldTotalLevels:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field total_levels", values[i])
+			} else if value.Valid {
+				_m.TotalLevels = int(value.Int64)
+			}
+		case run.FieldFireRate:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field fire_rate", values[i])
+			} else if value.Valid {
+				_m.FireRate = value.Float64
+			}
+		case run.FieldBulletSpeed:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field bullet_speed", values[i])
+			} else if value.Valid {
+				_m.BulletSpeed = value.Float64
+			}
+		case run.FieldCampaign:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field campaign", values[i])
+			} else if value.Valid {
+				_m.Campaign = value.String
+			}
+		case run.FieldResumed:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field resumed", values[i])
+			} else if value.Valid {
+				_m.Resumed = value.Bool
+			}
+		case run.FieldOutcome:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field outcome", values[i])
+			} else if value.Valid {
+				_m.Outcome = run.Outcome(value.String)
+			}
+		case run.FieldScore:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field score", values[i])
+			} else if value.Valid {
+				_m.Score = int(value.Int64)
+			}
+		case run.FieldClearedLevels:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field cleared_levels", values[i])
+			} else if value.Valid {
+				_m.ClearedLevels = int(value.Int64)
+			}
+		case run.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field profile_runs", value)
+			} else if value.Valid {
+				_m.profile_runs = new(int)
+				*_m.profile_runs = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Run.
+// This includes values selected through modifiers, order, etc.
+func (_m *Run) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryLevels queries the "levels" edge of the Run entity.
+func (_m *Run) QueryLevels() *LevelResultQuery {
+	return NewRunClient(_m.config).QueryLevels(_m)
+}
+
+// QueryProfile queries the "profile" edge of the Run entity.
+func (_m *Run) QueryProfile() *ProfileQuery {
+	return NewRunClient(_m.config).QueryProfile(_m)
+}
+
+// Update returns a builder for updating this Run.
+// Note that you need to call Run.Unwrap() before calling this method if this Run
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Run) Update() *RunUpdateOne {
+	return NewRunClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Run entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Run) Unwrap() *Run {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Run is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Run) String() string {
+	var builder strings.Builder
+	builder.WriteString("Run(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("started_at=")
+	builder.WriteString(_m.StartedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.EndedAt; v != nil {
+		builder.WriteString("ended_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("seed=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Seed))
+	builder.WriteString(", ")
+	builder.WriteString("total_levels=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TotalLevels))
+	builder.WriteString(", ")
+	builder.WriteString("fire_rate=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FireRate))
+	builder.WriteString(", ")
+	builder.WriteString("bullet_speed=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BulletSpeed))
+	builder.WriteString(", ")
+	builder.WriteString("campaign=")
+	builder.WriteString(_m.Campaign)
+	builder.WriteString(", ")
+	builder.WriteString("resumed=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Resumed))
+	builder.WriteString(", ")
+	builder.WriteString("outcome=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Outcome))
+	builder.WriteString(", ")
+	builder.WriteString("score=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Score))
+	builder.WriteString(", ")
+	builder.WriteString("cleared_levels=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ClearedLevels))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Runs is a parsable slice of Run.
+type Runs []*Run
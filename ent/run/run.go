@@ -0,0 +1,233 @@
+// Code generated by ent, DO NOT EDIT.
+
+package run
+
+import (
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the run type in the database.
+	Label = "run"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldStartedAt holds the string denoting the started_at field in the database.
+	FieldStartedAt = "started_at"
+	// FieldEndedAt holds the string denoting the ended_at field in the database.
+	FieldEndedAt = "ended_at"
+	// FieldSeed holds the string denoting the seed field in the database.
+	FieldSeed = "seed"
+	// FieldTotalLevels holds the string denoting the total_levels field in the database.
+	FieldTotalLevels = "total_levels"
+	// FieldFireRate holds the string denoting the fire_rate field in the database.
+	FieldFireRate = "fire_rate"
+	// FieldBulletSpeed holds the string denoting the bullet_speed field in the database.
+	FieldBulletSpeed = "bullet_speed"
+	// FieldCampaign holds the string denoting the campaign field in the database.
+	FieldCampaign = "campaign"
+	// FieldResumed holds the string denoting the resumed field in the database.
+	FieldResumed = "resumed"
+	// FieldOutcome holds the string denoting the outcome field in the database.
+	FieldOutcome = "outcome"
+	// FieldScore holds the string denoting the score field in the database.
+	FieldScore = "score"
+	// FieldClearedLevels holds the string denoting the cleared_levels field in the database.
+	FieldClearedLevels = "cleared_levels"
+	// EdgeLevels holds the string denoting the levels edge name in mutations.
+	EdgeLevels = "levels"
+	// EdgeProfile holds the string denoting the profile edge name in mutations.
+	EdgeProfile = "profile"
+	// Table holds the table name of the run in the database.
+	Table = "runs"
+	// LevelsTable is the table that holds the levels relation/edge.
+	LevelsTable = "level_results"
+	// LevelsInverseTable is the table name for the LevelResult entity.
+	// It exists in this package in order to avoid circular dependency with the "levelresult" package.
+	LevelsInverseTable = "level_results"
+	// LevelsColumn is the table column denoting the levels relation/edge.
+	LevelsColumn = "run_levels"
+	// ProfileTable is the table that holds the profile relation/edge.
+	ProfileTable = "runs"
+	// ProfileInverseTable is the table name for the Profile entity.
+	// It exists in this package in order to avoid circular dependency with the "profile" package.
+	ProfileInverseTable = "profiles"
+	// ProfileColumn is the table column denoting the profile relation/edge.
+	ProfileColumn = "profile_runs"
+)
+
+// Columns holds all SQL columns for run fields.
+var Columns = []string{
+	FieldID,
+	FieldStartedAt,
+	FieldEndedAt,
+	FieldSeed,
+	FieldTotalLevels,
+	FieldFireRate,
+	FieldBulletSpeed,
+	FieldCampaign,
+	FieldResumed,
+	FieldOutcome,
+	FieldScore,
+	FieldClearedLevels,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "runs"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"profile_runs",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCampaign holds the default value on creation for the "campaign" field.
+	DefaultCampaign string
+	// DefaultResumed holds the default value on creation for the "resumed" field.
+	DefaultResumed bool
+	// DefaultScore holds the default value on creation for the "score" field.
+	DefaultScore int
+	// DefaultClearedLevels holds the default value on creation for the "cleared_levels" field.
+	DefaultClearedLevels int
+)
+
+// Outcome defines the type for the "outcome" enum field.
+type Outcome string
+
+// OutcomePlaying is the default value of the Outcome enum.
+const DefaultOutcome = OutcomePlaying
+
+// Outcome values.
+const (
+	OutcomePlaying Outcome = "playing"
+	OutcomeWin     Outcome = "win"
+	OutcomeDeath   Outcome = "death"
+	OutcomeQuit    Outcome = "quit"
+)
+
+func (o Outcome) String() string {
+	return string(o)
+}
+
+// OutcomeValidator is a validator for the "outcome" field enum values. It is called by the builders before save.
+func OutcomeValidator(o Outcome) error {
+	switch o {
+	case OutcomePlaying, OutcomeWin, OutcomeDeath, OutcomeQuit:
+		return nil
+	default:
+		return fmt.Errorf("run: invalid enum value for outcome field: %q", o)
+	}
+}
+
+// OrderOption defines the ordering options for the Run queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByStartedAt orders the results by the started_at field.
+func ByStartedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartedAt, opts...).ToFunc()
+}
+
+// ByEndedAt orders the results by the ended_at field.
+func ByEndedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEndedAt, opts...).ToFunc()
+}
+
+// BySeed orders the results by the seed field.
+func BySeed(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSeed, opts...).ToFunc()
+}
+
+// ByTotalLevels orders the results by the total_levels field.
+func ByTotalLevels(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotalLevels, opts...).ToFunc()
+}
+
+// ByFireRate orders the results by the fire_rate field.
+func ByFireRate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFireRate, opts...).ToFunc()
+}
+
+// ByBulletSpeed orders the results by the bullet_speed field.
+func ByBulletSpeed(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBulletSpeed, opts...).ToFunc()
+}
+
+// ByCampaign orders the results by the campaign field.
+func ByCampaign(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCampaign, opts...).ToFunc()
+}
+
+// ByResumed orders the results by the resumed field.
+func ByResumed(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResumed, opts...).ToFunc()
+}
+
+// ByOutcome orders the results by the outcome field.
+func ByOutcome(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOutcome, opts...).ToFunc()
+}
+
+// ByScore orders the results by the score field.
+func ByScore(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScore, opts...).ToFunc()
+}
+
+// ByClearedLevels orders the results by the cleared_levels field.
+func ByClearedLevels(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClearedLevels, opts...).ToFunc()
+}
+
+// ByLevelsCount orders the results by levels count.
+func ByLevelsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newLevelsStep(), opts...)
+	}
+}
+
+// ByLevels orders the results by levels terms.
+func ByLevels(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLevelsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByProfileField orders the results by profile field.
+func ByProfileField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newProfileStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newLevelsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LevelsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, LevelsTable, LevelsColumn),
+	)
+}
+func newProfileStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ProfileInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ProfileTable, ProfileColumn),
+	)
+}
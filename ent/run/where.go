@@ -0,0 +1,592 @@
+// Code generated by ent, DO NOT EDIT.
+
+package run
+
+import (
+	"doomlike/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Run {
+	return predicate.Run(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Run {
+	return predicate.Run(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Run {
+	return predicate.Run(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Run {
+	return predicate.Run(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Run {
+	return predicate.Run(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Run {
+	return predicate.Run(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Run {
+	return predicate.Run(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Run {
+	return predicate.Run(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Run {
+	return predicate.Run(sql.FieldLTE(FieldID, id))
+}
+
+// StartedAt applies equality check predicate on the "started_at" field. It's identical to StartedAtEQ.
+func StartedAt(v time.Time) predicate.Run {
+	return predicate.Run(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// EndedAt applies equality check predicate on the "ended_at" field. It's identical to EndedAtEQ.
+func EndedAt(v time.Time) predicate.Run {
+	return predicate.Run(sql.FieldEQ(FieldEndedAt, v))
+}
+
+// Seed applies equality check predicate on the "seed" field. It's identical to SeedEQ.
+func Seed(v int64) predicate.Run {
+	return predicate.Run(sql.FieldEQ(FieldSeed, v))
+}
+
+// TotalLevels applies equality check predicate on the "total_levels" field. It's identical to TotalLevelsEQ.
+func TotalLevels(v int) predicate.Run {
+	return predicate.Run(sql.FieldEQ(FieldTotalLevels, v))
+}
+
+// FireRate applies equality check predicate on the "fire_rate" field. It's identical to FireRateEQ.
+func FireRate(v float64) predicate.Run {
+	return predicate.Run(sql.FieldEQ(FieldFireRate, v))
+}
+
+// BulletSpeed applies equality check predicate on the "bullet_speed" field. It's identical to BulletSpeedEQ.
+func BulletSpeed(v float64) predicate.Run {
+	return predicate.Run(sql.FieldEQ(FieldBulletSpeed, v))
+}
+
+// Campaign applies equality check predicate on the "campaign" field. It's identical to CampaignEQ.
+func Campaign(v string) predicate.Run {
+	return predicate.Run(sql.FieldEQ(FieldCampaign, v))
+}
+
+// Resumed applies equality check predicate on the "resumed" field. It's identical to ResumedEQ.
+func Resumed(v bool) predicate.Run {
+	return predicate.Run(sql.FieldEQ(FieldResumed, v))
+}
+
+// Score applies equality check predicate on the "score" field. It's identical to ScoreEQ.
+func Score(v int) predicate.Run {
+	return predicate.Run(sql.FieldEQ(FieldScore, v))
+}
+
+// ClearedLevels applies equality check predicate on the "cleared_levels" field. It's identical to ClearedLevelsEQ.
+func ClearedLevels(v int) predicate.Run {
+	return predicate.Run(sql.FieldEQ(FieldClearedLevels, v))
+}
+
+// StartedAtEQ applies the EQ predicate on the "started_at" field.
+func StartedAtEQ(v time.Time) predicate.Run {
+	return predicate.Run(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// StartedAtNEQ applies the NEQ predicate on the "started_at" field.
+func StartedAtNEQ(v time.Time) predicate.Run {
+	return predicate.Run(sql.FieldNEQ(FieldStartedAt, v))
+}
+
+// StartedAtIn applies the In predicate on the "started_at" field.
+func StartedAtIn(vs ...time.Time) predicate.Run {
+	return predicate.Run(sql.FieldIn(FieldStartedAt, vs...))
+}
+
+// StartedAtNotIn applies the NotIn predicate on the "started_at" field.
+func StartedAtNotIn(vs ...time.Time) predicate.Run {
+	return predicate.Run(sql.FieldNotIn(FieldStartedAt, vs...))
+}
+
+// StartedAtGT applies the GT predicate on the "started_at" field.
+func StartedAtGT(v time.Time) predicate.Run {
+	return predicate.Run(sql.FieldGT(FieldStartedAt, v))
+}
+
+// StartedAtGTE applies the GTE predicate on the "started_at" field.
+func StartedAtGTE(v time.Time) predicate.Run {
+	return predicate.Run(sql.FieldGTE(FieldStartedAt, v))
+}
+
+// StartedAtLT applies the LT predicate on the "started_at" field.
+func StartedAtLT(v time.Time) predicate.Run {
+	return predicate.Run(sql.FieldLT(FieldStartedAt, v))
+}
+
+// StartedAtLTE applies the LTE predicate on the "started_at" field.
+func StartedAtLTE(v time.Time) predicate.Run {
+	return predicate.Run(sql.FieldLTE(FieldStartedAt, v))
+}
+
+// EndedAtEQ applies the EQ predicate on the "ended_at" field.
+func EndedAtEQ(v time.Time) predicate.Run {
+	return predicate.Run(sql.FieldEQ(FieldEndedAt, v))
+}
+
+// EndedAtNEQ applies the NEQ predicate on the "ended_at" field.
+func EndedAtNEQ(v time.Time) predicate.Run {
+	return predicate.Run(sql.FieldNEQ(FieldEndedAt, v))
+}
+
+// EndedAtIn applies the In predicate on the "ended_at" field.
+func EndedAtIn(vs ...time.Time) predicate.Run {
+	return predicate.Run(sql.FieldIn(FieldEndedAt, vs...))
+}
+
+// EndedAtNotIn applies the NotIn predicate on the "ended_at" field.
+func EndedAtNotIn(vs ...time.Time) predicate.Run {
+	return predicate.Run(sql.FieldNotIn(FieldEndedAt, vs...))
+}
+
+// EndedAtGT applies the GT predicate on the "ended_at" field.
+func EndedAtGT(v time.Time) predicate.Run {
+	return predicate.Run(sql.FieldGT(FieldEndedAt, v))
+}
+
+// EndedAtGTE applies the GTE predicate on the "ended_at" field.
+func EndedAtGTE(v time.Time) predicate.Run {
+	return predicate.Run(sql.FieldGTE(FieldEndedAt, v))
+}
+
+// EndedAtLT applies the LT predicate on the "ended_at" field.
+func EndedAtLT(v time.Time) predicate.Run {
+	return predicate.Run(sql.FieldLT(FieldEndedAt, v))
+}
+
+// EndedAtLTE applies the LTE predicate on the "ended_at" field.
+func EndedAtLTE(v time.Time) predicate.Run {
+	return predicate.Run(sql.FieldLTE(FieldEndedAt, v))
+}
+
+// EndedAtIsNil applies the IsNil predicate on the "ended_at" field.
+func EndedAtIsNil() predicate.Run {
+	return predicate.Run(sql.FieldIsNull(FieldEndedAt))
+}
+
+// EndedAtNotNil applies the NotNil predicate on the "ended_at" field.
+func EndedAtNotNil() predicate.Run {
+	return predicate.Run(sql.FieldNotNull(FieldEndedAt))
+}
+
+// SeedEQ applies the EQ predicate on the "seed" field.
+func SeedEQ(v int64) predicate.Run {
+	return predicate.Run(sql.FieldEQ(FieldSeed, v))
+}
+
+// SeedNEQ applies the NEQ predicate on the "seed" field.
+func SeedNEQ(v int64) predicate.Run {
+	return predicate.Run(sql.FieldNEQ(FieldSeed, v))
+}
+
+// SeedIn applies the In predicate on the "seed" field.
+func SeedIn(vs ...int64) predicate.Run {
+	return predicate.Run(sql.FieldIn(FieldSeed, vs...))
+}
+
+// SeedNotIn applies the NotIn predicate on the "seed" field.
+func SeedNotIn(vs ...int64) predicate.Run {
+	return predicate.Run(sql.FieldNotIn(FieldSeed, vs...))
+}
+
+// SeedGT applies the GT predicate on the "seed" field.
+func SeedGT(v int64) predicate.Run {
+	return predicate.Run(sql.FieldGT(FieldSeed, v))
+}
+
+// SeedGTE applies the GTE predicate on the "seed" field.
+func SeedGTE(v int64) predicate.Run {
+	return predicate.Run(sql.FieldGTE(FieldSeed, v))
+}
+
+// SeedLT applies the LT predicate on the "seed" field.
+func SeedLT(v int64) predicate.Run {
+	return predicate.Run(sql.FieldLT(FieldSeed, v))
+}
+
+// SeedLTE applies the LTE predicate on the "seed" field.
+func SeedLTE(v int64) predicate.Run {
+	return predicate.Run(sql.FieldLTE(FieldSeed, v))
+}
+
+// TotalLevelsEQ applies the EQ predicate on the "total_levels" field.
+func TotalLevelsEQ(v int) predicate.Run {
+	return predicate.Run(sql.FieldEQ(FieldTotalLevels, v))
+}
+
+// TotalLevelsNEQ applies the NEQ predicate on the "total_levels" field.
+func TotalLevelsNEQ(v int) predicate.Run {
+	return predicate.Run(sql.FieldNEQ(FieldTotalLevels, v))
+}
+
+// TotalLevelsIn applies the In predicate on the "total_levels" field.
+func TotalLevelsIn(vs ...int) predicate.Run {
+	return predicate.Run(sql.FieldIn(FieldTotalLevels, vs...))
+}
+
+// TotalLevelsNotIn applies the NotIn predicate on the "total_levels" field.
+func TotalLevelsNotIn(vs ...int) predicate.Run {
+	return predicate.Run(sql.FieldNotIn(FieldTotalLevels, vs...))
+}
+
+// TotalLevelsGT applies the GT predicate on the "total_levels" field.
+func TotalLevelsGT(v int) predicate.Run {
+	return predicate.Run(sql.FieldGT(FieldTotalLevels, v))
+}
+
+// TotalLevelsGTE applies the GTE predicate on the "total_levels" field.
+func TotalLevelsGTE(v int) predicate.Run {
+	return predicate.Run(sql.FieldGTE(FieldTotalLevels, v))
+}
+
+// TotalLevelsLT applies the LT predicate on the "total_levels" field.
+func TotalLevelsLT(v int) predicate.Run {
+	return predicate.Run(sql.FieldLT(FieldTotalLevels, v))
+}
+
+// TotalLevelsLTE applies the LTE predicate on the "total_levels" field.
+func TotalLevelsLTE(v int) predicate.Run {
+	return predicate.Run(sql.FieldLTE(FieldTotalLevels, v))
+}
+
+// FireRateEQ applies the EQ predicate on the "fire_rate" field.
+func FireRateEQ(v float64) predicate.Run {
+	return predicate.Run(sql.FieldEQ(FieldFireRate, v))
+}
+
+// FireRateNEQ applies the NEQ predicate on the "fire_rate" field.
+func FireRateNEQ(v float64) predicate.Run {
+	return predicate.Run(sql.FieldNEQ(FieldFireRate, v))
+}
+
+// FireRateIn applies the In predicate on the "fire_rate" field.
+func FireRateIn(vs ...float64) predicate.Run {
+	return predicate.Run(sql.FieldIn(FieldFireRate, vs...))
+}
+
+// FireRateNotIn applies the NotIn predicate on the "fire_rate" field.
+func FireRateNotIn(vs ...float64) predicate.Run {
+	return predicate.Run(sql.FieldNotIn(FieldFireRate, vs...))
+}
+
+// FireRateGT applies the GT predicate on the "fire_rate" field.
+func FireRateGT(v float64) predicate.Run {
+	return predicate.Run(sql.FieldGT(FieldFireRate, v))
+}
+
+// FireRateGTE applies the GTE predicate on the "fire_rate" field.
+func FireRateGTE(v float64) predicate.Run {
+	return predicate.Run(sql.FieldGTE(FieldFireRate, v))
+}
+
+// FireRateLT applies the LT predicate on the "fire_rate" field.
+func FireRateLT(v float64) predicate.Run {
+	return predicate.Run(sql.FieldLT(FieldFireRate, v))
+}
+
+// FireRateLTE applies the LTE predicate on the "fire_rate" field.
+func FireRateLTE(v float64) predicate.Run {
+	return predicate.Run(sql.FieldLTE(FieldFireRate, v))
+}
+
+// BulletSpeedEQ applies the EQ predicate on the "bullet_speed" field.
+func BulletSpeedEQ(v float64) predicate.Run {
+	return predicate.Run(sql.FieldEQ(FieldBulletSpeed, v))
+}
+
+// BulletSpeedNEQ applies the NEQ predicate on the "bullet_speed" field.
+func BulletSpeedNEQ(v float64) predicate.Run {
+	return predicate.Run(sql.FieldNEQ(FieldBulletSpeed, v))
+}
+
+// BulletSpeedIn applies the In predicate on the "bullet_speed" field.
+func BulletSpeedIn(vs ...float64) predicate.Run {
+	return predicate.Run(sql.FieldIn(FieldBulletSpeed, vs...))
+}
+
+// BulletSpeedNotIn applies the NotIn predicate on the "bullet_speed" field.
+func BulletSpeedNotIn(vs ...float64) predicate.Run {
+	return predicate.Run(sql.FieldNotIn(FieldBulletSpeed, vs...))
+}
+
+// BulletSpeedGT applies the GT predicate on the "bullet_speed" field.
+func BulletSpeedGT(v float64) predicate.Run {
+	return predicate.Run(sql.FieldGT(FieldBulletSpeed, v))
+}
+
+// BulletSpeedGTE applies the GTE predicate on the "bullet_speed" field.
+func BulletSpeedGTE(v float64) predicate.Run {
+	return predicate.Run(sql.FieldGTE(FieldBulletSpeed, v))
+}
+
+// BulletSpeedLT applies the LT predicate on the "bullet_speed" field.
+func BulletSpeedLT(v float64) predicate.Run {
+	return predicate.Run(sql.FieldLT(FieldBulletSpeed, v))
+}
+
+// BulletSpeedLTE applies the LTE predicate on the "bullet_speed" field.
+func BulletSpeedLTE(v float64) predicate.Run {
+	return predicate.Run(sql.FieldLTE(FieldBulletSpeed, v))
+}
+
+// CampaignEQ applies the EQ predicate on the "campaign" field.
+func CampaignEQ(v string) predicate.Run {
+	return predicate.Run(sql.FieldEQ(FieldCampaign, v))
+}
+
+// CampaignNEQ applies the NEQ predicate on the "campaign" field.
+func CampaignNEQ(v string) predicate.Run {
+	return predicate.Run(sql.FieldNEQ(FieldCampaign, v))
+}
+
+// CampaignIn applies the In predicate on the "campaign" field.
+func CampaignIn(vs ...string) predicate.Run {
+	return predicate.Run(sql.FieldIn(FieldCampaign, vs...))
+}
+
+// CampaignNotIn applies the NotIn predicate on the "campaign" field.
+func CampaignNotIn(vs ...string) predicate.Run {
+	return predicate.Run(sql.FieldNotIn(FieldCampaign, vs...))
+}
+
+// CampaignGT applies the GT predicate on the "campaign" field.
+func CampaignGT(v string) predicate.Run {
+	return predicate.Run(sql.FieldGT(FieldCampaign, v))
+}
+
+// CampaignGTE applies the GTE predicate on the "campaign" field.
+func CampaignGTE(v string) predicate.Run {
+	return predicate.Run(sql.FieldGTE(FieldCampaign, v))
+}
+
+// CampaignLT applies the LT predicate on the "campaign" field.
+func CampaignLT(v string) predicate.Run {
+	return predicate.Run(sql.FieldLT(FieldCampaign, v))
+}
+
+// CampaignLTE applies the LTE predicate on the "campaign" field.
+func CampaignLTE(v string) predicate.Run {
+	return predicate.Run(sql.FieldLTE(FieldCampaign, v))
+}
+
+// CampaignContains applies the Contains predicate on the "campaign" field.
+func CampaignContains(v string) predicate.Run {
+	return predicate.Run(sql.FieldContains(FieldCampaign, v))
+}
+
+// CampaignHasPrefix applies the HasPrefix predicate on the "campaign" field.
+func CampaignHasPrefix(v string) predicate.Run {
+	return predicate.Run(sql.FieldHasPrefix(FieldCampaign, v))
+}
+
+// CampaignHasSuffix applies the HasSuffix predicate on the "campaign" field.
+func CampaignHasSuffix(v string) predicate.Run {
+	return predicate.Run(sql.FieldHasSuffix(FieldCampaign, v))
+}
+
+// CampaignEqualFold applies the EqualFold predicate on the "campaign" field.
+func CampaignEqualFold(v string) predicate.Run {
+	return predicate.Run(sql.FieldEqualFold(FieldCampaign, v))
+}
+
+// CampaignContainsFold applies the ContainsFold predicate on the "campaign" field.
+func CampaignContainsFold(v string) predicate.Run {
+	return predicate.Run(sql.FieldContainsFold(FieldCampaign, v))
+}
+
+// ResumedEQ applies the EQ predicate on the "resumed" field.
+func ResumedEQ(v bool) predicate.Run {
+	return predicate.Run(sql.FieldEQ(FieldResumed, v))
+}
+
+// ResumedNEQ applies the NEQ predicate on the "resumed" field.
+func ResumedNEQ(v bool) predicate.Run {
+	return predicate.Run(sql.FieldNEQ(FieldResumed, v))
+}
+
+// OutcomeEQ applies the EQ predicate on the "outcome" field.
+func OutcomeEQ(v Outcome) predicate.Run {
+	return predicate.Run(sql.FieldEQ(FieldOutcome, v))
+}
+
+// OutcomeNEQ applies the NEQ predicate on the "outcome" field.
+func OutcomeNEQ(v Outcome) predicate.Run {
+	return predicate.Run(sql.FieldNEQ(FieldOutcome, v))
+}
+
+// OutcomeIn applies the In predicate on the "outcome" field.
+func OutcomeIn(vs ...Outcome) predicate.Run {
+	return predicate.Run(sql.FieldIn(FieldOutcome, vs...))
+}
+
+// OutcomeNotIn applies the NotIn predicate on the "outcome" field.
+func OutcomeNotIn(vs ...Outcome) predicate.Run {
+	return predicate.Run(sql.FieldNotIn(FieldOutcome, vs...))
+}
+
+// ScoreEQ applies the EQ predicate on the "score" field.
+func ScoreEQ(v int) predicate.Run {
+	return predicate.Run(sql.FieldEQ(FieldScore, v))
+}
+
+// ScoreNEQ applies the NEQ predicate on the "score" field.
+func ScoreNEQ(v int) predicate.Run {
+	return predicate.Run(sql.FieldNEQ(FieldScore, v))
+}
+
+// ScoreIn applies the In predicate on the "score" field.
+func ScoreIn(vs ...int) predicate.Run {
+	return predicate.Run(sql.FieldIn(FieldScore, vs...))
+}
+
+// ScoreNotIn applies the NotIn predicate on the "score" field.
+func ScoreNotIn(vs ...int) predicate.Run {
+	return predicate.Run(sql.FieldNotIn(FieldScore, vs...))
+}
+
+// ScoreGT applies the GT predicate on the "score" field.
+func ScoreGT(v int) predicate.Run {
+	return predicate.Run(sql.FieldGT(FieldScore, v))
+}
+
+// ScoreGTE applies the GTE predicate on the "score" field.
+func ScoreGTE(v int) predicate.Run {
+	return predicate.Run(sql.FieldGTE(FieldScore, v))
+}
+
+// ScoreLT applies the LT predicate on the "score" field.
+func ScoreLT(v int) predicate.Run {
+	return predicate.Run(sql.FieldLT(FieldScore, v))
+}
+
+// ScoreLTE applies the LTE predicate on the "score" field.
+func ScoreLTE(v int) predicate.Run {
+	return predicate.Run(sql.FieldLTE(FieldScore, v))
+}
+
+// ClearedLevelsEQ applies the EQ predicate on the "cleared_levels" field.
+func ClearedLevelsEQ(v int) predicate.Run {
+	return predicate.Run(sql.FieldEQ(FieldClearedLevels, v))
+}
+
+// ClearedLevelsNEQ applies the NEQ predicate on the "cleared_levels" field.
+func ClearedLevelsNEQ(v int) predicate.Run {
+	return predicate.Run(sql.FieldNEQ(FieldClearedLevels, v))
+}
+
+// ClearedLevelsIn applies the In predicate on the "cleared_levels" field.
+func ClearedLevelsIn(vs ...int) predicate.Run {
+	return predicate.Run(sql.FieldIn(FieldClearedLevels, vs...))
+}
+
+// ClearedLevelsNotIn applies the NotIn predicate on the "cleared_levels" field.
+func ClearedLevelsNotIn(vs ...int) predicate.Run {
+	return predicate.Run(sql.FieldNotIn(FieldClearedLevels, vs...))
+}
+
+// ClearedLevelsGT applies the GT predicate on the "cleared_levels" field.
+func ClearedLevelsGT(v int) predicate.Run {
+	return predicate.Run(sql.FieldGT(FieldClearedLevels, v))
+}
+
+// ClearedLevelsGTE applies the GTE predicate on the "cleared_levels" field.
+func ClearedLevelsGTE(v int) predicate.Run {
+	return predicate.Run(sql.FieldGTE(FieldClearedLevels, v))
+}
+
+// ClearedLevelsLT applies the LT predicate on the "cleared_levels" field.
+func ClearedLevelsLT(v int) predicate.Run {
+	return predicate.Run(sql.FieldLT(FieldClearedLevels, v))
+}
+
+// ClearedLevelsLTE applies the LTE predicate on the "cleared_levels" field.
+func ClearedLevelsLTE(v int) predicate.Run {
+	return predicate.Run(sql.FieldLTE(FieldClearedLevels, v))
+}
+
+// HasLevels applies the HasEdge predicate on the "levels" edge.
+func HasLevels() predicate.Run {
+	return predicate.Run(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, LevelsTable, LevelsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLevelsWith applies the HasEdge predicate on the "levels" edge with a given conditions (other predicates).
+func HasLevelsWith(preds ...predicate.LevelResult) predicate.Run {
+	return predicate.Run(func(s *sql.Selector) {
+		step := newLevelsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasProfile applies the HasEdge predicate on the "profile" edge.
+func HasProfile() predicate.Run {
+	return predicate.Run(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ProfileTable, ProfileColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasProfileWith applies the HasEdge predicate on the "profile" edge with a given conditions (other predicates).
+func HasProfileWith(preds ...predicate.Profile) predicate.Run {
+	return predicate.Run(func(s *sql.Selector) {
+		step := newProfileStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Run) predicate.Run {
+	return predicate.Run(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Run) predicate.Run {
+	return predicate.Run(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Run) predicate.Run {
+	return predicate.Run(sql.NotPredicates(p))
+}
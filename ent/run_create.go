@@ -0,0 +1,459 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"doomlike/ent/levelresult"
+	"doomlike/ent/profile"
+	"doomlike/ent/run"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// RunCreate is the builder for creating a Run entity.
+type RunCreate struct {
+	config
+	mutation *RunMutation
+	hooks    []Hook
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_c *RunCreate) SetStartedAt(v time.Time) *RunCreate {
+	_c.mutation.SetStartedAt(v)
+	return _c
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (_c *RunCreate) SetEndedAt(v time.Time) *RunCreate {
+	_c.mutation.SetEndedAt(v)
+	return _c
+}
+
+// SetNillableEndedAt sets the "ended_at" field if the given value is not nil.
+func (_c *RunCreate) SetNillableEndedAt(v *time.Time) *RunCreate {
+	if v != nil {
+		_c.SetEndedAt(*v)
+	}
+	return _c
+}
+
+// SetSeed sets the "seed" field.
+func (_c *RunCreate) SetSeed(v int64) *RunCreate {
+	_c.mutation.SetSeed(v)
+	return _c
+}
+
+// SetTotalLevels sets the "total_levels" field.
+func (_c *RunCreate) SetTotalLevels(v int) *RunCreate {
+	_c.mutation.SetTotalLevels(v)
+	return _c
+}
+
+// SetFireRate sets the "fire_rate" field.
+func (_c *RunCreate) SetFireRate(v float64) *RunCreate {
+	_c.mutation.SetFireRate(v)
+	return _c
+}
+
+// SetBulletSpeed sets the "bullet_speed" field.
+func (_c *RunCreate) SetBulletSpeed(v float64) *RunCreate {
+	_c.mutation.SetBulletSpeed(v)
+	return _c
+}
+
+// SetCampaign sets the "campaign" field.
+func (_c *RunCreate) SetCampaign(v string) *RunCreate {
+	_c.mutation.SetCampaign(v)
+	return _c
+}
+
+// SetNillableCampaign sets the "campaign" field if the given value is not nil.
+func (_c *RunCreate) SetNillableCampaign(v *string) *RunCreate {
+	if v != nil {
+		_c.SetCampaign(*v)
+	}
+	return _c
+}
+
+// SetResumed sets the "resumed" field.
+func (_c *RunCreate) SetResumed(v bool) *RunCreate {
+	_c.mutation.SetResumed(v)
+	return _c
+}
+
+// SetNillableResumed sets the "resumed" field if the given value is not nil.
+func (_c *RunCreate) SetNillableResumed(v *bool) *RunCreate {
+	if v != nil {
+		_c.SetResumed(*v)
+	}
+	return _c
+}
+
+// SetOutcome sets the "outcome" field.
+func (_c *RunCreate) SetOutcome(v run.Outcome) *RunCreate {
+	_c.mutation.SetOutcome(v)
+	return _c
+}
+
+// SetNillableOutcome sets the "outcome" field if the given value is not nil.
+func (_c *RunCreate) SetNillableOutcome(v *run.Outcome) *RunCreate {
+	if v != nil {
+		_c.SetOutcome(*v)
+	}
+	return _c
+}
+
+// SetScore sets the "score" field.
+func (_c *RunCreate) SetScore(v int) *RunCreate {
+	_c.mutation.SetScore(v)
+	return _c
+}
+
+// SetNillableScore sets the "score" field if the given value is not nil.
+func (_c *RunCreate) SetNillableScore(v *int) *RunCreate {
+	if v != nil {
+		_c.SetScore(*v)
+	}
+	return _c
+}
+
+// SetClearedLevels sets the "cleared_levels" field.
+func (_c *RunCreate) SetClearedLevels(v int) *RunCreate {
+	_c.mutation.SetClearedLevels(v)
+	return _c
+}
+
+// SetNillableClearedLevels sets the "cleared_levels" field if the given value is not nil.
+func (_c *RunCreate) SetNillableClearedLevels(v *int) *RunCreate {
+	if v != nil {
+		_c.SetClearedLevels(*v)
+	}
+	return _c
+}
+
+// AddLevelIDs adds the "levels" edge to the LevelResult entity by IDs.
+func (_c *RunCreate) AddLevelIDs(ids ...int) *RunCreate {
+	_c.mutation.AddLevelIDs(ids...)
+	return _c
+}
+
+// AddLevels adds the "levels" edges to the LevelResult entity.
+func (_c *RunCreate) AddLevels(v ...*LevelResult) *RunCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddLevelIDs(ids...)
+}
+
+// SetProfileID sets the "profile" edge to the Profile entity by ID.
+func (_c *RunCreate) SetProfileID(id int) *RunCreate {
+	_c.mutation.SetProfileID(id)
+	return _c
+}
+
+// SetNillableProfileID sets the "profile" edge to the Profile entity by ID if the given value is not nil.
+func (_c *RunCreate) SetNillableProfileID(id *int) *RunCreate {
+	if id != nil {
+		_c = _c.SetProfileID(*id)
+	}
+	return _c
+}
+
+// SetProfile sets the "profile" edge to the Profile entity.
+func (_c *RunCreate) SetProfile(v *Profile) *RunCreate {
+	return _c.SetProfileID(v.ID)
+}
+
+// Mutation returns the RunMutation object of the builder.
+func (_c *RunCreate) Mutation() *RunMutation {
+	return _c.mutation
+}
+
+// Save creates the Run in the database.
+func (_c *RunCreate) Save(ctx context.Context) (*Run, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *RunCreate) SaveX(ctx context.Context) *Run {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RunCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RunCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *RunCreate) defaults() {
+	if _, ok := _c.mutation.Campaign(); !ok {
+		v := run.DefaultCampaign
+		_c.mutation.SetCampaign(v)
+	}
+	if _, ok := _c.mutation.Resumed(); !ok {
+		v := run.DefaultResumed
+		_c.mutation.SetResumed(v)
+	}
+	if _, ok := _c.mutation.Outcome(); !ok {
+		v := run.DefaultOutcome
+		_c.mutation.SetOutcome(v)
+	}
+	if _, ok := _c.mutation.Score(); !ok {
+		v := run.DefaultScore
+		_c.mutation.SetScore(v)
+	}
+	if _, ok := _c.mutation.ClearedLevels(); !ok {
+		v := run.DefaultClearedLevels
+		_c.mutation.SetClearedLevels(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *RunCreate) check() error {
+	if _, ok := _c.mutation.StartedAt(); !ok {
+		return &ValidationError{Name: "started_at", err: errors.New(`ent: missing required field "Run.started_at"`)}
+	}
+	if _, ok := _c.mutation.Seed(); !ok {
+		return &ValidationError{Name: "seed", err: errors.New(`ent: missing required field "Run.seed"`)}
+	}
+	if _, ok := _c.mutation.TotalLevels(); !ok {
+		return &ValidationError{Name: "total_levels", err: errors.New(`ent: missing required field "Run.total_levels"`)}
+	}
+	if _, ok := _c.mutation.FireRate(); !ok {
+		return &ValidationError{Name: "fire_rate", err: errors.New(`ent: missing required field "Run.fire_rate"`)}
+	}
+	if _, ok := _c.mutation.BulletSpeed(); !ok {
+		return &ValidationError{Name: "bullet_speed", err: errors.New(`ent: missing required field "Run.bullet_speed"`)}
+	}
+	if _, ok := _c.mutation.Campaign(); !ok {
+		return &ValidationError{Name: "campaign", err: errors.New(`ent: missing required field "Run.campaign"`)}
+	}
+	if _, ok := _c.mutation.Resumed(); !ok {
+		return &ValidationError{Name: "resumed", err: errors.New(`ent: missing required field "Run.resumed"`)}
+	}
+	if _, ok := _c.mutation.Outcome(); !ok {
+		return &ValidationError{Name: "outcome", err: errors.New(`ent: missing required field "Run.outcome"`)}
+	}
+	if v, ok := _c.mutation.Outcome(); ok {
+		if err := run.OutcomeValidator(v); err != nil {
+			return &ValidationError{Name: "outcome", err: fmt.Errorf(`ent: validator failed for field "Run.outcome": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Score(); !ok {
+		return &ValidationError{Name: "score", err: errors.New(`ent: missing required field "Run.score"`)}
+	}
+	if _, ok := _c.mutation.ClearedLevels(); !ok {
+		return &ValidationError{Name: "cleared_levels", err: errors.New(`ent: missing required field "Run.cleared_levels"`)}
+	}
+	return nil
+}
+
+func (_c *RunCreate) sqlSave(ctx context.Context) (*Run, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *RunCreate) createSpec() (*Run, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Run{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(run.Table, sqlgraph.NewFieldSpec(run.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.StartedAt(); ok {
+		_spec.SetField(run.FieldStartedAt, field.TypeTime, value)
+		_node.StartedAt = value
+	}
+	if value, ok := _c.mutation.EndedAt(); ok {
+		_spec.SetField(run.FieldEndedAt, field.TypeTime, value)
+		_node.EndedAt = &value
+	}
+	if value, ok := _c.mutation.Seed(); ok {
+		_spec.SetField(run.FieldSeed, field.TypeInt64, value)
+		_node.Seed = value
+	}
+	if value, ok := _c.mutation.TotalLevels(); ok {
+		_spec.SetField(run.FieldTotalLevels, field.TypeInt, value)
+		_node.TotalLevels = value
+	}
+	if value, ok := _c.mutation.FireRate(); ok {
+		_spec.SetField(run.FieldFireRate, field.TypeFloat64, value)
+		_node.FireRate = value
+	}
+	if value, ok := _c.mutation.BulletSpeed(); ok {
+		_spec.SetField(run.FieldBulletSpeed, field.TypeFloat64, value)
+		_node.BulletSpeed = value
+	}
+	if value, ok := _c.mutation.Campaign(); ok {
+		_spec.SetField(run.FieldCampaign, field.TypeString, value)
+		_node.Campaign = value
+	}
+	if value, ok := _c.mutation.Resumed(); ok {
+		_spec.SetField(run.FieldResumed, field.TypeBool, value)
+		_node.Resumed = value
+	}
+	if value, ok := _c.mutation.Outcome(); ok {
+		_spec.SetField(run.FieldOutcome, field.TypeEnum, value)
+		_node.Outcome = value
+	}
+	if value, ok := _c.mutation.Score(); ok {
+		_spec.SetField(run.FieldScore, field.TypeInt, value)
+		_node.Score = value
+	}
+	if value, ok := _c.mutation.ClearedLevels(); ok {
+		_spec.SetField(run.FieldClearedLevels, field.TypeInt, value)
+		_node.ClearedLevels = value
+	}
+	if nodes := _c.mutation.LevelsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   run.LevelsTable,
+			Columns: []string{run.LevelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(levelresult.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   run.ProfileTable,
+			Columns: []string{run.ProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.profile_runs = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// RunCreateBulk is the builder for creating many Run entities in bulk.
+type RunCreateBulk struct {
+	config
+	err      error
+	builders []*RunCreate
+}
+
+// Save creates the Run entities in the database.
+func (_c *RunCreateBulk) Save(ctx context.Context) ([]*Run, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Run, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RunMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *RunCreateBulk) SaveX(ctx context.Context) []*Run {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RunCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RunCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
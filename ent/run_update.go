@@ -0,0 +1,986 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"doomlike/ent/levelresult"
+	"doomlike/ent/predicate"
+	"doomlike/ent/profile"
+	"doomlike/ent/run"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// RunUpdate is the builder for updating Run entities.
+type RunUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RunMutation
+}
+
+// Where appends a list predicates to the RunUpdate builder.
+func (_u *RunUpdate) Where(ps ...predicate.Run) *RunUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_u *RunUpdate) SetStartedAt(v time.Time) *RunUpdate {
+	_u.mutation.SetStartedAt(v)
+	return _u
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_u *RunUpdate) SetNillableStartedAt(v *time.Time) *RunUpdate {
+	if v != nil {
+		_u.SetStartedAt(*v)
+	}
+	return _u
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (_u *RunUpdate) SetEndedAt(v time.Time) *RunUpdate {
+	_u.mutation.SetEndedAt(v)
+	return _u
+}
+
+// SetNillableEndedAt sets the "ended_at" field if the given value is not nil.
+func (_u *RunUpdate) SetNillableEndedAt(v *time.Time) *RunUpdate {
+	if v != nil {
+		_u.SetEndedAt(*v)
+	}
+	return _u
+}
+
+// ClearEndedAt clears the value of the "ended_at" field.
+func (_u *RunUpdate) ClearEndedAt() *RunUpdate {
+	_u.mutation.ClearEndedAt()
+	return _u
+}
+
+// SetSeed sets the "seed" field.
+func (_u *RunUpdate) SetSeed(v int64) *RunUpdate {
+	_u.mutation.ResetSeed()
+	_u.mutation.SetSeed(v)
+	return _u
+}
+
+// SetNillableSeed sets the "seed" field if the given value is not nil.
+func (_u *RunUpdate) SetNillableSeed(v *int64) *RunUpdate {
+	if v != nil {
+		_u.SetSeed(*v)
+	}
+	return _u
+}
+
+// AddSeed adds value to the "seed" field.
+func (_u *RunUpdate) AddSeed(v int64) *RunUpdate {
+	_u.mutation.AddSeed(v)
+	return _u
+}
+
+// SetTotalLevels sets the "total_levels" field.
+func (_u *RunUpdate) SetTotalLevels(v int) *RunUpdate {
+	_u.mutation.ResetTotalLevels()
+	_u.mutation.SetTotalLevels(v)
+	return _u
+}
+
+// SetNillableTotalLevels sets the "total_levels" field if the given value is not nil.
+func (_u *RunUpdate) SetNillableTotalLevels(v *int) *RunUpdate {
+	if v != nil {
+		_u.SetTotalLevels(*v)
+	}
+	return _u
+}
+
+// AddTotalLevels adds value to the "total_levels" field.
+func (_u *RunUpdate) AddTotalLevels(v int) *RunUpdate {
+	_u.mutation.AddTotalLevels(v)
+	return _u
+}
+
+// SetFireRate sets the "fire_rate" field.
+func (_u *RunUpdate) SetFireRate(v float64) *RunUpdate {
+	_u.mutation.ResetFireRate()
+	_u.mutation.SetFireRate(v)
+	return _u
+}
+
+// SetNillableFireRate sets the "fire_rate" field if the given value is not nil.
+func (_u *RunUpdate) SetNillableFireRate(v *float64) *RunUpdate {
+	if v != nil {
+		_u.SetFireRate(*v)
+	}
+	return _u
+}
+
+// AddFireRate adds value to the "fire_rate" field.
+func (_u *RunUpdate) AddFireRate(v float64) *RunUpdate {
+	_u.mutation.AddFireRate(v)
+	return _u
+}
+
+// SetBulletSpeed sets the "bullet_speed" field.
+func (_u *RunUpdate) SetBulletSpeed(v float64) *RunUpdate {
+	_u.mutation.ResetBulletSpeed()
+	_u.mutation.SetBulletSpeed(v)
+	return _u
+}
+
+// SetNillableBulletSpeed sets the "bullet_speed" field if the given value is not nil.
+func (_u *RunUpdate) SetNillableBulletSpeed(v *float64) *RunUpdate {
+	if v != nil {
+		_u.SetBulletSpeed(*v)
+	}
+	return _u
+}
+
+// AddBulletSpeed adds value to the "bullet_speed" field.
+func (_u *RunUpdate) AddBulletSpeed(v float64) *RunUpdate {
+	_u.mutation.AddBulletSpeed(v)
+	return _u
+}
+
+// SetCampaign sets the "campaign" field.
+func (_u *RunUpdate) SetCampaign(v string) *RunUpdate {
+	_u.mutation.SetCampaign(v)
+	return _u
+}
+
+// SetNillableCampaign sets the "campaign" field if the given value is not nil.
+func (_u *RunUpdate) SetNillableCampaign(v *string) *RunUpdate {
+	if v != nil {
+		_u.SetCampaign(*v)
+	}
+	return _u
+}
+
+// SetResumed sets the "resumed" field.
+func (_u *RunUpdate) SetResumed(v bool) *RunUpdate {
+	_u.mutation.SetResumed(v)
+	return _u
+}
+
+// SetNillableResumed sets the "resumed" field if the given value is not nil.
+func (_u *RunUpdate) SetNillableResumed(v *bool) *RunUpdate {
+	if v != nil {
+		_u.SetResumed(*v)
+	}
+	return _u
+}
+
+// SetOutcome sets the "outcome" field.
+func (_u *RunUpdate) SetOutcome(v run.Outcome) *RunUpdate {
+	_u.mutation.SetOutcome(v)
+	return _u
+}
+
+// SetNillableOutcome sets the "outcome" field if the given value is not nil.
+func (_u *RunUpdate) SetNillableOutcome(v *run.Outcome) *RunUpdate {
+	if v != nil {
+		_u.SetOutcome(*v)
+	}
+	return _u
+}
+
+// SetScore sets the "score" field.
+func (_u *RunUpdate) SetScore(v int) *RunUpdate {
+	_u.mutation.ResetScore()
+	_u.mutation.SetScore(v)
+	return _u
+}
+
+// SetNillableScore sets the "score" field if the given value is not nil.
+func (_u *RunUpdate) SetNillableScore(v *int) *RunUpdate {
+	if v != nil {
+		_u.SetScore(*v)
+	}
+	return _u
+}
+
+// AddScore adds value to the "score" field.
+func (_u *RunUpdate) AddScore(v int) *RunUpdate {
+	_u.mutation.AddScore(v)
+	return _u
+}
+
+// SetClearedLevels sets the "cleared_levels" field.
+func (_u *RunUpdate) SetClearedLevels(v int) *RunUpdate {
+	_u.mutation.ResetClearedLevels()
+	_u.mutation.SetClearedLevels(v)
+	return _u
+}
+
+// SetNillableClearedLevels sets the "cleared_levels" field if the given value is not nil.
+func (_u *RunUpdate) SetNillableClearedLevels(v *int) *RunUpdate {
+	if v != nil {
+		_u.SetClearedLevels(*v)
+	}
+	return _u
+}
+
+// AddClearedLevels adds value to the "cleared_levels" field.
+func (_u *RunUpdate) AddClearedLevels(v int) *RunUpdate {
+	_u.mutation.AddClearedLevels(v)
+	return _u
+}
+
+// AddLevelIDs adds the "levels" edge to the LevelResult entity by IDs.
+func (_u *RunUpdate) AddLevelIDs(ids ...int) *RunUpdate {
+	_u.mutation.AddLevelIDs(ids...)
+	return _u
+}
+
+// AddLevels adds the "levels" edges to the LevelResult entity.
+func (_u *RunUpdate) AddLevels(v ...*LevelResult) *RunUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLevelIDs(ids...)
+}
+
+// SetProfileID sets the "profile" edge to the Profile entity by ID.
+func (_u *RunUpdate) SetProfileID(id int) *RunUpdate {
+	_u.mutation.SetProfileID(id)
+	return _u
+}
+
+// SetNillableProfileID sets the "profile" edge to the Profile entity by ID if the given value is not nil.
+func (_u *RunUpdate) SetNillableProfileID(id *int) *RunUpdate {
+	if id != nil {
+		_u = _u.SetProfileID(*id)
+	}
+	return _u
+}
+
+// SetProfile sets the "profile" edge to the Profile entity.
+func (_u *RunUpdate) SetProfile(v *Profile) *RunUpdate {
+	return _u.SetProfileID(v.ID)
+}
+
+// Mutation returns the RunMutation object of the builder.
+func (_u *RunUpdate) Mutation() *RunMutation {
+	return _u.mutation
+}
+
+// ClearLevels clears all "levels" edges to the LevelResult entity.
+func (_u *RunUpdate) ClearLevels() *RunUpdate {
+	_u.mutation.ClearLevels()
+	return _u
+}
+
+// RemoveLevelIDs removes the "levels" edge to LevelResult entities by IDs.
+func (_u *RunUpdate) RemoveLevelIDs(ids ...int) *RunUpdate {
+	_u.mutation.RemoveLevelIDs(ids...)
+	return _u
+}
+
+// RemoveLevels removes "levels" edges to LevelResult entities.
+func (_u *RunUpdate) RemoveLevels(v ...*LevelResult) *RunUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLevelIDs(ids...)
+}
+
+// ClearProfile clears the "profile" edge to the Profile entity.
+func (_u *RunUpdate) ClearProfile() *RunUpdate {
+	_u.mutation.ClearProfile()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *RunUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RunUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *RunUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RunUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RunUpdate) check() error {
+	if v, ok := _u.mutation.Outcome(); ok {
+		if err := run.OutcomeValidator(v); err != nil {
+			return &ValidationError{Name: "outcome", err: fmt.Errorf(`ent: validator failed for field "Run.outcome": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *RunUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(run.Table, run.Columns, sqlgraph.NewFieldSpec(run.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.StartedAt(); ok {
+		_spec.SetField(run.FieldStartedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.EndedAt(); ok {
+		_spec.SetField(run.FieldEndedAt, field.TypeTime, value)
+	}
+	if _u.mutation.EndedAtCleared() {
+		_spec.ClearField(run.FieldEndedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Seed(); ok {
+		_spec.SetField(run.FieldSeed, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSeed(); ok {
+		_spec.AddField(run.FieldSeed, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.TotalLevels(); ok {
+		_spec.SetField(run.FieldTotalLevels, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTotalLevels(); ok {
+		_spec.AddField(run.FieldTotalLevels, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.FireRate(); ok {
+		_spec.SetField(run.FieldFireRate, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedFireRate(); ok {
+		_spec.AddField(run.FieldFireRate, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.BulletSpeed(); ok {
+		_spec.SetField(run.FieldBulletSpeed, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedBulletSpeed(); ok {
+		_spec.AddField(run.FieldBulletSpeed, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Campaign(); ok {
+		_spec.SetField(run.FieldCampaign, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Resumed(); ok {
+		_spec.SetField(run.FieldResumed, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Outcome(); ok {
+		_spec.SetField(run.FieldOutcome, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Score(); ok {
+		_spec.SetField(run.FieldScore, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedScore(); ok {
+		_spec.AddField(run.FieldScore, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ClearedLevels(); ok {
+		_spec.SetField(run.FieldClearedLevels, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedClearedLevels(); ok {
+		_spec.AddField(run.FieldClearedLevels, field.TypeInt, value)
+	}
+	if _u.mutation.LevelsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   run.LevelsTable,
+			Columns: []string{run.LevelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(levelresult.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedLevelsIDs(); len(nodes) > 0 && !_u.mutation.LevelsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   run.LevelsTable,
+			Columns: []string{run.LevelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(levelresult.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LevelsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   run.LevelsTable,
+			Columns: []string{run.LevelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(levelresult.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ProfileCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   run.ProfileTable,
+			Columns: []string{run.ProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   run.ProfileTable,
+			Columns: []string{run.ProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{run.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// RunUpdateOne is the builder for updating a single Run entity.
+type RunUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RunMutation
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_u *RunUpdateOne) SetStartedAt(v time.Time) *RunUpdateOne {
+	_u.mutation.SetStartedAt(v)
+	return _u
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_u *RunUpdateOne) SetNillableStartedAt(v *time.Time) *RunUpdateOne {
+	if v != nil {
+		_u.SetStartedAt(*v)
+	}
+	return _u
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (_u *RunUpdateOne) SetEndedAt(v time.Time) *RunUpdateOne {
+	_u.mutation.SetEndedAt(v)
+	return _u
+}
+
+// SetNillableEndedAt sets the "ended_at" field if the given value is not nil.
+func (_u *RunUpdateOne) SetNillableEndedAt(v *time.Time) *RunUpdateOne {
+	if v != nil {
+		_u.SetEndedAt(*v)
+	}
+	return _u
+}
+
+// ClearEndedAt clears the value of the "ended_at" field.
+func (_u *RunUpdateOne) ClearEndedAt() *RunUpdateOne {
+	_u.mutation.ClearEndedAt()
+	return _u
+}
+
+// SetSeed sets the "seed" field.
+func (_u *RunUpdateOne) SetSeed(v int64) *RunUpdateOne {
+	_u.mutation.ResetSeed()
+	_u.mutation.SetSeed(v)
+	return _u
+}
+
+// SetNillableSeed sets the "seed" field if the given value is not nil.
+func (_u *RunUpdateOne) SetNillableSeed(v *int64) *RunUpdateOne {
+	if v != nil {
+		_u.SetSeed(*v)
+	}
+	return _u
+}
+
+// AddSeed adds value to the "seed" field.
+func (_u *RunUpdateOne) AddSeed(v int64) *RunUpdateOne {
+	_u.mutation.AddSeed(v)
+	return _u
+}
+
+// SetTotalLevels sets the "total_levels" field.
+func (_u *RunUpdateOne) SetTotalLevels(v int) *RunUpdateOne {
+	_u.mutation.ResetTotalLevels()
+	_u.mutation.SetTotalLevels(v)
+	return _u
+}
+
+// SetNillableTotalLevels sets the "total_levels" field if the given value is not nil.
+func (_u *RunUpdateOne) SetNillableTotalLevels(v *int) *RunUpdateOne {
+	if v != nil {
+		_u.SetTotalLevels(*v)
+	}
+	return _u
+}
+
+// AddTotalLevels adds value to the "total_levels" field.
+func (_u *RunUpdateOne) AddTotalLevels(v int) *RunUpdateOne {
+	_u.mutation.AddTotalLevels(v)
+	return _u
+}
+
+// SetFireRate sets the "fire_rate" field.
+func (_u *RunUpdateOne) SetFireRate(v float64) *RunUpdateOne {
+	_u.mutation.ResetFireRate()
+	_u.mutation.SetFireRate(v)
+	return _u
+}
+
+// SetNillableFireRate sets the "fire_rate" field if the given value is not nil.
+func (_u *RunUpdateOne) SetNillableFireRate(v *float64) *RunUpdateOne {
+	if v != nil {
+		_u.SetFireRate(*v)
+	}
+	return _u
+}
+
+// AddFireRate adds value to the "fire_rate" field.
+func (_u *RunUpdateOne) AddFireRate(v float64) *RunUpdateOne {
+	_u.mutation.AddFireRate(v)
+	return _u
+}
+
+// SetBulletSpeed sets the "bullet_speed" field.
+func (_u *RunUpdateOne) SetBulletSpeed(v float64) *RunUpdateOne {
+	_u.mutation.ResetBulletSpeed()
+	_u.mutation.SetBulletSpeed(v)
+	return _u
+}
+
+// SetNillableBulletSpeed sets the "bullet_speed" field if the given value is not nil.
+func (_u *RunUpdateOne) SetNillableBulletSpeed(v *float64) *RunUpdateOne {
+	if v != nil {
+		_u.SetBulletSpeed(*v)
+	}
+	return _u
+}
+
+// AddBulletSpeed adds value to the "bullet_speed" field.
+func (_u *RunUpdateOne) AddBulletSpeed(v float64) *RunUpdateOne {
+	_u.mutation.AddBulletSpeed(v)
+	return _u
+}
+
+// SetCampaign sets the "campaign" field.
+func (_u *RunUpdateOne) SetCampaign(v string) *RunUpdateOne {
+	_u.mutation.SetCampaign(v)
+	return _u
+}
+
+// SetNillableCampaign sets the "campaign" field if the given value is not nil.
+func (_u *RunUpdateOne) SetNillableCampaign(v *string) *RunUpdateOne {
+	if v != nil {
+		_u.SetCampaign(*v)
+	}
+	return _u
+}
+
+// SetResumed sets the "resumed" field.
+func (_u *RunUpdateOne) SetResumed(v bool) *RunUpdateOne {
+	_u.mutation.SetResumed(v)
+	return _u
+}
+
+// SetNillableResumed sets the "resumed" field if the given value is not nil.
+func (_u *RunUpdateOne) SetNillableResumed(v *bool) *RunUpdateOne {
+	if v != nil {
+		_u.SetResumed(*v)
+	}
+	return _u
+}
+
+// SetOutcome sets the "outcome" field.
+func (_u *RunUpdateOne) SetOutcome(v run.Outcome) *RunUpdateOne {
+	_u.mutation.SetOutcome(v)
+	return _u
+}
+
+// SetNillableOutcome sets the "outcome" field if the given value is not nil.
+func (_u *RunUpdateOne) SetNillableOutcome(v *run.Outcome) *RunUpdateOne {
+	if v != nil {
+		_u.SetOutcome(*v)
+	}
+	return _u
+}
+
+// SetScore sets the "score" field.
+func (_u *RunUpdateOne) SetScore(v int) *RunUpdateOne {
+	_u.mutation.ResetScore()
+	_u.mutation.SetScore(v)
+	return _u
+}
+
+// SetNillableScore sets the "score" field if the given value is not nil.
+func (_u *RunUpdateOne) SetNillableScore(v *int) *RunUpdateOne {
+	if v != nil {
+		_u.SetScore(*v)
+	}
+	return _u
+}
+
+// AddScore adds value to the "score" field.
+func (_u *RunUpdateOne) AddScore(v int) *RunUpdateOne {
+	_u.mutation.AddScore(v)
+	return _u
+}
+
+// SetClearedLevels sets the "cleared_levels" field.
+func (_u *RunUpdateOne) SetClearedLevels(v int) *RunUpdateOne {
+	_u.mutation.ResetClearedLevels()
+	_u.mutation.SetClearedLevels(v)
+	return _u
+}
+
+// SetNillableClearedLevels sets the "cleared_levels" field if the given value is not nil.
+func (_u *RunUpdateOne) SetNillableClearedLevels(v *int) *RunUpdateOne {
+	if v != nil {
+		_u.SetClearedLevels(*v)
+	}
+	return _u
+}
+
+// AddClearedLevels adds value to the "cleared_levels" field.
+func (_u *RunUpdateOne) AddClearedLevels(v int) *RunUpdateOne {
+	_u.mutation.AddClearedLevels(v)
+	return _u
+}
+
+// AddLevelIDs adds the "levels" edge to the LevelResult entity by IDs.
+func (_u *RunUpdateOne) AddLevelIDs(ids ...int) *RunUpdateOne {
+	_u.mutation.AddLevelIDs(ids...)
+	return _u
+}
+
+// AddLevels adds the "levels" edges to the LevelResult entity.
+func (_u *RunUpdateOne) AddLevels(v ...*LevelResult) *RunUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLevelIDs(ids...)
+}
+
+// SetProfileID sets the "profile" edge to the Profile entity by ID.
+func (_u *RunUpdateOne) SetProfileID(id int) *RunUpdateOne {
+	_u.mutation.SetProfileID(id)
+	return _u
+}
+
+// SetNillableProfileID sets the "profile" edge to the Profile entity by ID if the given value is not nil.
+func (_u *RunUpdateOne) SetNillableProfileID(id *int) *RunUpdateOne {
+	if id != nil {
+		_u = _u.SetProfileID(*id)
+	}
+	return _u
+}
+
+// SetProfile sets the "profile" edge to the Profile entity.
+func (_u *RunUpdateOne) SetProfile(v *Profile) *RunUpdateOne {
+	return _u.SetProfileID(v.ID)
+}
+
+// Mutation returns the RunMutation object of the builder.
+func (_u *RunUpdateOne) Mutation() *RunMutation {
+	return _u.mutation
+}
+
+// ClearLevels clears all "levels" edges to the LevelResult entity.
+func (_u *RunUpdateOne) ClearLevels() *RunUpdateOne {
+	_u.mutation.ClearLevels()
+	return _u
+}
+
+// RemoveLevelIDs removes the "levels" edge to LevelResult entities by IDs.
+func (_u *RunUpdateOne) RemoveLevelIDs(ids ...int) *RunUpdateOne {
+	_u.mutation.RemoveLevelIDs(ids...)
+	return _u
+}
+
+// RemoveLevels removes "levels" edges to LevelResult entities.
+func (_u *RunUpdateOne) RemoveLevels(v ...*LevelResult) *RunUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLevelIDs(ids...)
+}
+
+// ClearProfile clears the "profile" edge to the Profile entity.
+func (_u *RunUpdateOne) ClearProfile() *RunUpdateOne {
+	_u.mutation.ClearProfile()
+	return _u
+}
+
+// Where appends a list predicates to the RunUpdate builder.
+func (_u *RunUpdateOne) Where(ps ...predicate.Run) *RunUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *RunUpdateOne) Select(field string, fields ...string) *RunUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Run entity.
+func (_u *RunUpdateOne) Save(ctx context.Context) (*Run, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RunUpdateOne) SaveX(ctx context.Context) *Run {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *RunUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RunUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RunUpdateOne) check() error {
+	if v, ok := _u.mutation.Outcome(); ok {
+		if err := run.OutcomeValidator(v); err != nil {
+			return &ValidationError{Name: "outcome", err: fmt.Errorf(`ent: validator failed for field "Run.outcome": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *RunUpdateOne) sqlSave(ctx context.Context) (_node *Run, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(run.Table, run.Columns, sqlgraph.NewFieldSpec(run.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Run.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, run.FieldID)
+		for _, f := range fields {
+			if !run.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != run.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.StartedAt(); ok {
+		_spec.SetField(run.FieldStartedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.EndedAt(); ok {
+		_spec.SetField(run.FieldEndedAt, field.TypeTime, value)
+	}
+	if _u.mutation.EndedAtCleared() {
+		_spec.ClearField(run.FieldEndedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Seed(); ok {
+		_spec.SetField(run.FieldSeed, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSeed(); ok {
+		_spec.AddField(run.FieldSeed, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.TotalLevels(); ok {
+		_spec.SetField(run.FieldTotalLevels, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTotalLevels(); ok {
+		_spec.AddField(run.FieldTotalLevels, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.FireRate(); ok {
+		_spec.SetField(run.FieldFireRate, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedFireRate(); ok {
+		_spec.AddField(run.FieldFireRate, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.BulletSpeed(); ok {
+		_spec.SetField(run.FieldBulletSpeed, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedBulletSpeed(); ok {
+		_spec.AddField(run.FieldBulletSpeed, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Campaign(); ok {
+		_spec.SetField(run.FieldCampaign, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Resumed(); ok {
+		_spec.SetField(run.FieldResumed, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Outcome(); ok {
+		_spec.SetField(run.FieldOutcome, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Score(); ok {
+		_spec.SetField(run.FieldScore, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedScore(); ok {
+		_spec.AddField(run.FieldScore, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ClearedLevels(); ok {
+		_spec.SetField(run.FieldClearedLevels, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedClearedLevels(); ok {
+		_spec.AddField(run.FieldClearedLevels, field.TypeInt, value)
+	}
+	if _u.mutation.LevelsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   run.LevelsTable,
+			Columns: []string{run.LevelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(levelresult.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedLevelsIDs(); len(nodes) > 0 && !_u.mutation.LevelsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   run.LevelsTable,
+			Columns: []string{run.LevelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(levelresult.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LevelsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   run.LevelsTable,
+			Columns: []string{run.LevelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(levelresult.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ProfileCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   run.ProfileTable,
+			Columns: []string{run.ProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   run.ProfileTable,
+			Columns: []string{run.ProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Run{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{run.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
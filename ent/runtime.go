@@ -4,6 +4,11 @@ package ent
 
 import (
 	"doomlike/ent/gamesettings"
+	"doomlike/ent/highscore"
+	"doomlike/ent/levelresult"
+	"doomlike/ent/profile"
+	"doomlike/ent/run"
+	"doomlike/ent/saveslot"
 	"doomlike/ent/schema"
 )
 
@@ -25,8 +30,140 @@ func init() {
 	gamesettingsDescLevelCount := gamesettingsFields[3].Descriptor()
 	// gamesettings.DefaultLevelCount holds the default value on creation for the level_count field.
 	gamesettings.DefaultLevelCount = gamesettingsDescLevelCount.Default.(int)
+	// gamesettingsDescSeed is the schema descriptor for seed field.
+	gamesettingsDescSeed := gamesettingsFields[4].Descriptor()
+	// gamesettings.DefaultSeed holds the default value on creation for the seed field.
+	gamesettings.DefaultSeed = gamesettingsDescSeed.Default.(int64)
+	// gamesettingsDescMouseSensitivity is the schema descriptor for mouse_sensitivity field.
+	gamesettingsDescMouseSensitivity := gamesettingsFields[5].Descriptor()
+	// gamesettings.DefaultMouseSensitivity holds the default value on creation for the mouse_sensitivity field.
+	gamesettings.DefaultMouseSensitivity = gamesettingsDescMouseSensitivity.Default.(float64)
+	// gamesettingsDescInvertMouse is the schema descriptor for invert_mouse field.
+	gamesettingsDescInvertMouse := gamesettingsFields[6].Descriptor()
+	// gamesettings.DefaultInvertMouse holds the default value on creation for the invert_mouse field.
+	gamesettings.DefaultInvertMouse = gamesettingsDescInvertMouse.Default.(bool)
+	// gamesettingsDescTurnSpeed is the schema descriptor for turn_speed field.
+	gamesettingsDescTurnSpeed := gamesettingsFields[7].Descriptor()
+	// gamesettings.DefaultTurnSpeed holds the default value on creation for the turn_speed field.
+	gamesettings.DefaultTurnSpeed = gamesettingsDescTurnSpeed.Default.(float64)
+	// gamesettingsDescFov is the schema descriptor for fov field.
+	gamesettingsDescFov := gamesettingsFields[8].Descriptor()
+	// gamesettings.DefaultFov holds the default value on creation for the fov field.
+	gamesettings.DefaultFov = gamesettingsDescFov.Default.(float64)
+	// gamesettingsDescRenderWidth is the schema descriptor for render_width field.
+	gamesettingsDescRenderWidth := gamesettingsFields[9].Descriptor()
+	// gamesettings.DefaultRenderWidth holds the default value on creation for the render_width field.
+	gamesettings.DefaultRenderWidth = gamesettingsDescRenderWidth.Default.(int)
+	// gamesettingsDescRenderHeight is the schema descriptor for render_height field.
+	gamesettingsDescRenderHeight := gamesettingsFields[10].Descriptor()
+	// gamesettings.DefaultRenderHeight holds the default value on creation for the render_height field.
+	gamesettings.DefaultRenderHeight = gamesettingsDescRenderHeight.Default.(int)
+	// gamesettingsDescGamepadTurnSpeed is the schema descriptor for gamepad_turn_speed field.
+	gamesettingsDescGamepadTurnSpeed := gamesettingsFields[11].Descriptor()
+	// gamesettings.DefaultGamepadTurnSpeed holds the default value on creation for the gamepad_turn_speed field.
+	gamesettings.DefaultGamepadTurnSpeed = gamesettingsDescGamepadTurnSpeed.Default.(float64)
+	// gamesettingsDescGamepadDeadzone is the schema descriptor for gamepad_deadzone field.
+	gamesettingsDescGamepadDeadzone := gamesettingsFields[12].Descriptor()
+	// gamesettings.DefaultGamepadDeadzone holds the default value on creation for the gamepad_deadzone field.
+	gamesettings.DefaultGamepadDeadzone = gamesettingsDescGamepadDeadzone.Default.(float64)
+	// gamesettingsDescGamepadCurve is the schema descriptor for gamepad_curve field.
+	gamesettingsDescGamepadCurve := gamesettingsFields[13].Descriptor()
+	// gamesettings.DefaultGamepadCurve holds the default value on creation for the gamepad_curve field.
+	gamesettings.DefaultGamepadCurve = gamesettingsDescGamepadCurve.Default.(float64)
 	// gamesettingsDescID is the schema descriptor for id field.
 	gamesettingsDescID := gamesettingsFields[0].Descriptor()
 	// gamesettings.DefaultID holds the default value on creation for the id field.
 	gamesettings.DefaultID = gamesettingsDescID.Default.(string)
+	highscoreFields := schema.HighScore{}.Fields()
+	_ = highscoreFields
+	// highscoreDescName is the schema descriptor for name field.
+	highscoreDescName := highscoreFields[0].Descriptor()
+	// highscore.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	highscore.NameValidator = highscoreDescName.Validators[0].(func(string) error)
+	// highscoreDescWon is the schema descriptor for won field.
+	highscoreDescWon := highscoreFields[5].Descriptor()
+	// highscore.DefaultWon holds the default value on creation for the won field.
+	highscore.DefaultWon = highscoreDescWon.Default.(bool)
+	levelresultFields := schema.LevelResult{}.Fields()
+	_ = levelresultFields
+	// levelresultDescMapName is the schema descriptor for map_name field.
+	levelresultDescMapName := levelresultFields[1].Descriptor()
+	// levelresult.DefaultMapName holds the default value on creation for the map_name field.
+	levelresult.DefaultMapName = levelresultDescMapName.Default.(string)
+	// levelresultDescPar is the schema descriptor for par field.
+	levelresultDescPar := levelresultFields[11].Descriptor()
+	// levelresult.DefaultPar holds the default value on creation for the par field.
+	levelresult.DefaultPar = levelresultDescPar.Default.(float64)
+	profileFields := schema.Profile{}.Fields()
+	_ = profileFields
+	// profileDescName is the schema descriptor for name field.
+	profileDescName := profileFields[0].Descriptor()
+	// profile.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	profile.NameValidator = func() func(string) error {
+		validators := profileDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	runFields := schema.Run{}.Fields()
+	_ = runFields
+	// runDescCampaign is the schema descriptor for campaign field.
+	runDescCampaign := runFields[6].Descriptor()
+	// run.DefaultCampaign holds the default value on creation for the campaign field.
+	run.DefaultCampaign = runDescCampaign.Default.(string)
+	// runDescResumed is the schema descriptor for resumed field.
+	runDescResumed := runFields[7].Descriptor()
+	// run.DefaultResumed holds the default value on creation for the resumed field.
+	run.DefaultResumed = runDescResumed.Default.(bool)
+	// runDescScore is the schema descriptor for score field.
+	runDescScore := runFields[9].Descriptor()
+	// run.DefaultScore holds the default value on creation for the score field.
+	run.DefaultScore = runDescScore.Default.(int)
+	// runDescClearedLevels is the schema descriptor for cleared_levels field.
+	runDescClearedLevels := runFields[10].Descriptor()
+	// run.DefaultClearedLevels holds the default value on creation for the cleared_levels field.
+	run.DefaultClearedLevels = runDescClearedLevels.Default.(int)
+	saveslotFields := schema.SaveSlot{}.Fields()
+	_ = saveslotFields
+	// saveslotDescPlayTime is the schema descriptor for play_time field.
+	saveslotDescPlayTime := saveslotFields[4].Descriptor()
+	// saveslot.DefaultPlayTime holds the default value on creation for the play_time field.
+	saveslot.DefaultPlayTime = saveslotDescPlayTime.Default.(float64)
+	// saveslotDescDefeated is the schema descriptor for defeated field.
+	saveslotDescDefeated := saveslotFields[16].Descriptor()
+	// saveslot.DefaultDefeated holds the default value on creation for the defeated field.
+	saveslot.DefaultDefeated = saveslotDescDefeated.Default.(int)
+	// saveslotDescLevelEnemyTotal is the schema descriptor for level_enemy_total field.
+	saveslotDescLevelEnemyTotal := saveslotFields[17].Descriptor()
+	// saveslot.DefaultLevelEnemyTotal holds the default value on creation for the level_enemy_total field.
+	saveslot.DefaultLevelEnemyTotal = saveslotDescLevelEnemyTotal.Default.(int)
+	// saveslotDescLevelTime is the schema descriptor for level_time field.
+	saveslotDescLevelTime := saveslotFields[18].Descriptor()
+	// saveslot.DefaultLevelTime holds the default value on creation for the level_time field.
+	saveslot.DefaultLevelTime = saveslotDescLevelTime.Default.(float64)
+	// saveslotDescCompletion is the schema descriptor for completion field.
+	saveslotDescCompletion := saveslotFields[19].Descriptor()
+	// saveslot.DefaultCompletion holds the default value on creation for the completion field.
+	saveslot.DefaultCompletion = saveslotDescCompletion.Default.(int)
+	// saveslotDescCampaign is the schema descriptor for campaign field.
+	saveslotDescCampaign := saveslotFields[23].Descriptor()
+	// saveslot.DefaultCampaign holds the default value on creation for the campaign field.
+	saveslot.DefaultCampaign = saveslotDescCampaign.Default.(string)
+	// saveslotDescMapName is the schema descriptor for map_name field.
+	saveslotDescMapName := saveslotFields[24].Descriptor()
+	// saveslot.DefaultMapName holds the default value on creation for the map_name field.
+	saveslot.DefaultMapName = saveslotDescMapName.Default.(string)
+	// saveslotDescPar is the schema descriptor for par field.
+	saveslotDescPar := saveslotFields[25].Descriptor()
+	// saveslot.DefaultPar holds the default value on creation for the par field.
+	saveslot.DefaultPar = saveslotDescPar.Default.(float64)
 }
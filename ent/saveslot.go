@@ -0,0 +1,475 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"doomlike/ent/profile"
+	"doomlike/ent/saveslot"
+	"doomlike/internal/sim"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// SaveSlot is the model entity for the SaveSlot schema.
+type SaveSlot struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Save slot number shown in the menus (1-based)
+	Slot int `json:"slot,omitempty"`
+	// Level the run was on when saved
+	Level int `json:"level,omitempty"`
+	// Number of levels in the run
+	TotalLevels int `json:"total_levels,omitempty"`
+	// Run seed the levels are generated from
+	Seed int64 `json:"seed,omitempty"`
+	// Seconds of play simulated in the run
+	PlayTime float64 `json:"play_time,omitempty"`
+	// Width of the level grid in tiles
+	MapWidth int `json:"map_width,omitempty"`
+	// Height of the level grid in tiles
+	MapHeight int `json:"map_height,omitempty"`
+	// Level tiles, one byte per tile in row-major order
+	Grid []byte `json:"grid,omitempty"`
+	// Player position, facing and stats
+	Player sim.Player `json:"player,omitempty"`
+	// Every enemy on the level, alive or dead
+	Enemies []sim.Enemy `json:"enemies,omitempty"`
+	// Enemy definition names indexed by the enemies' types, so a save survives definitions changing
+	EnemyTypes []string `json:"enemy_types,omitempty"`
+	// Every pickup on the level, taken or not
+	Pickups []sim.Pickup `json:"pickups,omitempty"`
+	// Projectiles in flight
+	Projectiles []sim.Projectile `json:"projectiles,omitempty"`
+	// Every door on the level with its key and how far open it is
+	Doors []sim.Door `json:"doors,omitempty"`
+	// Secret tiles on the level and whether each was found
+	Secrets []sim.Secret `json:"secrets,omitempty"`
+	// Explosive barrels on the level, standing, lit or blown up
+	Barrels []sim.Barrel `json:"barrels,omitempty"`
+	// Enemies defeated this run
+	Defeated int `json:"defeated,omitempty"`
+	// Enemies the level started with
+	LevelEnemyTotal int `json:"level_enemy_total,omitempty"`
+	// Seconds spent on the current level
+	LevelTime float64 `json:"level_time,omitempty"`
+	// Completion rule of the current level (exit, kills or both)
+	Completion int `json:"completion,omitempty"`
+	// Fire rate in effect for the run
+	FireRate float64 `json:"fire_rate,omitempty"`
+	// Bullet speed in effect for the run
+	BulletSpeed float64 `json:"bullet_speed,omitempty"`
+	// State of the gameplay random generator, bit-cast from its uint64 since SQLite integers are signed
+	RngState int64 `json:"rng_state,omitempty"`
+	// Campaign manifest the run was started from, empty for procedural runs
+	Campaign string `json:"campaign,omitempty"`
+	// Name of the authored map being played, if any
+	MapName string `json:"map_name,omitempty"`
+	// Par time of the authored map in seconds
+	Par float64 `json:"par,omitempty"`
+	// PNG thumbnail of the frame when the game was saved
+	Thumbnail []byte `json:"thumbnail,omitempty"`
+	// When this slot was first written
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// When this slot was last written
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SaveSlotQuery when eager-loading is set.
+	Edges         SaveSlotEdges `json:"edges"`
+	profile_saves *int
+	selectValues  sql.SelectValues
+}
+
+// SaveSlotEdges holds the relations/edges for other nodes in the graph.
+type SaveSlotEdges struct {
+	// Profile the run was saved by
+	Profile *Profile `json:"profile,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ProfileOrErr returns the Profile value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SaveSlotEdges) ProfileOrErr() (*Profile, error) {
+	if e.Profile != nil {
+		return e.Profile, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: profile.Label}
+	}
+	return nil, &NotLoadedError{edge: "profile"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SaveSlot) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case saveslot.FieldGrid, saveslot.FieldPlayer, saveslot.FieldEnemies, saveslot.FieldEnemyTypes, saveslot.FieldPickups, saveslot.FieldProjectiles, saveslot.FieldDoors, saveslot.FieldSecrets, saveslot.FieldBarrels, saveslot.FieldThumbnail:
+			values[i] = new([]byte)
+		case saveslot.FieldPlayTime, saveslot.FieldLevelTime, saveslot.FieldFireRate, saveslot.FieldBulletSpeed, saveslot.FieldPar:
+			values[i] = new(sql.NullFloat64)
+		case saveslot.FieldID, saveslot.FieldSlot, saveslot.FieldLevel, saveslot.FieldTotalLevels, saveslot.FieldSeed, saveslot.FieldMapWidth, saveslot.FieldMapHeight, saveslot.FieldDefeated, saveslot.FieldLevelEnemyTotal, saveslot.FieldCompletion, saveslot.FieldRngState:
+			values[i] = new(sql.NullInt64)
+		case saveslot.FieldCampaign, saveslot.FieldMapName:
+			values[i] = new(sql.NullString)
+		case saveslot.FieldCreatedAt, saveslot.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case saveslot.ForeignKeys[0]: // profile_saves
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SaveSlot fields.
+func (_m *SaveSlot) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case saveslot.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case saveslot.FieldSlot:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field slot", values[i])
+			} else if value.Valid {
+				_m.Slot = int(value.Int64)
+			}
+		case saveslot.FieldLevel:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field level", values[i])
+			} else if value.Valid {
+				_m.Level = int(value.Int64)
+			}
+		case saveslot.FieldTotalLevels:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field total_levels", values[i])
+			} else if value.Valid {
+				_m.TotalLevels = int(value.Int64)
+			}
+		case saveslot.FieldSeed:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field seed", values[i])
+			} else if value.Valid {
+				_m.Seed = value.Int64
+			}
+		case saveslot.FieldPlayTime:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field play_time", values[i])
+			} else if value.Valid {
+				_m.PlayTime = value.Float64
+			}
+		case saveslot.FieldMapWidth:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field map_width", values[i])
+			} else if value.Valid {
+				_m.MapWidth = int(value.Int64)
+			}
+		case saveslot.FieldMapHeight:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field map_height", values[i])
+			} else if value.Valid {
+				_m.MapHeight = int(value.Int64)
+			}
+		case saveslot.FieldGrid:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field grid", values[i])
+			} else if value != nil {
+				_m.Grid = *value
+			}
+		case saveslot.FieldPlayer:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field player", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Player); err != nil {
+					return fmt.Errorf("unmarshal field player: %w", err)
+				}
+			}
+		case saveslot.FieldEnemies:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field enemies", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Enemies); err != nil {
+					return fmt.Errorf("unmarshal field enemies: %w", err)
+				}
+			}
+		case saveslot.FieldEnemyTypes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field enemy_types", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.EnemyTypes); err != nil {
+					return fmt.Errorf("unmarshal field enemy_types: %w", err)
+				}
+			}
+		case saveslot.FieldPickups:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field pickups", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Pickups); err != nil {
+					return fmt.Errorf("unmarshal field pickups: %w", err)
+				}
+			}
+		case saveslot.FieldProjectiles:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field projectiles", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Projectiles); err != nil {
+					return fmt.Errorf("unmarshal field projectiles: %w", err)
+				}
+			}
+		case saveslot.FieldDoors:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field doors", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Doors); err != nil {
+					return fmt.Errorf("unmarshal field doors: %w", err)
+				}
+			}
+		case saveslot.FieldSecrets:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field secrets", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Secrets); err != nil {
+					return fmt.Errorf("unmarshal field secrets: %w", err)
+				}
+			}
+		case saveslot.FieldBarrels:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field barrels", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Barrels); err != nil {
+					return fmt.Errorf("unmarshal field barrels: %w", err)
+				}
+			}
+		case saveslot.FieldDefeated:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field defeated", values[i])
+			} else if value.Valid {
+				_m.Defeated = int(value.Int64)
+			}
+		case saveslot.FieldLevelEnemyTotal:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field level_enemy_total", values[i])
+			} else if value.Valid {
+				_m.LevelEnemyTotal = int(value.Int64)
+			}
+		case saveslot.FieldLevelTime:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field level_time", values[i])
+			} else if value.Valid {
+				_m.LevelTime = value.Float64
+			}
+		case saveslot.FieldCompletion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field completion", values[i])
+			} else if value.Valid {
+				_m.Completion = int(value.Int64)
+			}
+		case saveslot.FieldFireRate:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field fire_rate", values[i])
+			} else if value.Valid {
+				_m.FireRate = value.Float64
+			}
+		case saveslot.FieldBulletSpeed:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field bullet_speed", values[i])
+			} else if value.Valid {
+				_m.BulletSpeed = value.Float64
+			}
+		case saveslot.FieldRngState:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field rng_state", values[i])
+			} else if value.Valid {
+				_m.RngState = value.Int64
+			}
+		case saveslot.FieldCampaign:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field campaign", values[i])
+			} else if value.Valid {
+				_m.Campaign = value.String
+			}
+		case saveslot.FieldMapName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field map_name", values[i])
+			} else if value.Valid {
+				_m.MapName = value.String
+			}
+		case saveslot.FieldPar:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field par", values[i])
+			} else if value.Valid {
+				_m.Par = value.Float64
+			}
+		case saveslot.FieldThumbnail:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field thumbnail", values[i])
+			} else if value != nil {
+				_m.Thumbnail = *value
+			}
+		case saveslot.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case saveslot.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case saveslot.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field profile_saves", value)
+			} else if value.Valid {
+				_m.profile_saves = new(int)
+				*_m.profile_saves = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SaveSlot.
+// This includes values selected through modifiers, order, etc.
+func (_m *SaveSlot) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryProfile queries the "profile" edge of the SaveSlot entity.
+func (_m *SaveSlot) QueryProfile() *ProfileQuery {
+	return NewSaveSlotClient(_m.config).QueryProfile(_m)
+}
+
+// Update returns a builder for updating this SaveSlot.
+// Note that you need to call SaveSlot.Unwrap() before calling this method if this SaveSlot
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *SaveSlot) Update() *SaveSlotUpdateOne {
+	return NewSaveSlotClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the SaveSlot entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *SaveSlot) Unwrap() *SaveSlot {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SaveSlot is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *SaveSlot) String() string {
+	var builder strings.Builder
+	builder.WriteString("SaveSlot(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("slot=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Slot))
+	builder.WriteString(", ")
+	builder.WriteString("level=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Level))
+	builder.WriteString(", ")
+	builder.WriteString("total_levels=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TotalLevels))
+	builder.WriteString(", ")
+	builder.WriteString("seed=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Seed))
+	builder.WriteString(", ")
+	builder.WriteString("play_time=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PlayTime))
+	builder.WriteString(", ")
+	builder.WriteString("map_width=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MapWidth))
+	builder.WriteString(", ")
+	builder.WriteString("map_height=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MapHeight))
+	builder.WriteString(", ")
+	builder.WriteString("grid=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Grid))
+	builder.WriteString(", ")
+	builder.WriteString("player=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Player))
+	builder.WriteString(", ")
+	builder.WriteString("enemies=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Enemies))
+	builder.WriteString(", ")
+	builder.WriteString("enemy_types=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EnemyTypes))
+	builder.WriteString(", ")
+	builder.WriteString("pickups=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Pickups))
+	builder.WriteString(", ")
+	builder.WriteString("projectiles=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Projectiles))
+	builder.WriteString(", ")
+	builder.WriteString("doors=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Doors))
+	builder.WriteString(", ")
+	builder.WriteString("secrets=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Secrets))
+	builder.WriteString(", ")
+	builder.WriteString("barrels=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Barrels))
+	builder.WriteString(", ")
+	builder.WriteString("defeated=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Defeated))
+	builder.WriteString(", ")
+	builder.WriteString("level_enemy_total=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LevelEnemyTotal))
+	builder.WriteString(", ")
+	builder.WriteString("level_time=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LevelTime))
+	builder.WriteString(", ")
+	builder.WriteString("completion=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Completion))
+	builder.WriteString(", ")
+	builder.WriteString("fire_rate=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FireRate))
+	builder.WriteString(", ")
+	builder.WriteString("bullet_speed=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BulletSpeed))
+	builder.WriteString(", ")
+	builder.WriteString("rng_state=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RngState))
+	builder.WriteString(", ")
+	builder.WriteString("campaign=")
+	builder.WriteString(_m.Campaign)
+	builder.WriteString(", ")
+	builder.WriteString("map_name=")
+	builder.WriteString(_m.MapName)
+	builder.WriteString(", ")
+	builder.WriteString("par=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Par))
+	builder.WriteString(", ")
+	builder.WriteString("thumbnail=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Thumbnail))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SaveSlots is a parsable slice of SaveSlot.
+type SaveSlots []*SaveSlot
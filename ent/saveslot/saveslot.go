@@ -0,0 +1,275 @@
+// Code generated by ent, DO NOT EDIT.
+
+package saveslot
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the saveslot type in the database.
+	Label = "save_slot"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldSlot holds the string denoting the slot field in the database.
+	FieldSlot = "slot"
+	// FieldLevel holds the string denoting the level field in the database.
+	FieldLevel = "level"
+	// FieldTotalLevels holds the string denoting the total_levels field in the database.
+	FieldTotalLevels = "total_levels"
+	// FieldSeed holds the string denoting the seed field in the database.
+	FieldSeed = "seed"
+	// FieldPlayTime holds the string denoting the play_time field in the database.
+	FieldPlayTime = "play_time"
+	// FieldMapWidth holds the string denoting the map_width field in the database.
+	FieldMapWidth = "map_width"
+	// FieldMapHeight holds the string denoting the map_height field in the database.
+	FieldMapHeight = "map_height"
+	// FieldGrid holds the string denoting the grid field in the database.
+	FieldGrid = "grid"
+	// FieldPlayer holds the string denoting the player field in the database.
+	FieldPlayer = "player"
+	// FieldEnemies holds the string denoting the enemies field in the database.
+	FieldEnemies = "enemies"
+	// FieldEnemyTypes holds the string denoting the enemy_types field in the database.
+	FieldEnemyTypes = "enemy_types"
+	// FieldPickups holds the string denoting the pickups field in the database.
+	FieldPickups = "pickups"
+	// FieldProjectiles holds the string denoting the projectiles field in the database.
+	FieldProjectiles = "projectiles"
+	// FieldDoors holds the string denoting the doors field in the database.
+	FieldDoors = "doors"
+	// FieldSecrets holds the string denoting the secrets field in the database.
+	FieldSecrets = "secrets"
+	// FieldBarrels holds the string denoting the barrels field in the database.
+	FieldBarrels = "barrels"
+	// FieldDefeated holds the string denoting the defeated field in the database.
+	FieldDefeated = "defeated"
+	// FieldLevelEnemyTotal holds the string denoting the level_enemy_total field in the database.
+	FieldLevelEnemyTotal = "level_enemy_total"
+	// FieldLevelTime holds the string denoting the level_time field in the database.
+	FieldLevelTime = "level_time"
+	// FieldCompletion holds the string denoting the completion field in the database.
+	FieldCompletion = "completion"
+	// FieldFireRate holds the string denoting the fire_rate field in the database.
+	FieldFireRate = "fire_rate"
+	// FieldBulletSpeed holds the string denoting the bullet_speed field in the database.
+	FieldBulletSpeed = "bullet_speed"
+	// FieldRngState holds the string denoting the rng_state field in the database.
+	FieldRngState = "rng_state"
+	// FieldCampaign holds the string denoting the campaign field in the database.
+	FieldCampaign = "campaign"
+	// FieldMapName holds the string denoting the map_name field in the database.
+	FieldMapName = "map_name"
+	// FieldPar holds the string denoting the par field in the database.
+	FieldPar = "par"
+	// FieldThumbnail holds the string denoting the thumbnail field in the database.
+	FieldThumbnail = "thumbnail"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeProfile holds the string denoting the profile edge name in mutations.
+	EdgeProfile = "profile"
+	// Table holds the table name of the saveslot in the database.
+	Table = "save_slots"
+	// ProfileTable is the table that holds the profile relation/edge.
+	ProfileTable = "save_slots"
+	// ProfileInverseTable is the table name for the Profile entity.
+	// It exists in this package in order to avoid circular dependency with the "profile" package.
+	ProfileInverseTable = "profiles"
+	// ProfileColumn is the table column denoting the profile relation/edge.
+	ProfileColumn = "profile_saves"
+)
+
+// Columns holds all SQL columns for saveslot fields.
+var Columns = []string{
+	FieldID,
+	FieldSlot,
+	FieldLevel,
+	FieldTotalLevels,
+	FieldSeed,
+	FieldPlayTime,
+	FieldMapWidth,
+	FieldMapHeight,
+	FieldGrid,
+	FieldPlayer,
+	FieldEnemies,
+	FieldEnemyTypes,
+	FieldPickups,
+	FieldProjectiles,
+	FieldDoors,
+	FieldSecrets,
+	FieldBarrels,
+	FieldDefeated,
+	FieldLevelEnemyTotal,
+	FieldLevelTime,
+	FieldCompletion,
+	FieldFireRate,
+	FieldBulletSpeed,
+	FieldRngState,
+	FieldCampaign,
+	FieldMapName,
+	FieldPar,
+	FieldThumbnail,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "save_slots"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"profile_saves",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultPlayTime holds the default value on creation for the "play_time" field.
+	DefaultPlayTime float64
+	// DefaultDefeated holds the default value on creation for the "defeated" field.
+	DefaultDefeated int
+	// DefaultLevelEnemyTotal holds the default value on creation for the "level_enemy_total" field.
+	DefaultLevelEnemyTotal int
+	// DefaultLevelTime holds the default value on creation for the "level_time" field.
+	DefaultLevelTime float64
+	// DefaultCompletion holds the default value on creation for the "completion" field.
+	DefaultCompletion int
+	// DefaultCampaign holds the default value on creation for the "campaign" field.
+	DefaultCampaign string
+	// DefaultMapName holds the default value on creation for the "map_name" field.
+	DefaultMapName string
+	// DefaultPar holds the default value on creation for the "par" field.
+	DefaultPar float64
+)
+
+// OrderOption defines the ordering options for the SaveSlot queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// BySlot orders the results by the slot field.
+func BySlot(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSlot, opts...).ToFunc()
+}
+
+// ByLevel orders the results by the level field.
+func ByLevel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLevel, opts...).ToFunc()
+}
+
+// ByTotalLevels orders the results by the total_levels field.
+func ByTotalLevels(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotalLevels, opts...).ToFunc()
+}
+
+// BySeed orders the results by the seed field.
+func BySeed(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSeed, opts...).ToFunc()
+}
+
+// ByPlayTime orders the results by the play_time field.
+func ByPlayTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPlayTime, opts...).ToFunc()
+}
+
+// ByMapWidth orders the results by the map_width field.
+func ByMapWidth(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMapWidth, opts...).ToFunc()
+}
+
+// ByMapHeight orders the results by the map_height field.
+func ByMapHeight(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMapHeight, opts...).ToFunc()
+}
+
+// ByDefeated orders the results by the defeated field.
+func ByDefeated(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDefeated, opts...).ToFunc()
+}
+
+// ByLevelEnemyTotal orders the results by the level_enemy_total field.
+func ByLevelEnemyTotal(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLevelEnemyTotal, opts...).ToFunc()
+}
+
+// ByLevelTime orders the results by the level_time field.
+func ByLevelTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLevelTime, opts...).ToFunc()
+}
+
+// ByCompletion orders the results by the completion field.
+func ByCompletion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCompletion, opts...).ToFunc()
+}
+
+// ByFireRate orders the results by the fire_rate field.
+func ByFireRate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFireRate, opts...).ToFunc()
+}
+
+// ByBulletSpeed orders the results by the bullet_speed field.
+func ByBulletSpeed(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBulletSpeed, opts...).ToFunc()
+}
+
+// ByRngState orders the results by the rng_state field.
+func ByRngState(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRngState, opts...).ToFunc()
+}
+
+// ByCampaign orders the results by the campaign field.
+func ByCampaign(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCampaign, opts...).ToFunc()
+}
+
+// ByMapName orders the results by the map_name field.
+func ByMapName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMapName, opts...).ToFunc()
+}
+
+// ByPar orders the results by the par field.
+func ByPar(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPar, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByProfileField orders the results by profile field.
+func ByProfileField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newProfileStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newProfileStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ProfileInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ProfileTable, ProfileColumn),
+	)
+}
@@ -0,0 +1,1159 @@
+// Code generated by ent, DO NOT EDIT.
+
+package saveslot
+
+import (
+	"doomlike/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldLTE(FieldID, id))
+}
+
+// Slot applies equality check predicate on the "slot" field. It's identical to SlotEQ.
+func Slot(v int) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldEQ(FieldSlot, v))
+}
+
+// Level applies equality check predicate on the "level" field. It's identical to LevelEQ.
+func Level(v int) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldEQ(FieldLevel, v))
+}
+
+// TotalLevels applies equality check predicate on the "total_levels" field. It's identical to TotalLevelsEQ.
+func TotalLevels(v int) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldEQ(FieldTotalLevels, v))
+}
+
+// Seed applies equality check predicate on the "seed" field. It's identical to SeedEQ.
+func Seed(v int64) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldEQ(FieldSeed, v))
+}
+
+// PlayTime applies equality check predicate on the "play_time" field. It's identical to PlayTimeEQ.
+func PlayTime(v float64) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldEQ(FieldPlayTime, v))
+}
+
+// MapWidth applies equality check predicate on the "map_width" field. It's identical to MapWidthEQ.
+func MapWidth(v int) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldEQ(FieldMapWidth, v))
+}
+
+// MapHeight applies equality check predicate on the "map_height" field. It's identical to MapHeightEQ.
+func MapHeight(v int) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldEQ(FieldMapHeight, v))
+}
+
+// Grid applies equality check predicate on the "grid" field. It's identical to GridEQ.
+func Grid(v []byte) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldEQ(FieldGrid, v))
+}
+
+// Defeated applies equality check predicate on the "defeated" field. It's identical to DefeatedEQ.
+func Defeated(v int) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldEQ(FieldDefeated, v))
+}
+
+// LevelEnemyTotal applies equality check predicate on the "level_enemy_total" field. It's identical to LevelEnemyTotalEQ.
+func LevelEnemyTotal(v int) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldEQ(FieldLevelEnemyTotal, v))
+}
+
+// LevelTime applies equality check predicate on the "level_time" field. It's identical to LevelTimeEQ.
+func LevelTime(v float64) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldEQ(FieldLevelTime, v))
+}
+
+// Completion applies equality check predicate on the "completion" field. It's identical to CompletionEQ.
+func Completion(v int) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldEQ(FieldCompletion, v))
+}
+
+// FireRate applies equality check predicate on the "fire_rate" field. It's identical to FireRateEQ.
+func FireRate(v float64) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldEQ(FieldFireRate, v))
+}
+
+// BulletSpeed applies equality check predicate on the "bullet_speed" field. It's identical to BulletSpeedEQ.
+func BulletSpeed(v float64) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldEQ(FieldBulletSpeed, v))
+}
+
+// RngState applies equality check predicate on the "rng_state" field. It's identical to RngStateEQ.
+func RngState(v int64) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldEQ(FieldRngState, v))
+}
+
+// Campaign applies equality check predicate on the "campaign" field. It's identical to CampaignEQ.
+func Campaign(v string) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldEQ(FieldCampaign, v))
+}
+
+// MapName applies equality check predicate on the "map_name" field. It's identical to MapNameEQ.
+func MapName(v string) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldEQ(FieldMapName, v))
+}
+
+// Par applies equality check predicate on the "par" field. It's identical to ParEQ.
+func Par(v float64) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldEQ(FieldPar, v))
+}
+
+// Thumbnail applies equality check predicate on the "thumbnail" field. It's identical to ThumbnailEQ.
+func Thumbnail(v []byte) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldEQ(FieldThumbnail, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// SlotEQ applies the EQ predicate on the "slot" field.
+func SlotEQ(v int) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldEQ(FieldSlot, v))
+}
+
+// SlotNEQ applies the NEQ predicate on the "slot" field.
+func SlotNEQ(v int) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldNEQ(FieldSlot, v))
+}
+
+// SlotIn applies the In predicate on the "slot" field.
+func SlotIn(vs ...int) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldIn(FieldSlot, vs...))
+}
+
+// SlotNotIn applies the NotIn predicate on the "slot" field.
+func SlotNotIn(vs ...int) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldNotIn(FieldSlot, vs...))
+}
+
+// SlotGT applies the GT predicate on the "slot" field.
+func SlotGT(v int) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldGT(FieldSlot, v))
+}
+
+// SlotGTE applies the GTE predicate on the "slot" field.
+func SlotGTE(v int) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldGTE(FieldSlot, v))
+}
+
+// SlotLT applies the LT predicate on the "slot" field.
+func SlotLT(v int) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldLT(FieldSlot, v))
+}
+
+// SlotLTE applies the LTE predicate on the "slot" field.
+func SlotLTE(v int) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldLTE(FieldSlot, v))
+}
+
+// LevelEQ applies the EQ predicate on the "level" field.
+func LevelEQ(v int) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldEQ(FieldLevel, v))
+}
+
+// LevelNEQ applies the NEQ predicate on the "level" field.
+func LevelNEQ(v int) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldNEQ(FieldLevel, v))
+}
+
+// LevelIn applies the In predicate on the "level" field.
+func LevelIn(vs ...int) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldIn(FieldLevel, vs...))
+}
+
+// LevelNotIn applies the NotIn predicate on the "level" field.
+func LevelNotIn(vs ...int) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldNotIn(FieldLevel, vs...))
+}
+
+// LevelGT applies the GT predicate on the "level" field.
+func LevelGT(v int) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldGT(FieldLevel, v))
+}
+
+// LevelGTE applies the GTE predicate on the "level" field.
+func LevelGTE(v int) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldGTE(FieldLevel, v))
+}
+
+// LevelLT applies the LT predicate on the "level" field.
+func LevelLT(v int) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldLT(FieldLevel, v))
+}
+
+// LevelLTE applies the LTE predicate on the "level" field.
+func LevelLTE(v int) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldLTE(FieldLevel, v))
+}
+
+// TotalLevelsEQ applies the EQ predicate on the "total_levels" field.
+func TotalLevelsEQ(v int) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldEQ(FieldTotalLevels, v))
+}
+
+// TotalLevelsNEQ applies the NEQ predicate on the "total_levels" field.
+func TotalLevelsNEQ(v int) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldNEQ(FieldTotalLevels, v))
+}
+
+// TotalLevelsIn applies the In predicate on the "total_levels" field.
+func TotalLevelsIn(vs ...int) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldIn(FieldTotalLevels, vs...))
+}
+
+// TotalLevelsNotIn applies the NotIn predicate on the "total_levels" field.
+func TotalLevelsNotIn(vs ...int) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldNotIn(FieldTotalLevels, vs...))
+}
+
+// TotalLevelsGT applies the GT predicate on the "total_levels" field.
+func TotalLevelsGT(v int) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldGT(FieldTotalLevels, v))
+}
+
+// TotalLevelsGTE applies the GTE predicate on the "total_levels" field.
+func TotalLevelsGTE(v int) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldGTE(FieldTotalLevels, v))
+}
+
+// TotalLevelsLT applies the LT predicate on the "total_levels" field.
+func TotalLevelsLT(v int) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldLT(FieldTotalLevels, v))
+}
+
+// TotalLevelsLTE applies the LTE predicate on the "total_levels" field.
+func TotalLevelsLTE(v int) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldLTE(FieldTotalLevels, v))
+}
+
+// SeedEQ applies the EQ predicate on the "seed" field.
+func SeedEQ(v int64) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldEQ(FieldSeed, v))
+}
+
+// SeedNEQ applies the NEQ predicate on the "seed" field.
+func SeedNEQ(v int64) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldNEQ(FieldSeed, v))
+}
+
+// SeedIn applies the In predicate on the "seed" field.
+func SeedIn(vs ...int64) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldIn(FieldSeed, vs...))
+}
+
+// SeedNotIn applies the NotIn predicate on the "seed" field.
+func SeedNotIn(vs ...int64) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldNotIn(FieldSeed, vs...))
+}
+
+// SeedGT applies the GT predicate on the "seed" field.
+func SeedGT(v int64) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldGT(FieldSeed, v))
+}
+
+// SeedGTE applies the GTE predicate on the "seed" field.
+func SeedGTE(v int64) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldGTE(FieldSeed, v))
+}
+
+// SeedLT applies the LT predicate on the "seed" field.
+func SeedLT(v int64) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldLT(FieldSeed, v))
+}
+
+// SeedLTE applies the LTE predicate on the "seed" field.
+func SeedLTE(v int64) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldLTE(FieldSeed, v))
+}
+
+// PlayTimeEQ applies the EQ predicate on the "play_time" field.
+func PlayTimeEQ(v float64) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldEQ(FieldPlayTime, v))
+}
+
+// PlayTimeNEQ applies the NEQ predicate on the "play_time" field.
+func PlayTimeNEQ(v float64) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldNEQ(FieldPlayTime, v))
+}
+
+// PlayTimeIn applies the In predicate on the "play_time" field.
+func PlayTimeIn(vs ...float64) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldIn(FieldPlayTime, vs...))
+}
+
+// PlayTimeNotIn applies the NotIn predicate on the "play_time" field.
+func PlayTimeNotIn(vs ...float64) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldNotIn(FieldPlayTime, vs...))
+}
+
+// PlayTimeGT applies the GT predicate on the "play_time" field.
+func PlayTimeGT(v float64) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldGT(FieldPlayTime, v))
+}
+
+// PlayTimeGTE applies the GTE predicate on the "play_time" field.
+func PlayTimeGTE(v float64) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldGTE(FieldPlayTime, v))
+}
+
+// PlayTimeLT applies the LT predicate on the "play_time" field.
+func PlayTimeLT(v float64) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldLT(FieldPlayTime, v))
+}
+
+// PlayTimeLTE applies the LTE predicate on the "play_time" field.
+func PlayTimeLTE(v float64) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldLTE(FieldPlayTime, v))
+}
+
+// MapWidthEQ applies the EQ predicate on the "map_width" field.
+func MapWidthEQ(v int) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldEQ(FieldMapWidth, v))
+}
+
+// MapWidthNEQ applies the NEQ predicate on the "map_width" field.
+func MapWidthNEQ(v int) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldNEQ(FieldMapWidth, v))
+}
+
+// MapWidthIn applies the In predicate on the "map_width" field.
+func MapWidthIn(vs ...int) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldIn(FieldMapWidth, vs...))
+}
+
+// MapWidthNotIn applies the NotIn predicate on the "map_width" field.
+func MapWidthNotIn(vs ...int) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldNotIn(FieldMapWidth, vs...))
+}
+
+// MapWidthGT applies the GT predicate on the "map_width" field.
+func MapWidthGT(v int) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldGT(FieldMapWidth, v))
+}
+
+// MapWidthGTE applies the GTE predicate on the "map_width" field.
+func MapWidthGTE(v int) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldGTE(FieldMapWidth, v))
+}
+
+// MapWidthLT applies the LT predicate on the "map_width" field.
+func MapWidthLT(v int) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldLT(FieldMapWidth, v))
+}
+
+// MapWidthLTE applies the LTE predicate on the "map_width" field.
+func MapWidthLTE(v int) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldLTE(FieldMapWidth, v))
+}
+
+// MapHeightEQ applies the EQ predicate on the "map_height" field.
+func MapHeightEQ(v int) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldEQ(FieldMapHeight, v))
+}
+
+// MapHeightNEQ applies the NEQ predicate on the "map_height" field.
+func MapHeightNEQ(v int) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldNEQ(FieldMapHeight, v))
+}
+
+// MapHeightIn applies the In predicate on the "map_height" field.
+func MapHeightIn(vs ...int) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldIn(FieldMapHeight, vs...))
+}
+
+// MapHeightNotIn applies the NotIn predicate on the "map_height" field.
+func MapHeightNotIn(vs ...int) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldNotIn(FieldMapHeight, vs...))
+}
+
+// MapHeightGT applies the GT predicate on the "map_height" field.
+func MapHeightGT(v int) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldGT(FieldMapHeight, v))
+}
+
+// MapHeightGTE applies the GTE predicate on the "map_height" field.
+func MapHeightGTE(v int) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldGTE(FieldMapHeight, v))
+}
+
+// MapHeightLT applies the LT predicate on the "map_height" field.
+func MapHeightLT(v int) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldLT(FieldMapHeight, v))
+}
+
+// MapHeightLTE applies the LTE predicate on the "map_height" field.
+func MapHeightLTE(v int) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldLTE(FieldMapHeight, v))
+}
+
+// GridEQ applies the EQ predicate on the "grid" field.
+func GridEQ(v []byte) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldEQ(FieldGrid, v))
+}
+
+// GridNEQ applies the NEQ predicate on the "grid" field.
+func GridNEQ(v []byte) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldNEQ(FieldGrid, v))
+}
+
+// GridIn applies the In predicate on the "grid" field.
+func GridIn(vs ...[]byte) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldIn(FieldGrid, vs...))
+}
+
+// GridNotIn applies the NotIn predicate on the "grid" field.
+func GridNotIn(vs ...[]byte) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldNotIn(FieldGrid, vs...))
+}
+
+// GridGT applies the GT predicate on the "grid" field.
+func GridGT(v []byte) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldGT(FieldGrid, v))
+}
+
+// GridGTE applies the GTE predicate on the "grid" field.
+func GridGTE(v []byte) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldGTE(FieldGrid, v))
+}
+
+// GridLT applies the LT predicate on the "grid" field.
+func GridLT(v []byte) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldLT(FieldGrid, v))
+}
+
+// GridLTE applies the LTE predicate on the "grid" field.
+func GridLTE(v []byte) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldLTE(FieldGrid, v))
+}
+
+// EnemyTypesIsNil applies the IsNil predicate on the "enemy_types" field.
+func EnemyTypesIsNil() predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldIsNull(FieldEnemyTypes))
+}
+
+// EnemyTypesNotNil applies the NotNil predicate on the "enemy_types" field.
+func EnemyTypesNotNil() predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldNotNull(FieldEnemyTypes))
+}
+
+// DoorsIsNil applies the IsNil predicate on the "doors" field.
+func DoorsIsNil() predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldIsNull(FieldDoors))
+}
+
+// DoorsNotNil applies the NotNil predicate on the "doors" field.
+func DoorsNotNil() predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldNotNull(FieldDoors))
+}
+
+// SecretsIsNil applies the IsNil predicate on the "secrets" field.
+func SecretsIsNil() predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldIsNull(FieldSecrets))
+}
+
+// SecretsNotNil applies the NotNil predicate on the "secrets" field.
+func SecretsNotNil() predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldNotNull(FieldSecrets))
+}
+
+// BarrelsIsNil applies the IsNil predicate on the "barrels" field.
+func BarrelsIsNil() predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldIsNull(FieldBarrels))
+}
+
+// BarrelsNotNil applies the NotNil predicate on the "barrels" field.
+func BarrelsNotNil() predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldNotNull(FieldBarrels))
+}
+
+// DefeatedEQ applies the EQ predicate on the "defeated" field.
+func DefeatedEQ(v int) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldEQ(FieldDefeated, v))
+}
+
+// DefeatedNEQ applies the NEQ predicate on the "defeated" field.
+func DefeatedNEQ(v int) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldNEQ(FieldDefeated, v))
+}
+
+// DefeatedIn applies the In predicate on the "defeated" field.
+func DefeatedIn(vs ...int) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldIn(FieldDefeated, vs...))
+}
+
+// DefeatedNotIn applies the NotIn predicate on the "defeated" field.
+func DefeatedNotIn(vs ...int) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldNotIn(FieldDefeated, vs...))
+}
+
+// DefeatedGT applies the GT predicate on the "defeated" field.
+func DefeatedGT(v int) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldGT(FieldDefeated, v))
+}
+
+// DefeatedGTE applies the GTE predicate on the "defeated" field.
+func DefeatedGTE(v int) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldGTE(FieldDefeated, v))
+}
+
+// DefeatedLT applies the LT predicate on the "defeated" field.
+func DefeatedLT(v int) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldLT(FieldDefeated, v))
+}
+
+// DefeatedLTE applies the LTE predicate on the "defeated" field.
+func DefeatedLTE(v int) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldLTE(FieldDefeated, v))
+}
+
+// LevelEnemyTotalEQ applies the EQ predicate on the "level_enemy_total" field.
+func LevelEnemyTotalEQ(v int) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldEQ(FieldLevelEnemyTotal, v))
+}
+
+// LevelEnemyTotalNEQ applies the NEQ predicate on the "level_enemy_total" field.
+func LevelEnemyTotalNEQ(v int) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldNEQ(FieldLevelEnemyTotal, v))
+}
+
+// LevelEnemyTotalIn applies the In predicate on the "level_enemy_total" field.
+func LevelEnemyTotalIn(vs ...int) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldIn(FieldLevelEnemyTotal, vs...))
+}
+
+// LevelEnemyTotalNotIn applies the NotIn predicate on the "level_enemy_total" field.
+func LevelEnemyTotalNotIn(vs ...int) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldNotIn(FieldLevelEnemyTotal, vs...))
+}
+
+// LevelEnemyTotalGT applies the GT predicate on the "level_enemy_total" field.
+func LevelEnemyTotalGT(v int) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldGT(FieldLevelEnemyTotal, v))
+}
+
+// LevelEnemyTotalGTE applies the GTE predicate on the "level_enemy_total" field.
+func LevelEnemyTotalGTE(v int) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldGTE(FieldLevelEnemyTotal, v))
+}
+
+// LevelEnemyTotalLT applies the LT predicate on the "level_enemy_total" field.
+func LevelEnemyTotalLT(v int) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldLT(FieldLevelEnemyTotal, v))
+}
+
+// LevelEnemyTotalLTE applies the LTE predicate on the "level_enemy_total" field.
+func LevelEnemyTotalLTE(v int) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldLTE(FieldLevelEnemyTotal, v))
+}
+
+// LevelTimeEQ applies the EQ predicate on the "level_time" field.
+func LevelTimeEQ(v float64) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldEQ(FieldLevelTime, v))
+}
+
+// LevelTimeNEQ applies the NEQ predicate on the "level_time" field.
+func LevelTimeNEQ(v float64) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldNEQ(FieldLevelTime, v))
+}
+
+// LevelTimeIn applies the In predicate on the "level_time" field.
+func LevelTimeIn(vs ...float64) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldIn(FieldLevelTime, vs...))
+}
+
+// LevelTimeNotIn applies the NotIn predicate on the "level_time" field.
+func LevelTimeNotIn(vs ...float64) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldNotIn(FieldLevelTime, vs...))
+}
+
+// LevelTimeGT applies the GT predicate on the "level_time" field.
+func LevelTimeGT(v float64) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldGT(FieldLevelTime, v))
+}
+
+// LevelTimeGTE applies the GTE predicate on the "level_time" field.
+func LevelTimeGTE(v float64) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldGTE(FieldLevelTime, v))
+}
+
+// LevelTimeLT applies the LT predicate on the "level_time" field.
+func LevelTimeLT(v float64) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldLT(FieldLevelTime, v))
+}
+
+// LevelTimeLTE applies the LTE predicate on the "level_time" field.
+func LevelTimeLTE(v float64) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldLTE(FieldLevelTime, v))
+}
+
+// CompletionEQ applies the EQ predicate on the "completion" field.
+func CompletionEQ(v int) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldEQ(FieldCompletion, v))
+}
+
+// CompletionNEQ applies the NEQ predicate on the "completion" field.
+func CompletionNEQ(v int) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldNEQ(FieldCompletion, v))
+}
+
+// CompletionIn applies the In predicate on the "completion" field.
+func CompletionIn(vs ...int) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldIn(FieldCompletion, vs...))
+}
+
+// CompletionNotIn applies the NotIn predicate on the "completion" field.
+func CompletionNotIn(vs ...int) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldNotIn(FieldCompletion, vs...))
+}
+
+// CompletionGT applies the GT predicate on the "completion" field.
+func CompletionGT(v int) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldGT(FieldCompletion, v))
+}
+
+// CompletionGTE applies the GTE predicate on the "completion" field.
+func CompletionGTE(v int) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldGTE(FieldCompletion, v))
+}
+
+// CompletionLT applies the LT predicate on the "completion" field.
+func CompletionLT(v int) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldLT(FieldCompletion, v))
+}
+
+// CompletionLTE applies the LTE predicate on the "completion" field.
+func CompletionLTE(v int) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldLTE(FieldCompletion, v))
+}
+
+// FireRateEQ applies the EQ predicate on the "fire_rate" field.
+func FireRateEQ(v float64) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldEQ(FieldFireRate, v))
+}
+
+// FireRateNEQ applies the NEQ predicate on the "fire_rate" field.
+func FireRateNEQ(v float64) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldNEQ(FieldFireRate, v))
+}
+
+// FireRateIn applies the In predicate on the "fire_rate" field.
+func FireRateIn(vs ...float64) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldIn(FieldFireRate, vs...))
+}
+
+// FireRateNotIn applies the NotIn predicate on the "fire_rate" field.
+func FireRateNotIn(vs ...float64) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldNotIn(FieldFireRate, vs...))
+}
+
+// FireRateGT applies the GT predicate on the "fire_rate" field.
+func FireRateGT(v float64) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldGT(FieldFireRate, v))
+}
+
+// FireRateGTE applies the GTE predicate on the "fire_rate" field.
+func FireRateGTE(v float64) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldGTE(FieldFireRate, v))
+}
+
+// FireRateLT applies the LT predicate on the "fire_rate" field.
+func FireRateLT(v float64) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldLT(FieldFireRate, v))
+}
+
+// FireRateLTE applies the LTE predicate on the "fire_rate" field.
+func FireRateLTE(v float64) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldLTE(FieldFireRate, v))
+}
+
+// BulletSpeedEQ applies the EQ predicate on the "bullet_speed" field.
+func BulletSpeedEQ(v float64) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldEQ(FieldBulletSpeed, v))
+}
+
+// BulletSpeedNEQ applies the NEQ predicate on the "bullet_speed" field.
+func BulletSpeedNEQ(v float64) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldNEQ(FieldBulletSpeed, v))
+}
+
+// BulletSpeedIn applies the In predicate on the "bullet_speed" field.
+func BulletSpeedIn(vs ...float64) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldIn(FieldBulletSpeed, vs...))
+}
+
+// BulletSpeedNotIn applies the NotIn predicate on the "bullet_speed" field.
+func BulletSpeedNotIn(vs ...float64) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldNotIn(FieldBulletSpeed, vs...))
+}
+
+// BulletSpeedGT applies the GT predicate on the "bullet_speed" field.
+func BulletSpeedGT(v float64) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldGT(FieldBulletSpeed, v))
+}
+
+// BulletSpeedGTE applies the GTE predicate on the "bullet_speed" field.
+func BulletSpeedGTE(v float64) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldGTE(FieldBulletSpeed, v))
+}
+
+// BulletSpeedLT applies the LT predicate on the "bullet_speed" field.
+func BulletSpeedLT(v float64) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldLT(FieldBulletSpeed, v))
+}
+
+// BulletSpeedLTE applies the LTE predicate on the "bullet_speed" field.
+func BulletSpeedLTE(v float64) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldLTE(FieldBulletSpeed, v))
+}
+
+// RngStateEQ applies the EQ predicate on the "rng_state" field.
+func RngStateEQ(v int64) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldEQ(FieldRngState, v))
+}
+
+// RngStateNEQ applies the NEQ predicate on the "rng_state" field.
+func RngStateNEQ(v int64) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldNEQ(FieldRngState, v))
+}
+
+// RngStateIn applies the In predicate on the "rng_state" field.
+func RngStateIn(vs ...int64) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldIn(FieldRngState, vs...))
+}
+
+// RngStateNotIn applies the NotIn predicate on the "rng_state" field.
+func RngStateNotIn(vs ...int64) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldNotIn(FieldRngState, vs...))
+}
+
+// RngStateGT applies the GT predicate on the "rng_state" field.
+func RngStateGT(v int64) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldGT(FieldRngState, v))
+}
+
+// RngStateGTE applies the GTE predicate on the "rng_state" field.
+func RngStateGTE(v int64) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldGTE(FieldRngState, v))
+}
+
+// RngStateLT applies the LT predicate on the "rng_state" field.
+func RngStateLT(v int64) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldLT(FieldRngState, v))
+}
+
+// RngStateLTE applies the LTE predicate on the "rng_state" field.
+func RngStateLTE(v int64) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldLTE(FieldRngState, v))
+}
+
+// CampaignEQ applies the EQ predicate on the "campaign" field.
+func CampaignEQ(v string) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldEQ(FieldCampaign, v))
+}
+
+// CampaignNEQ applies the NEQ predicate on the "campaign" field.
+func CampaignNEQ(v string) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldNEQ(FieldCampaign, v))
+}
+
+// CampaignIn applies the In predicate on the "campaign" field.
+func CampaignIn(vs ...string) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldIn(FieldCampaign, vs...))
+}
+
+// CampaignNotIn applies the NotIn predicate on the "campaign" field.
+func CampaignNotIn(vs ...string) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldNotIn(FieldCampaign, vs...))
+}
+
+// CampaignGT applies the GT predicate on the "campaign" field.
+func CampaignGT(v string) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldGT(FieldCampaign, v))
+}
+
+// CampaignGTE applies the GTE predicate on the "campaign" field.
+func CampaignGTE(v string) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldGTE(FieldCampaign, v))
+}
+
+// CampaignLT applies the LT predicate on the "campaign" field.
+func CampaignLT(v string) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldLT(FieldCampaign, v))
+}
+
+// CampaignLTE applies the LTE predicate on the "campaign" field.
+func CampaignLTE(v string) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldLTE(FieldCampaign, v))
+}
+
+// CampaignContains applies the Contains predicate on the "campaign" field.
+func CampaignContains(v string) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldContains(FieldCampaign, v))
+}
+
+// CampaignHasPrefix applies the HasPrefix predicate on the "campaign" field.
+func CampaignHasPrefix(v string) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldHasPrefix(FieldCampaign, v))
+}
+
+// CampaignHasSuffix applies the HasSuffix predicate on the "campaign" field.
+func CampaignHasSuffix(v string) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldHasSuffix(FieldCampaign, v))
+}
+
+// CampaignEqualFold applies the EqualFold predicate on the "campaign" field.
+func CampaignEqualFold(v string) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldEqualFold(FieldCampaign, v))
+}
+
+// CampaignContainsFold applies the ContainsFold predicate on the "campaign" field.
+func CampaignContainsFold(v string) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldContainsFold(FieldCampaign, v))
+}
+
+// MapNameEQ applies the EQ predicate on the "map_name" field.
+func MapNameEQ(v string) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldEQ(FieldMapName, v))
+}
+
+// MapNameNEQ applies the NEQ predicate on the "map_name" field.
+func MapNameNEQ(v string) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldNEQ(FieldMapName, v))
+}
+
+// MapNameIn applies the In predicate on the "map_name" field.
+func MapNameIn(vs ...string) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldIn(FieldMapName, vs...))
+}
+
+// MapNameNotIn applies the NotIn predicate on the "map_name" field.
+func MapNameNotIn(vs ...string) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldNotIn(FieldMapName, vs...))
+}
+
+// MapNameGT applies the GT predicate on the "map_name" field.
+func MapNameGT(v string) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldGT(FieldMapName, v))
+}
+
+// MapNameGTE applies the GTE predicate on the "map_name" field.
+func MapNameGTE(v string) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldGTE(FieldMapName, v))
+}
+
+// MapNameLT applies the LT predicate on the "map_name" field.
+func MapNameLT(v string) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldLT(FieldMapName, v))
+}
+
+// MapNameLTE applies the LTE predicate on the "map_name" field.
+func MapNameLTE(v string) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldLTE(FieldMapName, v))
+}
+
+// MapNameContains applies the Contains predicate on the "map_name" field.
+func MapNameContains(v string) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldContains(FieldMapName, v))
+}
+
+// MapNameHasPrefix applies the HasPrefix predicate on the "map_name" field.
+func MapNameHasPrefix(v string) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldHasPrefix(FieldMapName, v))
+}
+
+// MapNameHasSuffix applies the HasSuffix predicate on the "map_name" field.
+func MapNameHasSuffix(v string) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldHasSuffix(FieldMapName, v))
+}
+
+// MapNameEqualFold applies the EqualFold predicate on the "map_name" field.
+func MapNameEqualFold(v string) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldEqualFold(FieldMapName, v))
+}
+
+// MapNameContainsFold applies the ContainsFold predicate on the "map_name" field.
+func MapNameContainsFold(v string) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldContainsFold(FieldMapName, v))
+}
+
+// ParEQ applies the EQ predicate on the "par" field.
+func ParEQ(v float64) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldEQ(FieldPar, v))
+}
+
+// ParNEQ applies the NEQ predicate on the "par" field.
+func ParNEQ(v float64) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldNEQ(FieldPar, v))
+}
+
+// ParIn applies the In predicate on the "par" field.
+func ParIn(vs ...float64) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldIn(FieldPar, vs...))
+}
+
+// ParNotIn applies the NotIn predicate on the "par" field.
+func ParNotIn(vs ...float64) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldNotIn(FieldPar, vs...))
+}
+
+// ParGT applies the GT predicate on the "par" field.
+func ParGT(v float64) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldGT(FieldPar, v))
+}
+
+// ParGTE applies the GTE predicate on the "par" field.
+func ParGTE(v float64) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldGTE(FieldPar, v))
+}
+
+// ParLT applies the LT predicate on the "par" field.
+func ParLT(v float64) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldLT(FieldPar, v))
+}
+
+// ParLTE applies the LTE predicate on the "par" field.
+func ParLTE(v float64) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldLTE(FieldPar, v))
+}
+
+// ThumbnailEQ applies the EQ predicate on the "thumbnail" field.
+func ThumbnailEQ(v []byte) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldEQ(FieldThumbnail, v))
+}
+
+// ThumbnailNEQ applies the NEQ predicate on the "thumbnail" field.
+func ThumbnailNEQ(v []byte) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldNEQ(FieldThumbnail, v))
+}
+
+// ThumbnailIn applies the In predicate on the "thumbnail" field.
+func ThumbnailIn(vs ...[]byte) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldIn(FieldThumbnail, vs...))
+}
+
+// ThumbnailNotIn applies the NotIn predicate on the "thumbnail" field.
+func ThumbnailNotIn(vs ...[]byte) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldNotIn(FieldThumbnail, vs...))
+}
+
+// ThumbnailGT applies the GT predicate on the "thumbnail" field.
+func ThumbnailGT(v []byte) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldGT(FieldThumbnail, v))
+}
+
+// ThumbnailGTE applies the GTE predicate on the "thumbnail" field.
+func ThumbnailGTE(v []byte) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldGTE(FieldThumbnail, v))
+}
+
+// ThumbnailLT applies the LT predicate on the "thumbnail" field.
+func ThumbnailLT(v []byte) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldLT(FieldThumbnail, v))
+}
+
+// ThumbnailLTE applies the LTE predicate on the "thumbnail" field.
+func ThumbnailLTE(v []byte) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldLTE(FieldThumbnail, v))
+}
+
+// ThumbnailIsNil applies the IsNil predicate on the "thumbnail" field.
+func ThumbnailIsNil() predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldIsNull(FieldThumbnail))
+}
+
+// ThumbnailNotNil applies the NotNil predicate on the "thumbnail" field.
+func ThumbnailNotNil() predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldNotNull(FieldThumbnail))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// CreatedAtIsNil applies the IsNil predicate on the "created_at" field.
+func CreatedAtIsNil() predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldIsNull(FieldCreatedAt))
+}
+
+// CreatedAtNotNil applies the NotNil predicate on the "created_at" field.
+func CreatedAtNotNil() predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldNotNull(FieldCreatedAt))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIsNil applies the IsNil predicate on the "updated_at" field.
+func UpdatedAtIsNil() predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldIsNull(FieldUpdatedAt))
+}
+
+// UpdatedAtNotNil applies the NotNil predicate on the "updated_at" field.
+func UpdatedAtNotNil() predicate.SaveSlot {
+	return predicate.SaveSlot(sql.FieldNotNull(FieldUpdatedAt))
+}
+
+// HasProfile applies the HasEdge predicate on the "profile" edge.
+func HasProfile() predicate.SaveSlot {
+	return predicate.SaveSlot(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ProfileTable, ProfileColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasProfileWith applies the HasEdge predicate on the "profile" edge with a given conditions (other predicates).
+func HasProfileWith(preds ...predicate.Profile) predicate.SaveSlot {
+	return predicate.SaveSlot(func(s *sql.Selector) {
+		step := newProfileStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SaveSlot) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SaveSlot) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SaveSlot) predicate.SaveSlot {
+	return predicate.SaveSlot(sql.NotPredicates(p))
+}
@@ -0,0 +1,683 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"doomlike/ent/profile"
+	"doomlike/ent/saveslot"
+	"doomlike/internal/sim"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SaveSlotCreate is the builder for creating a SaveSlot entity.
+type SaveSlotCreate struct {
+	config
+	mutation *SaveSlotMutation
+	hooks    []Hook
+}
+
+// SetSlot sets the "slot" field.
+func (_c *SaveSlotCreate) SetSlot(v int) *SaveSlotCreate {
+	_c.mutation.SetSlot(v)
+	return _c
+}
+
+// SetLevel sets the "level" field.
+func (_c *SaveSlotCreate) SetLevel(v int) *SaveSlotCreate {
+	_c.mutation.SetLevel(v)
+	return _c
+}
+
+// SetTotalLevels sets the "total_levels" field.
+func (_c *SaveSlotCreate) SetTotalLevels(v int) *SaveSlotCreate {
+	_c.mutation.SetTotalLevels(v)
+	return _c
+}
+
+// SetSeed sets the "seed" field.
+func (_c *SaveSlotCreate) SetSeed(v int64) *SaveSlotCreate {
+	_c.mutation.SetSeed(v)
+	return _c
+}
+
+// SetPlayTime sets the "play_time" field.
+func (_c *SaveSlotCreate) SetPlayTime(v float64) *SaveSlotCreate {
+	_c.mutation.SetPlayTime(v)
+	return _c
+}
+
+// SetNillablePlayTime sets the "play_time" field if the given value is not nil.
+func (_c *SaveSlotCreate) SetNillablePlayTime(v *float64) *SaveSlotCreate {
+	if v != nil {
+		_c.SetPlayTime(*v)
+	}
+	return _c
+}
+
+// SetMapWidth sets the "map_width" field.
+func (_c *SaveSlotCreate) SetMapWidth(v int) *SaveSlotCreate {
+	_c.mutation.SetMapWidth(v)
+	return _c
+}
+
+// SetMapHeight sets the "map_height" field.
+func (_c *SaveSlotCreate) SetMapHeight(v int) *SaveSlotCreate {
+	_c.mutation.SetMapHeight(v)
+	return _c
+}
+
+// SetGrid sets the "grid" field.
+func (_c *SaveSlotCreate) SetGrid(v []byte) *SaveSlotCreate {
+	_c.mutation.SetGrid(v)
+	return _c
+}
+
+// SetPlayer sets the "player" field.
+func (_c *SaveSlotCreate) SetPlayer(v sim.Player) *SaveSlotCreate {
+	_c.mutation.SetPlayer(v)
+	return _c
+}
+
+// SetEnemies sets the "enemies" field.
+func (_c *SaveSlotCreate) SetEnemies(v []sim.Enemy) *SaveSlotCreate {
+	_c.mutation.SetEnemies(v)
+	return _c
+}
+
+// SetEnemyTypes sets the "enemy_types" field.
+func (_c *SaveSlotCreate) SetEnemyTypes(v []string) *SaveSlotCreate {
+	_c.mutation.SetEnemyTypes(v)
+	return _c
+}
+
+// SetPickups sets the "pickups" field.
+func (_c *SaveSlotCreate) SetPickups(v []sim.Pickup) *SaveSlotCreate {
+	_c.mutation.SetPickups(v)
+	return _c
+}
+
+// SetProjectiles sets the "projectiles" field.
+func (_c *SaveSlotCreate) SetProjectiles(v []sim.Projectile) *SaveSlotCreate {
+	_c.mutation.SetProjectiles(v)
+	return _c
+}
+
+// SetDoors sets the "doors" field.
+func (_c *SaveSlotCreate) SetDoors(v []sim.Door) *SaveSlotCreate {
+	_c.mutation.SetDoors(v)
+	return _c
+}
+
+// SetSecrets sets the "secrets" field.
+func (_c *SaveSlotCreate) SetSecrets(v []sim.Secret) *SaveSlotCreate {
+	_c.mutation.SetSecrets(v)
+	return _c
+}
+
+// SetBarrels sets the "barrels" field.
+func (_c *SaveSlotCreate) SetBarrels(v []sim.Barrel) *SaveSlotCreate {
+	_c.mutation.SetBarrels(v)
+	return _c
+}
+
+// SetDefeated sets the "defeated" field.
+func (_c *SaveSlotCreate) SetDefeated(v int) *SaveSlotCreate {
+	_c.mutation.SetDefeated(v)
+	return _c
+}
+
+// SetNillableDefeated sets the "defeated" field if the given value is not nil.
+func (_c *SaveSlotCreate) SetNillableDefeated(v *int) *SaveSlotCreate {
+	if v != nil {
+		_c.SetDefeated(*v)
+	}
+	return _c
+}
+
+// SetLevelEnemyTotal sets the "level_enemy_total" field.
+func (_c *SaveSlotCreate) SetLevelEnemyTotal(v int) *SaveSlotCreate {
+	_c.mutation.SetLevelEnemyTotal(v)
+	return _c
+}
+
+// SetNillableLevelEnemyTotal sets the "level_enemy_total" field if the given value is not nil.
+func (_c *SaveSlotCreate) SetNillableLevelEnemyTotal(v *int) *SaveSlotCreate {
+	if v != nil {
+		_c.SetLevelEnemyTotal(*v)
+	}
+	return _c
+}
+
+// SetLevelTime sets the "level_time" field.
+func (_c *SaveSlotCreate) SetLevelTime(v float64) *SaveSlotCreate {
+	_c.mutation.SetLevelTime(v)
+	return _c
+}
+
+// SetNillableLevelTime sets the "level_time" field if the given value is not nil.
+func (_c *SaveSlotCreate) SetNillableLevelTime(v *float64) *SaveSlotCreate {
+	if v != nil {
+		_c.SetLevelTime(*v)
+	}
+	return _c
+}
+
+// SetCompletion sets the "completion" field.
+func (_c *SaveSlotCreate) SetCompletion(v int) *SaveSlotCreate {
+	_c.mutation.SetCompletion(v)
+	return _c
+}
+
+// SetNillableCompletion sets the "completion" field if the given value is not nil.
+func (_c *SaveSlotCreate) SetNillableCompletion(v *int) *SaveSlotCreate {
+	if v != nil {
+		_c.SetCompletion(*v)
+	}
+	return _c
+}
+
+// SetFireRate sets the "fire_rate" field.
+func (_c *SaveSlotCreate) SetFireRate(v float64) *SaveSlotCreate {
+	_c.mutation.SetFireRate(v)
+	return _c
+}
+
+// SetBulletSpeed sets the "bullet_speed" field.
+func (_c *SaveSlotCreate) SetBulletSpeed(v float64) *SaveSlotCreate {
+	_c.mutation.SetBulletSpeed(v)
+	return _c
+}
+
+// SetRngState sets the "rng_state" field.
+func (_c *SaveSlotCreate) SetRngState(v int64) *SaveSlotCreate {
+	_c.mutation.SetRngState(v)
+	return _c
+}
+
+// SetCampaign sets the "campaign" field.
+func (_c *SaveSlotCreate) SetCampaign(v string) *SaveSlotCreate {
+	_c.mutation.SetCampaign(v)
+	return _c
+}
+
+// SetNillableCampaign sets the "campaign" field if the given value is not nil.
+func (_c *SaveSlotCreate) SetNillableCampaign(v *string) *SaveSlotCreate {
+	if v != nil {
+		_c.SetCampaign(*v)
+	}
+	return _c
+}
+
+// SetMapName sets the "map_name" field.
+func (_c *SaveSlotCreate) SetMapName(v string) *SaveSlotCreate {
+	_c.mutation.SetMapName(v)
+	return _c
+}
+
+// SetNillableMapName sets the "map_name" field if the given value is not nil.
+func (_c *SaveSlotCreate) SetNillableMapName(v *string) *SaveSlotCreate {
+	if v != nil {
+		_c.SetMapName(*v)
+	}
+	return _c
+}
+
+// SetPar sets the "par" field.
+func (_c *SaveSlotCreate) SetPar(v float64) *SaveSlotCreate {
+	_c.mutation.SetPar(v)
+	return _c
+}
+
+// SetNillablePar sets the "par" field if the given value is not nil.
+func (_c *SaveSlotCreate) SetNillablePar(v *float64) *SaveSlotCreate {
+	if v != nil {
+		_c.SetPar(*v)
+	}
+	return _c
+}
+
+// SetThumbnail sets the "thumbnail" field.
+func (_c *SaveSlotCreate) SetThumbnail(v []byte) *SaveSlotCreate {
+	_c.mutation.SetThumbnail(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *SaveSlotCreate) SetCreatedAt(v time.Time) *SaveSlotCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *SaveSlotCreate) SetNillableCreatedAt(v *time.Time) *SaveSlotCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *SaveSlotCreate) SetUpdatedAt(v time.Time) *SaveSlotCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *SaveSlotCreate) SetNillableUpdatedAt(v *time.Time) *SaveSlotCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetProfileID sets the "profile" edge to the Profile entity by ID.
+func (_c *SaveSlotCreate) SetProfileID(id int) *SaveSlotCreate {
+	_c.mutation.SetProfileID(id)
+	return _c
+}
+
+// SetNillableProfileID sets the "profile" edge to the Profile entity by ID if the given value is not nil.
+func (_c *SaveSlotCreate) SetNillableProfileID(id *int) *SaveSlotCreate {
+	if id != nil {
+		_c = _c.SetProfileID(*id)
+	}
+	return _c
+}
+
+// SetProfile sets the "profile" edge to the Profile entity.
+func (_c *SaveSlotCreate) SetProfile(v *Profile) *SaveSlotCreate {
+	return _c.SetProfileID(v.ID)
+}
+
+// Mutation returns the SaveSlotMutation object of the builder.
+func (_c *SaveSlotCreate) Mutation() *SaveSlotMutation {
+	return _c.mutation
+}
+
+// Save creates the SaveSlot in the database.
+func (_c *SaveSlotCreate) Save(ctx context.Context) (*SaveSlot, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *SaveSlotCreate) SaveX(ctx context.Context) *SaveSlot {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SaveSlotCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SaveSlotCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *SaveSlotCreate) defaults() {
+	if _, ok := _c.mutation.PlayTime(); !ok {
+		v := saveslot.DefaultPlayTime
+		_c.mutation.SetPlayTime(v)
+	}
+	if _, ok := _c.mutation.Defeated(); !ok {
+		v := saveslot.DefaultDefeated
+		_c.mutation.SetDefeated(v)
+	}
+	if _, ok := _c.mutation.LevelEnemyTotal(); !ok {
+		v := saveslot.DefaultLevelEnemyTotal
+		_c.mutation.SetLevelEnemyTotal(v)
+	}
+	if _, ok := _c.mutation.LevelTime(); !ok {
+		v := saveslot.DefaultLevelTime
+		_c.mutation.SetLevelTime(v)
+	}
+	if _, ok := _c.mutation.Completion(); !ok {
+		v := saveslot.DefaultCompletion
+		_c.mutation.SetCompletion(v)
+	}
+	if _, ok := _c.mutation.Campaign(); !ok {
+		v := saveslot.DefaultCampaign
+		_c.mutation.SetCampaign(v)
+	}
+	if _, ok := _c.mutation.MapName(); !ok {
+		v := saveslot.DefaultMapName
+		_c.mutation.SetMapName(v)
+	}
+	if _, ok := _c.mutation.Par(); !ok {
+		v := saveslot.DefaultPar
+		_c.mutation.SetPar(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *SaveSlotCreate) check() error {
+	if _, ok := _c.mutation.Slot(); !ok {
+		return &ValidationError{Name: "slot", err: errors.New(`ent: missing required field "SaveSlot.slot"`)}
+	}
+	if _, ok := _c.mutation.Level(); !ok {
+		return &ValidationError{Name: "level", err: errors.New(`ent: missing required field "SaveSlot.level"`)}
+	}
+	if _, ok := _c.mutation.TotalLevels(); !ok {
+		return &ValidationError{Name: "total_levels", err: errors.New(`ent: missing required field "SaveSlot.total_levels"`)}
+	}
+	if _, ok := _c.mutation.Seed(); !ok {
+		return &ValidationError{Name: "seed", err: errors.New(`ent: missing required field "SaveSlot.seed"`)}
+	}
+	if _, ok := _c.mutation.PlayTime(); !ok {
+		return &ValidationError{Name: "play_time", err: errors.New(`ent: missing required field "SaveSlot.play_time"`)}
+	}
+	if _, ok := _c.mutation.MapWidth(); !ok {
+		return &ValidationError{Name: "map_width", err: errors.New(`ent: missing required field "SaveSlot.map_width"`)}
+	}
+	if _, ok := _c.mutation.MapHeight(); !ok {
+		return &ValidationError{Name: "map_height", err: errors.New(`ent: missing required field "SaveSlot.map_height"`)}
+	}
+	if _, ok := _c.mutation.Grid(); !ok {
+		return &ValidationError{Name: "grid", err: errors.New(`ent: missing required field "SaveSlot.grid"`)}
+	}
+	if _, ok := _c.mutation.Player(); !ok {
+		return &ValidationError{Name: "player", err: errors.New(`ent: missing required field "SaveSlot.player"`)}
+	}
+	if _, ok := _c.mutation.Enemies(); !ok {
+		return &ValidationError{Name: "enemies", err: errors.New(`ent: missing required field "SaveSlot.enemies"`)}
+	}
+	if _, ok := _c.mutation.Pickups(); !ok {
+		return &ValidationError{Name: "pickups", err: errors.New(`ent: missing required field "SaveSlot.pickups"`)}
+	}
+	if _, ok := _c.mutation.Projectiles(); !ok {
+		return &ValidationError{Name: "projectiles", err: errors.New(`ent: missing required field "SaveSlot.projectiles"`)}
+	}
+	if _, ok := _c.mutation.Defeated(); !ok {
+		return &ValidationError{Name: "defeated", err: errors.New(`ent: missing required field "SaveSlot.defeated"`)}
+	}
+	if _, ok := _c.mutation.LevelEnemyTotal(); !ok {
+		return &ValidationError{Name: "level_enemy_total", err: errors.New(`ent: missing required field "SaveSlot.level_enemy_total"`)}
+	}
+	if _, ok := _c.mutation.LevelTime(); !ok {
+		return &ValidationError{Name: "level_time", err: errors.New(`ent: missing required field "SaveSlot.level_time"`)}
+	}
+	if _, ok := _c.mutation.Completion(); !ok {
+		return &ValidationError{Name: "completion", err: errors.New(`ent: missing required field "SaveSlot.completion"`)}
+	}
+	if _, ok := _c.mutation.FireRate(); !ok {
+		return &ValidationError{Name: "fire_rate", err: errors.New(`ent: missing required field "SaveSlot.fire_rate"`)}
+	}
+	if _, ok := _c.mutation.BulletSpeed(); !ok {
+		return &ValidationError{Name: "bullet_speed", err: errors.New(`ent: missing required field "SaveSlot.bullet_speed"`)}
+	}
+	if _, ok := _c.mutation.RngState(); !ok {
+		return &ValidationError{Name: "rng_state", err: errors.New(`ent: missing required field "SaveSlot.rng_state"`)}
+	}
+	if _, ok := _c.mutation.Campaign(); !ok {
+		return &ValidationError{Name: "campaign", err: errors.New(`ent: missing required field "SaveSlot.campaign"`)}
+	}
+	if _, ok := _c.mutation.MapName(); !ok {
+		return &ValidationError{Name: "map_name", err: errors.New(`ent: missing required field "SaveSlot.map_name"`)}
+	}
+	if _, ok := _c.mutation.Par(); !ok {
+		return &ValidationError{Name: "par", err: errors.New(`ent: missing required field "SaveSlot.par"`)}
+	}
+	return nil
+}
+
+func (_c *SaveSlotCreate) sqlSave(ctx context.Context) (*SaveSlot, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *SaveSlotCreate) createSpec() (*SaveSlot, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SaveSlot{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(saveslot.Table, sqlgraph.NewFieldSpec(saveslot.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Slot(); ok {
+		_spec.SetField(saveslot.FieldSlot, field.TypeInt, value)
+		_node.Slot = value
+	}
+	if value, ok := _c.mutation.Level(); ok {
+		_spec.SetField(saveslot.FieldLevel, field.TypeInt, value)
+		_node.Level = value
+	}
+	if value, ok := _c.mutation.TotalLevels(); ok {
+		_spec.SetField(saveslot.FieldTotalLevels, field.TypeInt, value)
+		_node.TotalLevels = value
+	}
+	if value, ok := _c.mutation.Seed(); ok {
+		_spec.SetField(saveslot.FieldSeed, field.TypeInt64, value)
+		_node.Seed = value
+	}
+	if value, ok := _c.mutation.PlayTime(); ok {
+		_spec.SetField(saveslot.FieldPlayTime, field.TypeFloat64, value)
+		_node.PlayTime = value
+	}
+	if value, ok := _c.mutation.MapWidth(); ok {
+		_spec.SetField(saveslot.FieldMapWidth, field.TypeInt, value)
+		_node.MapWidth = value
+	}
+	if value, ok := _c.mutation.MapHeight(); ok {
+		_spec.SetField(saveslot.FieldMapHeight, field.TypeInt, value)
+		_node.MapHeight = value
+	}
+	if value, ok := _c.mutation.Grid(); ok {
+		_spec.SetField(saveslot.FieldGrid, field.TypeBytes, value)
+		_node.Grid = value
+	}
+	if value, ok := _c.mutation.Player(); ok {
+		_spec.SetField(saveslot.FieldPlayer, field.TypeJSON, value)
+		_node.Player = value
+	}
+	if value, ok := _c.mutation.Enemies(); ok {
+		_spec.SetField(saveslot.FieldEnemies, field.TypeJSON, value)
+		_node.Enemies = value
+	}
+	if value, ok := _c.mutation.EnemyTypes(); ok {
+		_spec.SetField(saveslot.FieldEnemyTypes, field.TypeJSON, value)
+		_node.EnemyTypes = value
+	}
+	if value, ok := _c.mutation.Pickups(); ok {
+		_spec.SetField(saveslot.FieldPickups, field.TypeJSON, value)
+		_node.Pickups = value
+	}
+	if value, ok := _c.mutation.Projectiles(); ok {
+		_spec.SetField(saveslot.FieldProjectiles, field.TypeJSON, value)
+		_node.Projectiles = value
+	}
+	if value, ok := _c.mutation.Doors(); ok {
+		_spec.SetField(saveslot.FieldDoors, field.TypeJSON, value)
+		_node.Doors = value
+	}
+	if value, ok := _c.mutation.Secrets(); ok {
+		_spec.SetField(saveslot.FieldSecrets, field.TypeJSON, value)
+		_node.Secrets = value
+	}
+	if value, ok := _c.mutation.Barrels(); ok {
+		_spec.SetField(saveslot.FieldBarrels, field.TypeJSON, value)
+		_node.Barrels = value
+	}
+	if value, ok := _c.mutation.Defeated(); ok {
+		_spec.SetField(saveslot.FieldDefeated, field.TypeInt, value)
+		_node.Defeated = value
+	}
+	if value, ok := _c.mutation.LevelEnemyTotal(); ok {
+		_spec.SetField(saveslot.FieldLevelEnemyTotal, field.TypeInt, value)
+		_node.LevelEnemyTotal = value
+	}
+	if value, ok := _c.mutation.LevelTime(); ok {
+		_spec.SetField(saveslot.FieldLevelTime, field.TypeFloat64, value)
+		_node.LevelTime = value
+	}
+	if value, ok := _c.mutation.Completion(); ok {
+		_spec.SetField(saveslot.FieldCompletion, field.TypeInt, value)
+		_node.Completion = value
+	}
+	if value, ok := _c.mutation.FireRate(); ok {
+		_spec.SetField(saveslot.FieldFireRate, field.TypeFloat64, value)
+		_node.FireRate = value
+	}
+	if value, ok := _c.mutation.BulletSpeed(); ok {
+		_spec.SetField(saveslot.FieldBulletSpeed, field.TypeFloat64, value)
+		_node.BulletSpeed = value
+	}
+	if value, ok := _c.mutation.RngState(); ok {
+		_spec.SetField(saveslot.FieldRngState, field.TypeInt64, value)
+		_node.RngState = value
+	}
+	if value, ok := _c.mutation.Campaign(); ok {
+		_spec.SetField(saveslot.FieldCampaign, field.TypeString, value)
+		_node.Campaign = value
+	}
+	if value, ok := _c.mutation.MapName(); ok {
+		_spec.SetField(saveslot.FieldMapName, field.TypeString, value)
+		_node.MapName = value
+	}
+	if value, ok := _c.mutation.Par(); ok {
+		_spec.SetField(saveslot.FieldPar, field.TypeFloat64, value)
+		_node.Par = value
+	}
+	if value, ok := _c.mutation.Thumbnail(); ok {
+		_spec.SetField(saveslot.FieldThumbnail, field.TypeBytes, value)
+		_node.Thumbnail = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(saveslot.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(saveslot.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.ProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   saveslot.ProfileTable,
+			Columns: []string{saveslot.ProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.profile_saves = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// SaveSlotCreateBulk is the builder for creating many SaveSlot entities in bulk.
+type SaveSlotCreateBulk struct {
+	config
+	err      error
+	builders []*SaveSlotCreate
+}
+
+// Save creates the SaveSlot entities in the database.
+func (_c *SaveSlotCreateBulk) Save(ctx context.Context) ([]*SaveSlot, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*SaveSlot, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SaveSlotMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *SaveSlotCreateBulk) SaveX(ctx context.Context) []*SaveSlot {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SaveSlotCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SaveSlotCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1709 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"doomlike/ent/predicate"
+	"doomlike/ent/profile"
+	"doomlike/ent/saveslot"
+	"doomlike/internal/sim"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// SaveSlotUpdate is the builder for updating SaveSlot entities.
+type SaveSlotUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SaveSlotMutation
+}
+
+// Where appends a list predicates to the SaveSlotUpdate builder.
+func (_u *SaveSlotUpdate) Where(ps ...predicate.SaveSlot) *SaveSlotUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetSlot sets the "slot" field.
+func (_u *SaveSlotUpdate) SetSlot(v int) *SaveSlotUpdate {
+	_u.mutation.ResetSlot()
+	_u.mutation.SetSlot(v)
+	return _u
+}
+
+// SetNillableSlot sets the "slot" field if the given value is not nil.
+func (_u *SaveSlotUpdate) SetNillableSlot(v *int) *SaveSlotUpdate {
+	if v != nil {
+		_u.SetSlot(*v)
+	}
+	return _u
+}
+
+// AddSlot adds value to the "slot" field.
+func (_u *SaveSlotUpdate) AddSlot(v int) *SaveSlotUpdate {
+	_u.mutation.AddSlot(v)
+	return _u
+}
+
+// SetLevel sets the "level" field.
+func (_u *SaveSlotUpdate) SetLevel(v int) *SaveSlotUpdate {
+	_u.mutation.ResetLevel()
+	_u.mutation.SetLevel(v)
+	return _u
+}
+
+// SetNillableLevel sets the "level" field if the given value is not nil.
+func (_u *SaveSlotUpdate) SetNillableLevel(v *int) *SaveSlotUpdate {
+	if v != nil {
+		_u.SetLevel(*v)
+	}
+	return _u
+}
+
+// AddLevel adds value to the "level" field.
+func (_u *SaveSlotUpdate) AddLevel(v int) *SaveSlotUpdate {
+	_u.mutation.AddLevel(v)
+	return _u
+}
+
+// SetTotalLevels sets the "total_levels" field.
+func (_u *SaveSlotUpdate) SetTotalLevels(v int) *SaveSlotUpdate {
+	_u.mutation.ResetTotalLevels()
+	_u.mutation.SetTotalLevels(v)
+	return _u
+}
+
+// SetNillableTotalLevels sets the "total_levels" field if the given value is not nil.
+func (_u *SaveSlotUpdate) SetNillableTotalLevels(v *int) *SaveSlotUpdate {
+	if v != nil {
+		_u.SetTotalLevels(*v)
+	}
+	return _u
+}
+
+// AddTotalLevels adds value to the "total_levels" field.
+func (_u *SaveSlotUpdate) AddTotalLevels(v int) *SaveSlotUpdate {
+	_u.mutation.AddTotalLevels(v)
+	return _u
+}
+
+// SetSeed sets the "seed" field.
+func (_u *SaveSlotUpdate) SetSeed(v int64) *SaveSlotUpdate {
+	_u.mutation.ResetSeed()
+	_u.mutation.SetSeed(v)
+	return _u
+}
+
+// SetNillableSeed sets the "seed" field if the given value is not nil.
+func (_u *SaveSlotUpdate) SetNillableSeed(v *int64) *SaveSlotUpdate {
+	if v != nil {
+		_u.SetSeed(*v)
+	}
+	return _u
+}
+
+// AddSeed adds value to the "seed" field.
+func (_u *SaveSlotUpdate) AddSeed(v int64) *SaveSlotUpdate {
+	_u.mutation.AddSeed(v)
+	return _u
+}
+
+// SetPlayTime sets the "play_time" field.
+func (_u *SaveSlotUpdate) SetPlayTime(v float64) *SaveSlotUpdate {
+	_u.mutation.ResetPlayTime()
+	_u.mutation.SetPlayTime(v)
+	return _u
+}
+
+// SetNillablePlayTime sets the "play_time" field if the given value is not nil.
+func (_u *SaveSlotUpdate) SetNillablePlayTime(v *float64) *SaveSlotUpdate {
+	if v != nil {
+		_u.SetPlayTime(*v)
+	}
+	return _u
+}
+
+// AddPlayTime adds value to the "play_time" field.
+func (_u *SaveSlotUpdate) AddPlayTime(v float64) *SaveSlotUpdate {
+	_u.mutation.AddPlayTime(v)
+	return _u
+}
+
+// SetMapWidth sets the "map_width" field.
+func (_u *SaveSlotUpdate) SetMapWidth(v int) *SaveSlotUpdate {
+	_u.mutation.ResetMapWidth()
+	_u.mutation.SetMapWidth(v)
+	return _u
+}
+
+// SetNillableMapWidth sets the "map_width" field if the given value is not nil.
+func (_u *SaveSlotUpdate) SetNillableMapWidth(v *int) *SaveSlotUpdate {
+	if v != nil {
+		_u.SetMapWidth(*v)
+	}
+	return _u
+}
+
+// AddMapWidth adds value to the "map_width" field.
+func (_u *SaveSlotUpdate) AddMapWidth(v int) *SaveSlotUpdate {
+	_u.mutation.AddMapWidth(v)
+	return _u
+}
+
+// SetMapHeight sets the "map_height" field.
+func (_u *SaveSlotUpdate) SetMapHeight(v int) *SaveSlotUpdate {
+	_u.mutation.ResetMapHeight()
+	_u.mutation.SetMapHeight(v)
+	return _u
+}
+
+// SetNillableMapHeight sets the "map_height" field if the given value is not nil.
+func (_u *SaveSlotUpdate) SetNillableMapHeight(v *int) *SaveSlotUpdate {
+	if v != nil {
+		_u.SetMapHeight(*v)
+	}
+	return _u
+}
+
+// AddMapHeight adds value to the "map_height" field.
+func (_u *SaveSlotUpdate) AddMapHeight(v int) *SaveSlotUpdate {
+	_u.mutation.AddMapHeight(v)
+	return _u
+}
+
+// SetGrid sets the "grid" field.
+func (_u *SaveSlotUpdate) SetGrid(v []byte) *SaveSlotUpdate {
+	_u.mutation.SetGrid(v)
+	return _u
+}
+
+// SetPlayer sets the "player" field.
+func (_u *SaveSlotUpdate) SetPlayer(v sim.Player) *SaveSlotUpdate {
+	_u.mutation.SetPlayer(v)
+	return _u
+}
+
+// SetNillablePlayer sets the "player" field if the given value is not nil.
+func (_u *SaveSlotUpdate) SetNillablePlayer(v *sim.Player) *SaveSlotUpdate {
+	if v != nil {
+		_u.SetPlayer(*v)
+	}
+	return _u
+}
+
+// SetEnemies sets the "enemies" field.
+func (_u *SaveSlotUpdate) SetEnemies(v []sim.Enemy) *SaveSlotUpdate {
+	_u.mutation.SetEnemies(v)
+	return _u
+}
+
+// AppendEnemies appends value to the "enemies" field.
+func (_u *SaveSlotUpdate) AppendEnemies(v []sim.Enemy) *SaveSlotUpdate {
+	_u.mutation.AppendEnemies(v)
+	return _u
+}
+
+// SetEnemyTypes sets the "enemy_types" field.
+func (_u *SaveSlotUpdate) SetEnemyTypes(v []string) *SaveSlotUpdate {
+	_u.mutation.SetEnemyTypes(v)
+	return _u
+}
+
+// AppendEnemyTypes appends value to the "enemy_types" field.
+func (_u *SaveSlotUpdate) AppendEnemyTypes(v []string) *SaveSlotUpdate {
+	_u.mutation.AppendEnemyTypes(v)
+	return _u
+}
+
+// ClearEnemyTypes clears the value of the "enemy_types" field.
+func (_u *SaveSlotUpdate) ClearEnemyTypes() *SaveSlotUpdate {
+	_u.mutation.ClearEnemyTypes()
+	return _u
+}
+
+// SetPickups sets the "pickups" field.
+func (_u *SaveSlotUpdate) SetPickups(v []sim.Pickup) *SaveSlotUpdate {
+	_u.mutation.SetPickups(v)
+	return _u
+}
+
+// AppendPickups appends value to the "pickups" field.
+func (_u *SaveSlotUpdate) AppendPickups(v []sim.Pickup) *SaveSlotUpdate {
+	_u.mutation.AppendPickups(v)
+	return _u
+}
+
+// SetProjectiles sets the "projectiles" field.
+func (_u *SaveSlotUpdate) SetProjectiles(v []sim.Projectile) *SaveSlotUpdate {
+	_u.mutation.SetProjectiles(v)
+	return _u
+}
+
+// AppendProjectiles appends value to the "projectiles" field.
+func (_u *SaveSlotUpdate) AppendProjectiles(v []sim.Projectile) *SaveSlotUpdate {
+	_u.mutation.AppendProjectiles(v)
+	return _u
+}
+
+// SetDoors sets the "doors" field.
+func (_u *SaveSlotUpdate) SetDoors(v []sim.Door) *SaveSlotUpdate {
+	_u.mutation.SetDoors(v)
+	return _u
+}
+
+// AppendDoors appends value to the "doors" field.
+func (_u *SaveSlotUpdate) AppendDoors(v []sim.Door) *SaveSlotUpdate {
+	_u.mutation.AppendDoors(v)
+	return _u
+}
+
+// ClearDoors clears the value of the "doors" field.
+func (_u *SaveSlotUpdate) ClearDoors() *SaveSlotUpdate {
+	_u.mutation.ClearDoors()
+	return _u
+}
+
+// SetSecrets sets the "secrets" field.
+func (_u *SaveSlotUpdate) SetSecrets(v []sim.Secret) *SaveSlotUpdate {
+	_u.mutation.SetSecrets(v)
+	return _u
+}
+
+// AppendSecrets appends value to the "secrets" field.
+func (_u *SaveSlotUpdate) AppendSecrets(v []sim.Secret) *SaveSlotUpdate {
+	_u.mutation.AppendSecrets(v)
+	return _u
+}
+
+// ClearSecrets clears the value of the "secrets" field.
+func (_u *SaveSlotUpdate) ClearSecrets() *SaveSlotUpdate {
+	_u.mutation.ClearSecrets()
+	return _u
+}
+
+// SetBarrels sets the "barrels" field.
+func (_u *SaveSlotUpdate) SetBarrels(v []sim.Barrel) *SaveSlotUpdate {
+	_u.mutation.SetBarrels(v)
+	return _u
+}
+
+// AppendBarrels appends value to the "barrels" field.
+func (_u *SaveSlotUpdate) AppendBarrels(v []sim.Barrel) *SaveSlotUpdate {
+	_u.mutation.AppendBarrels(v)
+	return _u
+}
+
+// ClearBarrels clears the value of the "barrels" field.
+func (_u *SaveSlotUpdate) ClearBarrels() *SaveSlotUpdate {
+	_u.mutation.ClearBarrels()
+	return _u
+}
+
+// SetDefeated sets the "defeated" field.
+func (_u *SaveSlotUpdate) SetDefeated(v int) *SaveSlotUpdate {
+	_u.mutation.ResetDefeated()
+	_u.mutation.SetDefeated(v)
+	return _u
+}
+
+// SetNillableDefeated sets the "defeated" field if the given value is not nil.
+func (_u *SaveSlotUpdate) SetNillableDefeated(v *int) *SaveSlotUpdate {
+	if v != nil {
+		_u.SetDefeated(*v)
+	}
+	return _u
+}
+
+// AddDefeated adds value to the "defeated" field.
+func (_u *SaveSlotUpdate) AddDefeated(v int) *SaveSlotUpdate {
+	_u.mutation.AddDefeated(v)
+	return _u
+}
+
+// SetLevelEnemyTotal sets the "level_enemy_total" field.
+func (_u *SaveSlotUpdate) SetLevelEnemyTotal(v int) *SaveSlotUpdate {
+	_u.mutation.ResetLevelEnemyTotal()
+	_u.mutation.SetLevelEnemyTotal(v)
+	return _u
+}
+
+// SetNillableLevelEnemyTotal sets the "level_enemy_total" field if the given value is not nil.
+func (_u *SaveSlotUpdate) SetNillableLevelEnemyTotal(v *int) *SaveSlotUpdate {
+	if v != nil {
+		_u.SetLevelEnemyTotal(*v)
+	}
+	return _u
+}
+
+// AddLevelEnemyTotal adds value to the "level_enemy_total" field.
+func (_u *SaveSlotUpdate) AddLevelEnemyTotal(v int) *SaveSlotUpdate {
+	_u.mutation.AddLevelEnemyTotal(v)
+	return _u
+}
+
+// SetLevelTime sets the "level_time" field.
+func (_u *SaveSlotUpdate) SetLevelTime(v float64) *SaveSlotUpdate {
+	_u.mutation.ResetLevelTime()
+	_u.mutation.SetLevelTime(v)
+	return _u
+}
+
+// SetNillableLevelTime sets the "level_time" field if the given value is not nil.
+func (_u *SaveSlotUpdate) SetNillableLevelTime(v *float64) *SaveSlotUpdate {
+	if v != nil {
+		_u.SetLevelTime(*v)
+	}
+	return _u
+}
+
+// AddLevelTime adds value to the "level_time" field.
+func (_u *SaveSlotUpdate) AddLevelTime(v float64) *SaveSlotUpdate {
+	_u.mutation.AddLevelTime(v)
+	return _u
+}
+
+// SetCompletion sets the "completion" field.
+func (_u *SaveSlotUpdate) SetCompletion(v int) *SaveSlotUpdate {
+	_u.mutation.ResetCompletion()
+	_u.mutation.SetCompletion(v)
+	return _u
+}
+
+// SetNillableCompletion sets the "completion" field if the given value is not nil.
+func (_u *SaveSlotUpdate) SetNillableCompletion(v *int) *SaveSlotUpdate {
+	if v != nil {
+		_u.SetCompletion(*v)
+	}
+	return _u
+}
+
+// AddCompletion adds value to the "completion" field.
+func (_u *SaveSlotUpdate) AddCompletion(v int) *SaveSlotUpdate {
+	_u.mutation.AddCompletion(v)
+	return _u
+}
+
+// SetFireRate sets the "fire_rate" field.
+func (_u *SaveSlotUpdate) SetFireRate(v float64) *SaveSlotUpdate {
+	_u.mutation.ResetFireRate()
+	_u.mutation.SetFireRate(v)
+	return _u
+}
+
+// SetNillableFireRate sets the "fire_rate" field if the given value is not nil.
+func (_u *SaveSlotUpdate) SetNillableFireRate(v *float64) *SaveSlotUpdate {
+	if v != nil {
+		_u.SetFireRate(*v)
+	}
+	return _u
+}
+
+// AddFireRate adds value to the "fire_rate" field.
+func (_u *SaveSlotUpdate) AddFireRate(v float64) *SaveSlotUpdate {
+	_u.mutation.AddFireRate(v)
+	return _u
+}
+
+// SetBulletSpeed sets the "bullet_speed" field.
+func (_u *SaveSlotUpdate) SetBulletSpeed(v float64) *SaveSlotUpdate {
+	_u.mutation.ResetBulletSpeed()
+	_u.mutation.SetBulletSpeed(v)
+	return _u
+}
+
+// SetNillableBulletSpeed sets the "bullet_speed" field if the given value is not nil.
+func (_u *SaveSlotUpdate) SetNillableBulletSpeed(v *float64) *SaveSlotUpdate {
+	if v != nil {
+		_u.SetBulletSpeed(*v)
+	}
+	return _u
+}
+
+// AddBulletSpeed adds value to the "bullet_speed" field.
+func (_u *SaveSlotUpdate) AddBulletSpeed(v float64) *SaveSlotUpdate {
+	_u.mutation.AddBulletSpeed(v)
+	return _u
+}
+
+// SetRngState sets the "rng_state" field.
+func (_u *SaveSlotUpdate) SetRngState(v int64) *SaveSlotUpdate {
+	_u.mutation.ResetRngState()
+	_u.mutation.SetRngState(v)
+	return _u
+}
+
+// SetNillableRngState sets the "rng_state" field if the given value is not nil.
+func (_u *SaveSlotUpdate) SetNillableRngState(v *int64) *SaveSlotUpdate {
+	if v != nil {
+		_u.SetRngState(*v)
+	}
+	return _u
+}
+
+// AddRngState adds value to the "rng_state" field.
+func (_u *SaveSlotUpdate) AddRngState(v int64) *SaveSlotUpdate {
+	_u.mutation.AddRngState(v)
+	return _u
+}
+
+// SetCampaign sets the "campaign" field.
+func (_u *SaveSlotUpdate) SetCampaign(v string) *SaveSlotUpdate {
+	_u.mutation.SetCampaign(v)
+	return _u
+}
+
+// SetNillableCampaign sets the "campaign" field if the given value is not nil.
+func (_u *SaveSlotUpdate) SetNillableCampaign(v *string) *SaveSlotUpdate {
+	if v != nil {
+		_u.SetCampaign(*v)
+	}
+	return _u
+}
+
+// SetMapName sets the "map_name" field.
+func (_u *SaveSlotUpdate) SetMapName(v string) *SaveSlotUpdate {
+	_u.mutation.SetMapName(v)
+	return _u
+}
+
+// SetNillableMapName sets the "map_name" field if the given value is not nil.
+func (_u *SaveSlotUpdate) SetNillableMapName(v *string) *SaveSlotUpdate {
+	if v != nil {
+		_u.SetMapName(*v)
+	}
+	return _u
+}
+
+// SetPar sets the "par" field.
+func (_u *SaveSlotUpdate) SetPar(v float64) *SaveSlotUpdate {
+	_u.mutation.ResetPar()
+	_u.mutation.SetPar(v)
+	return _u
+}
+
+// SetNillablePar sets the "par" field if the given value is not nil.
+func (_u *SaveSlotUpdate) SetNillablePar(v *float64) *SaveSlotUpdate {
+	if v != nil {
+		_u.SetPar(*v)
+	}
+	return _u
+}
+
+// AddPar adds value to the "par" field.
+func (_u *SaveSlotUpdate) AddPar(v float64) *SaveSlotUpdate {
+	_u.mutation.AddPar(v)
+	return _u
+}
+
+// SetThumbnail sets the "thumbnail" field.
+func (_u *SaveSlotUpdate) SetThumbnail(v []byte) *SaveSlotUpdate {
+	_u.mutation.SetThumbnail(v)
+	return _u
+}
+
+// ClearThumbnail clears the value of the "thumbnail" field.
+func (_u *SaveSlotUpdate) ClearThumbnail() *SaveSlotUpdate {
+	_u.mutation.ClearThumbnail()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *SaveSlotUpdate) SetCreatedAt(v time.Time) *SaveSlotUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *SaveSlotUpdate) SetNillableCreatedAt(v *time.Time) *SaveSlotUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (_u *SaveSlotUpdate) ClearCreatedAt() *SaveSlotUpdate {
+	_u.mutation.ClearCreatedAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *SaveSlotUpdate) SetUpdatedAt(v time.Time) *SaveSlotUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *SaveSlotUpdate) SetNillableUpdatedAt(v *time.Time) *SaveSlotUpdate {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *SaveSlotUpdate) ClearUpdatedAt() *SaveSlotUpdate {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetProfileID sets the "profile" edge to the Profile entity by ID.
+func (_u *SaveSlotUpdate) SetProfileID(id int) *SaveSlotUpdate {
+	_u.mutation.SetProfileID(id)
+	return _u
+}
+
+// SetNillableProfileID sets the "profile" edge to the Profile entity by ID if the given value is not nil.
+func (_u *SaveSlotUpdate) SetNillableProfileID(id *int) *SaveSlotUpdate {
+	if id != nil {
+		_u = _u.SetProfileID(*id)
+	}
+	return _u
+}
+
+// SetProfile sets the "profile" edge to the Profile entity.
+func (_u *SaveSlotUpdate) SetProfile(v *Profile) *SaveSlotUpdate {
+	return _u.SetProfileID(v.ID)
+}
+
+// Mutation returns the SaveSlotMutation object of the builder.
+func (_u *SaveSlotUpdate) Mutation() *SaveSlotMutation {
+	return _u.mutation
+}
+
+// ClearProfile clears the "profile" edge to the Profile entity.
+func (_u *SaveSlotUpdate) ClearProfile() *SaveSlotUpdate {
+	_u.mutation.ClearProfile()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *SaveSlotUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SaveSlotUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *SaveSlotUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SaveSlotUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *SaveSlotUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(saveslot.Table, saveslot.Columns, sqlgraph.NewFieldSpec(saveslot.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Slot(); ok {
+		_spec.SetField(saveslot.FieldSlot, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSlot(); ok {
+		_spec.AddField(saveslot.FieldSlot, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Level(); ok {
+		_spec.SetField(saveslot.FieldLevel, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLevel(); ok {
+		_spec.AddField(saveslot.FieldLevel, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TotalLevels(); ok {
+		_spec.SetField(saveslot.FieldTotalLevels, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTotalLevels(); ok {
+		_spec.AddField(saveslot.FieldTotalLevels, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Seed(); ok {
+		_spec.SetField(saveslot.FieldSeed, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSeed(); ok {
+		_spec.AddField(saveslot.FieldSeed, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.PlayTime(); ok {
+		_spec.SetField(saveslot.FieldPlayTime, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedPlayTime(); ok {
+		_spec.AddField(saveslot.FieldPlayTime, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.MapWidth(); ok {
+		_spec.SetField(saveslot.FieldMapWidth, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMapWidth(); ok {
+		_spec.AddField(saveslot.FieldMapWidth, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.MapHeight(); ok {
+		_spec.SetField(saveslot.FieldMapHeight, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMapHeight(); ok {
+		_spec.AddField(saveslot.FieldMapHeight, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Grid(); ok {
+		_spec.SetField(saveslot.FieldGrid, field.TypeBytes, value)
+	}
+	if value, ok := _u.mutation.Player(); ok {
+		_spec.SetField(saveslot.FieldPlayer, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.Enemies(); ok {
+		_spec.SetField(saveslot.FieldEnemies, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedEnemies(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, saveslot.FieldEnemies, value)
+		})
+	}
+	if value, ok := _u.mutation.EnemyTypes(); ok {
+		_spec.SetField(saveslot.FieldEnemyTypes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedEnemyTypes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, saveslot.FieldEnemyTypes, value)
+		})
+	}
+	if _u.mutation.EnemyTypesCleared() {
+		_spec.ClearField(saveslot.FieldEnemyTypes, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Pickups(); ok {
+		_spec.SetField(saveslot.FieldPickups, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedPickups(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, saveslot.FieldPickups, value)
+		})
+	}
+	if value, ok := _u.mutation.Projectiles(); ok {
+		_spec.SetField(saveslot.FieldProjectiles, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedProjectiles(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, saveslot.FieldProjectiles, value)
+		})
+	}
+	if value, ok := _u.mutation.Doors(); ok {
+		_spec.SetField(saveslot.FieldDoors, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedDoors(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, saveslot.FieldDoors, value)
+		})
+	}
+	if _u.mutation.DoorsCleared() {
+		_spec.ClearField(saveslot.FieldDoors, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Secrets(); ok {
+		_spec.SetField(saveslot.FieldSecrets, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedSecrets(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, saveslot.FieldSecrets, value)
+		})
+	}
+	if _u.mutation.SecretsCleared() {
+		_spec.ClearField(saveslot.FieldSecrets, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Barrels(); ok {
+		_spec.SetField(saveslot.FieldBarrels, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedBarrels(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, saveslot.FieldBarrels, value)
+		})
+	}
+	if _u.mutation.BarrelsCleared() {
+		_spec.ClearField(saveslot.FieldBarrels, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Defeated(); ok {
+		_spec.SetField(saveslot.FieldDefeated, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDefeated(); ok {
+		_spec.AddField(saveslot.FieldDefeated, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LevelEnemyTotal(); ok {
+		_spec.SetField(saveslot.FieldLevelEnemyTotal, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLevelEnemyTotal(); ok {
+		_spec.AddField(saveslot.FieldLevelEnemyTotal, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LevelTime(); ok {
+		_spec.SetField(saveslot.FieldLevelTime, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedLevelTime(); ok {
+		_spec.AddField(saveslot.FieldLevelTime, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Completion(); ok {
+		_spec.SetField(saveslot.FieldCompletion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCompletion(); ok {
+		_spec.AddField(saveslot.FieldCompletion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.FireRate(); ok {
+		_spec.SetField(saveslot.FieldFireRate, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedFireRate(); ok {
+		_spec.AddField(saveslot.FieldFireRate, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.BulletSpeed(); ok {
+		_spec.SetField(saveslot.FieldBulletSpeed, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedBulletSpeed(); ok {
+		_spec.AddField(saveslot.FieldBulletSpeed, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.RngState(); ok {
+		_spec.SetField(saveslot.FieldRngState, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedRngState(); ok {
+		_spec.AddField(saveslot.FieldRngState, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Campaign(); ok {
+		_spec.SetField(saveslot.FieldCampaign, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.MapName(); ok {
+		_spec.SetField(saveslot.FieldMapName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Par(); ok {
+		_spec.SetField(saveslot.FieldPar, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedPar(); ok {
+		_spec.AddField(saveslot.FieldPar, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Thumbnail(); ok {
+		_spec.SetField(saveslot.FieldThumbnail, field.TypeBytes, value)
+	}
+	if _u.mutation.ThumbnailCleared() {
+		_spec.ClearField(saveslot.FieldThumbnail, field.TypeBytes)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(saveslot.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(saveslot.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(saveslot.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(saveslot.FieldUpdatedAt, field.TypeTime)
+	}
+	if _u.mutation.ProfileCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   saveslot.ProfileTable,
+			Columns: []string{saveslot.ProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   saveslot.ProfileTable,
+			Columns: []string{saveslot.ProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{saveslot.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// SaveSlotUpdateOne is the builder for updating a single SaveSlot entity.
+type SaveSlotUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SaveSlotMutation
+}
+
+// SetSlot sets the "slot" field.
+func (_u *SaveSlotUpdateOne) SetSlot(v int) *SaveSlotUpdateOne {
+	_u.mutation.ResetSlot()
+	_u.mutation.SetSlot(v)
+	return _u
+}
+
+// SetNillableSlot sets the "slot" field if the given value is not nil.
+func (_u *SaveSlotUpdateOne) SetNillableSlot(v *int) *SaveSlotUpdateOne {
+	if v != nil {
+		_u.SetSlot(*v)
+	}
+	return _u
+}
+
+// AddSlot adds value to the "slot" field.
+func (_u *SaveSlotUpdateOne) AddSlot(v int) *SaveSlotUpdateOne {
+	_u.mutation.AddSlot(v)
+	return _u
+}
+
+// SetLevel sets the "level" field.
+func (_u *SaveSlotUpdateOne) SetLevel(v int) *SaveSlotUpdateOne {
+	_u.mutation.ResetLevel()
+	_u.mutation.SetLevel(v)
+	return _u
+}
+
+// SetNillableLevel sets the "level" field if the given value is not nil.
+func (_u *SaveSlotUpdateOne) SetNillableLevel(v *int) *SaveSlotUpdateOne {
+	if v != nil {
+		_u.SetLevel(*v)
+	}
+	return _u
+}
+
+// AddLevel adds value to the "level" field.
+func (_u *SaveSlotUpdateOne) AddLevel(v int) *SaveSlotUpdateOne {
+	_u.mutation.AddLevel(v)
+	return _u
+}
+
+// SetTotalLevels sets the "total_levels" field.
+func (_u *SaveSlotUpdateOne) SetTotalLevels(v int) *SaveSlotUpdateOne {
+	_u.mutation.ResetTotalLevels()
+	_u.mutation.SetTotalLevels(v)
+	return _u
+}
+
+// SetNillableTotalLevels sets the "total_levels" field if the given value is not nil.
+func (_u *SaveSlotUpdateOne) SetNillableTotalLevels(v *int) *SaveSlotUpdateOne {
+	if v != nil {
+		_u.SetTotalLevels(*v)
+	}
+	return _u
+}
+
+// AddTotalLevels adds value to the "total_levels" field.
+func (_u *SaveSlotUpdateOne) AddTotalLevels(v int) *SaveSlotUpdateOne {
+	_u.mutation.AddTotalLevels(v)
+	return _u
+}
+
+// SetSeed sets the "seed" field.
+func (_u *SaveSlotUpdateOne) SetSeed(v int64) *SaveSlotUpdateOne {
+	_u.mutation.ResetSeed()
+	_u.mutation.SetSeed(v)
+	return _u
+}
+
+// SetNillableSeed sets the "seed" field if the given value is not nil.
+func (_u *SaveSlotUpdateOne) SetNillableSeed(v *int64) *SaveSlotUpdateOne {
+	if v != nil {
+		_u.SetSeed(*v)
+	}
+	return _u
+}
+
+// AddSeed adds value to the "seed" field.
+func (_u *SaveSlotUpdateOne) AddSeed(v int64) *SaveSlotUpdateOne {
+	_u.mutation.AddSeed(v)
+	return _u
+}
+
+// SetPlayTime sets the "play_time" field.
+func (_u *SaveSlotUpdateOne) SetPlayTime(v float64) *SaveSlotUpdateOne {
+	_u.mutation.ResetPlayTime()
+	_u.mutation.SetPlayTime(v)
+	return _u
+}
+
+// SetNillablePlayTime sets the "play_time" field if the given value is not nil.
+func (_u *SaveSlotUpdateOne) SetNillablePlayTime(v *float64) *SaveSlotUpdateOne {
+	if v != nil {
+		_u.SetPlayTime(*v)
+	}
+	return _u
+}
+
+// AddPlayTime adds value to the "play_time" field.
+func (_u *SaveSlotUpdateOne) AddPlayTime(v float64) *SaveSlotUpdateOne {
+	_u.mutation.AddPlayTime(v)
+	return _u
+}
+
+// SetMapWidth sets the "map_width" field.
+func (_u *SaveSlotUpdateOne) SetMapWidth(v int) *SaveSlotUpdateOne {
+	_u.mutation.ResetMapWidth()
+	_u.mutation.SetMapWidth(v)
+	return _u
+}
+
+// SetNillableMapWidth sets the "map_width" field if the given value is not nil.
+func (_u *SaveSlotUpdateOne) SetNillableMapWidth(v *int) *SaveSlotUpdateOne {
+	if v != nil {
+		_u.SetMapWidth(*v)
+	}
+	return _u
+}
+
+// AddMapWidth adds value to the "map_width" field.
+func (_u *SaveSlotUpdateOne) AddMapWidth(v int) *SaveSlotUpdateOne {
+	_u.mutation.AddMapWidth(v)
+	return _u
+}
+
+// SetMapHeight sets the "map_height" field.
+func (_u *SaveSlotUpdateOne) SetMapHeight(v int) *SaveSlotUpdateOne {
+	_u.mutation.ResetMapHeight()
+	_u.mutation.SetMapHeight(v)
+	return _u
+}
+
+// SetNillableMapHeight sets the "map_height" field if the given value is not nil.
+func (_u *SaveSlotUpdateOne) SetNillableMapHeight(v *int) *SaveSlotUpdateOne {
+	if v != nil {
+		_u.SetMapHeight(*v)
+	}
+	return _u
+}
+
+// AddMapHeight adds value to the "map_height" field.
+func (_u *SaveSlotUpdateOne) AddMapHeight(v int) *SaveSlotUpdateOne {
+	_u.mutation.AddMapHeight(v)
+	return _u
+}
+
+// SetGrid sets the "grid" field.
+func (_u *SaveSlotUpdateOne) SetGrid(v []byte) *SaveSlotUpdateOne {
+	_u.mutation.SetGrid(v)
+	return _u
+}
+
+// SetPlayer sets the "player" field.
+func (_u *SaveSlotUpdateOne) SetPlayer(v sim.Player) *SaveSlotUpdateOne {
+	_u.mutation.SetPlayer(v)
+	return _u
+}
+
+// SetNillablePlayer sets the "player" field if the given value is not nil.
+func (_u *SaveSlotUpdateOne) SetNillablePlayer(v *sim.Player) *SaveSlotUpdateOne {
+	if v != nil {
+		_u.SetPlayer(*v)
+	}
+	return _u
+}
+
+// SetEnemies sets the "enemies" field.
+func (_u *SaveSlotUpdateOne) SetEnemies(v []sim.Enemy) *SaveSlotUpdateOne {
+	_u.mutation.SetEnemies(v)
+	return _u
+}
+
+// AppendEnemies appends value to the "enemies" field.
+func (_u *SaveSlotUpdateOne) AppendEnemies(v []sim.Enemy) *SaveSlotUpdateOne {
+	_u.mutation.AppendEnemies(v)
+	return _u
+}
+
+// SetEnemyTypes sets the "enemy_types" field.
+func (_u *SaveSlotUpdateOne) SetEnemyTypes(v []string) *SaveSlotUpdateOne {
+	_u.mutation.SetEnemyTypes(v)
+	return _u
+}
+
+// AppendEnemyTypes appends value to the "enemy_types" field.
+func (_u *SaveSlotUpdateOne) AppendEnemyTypes(v []string) *SaveSlotUpdateOne {
+	_u.mutation.AppendEnemyTypes(v)
+	return _u
+}
+
+// ClearEnemyTypes clears the value of the "enemy_types" field.
+func (_u *SaveSlotUpdateOne) ClearEnemyTypes() *SaveSlotUpdateOne {
+	_u.mutation.ClearEnemyTypes()
+	return _u
+}
+
+// SetPickups sets the "pickups" field.
+func (_u *SaveSlotUpdateOne) SetPickups(v []sim.Pickup) *SaveSlotUpdateOne {
+	_u.mutation.SetPickups(v)
+	return _u
+}
+
+// AppendPickups appends value to the "pickups" field.
+func (_u *SaveSlotUpdateOne) AppendPickups(v []sim.Pickup) *SaveSlotUpdateOne {
+	_u.mutation.AppendPickups(v)
+	return _u
+}
+
+// SetProjectiles sets the "projectiles" field.
+func (_u *SaveSlotUpdateOne) SetProjectiles(v []sim.Projectile) *SaveSlotUpdateOne {
+	_u.mutation.SetProjectiles(v)
+	return _u
+}
+
+// AppendProjectiles appends value to the "projectiles" field.
+func (_u *SaveSlotUpdateOne) AppendProjectiles(v []sim.Projectile) *SaveSlotUpdateOne {
+	_u.mutation.AppendProjectiles(v)
+	return _u
+}
+
+// SetDoors sets the "doors" field.
+func (_u *SaveSlotUpdateOne) SetDoors(v []sim.Door) *SaveSlotUpdateOne {
+	_u.mutation.SetDoors(v)
+	return _u
+}
+
+// AppendDoors appends value to the "doors" field.
+func (_u *SaveSlotUpdateOne) AppendDoors(v []sim.Door) *SaveSlotUpdateOne {
+	_u.mutation.AppendDoors(v)
+	return _u
+}
+
+// ClearDoors clears the value of the "doors" field.
+func (_u *SaveSlotUpdateOne) ClearDoors() *SaveSlotUpdateOne {
+	_u.mutation.ClearDoors()
+	return _u
+}
+
+// SetSecrets sets the "secrets" field.
+func (_u *SaveSlotUpdateOne) SetSecrets(v []sim.Secret) *SaveSlotUpdateOne {
+	_u.mutation.SetSecrets(v)
+	return _u
+}
+
+// AppendSecrets appends value to the "secrets" field.
+func (_u *SaveSlotUpdateOne) AppendSecrets(v []sim.Secret) *SaveSlotUpdateOne {
+	_u.mutation.AppendSecrets(v)
+	return _u
+}
+
+// ClearSecrets clears the value of the "secrets" field.
+func (_u *SaveSlotUpdateOne) ClearSecrets() *SaveSlotUpdateOne {
+	_u.mutation.ClearSecrets()
+	return _u
+}
+
+// SetBarrels sets the "barrels" field.
+func (_u *SaveSlotUpdateOne) SetBarrels(v []sim.Barrel) *SaveSlotUpdateOne {
+	_u.mutation.SetBarrels(v)
+	return _u
+}
+
+// AppendBarrels appends value to the "barrels" field.
+func (_u *SaveSlotUpdateOne) AppendBarrels(v []sim.Barrel) *SaveSlotUpdateOne {
+	_u.mutation.AppendBarrels(v)
+	return _u
+}
+
+// ClearBarrels clears the value of the "barrels" field.
+func (_u *SaveSlotUpdateOne) ClearBarrels() *SaveSlotUpdateOne {
+	_u.mutation.ClearBarrels()
+	return _u
+}
+
+// SetDefeated sets the "defeated" field.
+func (_u *SaveSlotUpdateOne) SetDefeated(v int) *SaveSlotUpdateOne {
+	_u.mutation.ResetDefeated()
+	_u.mutation.SetDefeated(v)
+	return _u
+}
+
+// SetNillableDefeated sets the "defeated" field if the given value is not nil.
+func (_u *SaveSlotUpdateOne) SetNillableDefeated(v *int) *SaveSlotUpdateOne {
+	if v != nil {
+		_u.SetDefeated(*v)
+	}
+	return _u
+}
+
+// AddDefeated adds value to the "defeated" field.
+func (_u *SaveSlotUpdateOne) AddDefeated(v int) *SaveSlotUpdateOne {
+	_u.mutation.AddDefeated(v)
+	return _u
+}
+
+// SetLevelEnemyTotal sets the "level_enemy_total" field.
+func (_u *SaveSlotUpdateOne) SetLevelEnemyTotal(v int) *SaveSlotUpdateOne {
+	_u.mutation.ResetLevelEnemyTotal()
+	_u.mutation.SetLevelEnemyTotal(v)
+	return _u
+}
+
+// SetNillableLevelEnemyTotal sets the "level_enemy_total" field if the given value is not nil.
+func (_u *SaveSlotUpdateOne) SetNillableLevelEnemyTotal(v *int) *SaveSlotUpdateOne {
+	if v != nil {
+		_u.SetLevelEnemyTotal(*v)
+	}
+	return _u
+}
+
+// AddLevelEnemyTotal adds value to the "level_enemy_total" field.
+func (_u *SaveSlotUpdateOne) AddLevelEnemyTotal(v int) *SaveSlotUpdateOne {
+	_u.mutation.AddLevelEnemyTotal(v)
+	return _u
+}
+
+// SetLevelTime sets the "level_time" field.
+func (_u *SaveSlotUpdateOne) SetLevelTime(v float64) *SaveSlotUpdateOne {
+	_u.mutation.ResetLevelTime()
+	_u.mutation.SetLevelTime(v)
+	return _u
+}
+
+// SetNillableLevelTime sets the "level_time" field if the given value is not nil.
+func (_u *SaveSlotUpdateOne) SetNillableLevelTime(v *float64) *SaveSlotUpdateOne {
+	if v != nil {
+		_u.SetLevelTime(*v)
+	}
+	return _u
+}
+
+// AddLevelTime adds value to the "level_time" field.
+func (_u *SaveSlotUpdateOne) AddLevelTime(v float64) *SaveSlotUpdateOne {
+	_u.mutation.AddLevelTime(v)
+	return _u
+}
+
+// SetCompletion sets the "completion" field.
+func (_u *SaveSlotUpdateOne) SetCompletion(v int) *SaveSlotUpdateOne {
+	_u.mutation.ResetCompletion()
+	_u.mutation.SetCompletion(v)
+	return _u
+}
+
+// SetNillableCompletion sets the "completion" field if the given value is not nil.
+func (_u *SaveSlotUpdateOne) SetNillableCompletion(v *int) *SaveSlotUpdateOne {
+	if v != nil {
+		_u.SetCompletion(*v)
+	}
+	return _u
+}
+
+// AddCompletion adds value to the "completion" field.
+func (_u *SaveSlotUpdateOne) AddCompletion(v int) *SaveSlotUpdateOne {
+	_u.mutation.AddCompletion(v)
+	return _u
+}
+
+// SetFireRate sets the "fire_rate" field.
+func (_u *SaveSlotUpdateOne) SetFireRate(v float64) *SaveSlotUpdateOne {
+	_u.mutation.ResetFireRate()
+	_u.mutation.SetFireRate(v)
+	return _u
+}
+
+// SetNillableFireRate sets the "fire_rate" field if the given value is not nil.
+func (_u *SaveSlotUpdateOne) SetNillableFireRate(v *float64) *SaveSlotUpdateOne {
+	if v != nil {
+		_u.SetFireRate(*v)
+	}
+	return _u
+}
+
+// AddFireRate adds value to the "fire_rate" field.
+func (_u *SaveSlotUpdateOne) AddFireRate(v float64) *SaveSlotUpdateOne {
+	_u.mutation.AddFireRate(v)
+	return _u
+}
+
+// SetBulletSpeed sets the "bullet_speed" field.
+func (_u *SaveSlotUpdateOne) SetBulletSpeed(v float64) *SaveSlotUpdateOne {
+	_u.mutation.ResetBulletSpeed()
+	_u.mutation.SetBulletSpeed(v)
+	return _u
+}
+
+// SetNillableBulletSpeed sets the "bullet_speed" field if the given value is not nil.
+func (_u *SaveSlotUpdateOne) SetNillableBulletSpeed(v *float64) *SaveSlotUpdateOne {
+	if v != nil {
+		_u.SetBulletSpeed(*v)
+	}
+	return _u
+}
+
+// AddBulletSpeed adds value to the "bullet_speed" field.
+func (_u *SaveSlotUpdateOne) AddBulletSpeed(v float64) *SaveSlotUpdateOne {
+	_u.mutation.AddBulletSpeed(v)
+	return _u
+}
+
+// SetRngState sets the "rng_state" field.
+func (_u *SaveSlotUpdateOne) SetRngState(v int64) *SaveSlotUpdateOne {
+	_u.mutation.ResetRngState()
+	_u.mutation.SetRngState(v)
+	return _u
+}
+
+// SetNillableRngState sets the "rng_state" field if the given value is not nil.
+func (_u *SaveSlotUpdateOne) SetNillableRngState(v *int64) *SaveSlotUpdateOne {
+	if v != nil {
+		_u.SetRngState(*v)
+	}
+	return _u
+}
+
+// AddRngState adds value to the "rng_state" field.
+func (_u *SaveSlotUpdateOne) AddRngState(v int64) *SaveSlotUpdateOne {
+	_u.mutation.AddRngState(v)
+	return _u
+}
+
+// SetCampaign sets the "campaign" field.
+func (_u *SaveSlotUpdateOne) SetCampaign(v string) *SaveSlotUpdateOne {
+	_u.mutation.SetCampaign(v)
+	return _u
+}
+
+// SetNillableCampaign sets the "campaign" field if the given value is not nil.
+func (_u *SaveSlotUpdateOne) SetNillableCampaign(v *string) *SaveSlotUpdateOne {
+	if v != nil {
+		_u.SetCampaign(*v)
+	}
+	return _u
+}
+
+// SetMapName sets the "map_name" field.
+func (_u *SaveSlotUpdateOne) SetMapName(v string) *SaveSlotUpdateOne {
+	_u.mutation.SetMapName(v)
+	return _u
+}
+
+// SetNillableMapName sets the "map_name" field if the given value is not nil.
+func (_u *SaveSlotUpdateOne) SetNillableMapName(v *string) *SaveSlotUpdateOne {
+	if v != nil {
+		_u.SetMapName(*v)
+	}
+	return _u
+}
+
+// SetPar sets the "par" field.
+func (_u *SaveSlotUpdateOne) SetPar(v float64) *SaveSlotUpdateOne {
+	_u.mutation.ResetPar()
+	_u.mutation.SetPar(v)
+	return _u
+}
+
+// SetNillablePar sets the "par" field if the given value is not nil.
+func (_u *SaveSlotUpdateOne) SetNillablePar(v *float64) *SaveSlotUpdateOne {
+	if v != nil {
+		_u.SetPar(*v)
+	}
+	return _u
+}
+
+// AddPar adds value to the "par" field.
+func (_u *SaveSlotUpdateOne) AddPar(v float64) *SaveSlotUpdateOne {
+	_u.mutation.AddPar(v)
+	return _u
+}
+
+// SetThumbnail sets the "thumbnail" field.
+func (_u *SaveSlotUpdateOne) SetThumbnail(v []byte) *SaveSlotUpdateOne {
+	_u.mutation.SetThumbnail(v)
+	return _u
+}
+
+// ClearThumbnail clears the value of the "thumbnail" field.
+func (_u *SaveSlotUpdateOne) ClearThumbnail() *SaveSlotUpdateOne {
+	_u.mutation.ClearThumbnail()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *SaveSlotUpdateOne) SetCreatedAt(v time.Time) *SaveSlotUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *SaveSlotUpdateOne) SetNillableCreatedAt(v *time.Time) *SaveSlotUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (_u *SaveSlotUpdateOne) ClearCreatedAt() *SaveSlotUpdateOne {
+	_u.mutation.ClearCreatedAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *SaveSlotUpdateOne) SetUpdatedAt(v time.Time) *SaveSlotUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *SaveSlotUpdateOne) SetNillableUpdatedAt(v *time.Time) *SaveSlotUpdateOne {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *SaveSlotUpdateOne) ClearUpdatedAt() *SaveSlotUpdateOne {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetProfileID sets the "profile" edge to the Profile entity by ID.
+func (_u *SaveSlotUpdateOne) SetProfileID(id int) *SaveSlotUpdateOne {
+	_u.mutation.SetProfileID(id)
+	return _u
+}
+
+// SetNillableProfileID sets the "profile" edge to the Profile entity by ID if the given value is not nil.
+func (_u *SaveSlotUpdateOne) SetNillableProfileID(id *int) *SaveSlotUpdateOne {
+	if id != nil {
+		_u = _u.SetProfileID(*id)
+	}
+	return _u
+}
+
+// SetProfile sets the "profile" edge to the Profile entity.
+func (_u *SaveSlotUpdateOne) SetProfile(v *Profile) *SaveSlotUpdateOne {
+	return _u.SetProfileID(v.ID)
+}
+
+// Mutation returns the SaveSlotMutation object of the builder.
+func (_u *SaveSlotUpdateOne) Mutation() *SaveSlotMutation {
+	return _u.mutation
+}
+
+// ClearProfile clears the "profile" edge to the Profile entity.
+func (_u *SaveSlotUpdateOne) ClearProfile() *SaveSlotUpdateOne {
+	_u.mutation.ClearProfile()
+	return _u
+}
+
+// Where appends a list predicates to the SaveSlotUpdate builder.
+func (_u *SaveSlotUpdateOne) Where(ps ...predicate.SaveSlot) *SaveSlotUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *SaveSlotUpdateOne) Select(field string, fields ...string) *SaveSlotUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated SaveSlot entity.
+func (_u *SaveSlotUpdateOne) Save(ctx context.Context) (*SaveSlot, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SaveSlotUpdateOne) SaveX(ctx context.Context) *SaveSlot {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *SaveSlotUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SaveSlotUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *SaveSlotUpdateOne) sqlSave(ctx context.Context) (_node *SaveSlot, err error) {
+	_spec := sqlgraph.NewUpdateSpec(saveslot.Table, saveslot.Columns, sqlgraph.NewFieldSpec(saveslot.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SaveSlot.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, saveslot.FieldID)
+		for _, f := range fields {
+			if !saveslot.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != saveslot.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Slot(); ok {
+		_spec.SetField(saveslot.FieldSlot, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSlot(); ok {
+		_spec.AddField(saveslot.FieldSlot, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Level(); ok {
+		_spec.SetField(saveslot.FieldLevel, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLevel(); ok {
+		_spec.AddField(saveslot.FieldLevel, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TotalLevels(); ok {
+		_spec.SetField(saveslot.FieldTotalLevels, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTotalLevels(); ok {
+		_spec.AddField(saveslot.FieldTotalLevels, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Seed(); ok {
+		_spec.SetField(saveslot.FieldSeed, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSeed(); ok {
+		_spec.AddField(saveslot.FieldSeed, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.PlayTime(); ok {
+		_spec.SetField(saveslot.FieldPlayTime, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedPlayTime(); ok {
+		_spec.AddField(saveslot.FieldPlayTime, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.MapWidth(); ok {
+		_spec.SetField(saveslot.FieldMapWidth, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMapWidth(); ok {
+		_spec.AddField(saveslot.FieldMapWidth, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.MapHeight(); ok {
+		_spec.SetField(saveslot.FieldMapHeight, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMapHeight(); ok {
+		_spec.AddField(saveslot.FieldMapHeight, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Grid(); ok {
+		_spec.SetField(saveslot.FieldGrid, field.TypeBytes, value)
+	}
+	if value, ok := _u.mutation.Player(); ok {
+		_spec.SetField(saveslot.FieldPlayer, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.Enemies(); ok {
+		_spec.SetField(saveslot.FieldEnemies, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedEnemies(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, saveslot.FieldEnemies, value)
+		})
+	}
+	if value, ok := _u.mutation.EnemyTypes(); ok {
+		_spec.SetField(saveslot.FieldEnemyTypes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedEnemyTypes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, saveslot.FieldEnemyTypes, value)
+		})
+	}
+	if _u.mutation.EnemyTypesCleared() {
+		_spec.ClearField(saveslot.FieldEnemyTypes, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Pickups(); ok {
+		_spec.SetField(saveslot.FieldPickups, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedPickups(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, saveslot.FieldPickups, value)
+		})
+	}
+	if value, ok := _u.mutation.Projectiles(); ok {
+		_spec.SetField(saveslot.FieldProjectiles, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedProjectiles(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, saveslot.FieldProjectiles, value)
+		})
+	}
+	if value, ok := _u.mutation.Doors(); ok {
+		_spec.SetField(saveslot.FieldDoors, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedDoors(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, saveslot.FieldDoors, value)
+		})
+	}
+	if _u.mutation.DoorsCleared() {
+		_spec.ClearField(saveslot.FieldDoors, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Secrets(); ok {
+		_spec.SetField(saveslot.FieldSecrets, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedSecrets(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, saveslot.FieldSecrets, value)
+		})
+	}
+	if _u.mutation.SecretsCleared() {
+		_spec.ClearField(saveslot.FieldSecrets, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Barrels(); ok {
+		_spec.SetField(saveslot.FieldBarrels, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedBarrels(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, saveslot.FieldBarrels, value)
+		})
+	}
+	if _u.mutation.BarrelsCleared() {
+		_spec.ClearField(saveslot.FieldBarrels, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Defeated(); ok {
+		_spec.SetField(saveslot.FieldDefeated, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDefeated(); ok {
+		_spec.AddField(saveslot.FieldDefeated, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LevelEnemyTotal(); ok {
+		_spec.SetField(saveslot.FieldLevelEnemyTotal, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLevelEnemyTotal(); ok {
+		_spec.AddField(saveslot.FieldLevelEnemyTotal, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LevelTime(); ok {
+		_spec.SetField(saveslot.FieldLevelTime, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedLevelTime(); ok {
+		_spec.AddField(saveslot.FieldLevelTime, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Completion(); ok {
+		_spec.SetField(saveslot.FieldCompletion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCompletion(); ok {
+		_spec.AddField(saveslot.FieldCompletion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.FireRate(); ok {
+		_spec.SetField(saveslot.FieldFireRate, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedFireRate(); ok {
+		_spec.AddField(saveslot.FieldFireRate, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.BulletSpeed(); ok {
+		_spec.SetField(saveslot.FieldBulletSpeed, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedBulletSpeed(); ok {
+		_spec.AddField(saveslot.FieldBulletSpeed, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.RngState(); ok {
+		_spec.SetField(saveslot.FieldRngState, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedRngState(); ok {
+		_spec.AddField(saveslot.FieldRngState, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Campaign(); ok {
+		_spec.SetField(saveslot.FieldCampaign, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.MapName(); ok {
+		_spec.SetField(saveslot.FieldMapName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Par(); ok {
+		_spec.SetField(saveslot.FieldPar, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedPar(); ok {
+		_spec.AddField(saveslot.FieldPar, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Thumbnail(); ok {
+		_spec.SetField(saveslot.FieldThumbnail, field.TypeBytes, value)
+	}
+	if _u.mutation.ThumbnailCleared() {
+		_spec.ClearField(saveslot.FieldThumbnail, field.TypeBytes)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(saveslot.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(saveslot.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(saveslot.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(saveslot.FieldUpdatedAt, field.TypeTime)
+	}
+	if _u.mutation.ProfileCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   saveslot.ProfileTable,
+			Columns: []string{saveslot.ProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   saveslot.ProfileTable,
+			Columns: []string{saveslot.ProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &SaveSlot{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{saveslot.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -1,7 +1,10 @@
 package schema
 
 import (
+	"doomlike/internal/sim"
+
 	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
 )
@@ -18,14 +21,44 @@ func (GameSettings) Fields() []ent.Field {
 			Default("default").
 			Comment("Settings ID - using 'default' for main settings"),
 		field.Float("fire_rate").
-			Default(0.08).
+			Default(sim.DefaultFireRate).
 			Comment("Fire rate in seconds between shots"),
 		field.Float("bullet_speed").
-			Default(22.0).
+			Default(sim.DefaultBulletSpeed).
 			Comment("Bullet speed multiplier"),
 		field.Int("level_count").
 			Default(5).
 			Comment("Number of levels to play"),
+		field.Int64("seed").
+			Default(0).
+			Comment("Run seed for level generation (0 = random each run)"),
+		field.Float("mouse_sensitivity").
+			Default(0.002).
+			Comment("Turn in radians per pixel of mouse movement"),
+		field.Bool("invert_mouse").
+			Default(false).
+			Comment("Turn the other way for horizontal mouse movement"),
+		field.Float("turn_speed").
+			Default(2.6).
+			Comment("Keyboard turn speed in radians per second"),
+		field.Float("fov").
+			Default(75).
+			Comment("Horizontal field of view in degrees"),
+		field.Int("render_width").
+			Default(640).
+			Comment("Width of the internal render resolution"),
+		field.Int("render_height").
+			Default(400).
+			Comment("Height of the internal render resolution"),
+		field.Float("gamepad_turn_speed").
+			Default(3).
+			Comment("Right stick turn speed at full deflection, in radians per second"),
+		field.Float("gamepad_deadzone").
+			Default(0.15).
+			Comment("Stick deflection below which a gamepad stick reads as centred"),
+		field.Float("gamepad_curve").
+			Default(1.5).
+			Comment("Exponent of the stick response curve; 1 is linear"),
 		field.Time("created_at").
 			Optional().
 			Comment("When these settings were created"),
@@ -37,7 +70,12 @@ func (GameSettings) Fields() []ent.Field {
 
 // Edges of the GameSettings.
 func (GameSettings) Edges() []ent.Edge {
-	return nil
+	return []ent.Edge{
+		edge.From("profile", Profile.Type).
+			Ref("settings").
+			Unique().
+			Comment("Profile the settings belong to, unset for settings kept from before profiles"),
+	}
 }
 
 // Indexes of the GameSettings.
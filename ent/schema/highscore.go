@@ -0,0 +1,56 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// HighScore holds the schema definition for the HighScore entity.
+type HighScore struct {
+	ent.Schema
+}
+
+// Fields of the HighScore.
+func (HighScore) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			MaxLen(16).
+			Comment("Name the player entered for the score"),
+		field.Int("score").
+			Comment("Final score of the run"),
+		field.Int64("seed").
+			Comment("Run seed the levels were generated from"),
+		field.Int("level").
+			Comment("Level the run ended on"),
+		field.Int("total_levels").
+			Comment("Number of levels in the run"),
+		field.Bool("won").
+			Default(false).
+			Comment("Whether the run cleared its last level"),
+		field.Float("fire_rate").
+			Comment("Fire rate in effect for the run"),
+		field.Float("bullet_speed").
+			Comment("Bullet speed in effect for the run"),
+		field.Time("created_at").
+			Comment("When the score was recorded"),
+	}
+}
+
+// Edges of the HighScore.
+func (HighScore) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("profile", Profile.Type).
+			Ref("high_scores").
+			Unique().
+			Comment("Profile that set the score"),
+	}
+}
+
+// Indexes of the HighScore.
+func (HighScore) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("score"),
+	}
+}
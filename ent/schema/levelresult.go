@@ -0,0 +1,57 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// LevelResult holds the schema definition for the LevelResult entity.
+type LevelResult struct {
+	ent.Schema
+}
+
+// Fields of the LevelResult.
+func (LevelResult) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("level").
+			Comment("Level number within the run"),
+		field.String("map_name").
+			Default("").
+			Comment("Name of the authored map, empty for generated levels"),
+		field.Bool("cleared").
+			Comment("Whether the level was cleared rather than died on or quit"),
+		field.JSON("kills", map[string]int{}).
+			Comment("Enemies killed by type name"),
+		field.Int("enemy_total").
+			Comment("Enemies the level started with"),
+		field.Int("shots").
+			Comment("Rounds the player fired"),
+		field.Int("hits").
+			Comment("Rounds that struck an enemy"),
+		field.Int("damage_dealt").
+			Comment("Damage done to enemies"),
+		field.Int("damage_taken").
+			Comment("Health the player lost"),
+		field.Int("pickups").
+			Comment("Pickups collected, keycards and enemy drops included"),
+		field.Float("time").
+			Comment("Seconds spent on the level"),
+		field.Float("par").
+			Default(0).
+			Comment("Par time of the level in seconds"),
+		field.Int("score").
+			Comment("Run score when the level ended"),
+	}
+}
+
+// Edges of the LevelResult.
+func (LevelResult) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("run", Run.Type).
+			Ref("levels").
+			Unique().
+			Required().
+			Comment("Run the level was played in"),
+	}
+}
@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Profile holds the schema definition for the Profile entity.
+type Profile struct {
+	ent.Schema
+}
+
+// Fields of the Profile.
+func (Profile) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			NotEmpty().
+			MaxLen(16).
+			Unique().
+			Comment("Name shown on the profile screen"),
+		field.Time("created_at").
+			Comment("When the profile was created"),
+		field.Time("last_used_at").
+			Comment("When the profile was last picked; the latest is used on startup"),
+		field.JSON("key_bindings", map[string][]string{}).
+			Optional().
+			Comment("Key and mouse button names by action; actions left out use their defaults"),
+	}
+}
+
+// Edges of the Profile.
+func (Profile) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("settings", GameSettings.Type).
+			Unique().
+			Comment("Game settings of the profile"),
+		edge.To("high_scores", HighScore.Type).
+			Comment("High scores set by the profile"),
+		edge.To("runs", Run.Type).
+			Comment("Runs played by the profile"),
+		edge.To("saves", SaveSlot.Type).
+			Comment("Save slots of the profile"),
+	}
+}
@@ -0,0 +1,68 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Run holds the schema definition for the Run entity.
+type Run struct {
+	ent.Schema
+}
+
+// Fields of the Run.
+func (Run) Fields() []ent.Field {
+	return []ent.Field{
+		field.Time("started_at").
+			Comment("When the run began, or was picked up from a save"),
+		field.Time("ended_at").
+			Optional().
+			Nillable().
+			Comment("When the run ended; unset while it is being played"),
+		field.Int64("seed").
+			Comment("Run seed the levels are generated from"),
+		field.Int("total_levels").
+			Comment("Number of levels in the run"),
+		field.Float("fire_rate").
+			Comment("Fire rate in effect for the run"),
+		field.Float("bullet_speed").
+			Comment("Bullet speed in effect for the run"),
+		field.String("campaign").
+			Default("").
+			Comment("Campaign manifest the run was started from, empty for procedural runs"),
+		field.Bool("resumed").
+			Default(false).
+			Comment("Whether the run was continued from a save slot"),
+		field.Enum("outcome").
+			Values("playing", "win", "death", "quit").
+			Default("playing").
+			Comment("How the run ended"),
+		field.Int("score").
+			Default(0).
+			Comment("Score when the run ended"),
+		field.Int("cleared_levels").
+			Default(0).
+			Comment("Levels cleared during the run"),
+	}
+}
+
+// Edges of the Run.
+func (Run) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("levels", LevelResult.Type).
+			Comment("One result for every level played, cleared or not"),
+		edge.From("profile", Profile.Type).
+			Ref("runs").
+			Unique().
+			Comment("Profile that played the run"),
+	}
+}
+
+// Indexes of the Run.
+func (Run) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("started_at"),
+	}
+}
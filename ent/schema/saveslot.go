@@ -0,0 +1,112 @@
+package schema
+
+import (
+	"doomlike/internal/sim"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// SaveSlot holds the schema definition for the SaveSlot entity.
+type SaveSlot struct {
+	ent.Schema
+}
+
+// Fields of the SaveSlot.
+func (SaveSlot) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("slot").
+			Comment("Save slot number shown in the menus (1-based)"),
+		field.Int("level").
+			Comment("Level the run was on when saved"),
+		field.Int("total_levels").
+			Comment("Number of levels in the run"),
+		field.Int64("seed").
+			Comment("Run seed the levels are generated from"),
+		field.Float("play_time").
+			Default(0).
+			Comment("Seconds of play simulated in the run"),
+		field.Int("map_width").
+			Comment("Width of the level grid in tiles"),
+		field.Int("map_height").
+			Comment("Height of the level grid in tiles"),
+		field.Bytes("grid").
+			Comment("Level tiles, one byte per tile in row-major order"),
+		field.JSON("player", sim.Player{}).
+			Comment("Player position, facing and stats"),
+		field.JSON("enemies", []sim.Enemy{}).
+			Comment("Every enemy on the level, alive or dead"),
+		field.JSON("enemy_types", []string{}).
+			Optional().
+			Comment("Enemy definition names indexed by the enemies' types, so a save survives definitions changing"),
+		field.JSON("pickups", []sim.Pickup{}).
+			Comment("Every pickup on the level, taken or not"),
+		field.JSON("projectiles", []sim.Projectile{}).
+			Comment("Projectiles in flight"),
+		field.JSON("doors", []sim.Door{}).
+			Optional().
+			Comment("Every door on the level with its key and how far open it is"),
+		field.JSON("secrets", []sim.Secret{}).
+			Optional().
+			Comment("Secret tiles on the level and whether each was found"),
+		field.JSON("barrels", []sim.Barrel{}).
+			Optional().
+			Comment("Explosive barrels on the level, standing, lit or blown up"),
+		field.Int("defeated").
+			Default(0).
+			Comment("Enemies defeated this run"),
+		field.Int("level_enemy_total").
+			Default(0).
+			Comment("Enemies the level started with"),
+		field.Float("level_time").
+			Default(0).
+			Comment("Seconds spent on the current level"),
+		field.Int("completion").
+			Default(0).
+			Comment("Completion rule of the current level (exit, kills or both)"),
+		field.Float("fire_rate").
+			Comment("Fire rate in effect for the run"),
+		field.Float("bullet_speed").
+			Comment("Bullet speed in effect for the run"),
+		field.Int64("rng_state").
+			Comment("State of the gameplay random generator, bit-cast from its uint64 since SQLite integers are signed"),
+		field.String("campaign").
+			Default("").
+			Comment("Campaign manifest the run was started from, empty for procedural runs"),
+		field.String("map_name").
+			Default("").
+			Comment("Name of the authored map being played, if any"),
+		field.Float("par").
+			Default(0).
+			Comment("Par time of the authored map in seconds"),
+		field.Bytes("thumbnail").
+			Optional().
+			Comment("PNG thumbnail of the frame when the game was saved"),
+		field.Time("created_at").
+			Optional().
+			Comment("When this slot was first written"),
+		field.Time("updated_at").
+			Optional().
+			Comment("When this slot was last written"),
+	}
+}
+
+// Edges of the SaveSlot.
+func (SaveSlot) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("profile", Profile.Type).
+			Ref("saves").
+			Unique().
+			Comment("Profile the run was saved by"),
+	}
+}
+
+// Indexes of the SaveSlot.
+func (SaveSlot) Indexes() []ent.Index {
+	return []ent.Index{
+		// Every profile has its own set of slots
+		index.Fields("slot").Edges("profile").Unique(),
+	}
+}
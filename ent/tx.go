@@ -14,6 +14,16 @@ type Tx struct {
 	config
 	// GameSettings is the client for interacting with the GameSettings builders.
 	GameSettings *GameSettingsClient
+	// HighScore is the client for interacting with the HighScore builders.
+	HighScore *HighScoreClient
+	// LevelResult is the client for interacting with the LevelResult builders.
+	LevelResult *LevelResultClient
+	// Profile is the client for interacting with the Profile builders.
+	Profile *ProfileClient
+	// Run is the client for interacting with the Run builders.
+	Run *RunClient
+	// SaveSlot is the client for interacting with the SaveSlot builders.
+	SaveSlot *SaveSlotClient
 
 	// lazily loaded.
 	client     *Client
@@ -146,6 +156,11 @@ func (tx *Tx) Client() *Client {
 
 func (tx *Tx) init() {
 	tx.GameSettings = NewGameSettingsClient(tx.config)
+	tx.HighScore = NewHighScoreClient(tx.config)
+	tx.LevelResult = NewLevelResultClient(tx.config)
+	tx.Profile = NewProfileClient(tx.config)
+	tx.Run = NewRunClient(tx.config)
+	tx.SaveSlot = NewSaveSlotClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
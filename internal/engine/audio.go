@@ -3,6 +3,8 @@ package engine
 import (
 	"math"
 
+	"doomlike/internal/sim"
+
 	"github.com/hajimehoshi/ebiten/v2/audio"
 )
 
@@ -196,6 +198,180 @@ func generateOneUpSound(sampleRate int) []byte {
 	return data
 }
 
+// generateDoorSound creates a grinding slide for doors opening
+func generateDoorSound(sampleRate int) []byte {
+	const duration = 0.6 // 600ms, roughly how long a door takes to open
+	samples := int(float64(sampleRate) * duration)
+	data := make([]byte, samples*2) // 16-bit audio
+
+	for i := 0; i < samples; i++ {
+		t := float64(i) / float64(sampleRate)
+
+		// Low motor hum that rises slightly as the slab speeds up
+		humFreq := 70.0 + 20.0*t // 70-82 Hz
+		hum := math.Sin(t * humFreq * 2 * math.Pi)
+
+		// Rattle of the slab in its track
+		rattle := math.Sin(t*180*2*math.Pi) * (0.5 + 0.5*math.Sin(t*40*2*math.Pi))
+
+		// Combine components
+		noise := 0.6*hum + 0.4*rattle
+
+		// Envelope: soft attack, sustained, clunk at the end
+		envelope := 1.0
+		if t < 0.08 {
+			envelope = t / 0.08 // Soft attack
+		} else if t > 0.5 {
+			envelope = (duration - t) / 0.1 // Quick decay
+		}
+
+		// Convert to 16-bit PCM
+		sample := int16(noise * envelope * 9000)
+		data[i*2] = byte(sample)
+		data[i*2+1] = byte(sample >> 8)
+	}
+
+	return data
+}
+
+// generateLockedSound creates a short buzzer for doors the player has no key for
+func generateLockedSound(sampleRate int) []byte {
+	const duration = 0.25 // 250ms
+	samples := int(float64(sampleRate) * duration)
+	data := make([]byte, samples*2) // 16-bit audio
+
+	for i := 0; i < samples; i++ {
+		t := float64(i) / float64(sampleRate)
+
+		// Two detuned square-ish tones give a harsh buzz
+		buzz := math.Copysign(1, math.Sin(t*110*2*math.Pi)) + math.Copysign(1, math.Sin(t*116*2*math.Pi))
+
+		// Envelope: flat with a quick fade out
+		envelope := 1.0
+		if t > 0.2 {
+			envelope = (duration - t) / 0.05
+		}
+
+		// Convert to 16-bit PCM
+		sample := int16(buzz * 0.5 * envelope * 5000)
+		data[i*2] = byte(sample)
+		data[i*2+1] = byte(sample >> 8)
+	}
+
+	return data
+}
+
+// noiseSample returns a repeatable white noise value in -1..1 for sample i
+func noiseSample(i int) float64 {
+	x := uint32(i)*1103515245 + 12345
+	x ^= x >> 13
+	x *= 0x5bd1e995
+	x ^= x >> 15
+	return float64(x)/float64(math.MaxUint32)*2 - 1
+}
+
+// generateShotgunSound creates a heavy blast with a boomy low end
+func generateShotgunSound(sampleRate int) []byte {
+	const duration = 0.25 // 250ms
+	samples := int(float64(sampleRate) * duration)
+	data := make([]byte, samples*2) // 16-bit audio
+
+	for i := 0; i < samples; i++ {
+		t := float64(i) / float64(sampleRate)
+
+		// Noise burst for the blast and a falling low tone for the boom
+		blast := noiseSample(i) * math.Exp(-t*25)
+		boom := math.Sin(t*(90-120*t)*2*math.Pi) * math.Exp(-t*10)
+
+		// Convert to 16-bit PCM
+		sample := int16((0.6*blast + 0.6*boom) * 16000)
+		data[i*2] = byte(sample)
+		data[i*2+1] = byte(sample >> 8)
+	}
+
+	return data
+}
+
+// generateChaingunSound creates a short, sharp crack that can repeat quickly
+func generateChaingunSound(sampleRate int) []byte {
+	const duration = 0.06 // 60ms
+	samples := int(float64(sampleRate) * duration)
+	data := make([]byte, samples*2) // 16-bit audio
+
+	for i := 0; i < samples; i++ {
+		t := float64(i) / float64(sampleRate)
+
+		// Noise crack over a mid tone, both dying off fast
+		crack := noiseSample(i) * math.Exp(-t*60)
+		tone := math.Sin(t*700*2*math.Pi) * math.Exp(-t*40)
+
+		// Convert to 16-bit PCM
+		sample := int16((0.6*crack + 0.4*tone) * 14000)
+		data[i*2] = byte(sample)
+		data[i*2+1] = byte(sample >> 8)
+	}
+
+	return data
+}
+
+// generateRocketSound creates the whoosh of a rocket leaving the launcher
+func generateRocketSound(sampleRate int) []byte {
+	const duration = 0.35 // 350ms
+	samples := int(float64(sampleRate) * duration)
+	data := make([]byte, samples*2) // 16-bit audio
+
+	for i := 0; i < samples; i++ {
+		t := float64(i) / float64(sampleRate)
+
+		// Rising hiss with a low thump at launch
+		hiss := noiseSample(i) * (0.3 + t)
+		thump := math.Sin(t*60*2*math.Pi) * math.Exp(-t*15)
+
+		// Envelope: quick attack, fade out over the last 150ms
+		envelope := 1.0
+		if t < 0.01 {
+			envelope = t / 0.01
+		} else if t > 0.2 {
+			envelope = (duration - t) / 0.15
+		}
+
+		// Convert to 16-bit PCM
+		sample := int16((0.4*hiss + 0.6*thump) * envelope * 12000)
+		data[i*2] = byte(sample)
+		data[i*2+1] = byte(sample >> 8)
+	}
+
+	return data
+}
+
+// generateExplosionSound creates a long rumbling blast
+func generateExplosionSound(sampleRate int) []byte {
+	const duration = 0.8 // 800ms
+	samples := int(float64(sampleRate) * duration)
+	data := make([]byte, samples*2) // 16-bit audio
+
+	low := 0.0
+	for i := 0; i < samples; i++ {
+		t := float64(i) / float64(sampleRate)
+
+		// Low-passed noise gives the rumble, raw noise the initial crack
+		low += (noiseSample(i) - low) * 0.05
+		rumble := low * 4 * math.Exp(-t*4)
+		crack := noiseSample(i) * math.Exp(-t*30)
+
+		// Convert to 16-bit PCM
+		sample := int16(clampAudio(0.7*rumble+0.5*crack) * 18000)
+		data[i*2] = byte(sample)
+		data[i*2+1] = byte(sample >> 8)
+	}
+
+	return data
+}
+
+func clampAudio(v float64) float64 {
+	return math.Max(-1, math.Min(1, v))
+}
+
 // generateZombieGrumbler creates a low, guttural grumbling sound
 func generateZombieGrumbler(sampleRate int) []byte {
 	const duration = 2.0 // 2 seconds
@@ -314,6 +490,12 @@ func (g *Game) initAudio() error {
 	g.reloadSoundData = generateReloadSound(44100)
 	g.oneUpSoundData = generateOneUpSound(44100)
 	g.bulletWhizData = generateBulletWhizSound(44100)
+	g.doorSoundData = generateDoorSound(44100)
+	g.lockedSoundData = generateLockedSound(44100)
+	g.shotgunSoundData = generateShotgunSound(44100)
+	g.chaingunSoundData = generateChaingunSound(44100)
+	g.rocketSoundData = generateRocketSound(44100)
+	g.explosionSoundData = generateExplosionSound(44100)
 
 	// Create grumbling sound players
 	zombieData := generateZombieGrumbler(44100)
@@ -337,12 +519,29 @@ func (g *Game) initAudio() error {
 	return nil
 }
 
-// playBulletSound plays the bullet firing sound
-func (g *Game) playBulletSound() {
-	if g.audioContext != nil && g.bulletSoundData != nil {
-		// Create a new player for each shot to avoid rewind delay
-		player := audio.NewPlayerFromBytes(g.audioContext, g.bulletSoundData)
-		player.SetVolume(0.3)
+// playWeaponSound plays the firing sound of a weapon
+func (g *Game) playWeaponSound(t sim.WeaponType) {
+	data, volume := g.bulletSoundData, 0.3
+	switch t {
+	case sim.WeaponShotgun:
+		data, volume = g.shotgunSoundData, 0.35
+	case sim.WeaponChaingun:
+		data, volume = g.chaingunSoundData, 0.2
+	case sim.WeaponRocketLauncher:
+		data, volume = g.rocketSoundData, 0.3
+	}
+	if g.audioContext != nil && data != nil {
+		player := audio.NewPlayerFromBytes(g.audioContext, data)
+		player.SetVolume(volume)
+		player.Play()
+	}
+}
+
+// playExplosionSound plays the blast of a rocket going off
+func (g *Game) playExplosionSound() {
+	if g.audioContext != nil && g.explosionSoundData != nil {
+		player := audio.NewPlayerFromBytes(g.audioContext, g.explosionSoundData)
+		player.SetVolume(0.35)
 		player.Play()
 	}
 }
@@ -387,13 +586,31 @@ func (g *Game) playBulletWhizSound() {
 	}
 }
 
+// playDoorSound plays the sliding sound for a door starting to open
+func (g *Game) playDoorSound() {
+	if g.audioContext != nil && g.doorSoundData != nil {
+		player := audio.NewPlayerFromBytes(g.audioContext, g.doorSoundData)
+		player.SetVolume(0.2)
+		player.Play()
+	}
+}
+
+// playLockedSound plays the buzzer for a locked door
+func (g *Game) playLockedSound() {
+	if g.audioContext != nil && g.lockedSoundData != nil {
+		player := audio.NewPlayerFromBytes(g.audioContext, g.lockedSoundData)
+		player.SetVolume(0.1)
+		player.Play()
+	}
+}
+
 // updateGrumblingSounds updates the volume of grumbling sounds based on distance to enemies
 func (g *Game) updateGrumblingSounds() {
 	if g.audioContext == nil {
 		return
 	}
 
-	// Count enemies by type
+	// Count enemies by the ambient loop their definition asks for
 	zombieCount := 0
 	runnerCount := 0
 	shooterCount := 0
@@ -402,27 +619,27 @@ func (g *Game) updateGrumblingSounds() {
 	minShooterDist := 1000.0
 
 	// Calculate distances to nearest enemies of each type
-	for _, enemy := range g.enemies {
-		if enemy.dead {
+	for _, enemy := range g.world.Enemies {
+		if enemy.Dead {
 			continue
 		}
 
-		dx := enemy.pos.x - g.p.pos.x
-		dy := enemy.pos.y - g.p.pos.y
+		dx := enemy.Pos.X - g.world.Player.Pos.X
+		dy := enemy.Pos.Y - g.world.Player.Pos.Y
 		dist := math.Sqrt(dx*dx + dy*dy)
 
-		switch enemy.etype {
-		case eZombie:
+		switch g.world.EnemyDef(enemy.Type).Ambient {
+		case "zombie":
 			zombieCount++
 			if dist < minZombieDist {
 				minZombieDist = dist
 			}
-		case eRunner:
+		case "runner":
 			runnerCount++
 			if dist < minRunnerDist {
 				minRunnerDist = dist
 			}
-		case eShooter:
+		case "shooter":
 			shooterCount++
 			if dist < minShooterDist {
 				minShooterDist = dist
@@ -1,80 +1,78 @@
 package engine
 
-import "image/color"
+import (
+	"image"
+	"image/color"
+
+	"doomlike/internal/sim"
+)
 
 const (
 	ScreenW = 1920
 	ScreenH = 1080
 
-	renderW = 640
-	renderH = 400
-
 	WallScale = 0.65
 
-	// Map-size reference points (the algorithm scales from these)
-	BaseMapW = 48
-	BaseMapH = 36
-	MaxMapW  = 96
-	MaxMapH  = 72
-
-	// Generation controls
-	MaxRooms        = 32
-	RoomMinSize     = 4
-	RoomMaxSize     = 10
-	MinRoomSpacing  = 1
-	SpawnSafeRadius = 6.0
-
 	// Caps / defaults
-	MaxLevelCap    = 20 // hard upper bound for selectable levels
-	DefaultLevels  = 5  // default selected level count if user doesn't change
-	BaseEnemyValue = 14 // baseline used by scaling algorithm (middle level ≈ this)
-	BaseFoodValue  = 16 // baseline pickups count (med+ammo) at middle level
-
-	fovDegrees = 75.0
-	moveSpeed  = 3.2
-	sprintMul  = 1.8
-	rotSpeed   = 2.6
-	mouseSens  = 0.002
+	MaxLevelCap   = 20 // hard upper bound for selectable levels
+	DefaultLevels = 5  // default selected level count if user doesn't change
 
 	maxDepth = 32.0
 
 	minimapOnAtStart = true
 
-	shootCooldownSec = 0.08
-	playerMaxHP      = 100
-	playerStartHP    = 85
-	playerStartAmmo  = 120
-	medkitHeal       = 25
-	ammoPickupAmt    = 32
+	// Pickup message duration
+	pickupMessageDuration = 2.0
 
-	playerShotSpd = 22.0
-	playerShotTTL = 1.0
-	playerShotDmg = 1
+	// How long wall puffs and blood splashes from hitscan shots stay visible
+	impactEffectDuration = 0.35
 
-	zombieSpeed  = 1.35
-	zombieHP     = 3
-	runnerSpeed  = 2.25
-	runnerHP     = 2
-	shooterSpeed = 1.15
-	shooterHP    = 3
+	// How long a killed enemy takes to fall into a corpse
+	deathAnimDuration = 0.45
 
-	zombieRadius = 0.25
-	touchDPS     = 10.0
+	// Where the last run is recorded and "Play Demo" reads from, in the data directory
+	demoFile = "demo.lmp"
 
-	enemyShotCD  = 1.6
-	enemyShotSpd = 12.0
-	enemyShotDmg = 12
-	enemyShotTTL = 1.6
+	// Map editor: default file in the data directory, new map size, size
+	// limits and screen layout
+	editorFile      = "maps/untitled.json"
+	editorDefaultW  = 32
+	editorDefaultH  = 24
+	editorMinSize   = 5
+	editorMaxSize   = 160
+	editorUndoLimit = 100
+	editorSidebarW  = 340
+	editorMargin    = 30
 
-	enemyKeepNear = 4.5
+	// Save slots and the size of their thumbnails
+	saveSlotCount = 5
+	thumbW        = 160
+	thumbH        = 100
 
-	// Pickup message duration
-	pickupMessageDuration = 2.0
+	// High-score table size and the name recorded when none is typed
+	highScoreCount   = 10
+	highScoreNameMax = 16
+	defaultScoreName = "PLAYER"
+
+	// Profile made on first start, and the longest profile name
+	defaultProfileName = "Player 1"
+	profileNameMax     = 16
+
+	// Runs shown in the accuracy trend on the statistics screen
+	statsRecentRuns = 20
 
 	// Default game settings
-	defaultFireRate    = 0.275 // Center value (0.05 + 0.5) / 2
-	defaultBulletSpeed = 22.0
+	defaultFireRate    = sim.DefaultFireRate // Center value (0.05 + 0.5) / 2
+	defaultBulletSpeed = sim.DefaultBulletSpeed
 	defaultLevelCount  = 5
+	defaultMouseSens   = 0.002
+	defaultTurnSpeed   = 2.6
+	defaultFOV         = 75.0
+	defaultRenderW     = 640
+	defaultRenderH     = 400
+	defaultPadTurn     = 3.0
+	defaultPadDeadzone = 0.15
+	defaultPadCurve    = 1.5
 
 	// Settings ranges
 	minFireRate    = 0.05
@@ -83,8 +81,31 @@ const (
 	maxBulletSpeed = 40.0
 	minLevelCount  = 1
 	maxLevelCount  = 20
+	minMouseSens   = 0.0005
+	maxMouseSens   = 0.006
+	minTurnSpeed   = 1.0
+	maxTurnSpeed   = 5.0
+	minFOV         = 60.0
+	maxFOV         = 110.0
+	minRenderW     = 320
+	maxRenderW     = 1280
+	minRenderH     = 200
+	maxRenderH     = 800
+	minPadTurn     = 1.0
+	maxPadTurn     = 6.0
+	minPadDeadzone = 0.05
+	maxPadDeadzone = 0.4
+	minPadCurve    = 1.0
+	maxPadCurve    = 3.0
+
+	// How long the gamepad connected / disconnected notice stays up
+	padNoticeDuration = 3.0
 )
 
+// renderSizes are the internal render resolutions the options screen steps
+// through; any size within the ranges above can still be imported
+var renderSizes = []image.Point{{320, 200}, {480, 300}, {640, 400}, {800, 500}, {960, 600}, {1280, 800}}
+
 var (
 	floorA = color.RGBA{26, 28, 26, 255}
 	floorB = color.RGBA{36, 40, 36, 255}
@@ -100,5 +121,48 @@ var (
 	gray     = color.RGBA{150, 150, 150, 255}
 	cyan     = color.RGBA{120, 210, 230, 255}
 	magenta  = color.RGBA{210, 120, 230, 255}
+	orange   = color.RGBA{255, 150, 40, 255}
 	black    = color.RGBA{0, 0, 0, 255}
+
+	exitFloor   = color.RGBA{40, 150, 70, 255}
+	barrelColor = color.RGBA{90, 130, 70, 255}
+	bloodColor  = color.RGBA{120, 8, 8, 255}
 )
+
+// completionGoals describes each level completion rule on the HUD
+var completionGoals = map[sim.Completion]string{
+	sim.CompleteExit:  "reach the exit",
+	sim.CompleteKills: "kill every enemy",
+	sim.CompleteBoth:  "kill all, then exit",
+}
+
+// aiStateColors marks enemies on the minimap while the AI overlay is on
+var aiStateColors = map[sim.AIState]color.RGBA{
+	sim.AIIdle:   {90, 150, 90, 255},
+	sim.AIPatrol: {110, 200, 230, 255},
+	sim.AIAlert:  {240, 220, 120, 255},
+	sim.AIChase:  {230, 60, 60, 255},
+	sim.AISearch: {240, 150, 60, 255},
+}
+
+// keyColors tints keycards, their doors and the HUD key slots
+var keyColors = map[sim.KeyColor]color.RGBA{
+	sim.KeyNone:   {140, 140, 150, 255},
+	sim.KeyRed:    {220, 50, 50, 255},
+	sim.KeyBlue:   {70, 110, 240, 255},
+	sim.KeyYellow: {240, 210, 60, 255},
+}
+
+// effectDurations is how long each kind of impact effect stays visible
+var effectDurations = map[effectKind]float64{
+	effectPuff:      impactEffectDuration,
+	effectBlood:     impactEffectDuration,
+	effectExplosion: 0.6,
+}
+
+// armorColors tints armor pickups and the HUD armor bar
+var armorColors = map[sim.ArmorClass]color.RGBA{
+	sim.ArmorNone:  {90, 90, 90, 255},
+	sim.ArmorGreen: {60, 200, 60, 255},
+	sim.ArmorBlue:  {70, 120, 255, 255},
+}
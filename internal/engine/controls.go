@@ -0,0 +1,207 @@
+package engine
+
+import (
+	"fmt"
+	"image/color"
+	"log"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+	"github.com/hajimehoshi/ebiten/v2/text"
+)
+
+// loadBindings reads the current profile's key bindings over the defaults
+func loadBindings(db *Database) bindings {
+	b := defaultBindings()
+	if db == nil {
+		return b
+	}
+	stored, err := db.LoadBindings()
+	if err != nil {
+		log.Printf("Failed to load key bindings: %v", err)
+		return b
+	}
+	b, notes := decodeBindings(stored, b)
+	for _, note := range notes {
+		log.Printf("Key bindings: %s", note)
+	}
+	return b
+}
+
+// saveBindings stores the key bindings for the current profile
+func (g *Game) saveBindings() {
+	if g.db != nil {
+		if err := g.db.SaveBindings(g.bindings.encode()); err != nil {
+			log.Printf("Failed to save key bindings: %v", err)
+		}
+	}
+}
+
+// openControls shows the controls page of the options screen
+func (g *Game) openControls() {
+	g.menu.selectedAction = 0
+	g.menu.selectedBinding = 0
+	g.capturingBinding = false
+	g.controlsMessage = ""
+	g.state = stateControls
+}
+
+func (g *Game) updateControls() {
+	if g.capturingBinding {
+		g.updateBindingCapture()
+		return
+	}
+
+	if inpututil.IsKeyJustPressed(ebiten.KeyEscape) || g.padJustPressed(padBack) {
+		g.state = stateOptions
+		return
+	}
+	if g.menuUp() {
+		g.menu.selectedAction = (g.menu.selectedAction + len(actionDefs) - 1) % len(actionDefs)
+	}
+	if g.menuDown() {
+		g.menu.selectedAction = (g.menu.selectedAction + 1) % len(actionDefs)
+	}
+	if g.menuLeft() || g.menuRight() {
+		g.menu.selectedBinding = (g.menu.selectedBinding + 1) % bindingSlots
+	}
+
+	a := action(g.menu.selectedAction)
+	switch {
+	case g.menuConfirm():
+		g.capturingBinding = true
+		g.controlsMessage = ""
+	case inpututil.IsKeyJustPressed(ebiten.KeyDelete) || inpututil.IsKeyJustPressed(ebiten.KeyBackspace):
+		g.bindings[a][g.menu.selectedBinding] = binding{}
+		g.controlsMessage = ""
+		g.saveBindings()
+	case inpututil.IsKeyJustPressed(ebiten.KeyR):
+		g.bindings = defaultBindings()
+		g.controlsMessage = "All controls reset to defaults"
+		g.saveBindings()
+	}
+}
+
+// updateBindingCapture waits for the key or mouse button to bind to the
+// selected slot. Esc or B cancels; Esc cannot be bound, as it always opens the menu.
+func (g *Game) updateBindingCapture() {
+	if inpututil.IsKeyJustPressed(ebiten.KeyEscape) || g.padJustPressed(padBack) {
+		g.capturingBinding = false
+		return
+	}
+
+	var in binding
+	if keys := inpututil.AppendJustPressedKeys(nil); len(keys) > 0 {
+		in = keyBinding(keys[0])
+	} else {
+		for b := ebiten.MouseButton0; b <= ebiten.MouseButtonMax; b++ {
+			if inpututil.IsMouseButtonJustPressed(b) {
+				in = mouseBinding(b)
+				break
+			}
+		}
+	}
+	if in.kind == bindNone {
+		return
+	}
+
+	a := action(g.menu.selectedAction)
+	g.capturingBinding = false
+	g.controlsMessage = ""
+	if from := g.bindings.bind(a, g.menu.selectedBinding, in); from >= 0 && from != a {
+		g.controlsMessage = fmt.Sprintf("%s moved here from %s", in, actionDefs[from].label)
+		if !g.bound(from) {
+			g.controlsMessage += ", which now has no input"
+		}
+	}
+	g.saveBindings()
+}
+
+// bindingLabel names the inputs of a for help text, joined by slashes
+func (g *Game) bindingLabel(a action) string {
+	label := ""
+	for _, b := range g.bindings[a] {
+		if b.kind == bindNone {
+			continue
+		}
+		if label != "" {
+			label += "/"
+		}
+		label += b.String()
+	}
+	if label == "" {
+		return "-"
+	}
+	return label
+}
+
+// bound reports whether a has any input at all
+func (g *Game) bound(a action) bool {
+	for _, b := range g.bindings[a] {
+		if b.kind != bindNone {
+			return true
+		}
+	}
+	return false
+}
+
+func (g *Game) drawControls(dst *ebiten.Image) {
+	drawRect(dst, g.pix, 0, 0, ScreenW, ScreenH, color.RGBA{0, 0, 0, 180})
+
+	w, h := 560, 150+len(actionDefs)*22
+	x := (ScreenW - w) / 2
+	y := (ScreenH - h) / 2
+
+	drawRect(dst, g.pix, x, y, w, h, uiBox)
+	drawRect(dst, g.pix, x, y, w, 2, uiAccent)
+	drawRect(dst, g.pix, x, y+h-2, w, 2, uiAccent)
+	drawRect(dst, g.pix, x, y, 2, h, uiAccent)
+	drawRect(dst, g.pix, x+w-2, y, 2, h, uiAccent)
+
+	lx := x + 30
+	ly := y + 30
+	text.Draw(dst, "CONTROLS", g.face, lx, ly, uiAccent)
+	ly += 30
+	slotX := [bindingSlots]int{lx + 200, lx + 360}
+	text.Draw(dst, "Primary", g.face, slotX[0], ly, gray)
+	text.Draw(dst, "Secondary", g.face, slotX[1], ly, gray)
+	ly += 24
+
+	for i, def := range actionDefs {
+		selected := i == g.menu.selectedAction
+		col := white
+		if !g.bound(action(i)) {
+			col = red
+		}
+		if selected {
+			col = yellow
+			text.Draw(dst, ">", g.face, lx-18, ly, col)
+		}
+		text.Draw(dst, def.label, g.face, lx, ly, col)
+
+		for s, b := range g.bindings[i] {
+			label, slotCol := b.String(), white
+			if label == "" {
+				label, slotCol = "-", gray
+			}
+			if selected && s == g.menu.selectedBinding {
+				drawRect(dst, g.pix, slotX[s]-4, ly-14, 150, 20, color.RGBA{60, 60, 60, 255})
+				slotCol = yellow
+				if g.capturingBinding {
+					label = "press a key..."
+				}
+			}
+			text.Draw(dst, label, g.face, slotX[s], ly, slotCol)
+		}
+		ly += 22
+	}
+
+	if g.controlsMessage != "" {
+		text.Draw(dst, g.controlsMessage, g.face, lx, y+h-46, yellow)
+	}
+	help := "Enter: Rebind  Left/Right: Slot  Del: Clear  R: Defaults  Esc: Back"
+	if g.capturingBinding {
+		help = "Press a key or mouse button  Esc: Cancel"
+	}
+	text.Draw(dst, help, g.face, lx, y+h-20, gray)
+}
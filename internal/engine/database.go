@@ -10,6 +10,8 @@ import (
 	"time"
 
 	"doomlike/ent"
+	"doomlike/ent/gamesettings"
+	"doomlike/ent/profile"
 
 	"entgo.io/ent/dialect"
 	entsql "entgo.io/ent/dialect/sql"
@@ -18,34 +20,81 @@ import (
 
 type Database struct {
 	client *ent.Client
+
+	// profileID is the profile settings, high scores and runs are kept for
+	profileID int
+
+	// readOnly is set when the file was opened with NewReadOnlyDatabase;
+	// writes fail and switching profiles is not remembered
+	readOnly bool
 }
 
-// NewDatabase creates a new database connection and initializes the schema
-func NewDatabase() (*Database, error) {
-	// Create data directory if it doesn't exist
-	dataDir := "data"
-	if err := os.MkdirAll(dataDir, 0o755); err != nil {
-		return nil, fmt.Errorf("failed to create data directory: %w", err)
+// NewDatabase opens the database at dbPath and migrates the schema;
+// MemoryDatabase opens an empty one that lives in memory
+func NewDatabase(dbPath string) (*Database, error) {
+	dsn := "file::memory:?_foreign_keys=1"
+	if dbPath != MemoryDatabase {
+		// Create data directory if it doesn't exist
+		if err := os.MkdirAll(filepath.Dir(dbPath), 0o755); err != nil {
+			return nil, fmt.Errorf("failed to create data directory: %w", err)
+		}
+		// Wait a little for another instance holding a lock before giving up
+		dsn = dbPath + "?_foreign_keys=1&_busy_timeout=2000"
 	}
 
 	// Open SQLite database
-	dbPath := filepath.Join(dataDir, "doomlike.db")
-	db, err := sql.Open("sqlite3", dbPath+"?_foreign_keys=1")
+	db, err := sql.Open("sqlite3", dsn)
 	if err != nil {
 		return nil, fmt.Errorf("failed to open database: %w", err)
 	}
+	if dbPath == MemoryDatabase {
+		// Every connection to :memory: is a separate, empty database
+		db.SetMaxOpenConns(1)
+	}
+
+	// Run migrations
+	ctx := context.Background()
+	if err := migrateDatabase(ctx, db, dbPath); err != nil {
+		db.Close()
+		return nil, err
+	}
 
 	// Create ent client
 	drv := entsql.OpenDB(dialect.SQLite, db)
 	client := ent.NewClient(ent.Driver(drv))
 
-	// Run migrations
+	database := &Database{client: client}
+	if err := database.ensureProfile(ctx); err != nil {
+		client.Close()
+		return nil, err
+	}
+	return database, nil
+}
+
+// NewReadOnlyDatabase opens the database at dbPath without writing to it: no
+// migrations, backups or default profile. The schema must already be at
+// least this build's version, and the file must hold a profile.
+func NewReadOnlyDatabase(dbPath string) (*Database, error) {
+	db, err := sql.Open("sqlite3", "file:"+dbPath+"?mode=ro&_foreign_keys=1&_busy_timeout=2000")
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database: %w", err)
+	}
+
 	ctx := context.Background()
-	if err := client.Schema.Create(ctx); err != nil {
-		return nil, fmt.Errorf("failed to create schema: %w", err)
+	if err := checkSchemaVersion(ctx, db, dbPath); err != nil {
+		db.Close()
+		return nil, err
 	}
 
-	return &Database{client: client}, nil
+	drv := entsql.OpenDB(dialect.SQLite, db)
+	client := ent.NewClient(ent.Driver(drv))
+
+	database := &Database{client: client, readOnly: true}
+	if err := database.selectProfile(ctx); err != nil {
+		client.Close()
+		return nil, err
+	}
+	return database, nil
 }
 
 // Close closes the database connection
@@ -53,12 +102,18 @@ func (db *Database) Close() error {
 	return db.client.Close()
 }
 
-// LoadSettings loads game settings from the database
+// profileSettings queries the settings row of the current profile
+func (db *Database) profileSettings() *ent.GameSettingsQuery {
+	return db.client.GameSettings.Query().
+		Where(gamesettings.HasProfileWith(profile.ID(db.profileID)))
+}
+
+// LoadSettings loads the current profile's game settings from the database
 func (db *Database) LoadSettings() (*gameSettings, error) {
 	ctx := context.Background()
 
 	// Try to get existing settings
-	settings, err := db.client.GameSettings.Get(ctx, "default")
+	settings, err := db.profileSettings().Only(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
 			// Create default settings if none exist
@@ -71,23 +126,45 @@ func (db *Database) LoadSettings() (*gameSettings, error) {
 		fireRate:    settings.FireRate,
 		bulletSpeed: settings.BulletSpeed,
 		levelCount:  settings.LevelCount,
+		seed:        settings.Seed,
+		mouseSens:   settings.MouseSensitivity,
+		invertMouse: settings.InvertMouse,
+		turnSpeed:   settings.TurnSpeed,
+		fov:         settings.Fov,
+		renderW:     settings.RenderWidth,
+		renderH:     settings.RenderHeight,
+
+		padTurnSpeed: settings.GamepadTurnSpeed,
+		padDeadzone:  settings.GamepadDeadzone,
+		padCurve:     settings.GamepadCurve,
 	}, nil
 }
 
-// SaveSettings saves game settings to the database
+// SaveSettings saves the current profile's game settings to the database
 func (db *Database) SaveSettings(settings *gameSettings) error {
 	ctx := context.Background()
 
 	// Check if settings exist
-	_, err := db.client.GameSettings.Get(ctx, "default")
+	existing, err := db.profileSettings().Only(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
 			// Create new settings
 			_, err = db.client.GameSettings.Create().
-				SetID("default").
+				SetID(db.settingsID()).
+				SetProfileID(db.profileID).
 				SetFireRate(settings.fireRate).
 				SetBulletSpeed(settings.bulletSpeed).
 				SetLevelCount(settings.levelCount).
+				SetSeed(settings.seed).
+				SetMouseSensitivity(settings.mouseSens).
+				SetInvertMouse(settings.invertMouse).
+				SetTurnSpeed(settings.turnSpeed).
+				SetFov(settings.fov).
+				SetRenderWidth(settings.renderW).
+				SetRenderHeight(settings.renderH).
+				SetGamepadTurnSpeed(settings.padTurnSpeed).
+				SetGamepadDeadzone(settings.padDeadzone).
+				SetGamepadCurve(settings.padCurve).
 				SetCreatedAt(time.Now()).
 				SetUpdatedAt(time.Now()).
 				Save(ctx)
@@ -99,10 +176,20 @@ func (db *Database) SaveSettings(settings *gameSettings) error {
 		}
 	} else {
 		// Update existing settings
-		_, err = db.client.GameSettings.UpdateOneID("default").
+		_, err = db.client.GameSettings.UpdateOne(existing).
 			SetFireRate(settings.fireRate).
 			SetBulletSpeed(settings.bulletSpeed).
 			SetLevelCount(settings.levelCount).
+			SetSeed(settings.seed).
+			SetMouseSensitivity(settings.mouseSens).
+			SetInvertMouse(settings.invertMouse).
+			SetTurnSpeed(settings.turnSpeed).
+			SetFov(settings.fov).
+			SetRenderWidth(settings.renderW).
+			SetRenderHeight(settings.renderH).
+			SetGamepadTurnSpeed(settings.padTurnSpeed).
+			SetGamepadDeadzone(settings.padDeadzone).
+			SetGamepadCurve(settings.padCurve).
 			SetUpdatedAt(time.Now()).
 			Save(ctx)
 		if err != nil {
@@ -115,17 +202,23 @@ func (db *Database) SaveSettings(settings *gameSettings) error {
 
 // createDefaultSettings creates default settings in the database
 func (db *Database) createDefaultSettings(ctx context.Context) (*gameSettings, error) {
-	settings := &gameSettings{
-		fireRate:    defaultFireRate,
-		bulletSpeed: defaultBulletSpeed,
-		levelCount:  defaultLevelCount,
-	}
+	settings := defaultGameSettings()
 
 	_, err := db.client.GameSettings.Create().
-		SetID("default").
+		SetID(db.settingsID()).
+		SetProfileID(db.profileID).
 		SetFireRate(settings.fireRate).
 		SetBulletSpeed(settings.bulletSpeed).
 		SetLevelCount(settings.levelCount).
+		SetMouseSensitivity(settings.mouseSens).
+		SetInvertMouse(settings.invertMouse).
+		SetTurnSpeed(settings.turnSpeed).
+		SetFov(settings.fov).
+		SetRenderWidth(settings.renderW).
+		SetRenderHeight(settings.renderH).
+		SetGamepadTurnSpeed(settings.padTurnSpeed).
+		SetGamepadDeadzone(settings.padDeadzone).
+		SetGamepadCurve(settings.padCurve).
 		SetCreatedAt(time.Now()).
 		SetUpdatedAt(time.Now()).
 		Save(ctx)
@@ -134,5 +227,11 @@ func (db *Database) createDefaultSettings(ctx context.Context) (*gameSettings, e
 	}
 
 	log.Println("Created default game settings in database")
-	return settings, nil
+	return &settings, nil
+}
+
+// settingsID is the key of a new settings row for the current profile. The
+// row kept from before profiles is still keyed "default".
+func (db *Database) settingsID() string {
+	return fmt.Sprintf("profile-%d", db.profileID)
 }
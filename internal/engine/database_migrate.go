@@ -0,0 +1,259 @@
+package engine
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io/fs"
+	"log"
+	"time"
+
+	"doomlike/ent/migrate/migrations"
+
+	"ariga.io/atlas/sql/migrate"
+	"ariga.io/atlas/sql/sqlite"
+)
+
+// dataMigrations change the rows along with the schema migration of the same
+// version, inside its transaction. They use literal values rather than the
+// game's constants so they keep doing what they did when they were written.
+var dataMigrations = map[string]func(ctx context.Context, tx *sql.Tx) error{
+	"20261017054636": clampSettingRanges,
+	"20261017061820": assignSaveSlots,
+}
+
+// clampSettingRanges pulls settings saved by older builds, which did not
+// check them, into the ranges the options screen allows.
+func clampSettingRanges(ctx context.Context, tx *sql.Tx) error {
+	_, err := tx.ExecContext(ctx, "UPDATE `game_settings` SET "+
+		"`fire_rate` = MIN(MAX(`fire_rate`, 0.05), 0.5), "+
+		"`bullet_speed` = MIN(MAX(`bullet_speed`, 10), 40), "+
+		"`level_count` = MIN(MAX(`level_count`, 1), 20)")
+	if err != nil {
+		return fmt.Errorf("failed to clamp settings: %w", err)
+	}
+	return nil
+}
+
+// assignSaveSlots gives the save slots written before slots belonged to a
+// profile to the profile used last, the one that was playing at the time.
+// Without any profile they stay unowned until ensureProfile adopts them.
+func assignSaveSlots(ctx context.Context, tx *sql.Tx) error {
+	_, err := tx.ExecContext(ctx, "UPDATE `save_slots` SET `profile_saves` = "+
+		"(SELECT `id` FROM `profiles` ORDER BY `last_used_at` DESC LIMIT 1) "+
+		"WHERE `profile_saves` IS NULL")
+	if err != nil {
+		return fmt.Errorf("failed to assign save slots: %w", err)
+	}
+	return nil
+}
+
+// migrateDatabase brings the database at path up to the newest schema
+// version this build knows. The version is kept in SQLite's user_version,
+// counting the migration files applied. An existing database is copied
+// next to itself before it is changed, and one written by a newer build is
+// refused rather than touched.
+func migrateDatabase(ctx context.Context, db *sql.DB, path string) error {
+	files, err := loadMigrations()
+	if err != nil {
+		return err
+	}
+
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to open database connection: %w", err)
+	}
+	defer conn.Close()
+
+	var version int
+	if err := conn.QueryRowContext(ctx, "PRAGMA user_version").Scan(&version); err != nil {
+		return fmt.Errorf("failed to read schema version: %w", err)
+	}
+	if version > len(files) {
+		return fmt.Errorf("database %s has schema version %d but this build only knows up to %d; "+
+			"it was written by a newer version of the game", path, version, len(files))
+	}
+	if version == len(files) {
+		return nil
+	}
+
+	// Databases from before versioned migrations have tables but no version
+	legacy := false
+	if version == 0 {
+		var tables int
+		err := conn.QueryRowContext(ctx,
+			"SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name NOT LIKE 'sqlite_%'").Scan(&tables)
+		if err != nil {
+			return fmt.Errorf("failed to inspect database: %w", err)
+		}
+		legacy = tables > 0
+	}
+	if version > 0 || legacy {
+		backup := fmt.Sprintf("%s.v%d-%s.bak", path, version, time.Now().Format("20060102-150405"))
+		if _, err := conn.ExecContext(ctx, "VACUUM INTO ?", backup); err != nil {
+			return fmt.Errorf("failed to back up database: %w", err)
+		}
+		log.Printf("Backed up database to %s before upgrading", backup)
+	}
+
+	// Table rebuilds drop and recreate tables other rows point at
+	if _, err := conn.ExecContext(ctx, "PRAGMA foreign_keys = off"); err != nil {
+		return fmt.Errorf("failed to disable foreign keys: %w", err)
+	}
+	defer conn.ExecContext(ctx, "PRAGMA foreign_keys = on")
+
+	for i := version; i < len(files); i++ {
+		f := files[i]
+		if i == 0 && legacy {
+			if err := upgradeLegacy(ctx, conn, f); err != nil {
+				return err
+			}
+		}
+		if err := applyMigration(ctx, conn, f, i+1, i == 0 && legacy); err != nil {
+			return err
+		}
+		log.Printf("Migrated database to schema version %d (%s)", i+1, f.Desc())
+	}
+	return nil
+}
+
+// checkSchemaVersion makes sure the database at path can be read without
+// migrating it first. A newer schema is accepted, as reading it cannot
+// damage it.
+func checkSchemaVersion(ctx context.Context, db *sql.DB, path string) error {
+	files, err := loadMigrations()
+	if err != nil {
+		return err
+	}
+	var version int
+	if err := db.QueryRowContext(ctx, "PRAGMA user_version").Scan(&version); err != nil {
+		return fmt.Errorf("failed to read schema version: %w", err)
+	}
+	if version < len(files) {
+		return fmt.Errorf("database %s has schema version %d and needs upgrading to %d", path, version, len(files))
+	}
+	return nil
+}
+
+// loadMigrations reads the embedded migration files in order, checking them
+// against atlas.sum so an edited migration is caught.
+func loadMigrations() ([]migrate.File, error) {
+	dir := &migrate.MemDir{}
+	entries, err := fs.ReadDir(migrations.FS, ".")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read migrations: %w", err)
+	}
+	for _, entry := range entries {
+		data, err := fs.ReadFile(migrations.FS, entry.Name())
+		if err != nil {
+			return nil, fmt.Errorf("failed to read migration %s: %w", entry.Name(), err)
+		}
+		if err := dir.WriteFile(entry.Name(), data); err != nil {
+			return nil, fmt.Errorf("failed to load migration %s: %w", entry.Name(), err)
+		}
+	}
+	if err := migrate.Validate(dir); err != nil {
+		return nil, fmt.Errorf("failed to validate migrations: %w", err)
+	}
+	files, err := dir.Files()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list migrations: %w", err)
+	}
+	return files, nil
+}
+
+// applyMigration runs one migration file and its data migration, and records
+// the new version, in a single transaction. The statements of the baseline
+// are skipped when upgradeLegacy has already brought the tables in line.
+func applyMigration(ctx context.Context, conn *sql.Conn, f migrate.File, version int, skipStmts bool) error {
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin migration %s: %w", f.Name(), err)
+	}
+	defer tx.Rollback()
+
+	if !skipStmts {
+		stmts, err := f.Stmts()
+		if err != nil {
+			return fmt.Errorf("failed to parse migration %s: %w", f.Name(), err)
+		}
+		for _, stmt := range stmts {
+			if _, err := tx.ExecContext(ctx, stmt); err != nil {
+				return fmt.Errorf("failed to apply migration %s: %w", f.Name(), err)
+			}
+		}
+	}
+	if data, ok := dataMigrations[f.Version()]; ok {
+		if err := data(ctx, tx); err != nil {
+			return fmt.Errorf("failed to migrate data for %s: %w", f.Name(), err)
+		}
+	}
+
+	rows, err := tx.QueryContext(ctx, "PRAGMA foreign_key_check")
+	if err != nil {
+		return fmt.Errorf("failed to check foreign keys after %s: %w", f.Name(), err)
+	}
+	broken := rows.Next()
+	rows.Close()
+	if broken {
+		return fmt.Errorf("migration %s left rows with broken references", f.Name())
+	}
+
+	// PRAGMA values cannot be bound as parameters
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf("PRAGMA user_version = %d", version)); err != nil {
+		return fmt.Errorf("failed to record schema version: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit migration %s: %w", f.Name(), err)
+	}
+	return nil
+}
+
+// upgradeLegacy brings a database created by the automatic schema creation
+// of earlier builds in line with the baseline migration, by replaying the
+// baseline into an empty in-memory database and applying the difference.
+func upgradeLegacy(ctx context.Context, conn *sql.Conn, baseline migrate.File) error {
+	dev, err := sql.Open("sqlite3", "file:baseline?mode=memory")
+	if err != nil {
+		return fmt.Errorf("failed to open baseline database: %w", err)
+	}
+	defer dev.Close()
+	// Each connection to an in-memory database gets its own
+	dev.SetMaxOpenConns(1)
+
+	stmts, err := baseline.Stmts()
+	if err != nil {
+		return fmt.Errorf("failed to parse migration %s: %w", baseline.Name(), err)
+	}
+	for _, stmt := range stmts {
+		if _, err := dev.ExecContext(ctx, stmt); err != nil {
+			return fmt.Errorf("failed to replay migration %s: %w", baseline.Name(), err)
+		}
+	}
+
+	devDrv, err := sqlite.Open(dev)
+	if err != nil {
+		return fmt.Errorf("failed to inspect baseline database: %w", err)
+	}
+	want, err := devDrv.InspectRealm(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to inspect baseline schema: %w", err)
+	}
+	drv, err := sqlite.Open(conn)
+	if err != nil {
+		return fmt.Errorf("failed to inspect database: %w", err)
+	}
+	have, err := drv.InspectRealm(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to inspect schema: %w", err)
+	}
+
+	changes, err := drv.RealmDiff(have, want)
+	if err != nil {
+		return fmt.Errorf("failed to compare schema with baseline: %w", err)
+	}
+	if err := drv.ApplyChanges(ctx, changes); err != nil {
+		return fmt.Errorf("failed to bring schema up to baseline: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,158 @@
+package engine
+
+import (
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"runtime"
+)
+
+const (
+	// MemoryDatabase as the database path keeps everything in memory for
+	// the life of the process, for tests and kiosk demos
+	MemoryDatabase = ":memory:"
+
+	// DatabaseEnv names the environment variable that overrides the
+	// database path when no flag is given
+	DatabaseEnv = "DOOMLIKE_DB"
+
+	databaseFile = "doomlike.db"
+
+	// Where earlier versions kept the database, the last demo and the
+	// editor's maps, relative to the working directory
+	legacyDataDir = "data"
+	legacyMapsDir = "maps"
+)
+
+// resolveDatabasePath picks the database file: the flag value, then
+// $DOOMLIKE_DB, then doomlike.db in the game's data directory. The default
+// path first takes over the files an earlier version left under the working
+// directory, so a player upgrading keeps their profiles, saves and maps.
+func resolveDatabasePath(flagPath string) string {
+	if flagPath != "" {
+		return flagPath
+	}
+	if env := os.Getenv(DatabaseEnv); env != "" {
+		return env
+	}
+	dir := gameDataDir()
+	if err := adoptLegacyData(".", dir); err != nil {
+		log.Printf("Failed to move data from %s: %v", legacyDataDir, err)
+	}
+	return filepath.Join(dir, databaseFile)
+}
+
+// adoptLegacyData copies the database, demo and maps an earlier version kept
+// in data/ and maps/ under root into dir. It only runs while dir has no
+// database, and the database is copied last, so an interrupted copy is
+// finished on the next start and newer files are never overwritten. The old
+// copies are left where they were.
+func adoptLegacyData(root, dir string) error {
+	legacyDB := filepath.Join(root, legacyDataDir, databaseFile)
+	newDB := filepath.Join(dir, databaseFile)
+	if fileExists(newDB) || !fileExists(legacyDB) {
+		return nil
+	}
+
+	if demo := filepath.Join(root, legacyDataDir, demoFile); fileExists(demo) {
+		if err := copyNewFile(demo, filepath.Join(dir, demoFile)); err != nil {
+			return err
+		}
+		log.Printf("Copied last demo from %s", demo)
+	}
+
+	maps := filepath.Join(root, legacyMapsDir)
+	if info, err := os.Stat(maps); err == nil && info.IsDir() {
+		err := filepath.WalkDir(maps, func(path string, d fs.DirEntry, err error) error {
+			if err != nil || !d.Type().IsRegular() {
+				return err
+			}
+			rel, err := filepath.Rel(root, path)
+			if err != nil {
+				return err
+			}
+			return copyNewFile(path, filepath.Join(dir, rel))
+		})
+		if err != nil {
+			return err
+		}
+		log.Printf("Copied maps from %s to %s", maps, filepath.Join(dir, legacyMapsDir))
+	}
+
+	// The journal holds writes not yet in the main file
+	for _, suffix := range []string{"-wal", "-shm"} {
+		if fileExists(legacyDB + suffix) {
+			if err := copyNewFile(legacyDB+suffix, newDB+suffix); err != nil {
+				return err
+			}
+		}
+	}
+	if err := copyNewFile(legacyDB, newDB); err != nil {
+		return err
+	}
+	log.Printf("Copied database from %s to %s; the old copy can be deleted", legacyDB, newDB)
+	return nil
+}
+
+// copyNewFile copies src to dst, creating dst's directory. A dst that
+// already exists is left alone.
+func copyNewFile(src, dst string) error {
+	if fileExists(dst) {
+		return nil
+	}
+	in, err := os.Open(src)
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", src, err)
+	}
+	defer in.Close()
+	if err := os.MkdirAll(filepath.Dir(dst), 0o755); err != nil {
+		return fmt.Errorf("failed to create %s: %w", filepath.Dir(dst), err)
+	}
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", dst, err)
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		os.Remove(dst)
+		return fmt.Errorf("failed to copy %s: %w", src, err)
+	}
+	if err := out.Close(); err != nil {
+		os.Remove(dst)
+		return fmt.Errorf("failed to copy %s: %w", src, err)
+	}
+	return nil
+}
+
+func fileExists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
+
+// gameDataDir is the doomlike directory in the per-user data directory. It
+// only falls back to the working directory when no data directory is known.
+func gameDataDir() string {
+	if dir := userDataDir(); dir != "" {
+		return filepath.Join(dir, "doomlike")
+	}
+	return "data"
+}
+
+// userDataDir follows the XDG base directory spec: $XDG_DATA_HOME, or
+// ~/.local/share when it is unset. Windows uses %LOCALAPPDATA% instead.
+func userDataDir() string {
+	if dir := os.Getenv("XDG_DATA_HOME"); filepath.IsAbs(dir) {
+		return dir
+	}
+	if runtime.GOOS == "windows" {
+		return os.Getenv("LOCALAPPDATA")
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".local", "share")
+}
@@ -0,0 +1,65 @@
+package engine
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeFile(t *testing.T, path, data string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(data), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func readFile(t *testing.T, path string) string {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(data)
+}
+
+func TestAdoptLegacyData(t *testing.T) {
+	root := t.TempDir()
+	dir := filepath.Join(t.TempDir(), "doomlike")
+	writeFile(t, filepath.Join(root, "data", databaseFile), "db")
+	writeFile(t, filepath.Join(root, "data", demoFile), "demo")
+	writeFile(t, filepath.Join(root, "maps", "untitled.json"), "map")
+	writeFile(t, filepath.Join(root, "maps", "episode", "e1m1.json"), "e1m1")
+
+	if err := adoptLegacyData(root, dir); err != nil {
+		t.Fatal(err)
+	}
+	for rel, want := range map[string]string{
+		databaseFile:             "db",
+		demoFile:                 "demo",
+		"maps/untitled.json":     "map",
+		"maps/episode/e1m1.json": "e1m1",
+	} {
+		if got := readFile(t, filepath.Join(dir, filepath.FromSlash(rel))); got != want {
+			t.Errorf("%s holds %q, want %q", rel, got, want)
+		}
+	}
+	if !fileExists(filepath.Join(root, "data", databaseFile)) {
+		t.Error("the legacy database was removed")
+	}
+
+	// Once the data directory has a database the old files are not read again
+	writeFile(t, filepath.Join(root, "data", databaseFile), "older")
+	writeFile(t, filepath.Join(root, "maps", "new.json"), "new")
+	if err := adoptLegacyData(root, dir); err != nil {
+		t.Fatal(err)
+	}
+	if got := readFile(t, filepath.Join(dir, databaseFile)); got != "db" {
+		t.Errorf("database replaced by %q on a later start", got)
+	}
+	if fileExists(filepath.Join(dir, "maps", "new.json")) {
+		t.Error("maps copied again on a later start")
+	}
+}
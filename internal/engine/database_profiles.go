@@ -0,0 +1,228 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"doomlike/ent"
+	"doomlike/ent/gamesettings"
+	"doomlike/ent/highscore"
+	"doomlike/ent/levelresult"
+	"doomlike/ent/profile"
+	"doomlike/ent/run"
+	"doomlike/ent/saveslot"
+)
+
+// profileInfo is a player profile as listed on the profile screen
+type profileInfo struct {
+	id       int
+	name     string
+	lastUsed time.Time
+}
+
+// ensureProfile makes sure a profile exists and selects the one used last.
+// The first profile takes over the settings, high scores, runs and saves
+// recorded before there were profiles.
+func (db *Database) ensureProfile(ctx context.Context) error {
+	n, err := db.client.Profile.Query().Count(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to count profiles: %w", err)
+	}
+	if n == 0 {
+		p, err := db.client.Profile.Create().
+			SetName(defaultProfileName).
+			SetCreatedAt(time.Now()).
+			SetLastUsedAt(time.Now()).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to create default profile: %w", err)
+		}
+		if err := db.adoptOrphans(ctx, p.ID); err != nil {
+			return err
+		}
+		log.Printf("Created profile %q", p.Name)
+	}
+	return db.selectProfile(ctx)
+}
+
+// selectProfile selects the profile used last
+func (db *Database) selectProfile(ctx context.Context) error {
+	p, err := db.client.Profile.Query().
+		Order(ent.Desc(profile.FieldLastUsedAt)).
+		First(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to find last used profile: %w", err)
+	}
+	db.profileID = p.ID
+	return nil
+}
+
+// adoptOrphans hands everything recorded without a profile to one
+func (db *Database) adoptOrphans(ctx context.Context, profileID int) error {
+	if _, err := db.client.GameSettings.Update().
+		Where(gamesettings.Not(gamesettings.HasProfile())).
+		SetProfileID(profileID).
+		Save(ctx); err != nil {
+		return fmt.Errorf("failed to move settings to profile: %w", err)
+	}
+	if _, err := db.client.HighScore.Update().
+		Where(highscore.Not(highscore.HasProfile())).
+		SetProfileID(profileID).
+		Save(ctx); err != nil {
+		return fmt.Errorf("failed to move high scores to profile: %w", err)
+	}
+	if _, err := db.client.Run.Update().
+		Where(run.Not(run.HasProfile())).
+		SetProfileID(profileID).
+		Save(ctx); err != nil {
+		return fmt.Errorf("failed to move runs to profile: %w", err)
+	}
+	if _, err := db.client.SaveSlot.Update().
+		Where(saveslot.Not(saveslot.HasProfile())).
+		SetProfileID(profileID).
+		Save(ctx); err != nil {
+		return fmt.Errorf("failed to move saves to profile: %w", err)
+	}
+	return nil
+}
+
+// ListProfiles returns every profile in the order they were created
+func (db *Database) ListProfiles() ([]profileInfo, error) {
+	ctx := context.Background()
+
+	rows, err := db.client.Profile.Query().
+		Order(ent.Asc(profile.FieldCreatedAt), ent.Asc(profile.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list profiles: %w", err)
+	}
+	infos := make([]profileInfo, 0, len(rows))
+	for _, p := range rows {
+		infos = append(infos, profileInfo{id: p.ID, name: p.Name, lastUsed: p.LastUsedAt})
+	}
+	return infos, nil
+}
+
+// CurrentProfile returns the profile settings, scores and runs are kept for
+func (db *Database) CurrentProfile() (profileInfo, error) {
+	ctx := context.Background()
+
+	p, err := db.client.Profile.Get(ctx, db.profileID)
+	if err != nil {
+		return profileInfo{}, fmt.Errorf("failed to load profile: %w", err)
+	}
+	return profileInfo{id: p.ID, name: p.Name, lastUsed: p.LastUsedAt}, nil
+}
+
+// CreateProfile adds a profile with default settings
+func (db *Database) CreateProfile(name string) (profileInfo, error) {
+	ctx := context.Background()
+
+	p, err := db.client.Profile.Create().
+		SetName(name).
+		SetCreatedAt(time.Now()).
+		SetLastUsedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return profileInfo{}, fmt.Errorf("failed to create profile: %w", err)
+	}
+	return profileInfo{id: p.ID, name: p.Name, lastUsed: p.LastUsedAt}, nil
+}
+
+// RenameProfile changes the name of a profile
+func (db *Database) RenameProfile(id int, name string) error {
+	ctx := context.Background()
+
+	if err := db.client.Profile.UpdateOneID(id).SetName(name).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to rename profile: %w", err)
+	}
+	return nil
+}
+
+// DeleteProfile removes a profile along with its settings, high scores, run
+// history and saves. The profile in use cannot be deleted.
+func (db *Database) DeleteProfile(id int) error {
+	if id == db.profileID {
+		return fmt.Errorf("failed to delete profile: it is in use")
+	}
+	ctx := context.Background()
+
+	tx, err := db.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	owned := profile.ID(id)
+	steps := []func() error{
+		func() error {
+			_, err := tx.LevelResult.Delete().Where(levelresult.HasRunWith(run.HasProfileWith(owned))).Exec(ctx)
+			return err
+		},
+		func() error {
+			_, err := tx.Run.Delete().Where(run.HasProfileWith(owned)).Exec(ctx)
+			return err
+		},
+		func() error {
+			_, err := tx.HighScore.Delete().Where(highscore.HasProfileWith(owned)).Exec(ctx)
+			return err
+		},
+		func() error {
+			_, err := tx.GameSettings.Delete().Where(gamesettings.HasProfileWith(owned)).Exec(ctx)
+			return err
+		},
+		func() error {
+			_, err := tx.SaveSlot.Delete().Where(saveslot.HasProfileWith(owned)).Exec(ctx)
+			return err
+		},
+		func() error { return tx.Profile.DeleteOneID(id).Exec(ctx) },
+	}
+	for _, step := range steps {
+		if err := step(); err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf("failed to delete profile: %w", err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to delete profile: %w", err)
+	}
+	return nil
+}
+
+// UseProfile switches to a profile and remembers it as the one used last,
+// unless the database is read-only
+func (db *Database) UseProfile(id int) error {
+	ctx := context.Background()
+
+	if db.readOnly {
+		if _, err := db.client.Profile.Get(ctx, id); err != nil {
+			return fmt.Errorf("failed to switch profile: %w", err)
+		}
+	} else if err := db.client.Profile.UpdateOneID(id).SetLastUsedAt(time.Now()).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to switch profile: %w", err)
+	}
+	db.profileID = id
+	return nil
+}
+
+// LoadBindings loads the current profile's key bindings, nil when it has
+// never changed them
+func (db *Database) LoadBindings() (map[string][]string, error) {
+	ctx := context.Background()
+
+	p, err := db.client.Profile.Get(ctx, db.profileID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load key bindings: %w", err)
+	}
+	return p.KeyBindings, nil
+}
+
+// SaveBindings stores the current profile's key bindings
+func (db *Database) SaveBindings(bindings map[string][]string) error {
+	ctx := context.Background()
+
+	if err := db.client.Profile.UpdateOneID(db.profileID).SetKeyBindings(bindings).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to save key bindings: %w", err)
+	}
+	return nil
+}
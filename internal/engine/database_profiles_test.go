@@ -0,0 +1,157 @@
+package engine
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"doomlike/internal/sim"
+)
+
+func testDatabase(t *testing.T) *Database {
+	t.Helper()
+	db, err := NewDatabase(MemoryDatabase)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+// saveRun saves the first level of a run with the given seed into a slot of
+// the current profile
+func saveRun(t *testing.T, db *Database, slot int, seed int64) {
+	t.Helper()
+	w := sim.NewWorld(seed, 3, sim.Settings{FireRate: defaultFireRate, BulletSpeed: defaultBulletSpeed}, sim.BuiltinEnemyDefs())
+	w.SetupLevel(1, true)
+	if err := db.SaveGame(slot, w.Snapshot(), nil); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// loadedSeed loads a slot of the current profile and returns the seed of the
+// run in it
+func loadedSeed(t *testing.T, db *Database, slot int) int64 {
+	t.Helper()
+	snap, err := db.LoadGame(slot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return snap.Seed
+}
+
+func TestProfileSaveSlots(t *testing.T) {
+	db := testDatabase(t)
+	first := db.profileID
+	second, err := db.CreateProfile("second")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	saveRun(t, db, 1, 111)
+	if err := db.UseProfile(second.id); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.LoadGame(1); err == nil {
+		t.Fatal("a new profile can load another profile's slot 1")
+	}
+	saveRun(t, db, 1, 222)
+
+	if got := loadedSeed(t, db, 1); got != 222 {
+		t.Errorf("second profile slot 1 has seed %d, want 222", got)
+	}
+	if err := db.UseProfile(first); err != nil {
+		t.Fatal(err)
+	}
+	if got := loadedSeed(t, db, 1); got != 111 {
+		t.Errorf("first profile slot 1 has seed %d, want 111 after the second profile saved", got)
+	}
+}
+
+func TestDeleteProfileSaves(t *testing.T) {
+	db := testDatabase(t)
+	first := db.profileID
+	second, err := db.CreateProfile("second")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	saveRun(t, db, 1, 111)
+	if err := db.UseProfile(second.id); err != nil {
+		t.Fatal(err)
+	}
+	saveRun(t, db, 1, 222)
+	saveRun(t, db, 2, 333)
+	if err := db.UseProfile(first); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.DeleteProfile(second.id); err != nil {
+		t.Fatal(err)
+	}
+
+	n, err := db.client.SaveSlot.Query().Count(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 1 {
+		t.Errorf("%d save slots left after deleting a profile, want 1", n)
+	}
+	if got := loadedSeed(t, db, 1); got != 111 {
+		t.Errorf("remaining profile slot 1 has seed %d, want 111", got)
+	}
+}
+
+func TestReadOnlyDatabase(t *testing.T) {
+	path := filepath.Join(t.TempDir(), databaseFile)
+	db, err := NewDatabase(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	first := db.profileID
+	saveRun(t, db, 1, 111)
+	if _, err := db.CreateProfile("second"); err != nil {
+		t.Fatal(err)
+	}
+	db.Close()
+	before, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ro, err := NewReadOnlyDatabase(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ro.Close()
+	if err := ro.UseProfile(first); err != nil {
+		t.Fatalf("cannot switch profile read-only: %v", err)
+	}
+	if got := loadedSeed(t, ro, 1); got != 111 {
+		t.Errorf("slot 1 has seed %d, want 111", got)
+	}
+	w := sim.NewWorld(222, 3, sim.Settings{FireRate: defaultFireRate, BulletSpeed: defaultBulletSpeed}, sim.BuiltinEnemyDefs())
+	w.SetupLevel(1, true)
+	if err := ro.SaveGame(2, w.Snapshot(), nil); err == nil {
+		t.Error("saved into a read-only database")
+	}
+	after, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(before, after) {
+		t.Error("the database file changed while open read-only")
+	}
+}
+
+func TestReadOnlyDatabaseMissing(t *testing.T) {
+	path := filepath.Join(t.TempDir(), databaseFile)
+	if db, err := NewReadOnlyDatabase(path); err == nil {
+		db.Close()
+		t.Fatal("opened a database that does not exist")
+	}
+	if _, err := os.Stat(path); err == nil {
+		t.Error("opening read-only created the file")
+	}
+}
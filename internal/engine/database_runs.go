@@ -0,0 +1,176 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"doomlike/ent"
+	"doomlike/ent/profile"
+	"doomlike/ent/run"
+)
+
+// runInfo describes a run as it starts
+type runInfo struct {
+	seed        int64
+	totalLevels int
+	fireRate    float64
+	bulletSpeed float64
+	campaign    string
+	resumed     bool
+}
+
+// levelResult is the record of one level played in a run
+type levelResult struct {
+	level      int
+	mapName    string
+	cleared    bool
+	kills      map[string]int
+	enemyTotal int
+	shots      int
+	hits       int
+	dealt      int
+	taken      int
+	pickups    int
+	time       float64
+	par        float64
+	score      int
+}
+
+// lifetimeStats sums up every run recorded
+type lifetimeStats struct {
+	runs, wins, deaths, quits int
+	levelsCleared             int
+	playTime                  float64
+	bestScore                 int
+	kills                     map[string]int
+	shots, hits               int
+	dealt, taken              int
+	pickups                   int
+
+	// favoriteLevels is the run length played most often, 0 without runs
+	favoriteLevels int
+	// recentAccuracy is the accuracy of the latest runs that fired a shot,
+	// oldest first, as whole percentages
+	recentAccuracy []int
+}
+
+// StartRun records the start of a run and returns its ID
+func (db *Database) StartRun(info runInfo) (int, error) {
+	ctx := context.Background()
+
+	r, err := db.client.Run.Create().
+		SetProfileID(db.profileID).
+		SetStartedAt(time.Now()).
+		SetSeed(info.seed).
+		SetTotalLevels(info.totalLevels).
+		SetFireRate(info.fireRate).
+		SetBulletSpeed(info.bulletSpeed).
+		SetCampaign(info.campaign).
+		SetResumed(info.resumed).
+		Save(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to record run start: %w", err)
+	}
+	return r.ID, nil
+}
+
+// RecordLevel adds the result of a level to a run
+func (db *Database) RecordLevel(runID int, res levelResult) error {
+	ctx := context.Background()
+
+	_, err := db.client.LevelResult.Create().
+		SetRunID(runID).
+		SetLevel(res.level).
+		SetMapName(res.mapName).
+		SetCleared(res.cleared).
+		SetKills(res.kills).
+		SetEnemyTotal(res.enemyTotal).
+		SetShots(res.shots).
+		SetHits(res.hits).
+		SetDamageDealt(res.dealt).
+		SetDamageTaken(res.taken).
+		SetPickups(res.pickups).
+		SetTime(res.time).
+		SetPar(res.par).
+		SetScore(res.score).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to record level result: %w", err)
+	}
+	return nil
+}
+
+// FinishRun records how a run ended
+func (db *Database) FinishRun(runID int, outcome run.Outcome, score, levelsCleared int) error {
+	ctx := context.Background()
+
+	err := db.client.Run.UpdateOneID(runID).
+		SetEndedAt(time.Now()).
+		SetOutcome(outcome).
+		SetScore(score).
+		SetClearedLevels(levelsCleared).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to record run end: %w", err)
+	}
+	return nil
+}
+
+// LifetimeStats totals every run the current profile played. Runs left
+// "playing" by a crash count as quit.
+func (db *Database) LifetimeStats(recent int) (*lifetimeStats, error) {
+	ctx := context.Background()
+
+	runs, err := db.client.Run.Query().
+		Where(run.HasProfileWith(profile.ID(db.profileID))).
+		WithLevels().
+		Order(ent.Asc(run.FieldStartedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load run history: %w", err)
+	}
+
+	st := &lifetimeStats{kills: make(map[string]int)}
+	lengths := make(map[int]int)
+	for _, r := range runs {
+		st.runs++
+		switch r.Outcome {
+		case run.OutcomeWin:
+			st.wins++
+		case run.OutcomeDeath:
+			st.deaths++
+		default:
+			st.quits++
+		}
+		st.levelsCleared += r.ClearedLevels
+		st.bestScore = max(st.bestScore, r.Score)
+		lengths[r.TotalLevels]++
+		if n := lengths[r.TotalLevels]; n > lengths[st.favoriteLevels] ||
+			(n == lengths[st.favoriteLevels] && r.TotalLevels < st.favoriteLevels) {
+			st.favoriteLevels = r.TotalLevels
+		}
+
+		shots, hits := 0, 0
+		for _, l := range r.Edges.Levels {
+			st.playTime += l.Time
+			for name, n := range l.Kills {
+				st.kills[name] += n
+			}
+			shots += l.Shots
+			hits += l.Hits
+			st.dealt += l.DamageDealt
+			st.taken += l.DamageTaken
+			st.pickups += l.Pickups
+		}
+		st.shots += shots
+		st.hits += hits
+		if shots > 0 {
+			st.recentAccuracy = append(st.recentAccuracy, hits*100/shots)
+		}
+	}
+	if len(st.recentAccuracy) > recent {
+		st.recentAccuracy = st.recentAccuracy[len(st.recentAccuracy)-recent:]
+	}
+	return st, nil
+}
@@ -0,0 +1,225 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"doomlike/ent"
+	"doomlike/ent/profile"
+	"doomlike/ent/saveslot"
+	"doomlike/internal/sim"
+)
+
+// saveSlotInfo summarizes a save slot for the save/load menu
+type saveSlotInfo struct {
+	slot        int
+	level       int
+	totalLevels int
+	seed        int64
+	playTime    float64
+	updatedAt   time.Time
+	thumbnail   []byte
+}
+
+// profileSaves queries the save slots of the current profile
+func (db *Database) profileSaves() *ent.SaveSlotQuery {
+	return db.client.SaveSlot.Query().
+		Where(saveslot.HasProfileWith(profile.ID(db.profileID)))
+}
+
+// ListSaves returns the current profile's occupied save slots ordered by slot number
+func (db *Database) ListSaves() ([]saveSlotInfo, error) {
+	ctx := context.Background()
+
+	slots, err := db.profileSaves().
+		Order(ent.Asc(saveslot.FieldSlot)).
+		Select(
+			saveslot.FieldSlot,
+			saveslot.FieldLevel,
+			saveslot.FieldTotalLevels,
+			saveslot.FieldSeed,
+			saveslot.FieldPlayTime,
+			saveslot.FieldUpdatedAt,
+			saveslot.FieldThumbnail,
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list saves: %w", err)
+	}
+
+	infos := make([]saveSlotInfo, 0, len(slots))
+	for _, s := range slots {
+		infos = append(infos, saveSlotInfo{
+			slot:        s.Slot,
+			level:       s.Level,
+			totalLevels: s.TotalLevels,
+			seed:        s.Seed,
+			playTime:    s.PlayTime,
+			updatedAt:   s.UpdatedAt,
+			thumbnail:   s.Thumbnail,
+		})
+	}
+	return infos, nil
+}
+
+// SaveGame writes a snapshot of the current run into one of the current
+// profile's slots, replacing what was there.
+func (db *Database) SaveGame(slot int, snap sim.Snapshot, thumbnail []byte) error {
+	ctx := context.Background()
+
+	grid := make([]byte, len(snap.Grid))
+	for i, t := range snap.Grid {
+		grid[i] = byte(t)
+	}
+	enemies := make([]sim.Enemy, len(snap.Enemies))
+	copy(enemies, snap.Enemies)
+	pickups := make([]sim.Pickup, len(snap.Pickups))
+	copy(pickups, snap.Pickups)
+	bullets := make([]sim.Projectile, len(snap.Bullets))
+	copy(bullets, snap.Bullets)
+	doors := make([]sim.Door, len(snap.Doors))
+	copy(doors, snap.Doors)
+	secrets := make([]sim.Secret, len(snap.Secrets))
+	copy(secrets, snap.Secrets)
+	barrels := make([]sim.Barrel, len(snap.Barrels))
+	copy(barrels, snap.Barrels)
+
+	existing, err := db.profileSaves().Where(saveslot.Slot(slot)).Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return fmt.Errorf("failed to check save slot %d: %w", slot, err)
+	}
+
+	if existing == nil {
+		_, err = db.client.SaveSlot.Create().
+			SetProfileID(db.profileID).
+			SetSlot(slot).
+			SetLevel(snap.Level).
+			SetTotalLevels(snap.TotalLevels).
+			SetSeed(snap.Seed).
+			SetPlayTime(snap.Time).
+			SetMapWidth(snap.W).
+			SetMapHeight(snap.H).
+			SetGrid(grid).
+			SetPlayer(snap.Player).
+			SetEnemies(enemies).
+			SetEnemyTypes(snap.EnemyTypes).
+			SetPickups(pickups).
+			SetProjectiles(bullets).
+			SetDoors(doors).
+			SetSecrets(secrets).
+			SetBarrels(barrels).
+			SetDefeated(snap.Defeated).
+			SetLevelEnemyTotal(snap.LevelEnemyTotal).
+			SetLevelTime(snap.LevelTime).
+			SetCompletion(int(snap.Rule)).
+			SetFireRate(snap.Settings.FireRate).
+			SetBulletSpeed(snap.Settings.BulletSpeed).
+			SetRngState(rngColumn(snap.RNGState)).
+			SetCampaign(snap.Campaign).
+			SetMapName(snap.MapName).
+			SetPar(snap.Par).
+			SetThumbnail(thumbnail).
+			SetCreatedAt(time.Now()).
+			SetUpdatedAt(time.Now()).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to create save slot %d: %w", slot, err)
+		}
+		return nil
+	}
+
+	_, err = existing.Update().
+		SetLevel(snap.Level).
+		SetTotalLevels(snap.TotalLevels).
+		SetSeed(snap.Seed).
+		SetPlayTime(snap.Time).
+		SetMapWidth(snap.W).
+		SetMapHeight(snap.H).
+		SetGrid(grid).
+		SetPlayer(snap.Player).
+		SetEnemies(enemies).
+		SetEnemyTypes(snap.EnemyTypes).
+		SetPickups(pickups).
+		SetProjectiles(bullets).
+		SetDoors(doors).
+		SetSecrets(secrets).
+		SetBarrels(barrels).
+		SetDefeated(snap.Defeated).
+		SetLevelEnemyTotal(snap.LevelEnemyTotal).
+		SetLevelTime(snap.LevelTime).
+		SetCompletion(int(snap.Rule)).
+		SetFireRate(snap.Settings.FireRate).
+		SetBulletSpeed(snap.Settings.BulletSpeed).
+		SetRngState(rngColumn(snap.RNGState)).
+		SetCampaign(snap.Campaign).
+		SetMapName(snap.MapName).
+		SetPar(snap.Par).
+		SetThumbnail(thumbnail).
+		SetUpdatedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to update save slot %d: %w", slot, err)
+	}
+	return nil
+}
+
+// LoadGame reads the snapshot stored in one of the current profile's slots
+func (db *Database) LoadGame(slot int) (sim.Snapshot, error) {
+	ctx := context.Background()
+
+	s, err := db.profileSaves().Where(saveslot.Slot(slot)).Only(ctx)
+	if err != nil {
+		return sim.Snapshot{}, fmt.Errorf("failed to load save slot %d: %w", slot, err)
+	}
+	if len(s.Grid) != s.MapWidth*s.MapHeight {
+		return sim.Snapshot{}, fmt.Errorf("save slot %d is corrupt: %d tiles for a %dx%d map", slot, len(s.Grid), s.MapWidth, s.MapHeight)
+	}
+
+	grid := make([]int, len(s.Grid))
+	for i, t := range s.Grid {
+		grid[i] = int(t)
+	}
+
+	return sim.Snapshot{
+		Level:           s.Level,
+		TotalLevels:     s.TotalLevels,
+		Seed:            s.Seed,
+		Time:            s.PlayTime,
+		W:               s.MapWidth,
+		H:               s.MapHeight,
+		Grid:            grid,
+		Player:          s.Player,
+		Enemies:         s.Enemies,
+		EnemyTypes:      s.EnemyTypes,
+		Pickups:         s.Pickups,
+		Bullets:         s.Projectiles,
+		Doors:           s.Doors,
+		Secrets:         s.Secrets,
+		Barrels:         s.Barrels,
+		Defeated:        s.Defeated,
+		LevelEnemyTotal: s.LevelEnemyTotal,
+		LevelTime:       s.LevelTime,
+		Rule:            sim.Completion(s.Completion),
+		Settings: sim.Settings{
+			FireRate:    s.FireRate,
+			BulletSpeed: s.BulletSpeed,
+		},
+		RNGState: rngState(s.RngState),
+		Campaign: s.Campaign,
+		MapName:  s.MapName,
+		Par:      s.Par,
+	}, nil
+}
+
+// rngColumn stores the generator state in the rng_state column. SQLite
+// integers are signed, so it is bit-cast: states with the high bit set are
+// kept as negative numbers, and rngState casts them back unchanged.
+func rngColumn(state uint64) int64 {
+	return int64(state)
+}
+
+// rngState reads the generator state back from the rng_state column
+func rngState(column int64) uint64 {
+	return uint64(column)
+}
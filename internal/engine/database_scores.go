@@ -0,0 +1,76 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"doomlike/ent"
+	"doomlike/ent/highscore"
+	"doomlike/ent/profile"
+)
+
+// highScoreEntry is one row of the high-score table
+type highScoreEntry struct {
+	name        string
+	score       int
+	seed        int64
+	level       int
+	totalLevels int
+	won         bool
+	fireRate    float64
+	bulletSpeed float64
+	createdAt   time.Time
+}
+
+// HighScores returns the current profile's best scores, highest first; ties go to the earlier run
+func (db *Database) HighScores(limit int) ([]highScoreEntry, error) {
+	ctx := context.Background()
+
+	rows, err := db.client.HighScore.Query().
+		Where(highscore.HasProfileWith(profile.ID(db.profileID))).
+		Order(ent.Desc(highscore.FieldScore), ent.Asc(highscore.FieldCreatedAt)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list high scores: %w", err)
+	}
+
+	entries := make([]highScoreEntry, 0, len(rows))
+	for _, r := range rows {
+		entries = append(entries, highScoreEntry{
+			name:        r.Name,
+			score:       r.Score,
+			seed:        r.Seed,
+			level:       r.Level,
+			totalLevels: r.TotalLevels,
+			won:         r.Won,
+			fireRate:    r.FireRate,
+			bulletSpeed: r.BulletSpeed,
+			createdAt:   r.CreatedAt,
+		})
+	}
+	return entries, nil
+}
+
+// AddHighScore records the score of a finished run
+func (db *Database) AddHighScore(e highScoreEntry) error {
+	ctx := context.Background()
+
+	_, err := db.client.HighScore.Create().
+		SetProfileID(db.profileID).
+		SetName(e.name).
+		SetScore(e.score).
+		SetSeed(e.seed).
+		SetLevel(e.level).
+		SetTotalLevels(e.totalLevels).
+		SetWon(e.won).
+		SetFireRate(e.fireRate).
+		SetBulletSpeed(e.bulletSpeed).
+		SetCreatedAt(e.createdAt).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to save high score: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"path/filepath"
+
+	"doomlike/internal/sim"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// defaultDemoPath is where runs are recorded unless -record says otherwise
+func defaultDemoPath() string {
+	return filepath.Join(gameDataDir(), demoFile)
+}
+
+// startDemoPlayback loads a recorded demo and replays it through the normal
+// playing state, starting from the run's original seed and settings.
+func (g *Game) startDemoPlayback(path string) {
+	p, err := sim.LoadDemo(path)
+	if err != nil {
+		log.Printf("Failed to load demo %s: %v", path, err)
+		return
+	}
+
+	if p.Header.Enemies != g.enemyDefs.Hash() {
+		log.Printf("Failed to play demo %s: it was recorded with different enemy definitions", path)
+		return
+	}
+
+	var campaign *sim.Campaign
+	if p.Header.Campaign != "" {
+		if campaign, err = g.findCampaign(p.Header.Campaign); err != nil {
+			log.Printf("Failed to load campaign for demo %s: %v", path, err)
+			return
+		}
+	}
+
+	g.saveDemo()
+	g.world = sim.NewWorld(p.Header.Seed, p.Header.LevelCount, p.Header.Settings, g.enemyDefs)
+	g.world.Campaign = campaign
+	g.world.SetupLevel(1, true)
+	g.demoPlay = p
+	g.demoStatus = ""
+	g.pickupMessages = make([]pickupMessage, 0)
+	g.effects = g.effects[:0]
+	g.state = statePlaying
+	g.mouseGrabbed = false
+	ebiten.SetCursorMode(ebiten.CursorModeVisible)
+	log.Printf("Playing demo %s (seed %d, %d levels)", path, p.Header.Seed, p.Header.LevelCount)
+}
+
+// updateDemoPlayback advances one tick of a demo being played back. Level
+// transitions happen immediately, and playback returns to the main menu when
+// the recording ends or the run does.
+func (g *Game) updateDemoPlayback() error {
+	in, ok, err := g.demoPlay.Next(g.world)
+	if err != nil {
+		log.Printf("Demo playback stopped: %v", err)
+	}
+	if !ok {
+		g.resetToMainMenu()
+		return nil
+	}
+
+	outcome := g.world.Step(in)
+	if err := g.demoPlay.Verify(g.world); err != nil {
+		log.Printf("Demo playback: %v", err)
+		if errors.Is(err, sim.ErrDesync) && g.demoStatus == "" {
+			g.demoStatus = fmt.Sprintf("DESYNC at tick %d", g.demoPlay.Ticks())
+		}
+	}
+	g.handleWorldEvents()
+	g.updateGrumblingSounds()
+	g.updatePickupMessages(sim.TickDT)
+	g.updateEffects(sim.TickDT)
+
+	switch outcome {
+	case sim.PlayerDied:
+		g.resetToMainMenu()
+	case sim.LevelCleared:
+		if g.world.Level >= g.world.TotalLevels {
+			g.resetToMainMenu()
+			return nil
+		}
+		g.world.SetupLevel(g.world.Level+1, false)
+		g.effects = g.effects[:0]
+	}
+	return nil
+}
+
+// saveDemo writes the run being recorded, if any, and stops recording.
+func (g *Game) saveDemo() {
+	if g.demoRec == nil {
+		return
+	}
+	rec := g.demoRec
+	g.demoRec = nil
+	if rec.Ticks() == 0 {
+		return
+	}
+	if err := rec.WriteFile(g.demoPath); err != nil {
+		log.Printf("Failed to save demo: %v", err)
+		return
+	}
+	log.Printf("Saved demo of %d ticks to %s", rec.Ticks(), g.demoPath)
+}
@@ -0,0 +1,688 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"image/color"
+	"log"
+	"math"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"doomlike/internal/sim"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+	"github.com/hajimehoshi/ebiten/v2/text"
+)
+
+type editorTool int
+
+const (
+	toolWall editorTool = iota
+	toolFloor
+	toolEnemy
+	toolMedkit
+	toolAmmo
+	toolStart
+	toolDoor
+	toolRedDoor
+	toolBlueDoor
+	toolYellowDoor
+	toolRedKey
+	toolBlueKey
+	toolYellowKey
+	toolExit
+	toolSecret
+	toolShells
+	toolRockets
+	toolShotgun
+	toolChaingun
+	toolRocketLauncher
+	toolGreenArmor
+	toolBlueArmor
+	toolBarrel
+)
+
+// editorToolNames lists the tools in the order of their number keys; the
+// ones past 0 are reached with Tab or the mouse wheel
+var editorToolNames = []string{
+	"Wall", "Floor", "Enemy", "Medkit", "Ammo", "Player Start",
+	"Door", "Red Door", "Blue Door", "Yellow Door", "Red Key", "Blue Key", "Yellow Key",
+	"Exit", "Secret", "Shells", "Rockets", "Shotgun", "Chaingun", "Rocket Launcher",
+	"Green Armor", "Blue Armor", "Barrel",
+}
+
+var editorPickupTools = map[editorTool]sim.PickupType{
+	toolMedkit:    sim.PickupMedkit,
+	toolAmmo:      sim.PickupAmmo,
+	toolRedKey:    sim.PickupKeyRed,
+	toolBlueKey:   sim.PickupKeyBlue,
+	toolYellowKey: sim.PickupKeyYellow,
+
+	toolShells:         sim.PickupShells,
+	toolRockets:        sim.PickupRockets,
+	toolShotgun:        sim.PickupShotgun,
+	toolChaingun:       sim.PickupChaingun,
+	toolRocketLauncher: sim.PickupRocketLauncher,
+	toolGreenArmor:     sim.PickupArmorGreen,
+	toolBlueArmor:      sim.PickupArmorBlue,
+}
+
+var editorDoorTools = map[editorTool]sim.KeyColor{
+	toolDoor:       sim.KeyNone,
+	toolRedDoor:    sim.KeyRed,
+	toolBlueDoor:   sim.KeyBlue,
+	toolYellowDoor: sim.KeyYellow,
+}
+
+// mapEditor is the state of the top-down level editor
+type mapEditor struct {
+	m    *sim.Map
+	path string
+	tool editorTool
+	kind sim.EnemyType // what the enemy tool places
+	defs sim.EnemyDefs // the registry maps are loaded and made with
+
+	undo []*sim.Map
+	redo []*sim.Map
+
+	stroke           bool // a mouse button is held down over the map
+	cursorX, cursorY int  // hovered tile
+	cursorIn         bool
+
+	editingPath bool
+	pathInput   []rune
+
+	message string
+}
+
+// defaultEditorPath is the map the editor opens when no file is given
+func defaultEditorPath() string {
+	return filepath.Join(gameDataDir(), filepath.FromSlash(editorFile))
+}
+
+// openEditor enters the editor, keeping the map from the last session if there was one
+func (g *Game) openEditor() {
+	if g.editor == nil {
+		g.editor = &mapEditor{path: defaultEditorPath(), defs: g.enemyDefs}
+		if g.opts.EditMap != "" {
+			g.editor.path = g.opts.EditMap
+		}
+		if m, err := sim.LoadMap(g.editor.path, g.editor.defs); err == nil {
+			g.editor.m = m
+			g.editor.message = "Loaded " + g.editor.path
+		} else {
+			g.editor.m = sim.NewMap(editorDefaultW, editorDefaultH, g.editor.defs)
+		}
+	}
+	g.state = stateEditor
+	g.mouseGrabbed = false
+	ebiten.SetCursorMode(ebiten.CursorModeVisible)
+}
+
+// layout returns the screen position of tile (0,0) and the size of a tile in pixels
+func (ed *mapEditor) layout() (ox, oy, cell int) {
+	areaW := ScreenW - editorSidebarW - 2*editorMargin
+	areaH := ScreenH - 2*editorMargin
+	cell = min(areaW/ed.m.W, areaH/ed.m.H)
+	if cell < 2 {
+		cell = 2
+	}
+	ox = editorMargin + (areaW-cell*ed.m.W)/2
+	oy = editorMargin + (areaH-cell*ed.m.H)/2
+	return ox, oy, cell
+}
+
+func (ed *mapEditor) pushUndo() {
+	ed.undo = append(ed.undo, ed.m.Clone())
+	if len(ed.undo) > editorUndoLimit {
+		ed.undo = ed.undo[1:]
+	}
+	ed.redo = nil
+}
+
+func (g *Game) updateEditor() {
+	ed := g.editor
+
+	if ed.editingPath {
+		ed.updatePathInput()
+		return
+	}
+
+	// Track the hovered tile
+	mx, my := ebiten.CursorPosition()
+	ox, oy, cell := ed.layout()
+	ed.cursorX = int(math.Floor(float64(mx-ox) / float64(cell)))
+	ed.cursorY = int(math.Floor(float64(my-oy) / float64(cell)))
+	ed.cursorIn = ed.cursorX >= 0 && ed.cursorY >= 0 && ed.cursorX < ed.m.W && ed.cursorY < ed.m.H
+
+	ctrl := ebiten.IsKeyPressed(ebiten.KeyControl)
+	shift := ebiten.IsKeyPressed(ebiten.KeyShift)
+
+	if ctrl {
+		switch {
+		case inpututil.IsKeyJustPressed(ebiten.KeyZ) && shift, inpututil.IsKeyJustPressed(ebiten.KeyY):
+			ed.redoEdit()
+		case inpututil.IsKeyJustPressed(ebiten.KeyZ):
+			ed.undoEdit()
+		case inpututil.IsKeyJustPressed(ebiten.KeyS):
+			ed.save()
+		case inpututil.IsKeyJustPressed(ebiten.KeyL):
+			ed.load()
+		case inpututil.IsKeyJustPressed(ebiten.KeyN):
+			ed.pushUndo()
+			ed.m = sim.NewMap(editorDefaultW, editorDefaultH, ed.defs)
+			ed.message = "New map"
+		}
+		return
+	}
+
+	// Tool selection
+	for i := range min(len(editorToolNames), 10) {
+		if inpututil.IsKeyJustPressed(editorToolKey(i)) {
+			ed.tool = editorTool(i)
+		}
+	}
+	step := 0
+	if inpututil.IsKeyJustPressed(ebiten.KeyTab) {
+		step = 1
+		if shift {
+			step = -1
+		}
+	}
+	if _, wy := ebiten.Wheel(); wy != 0 {
+		step = -int(math.Copysign(1, wy))
+	}
+	if step != 0 {
+		n := len(editorToolNames)
+		ed.tool = editorTool((int(ed.tool) + step + n) % n)
+	}
+
+	// Pick the enemy the enemy tool places
+	kinds := 0
+	if inpututil.IsKeyJustPressed(ebiten.KeyBracketRight) {
+		kinds = 1
+	}
+	if inpututil.IsKeyJustPressed(ebiten.KeyBracketLeft) {
+		kinds = -1
+	}
+	if kinds != 0 {
+		n := len(ed.defs)
+		ed.kind = sim.EnemyType((int(ed.kind) + kinds + n) % n)
+		ed.tool = toolEnemy
+	}
+
+	// Resize with Shift+arrows
+	if shift {
+		dw, dh := 0, 0
+		if inpututil.IsKeyJustPressed(ebiten.KeyLeft) {
+			dw--
+		}
+		if inpututil.IsKeyJustPressed(ebiten.KeyRight) {
+			dw++
+		}
+		if inpututil.IsKeyJustPressed(ebiten.KeyUp) {
+			dh--
+		}
+		if inpututil.IsKeyJustPressed(ebiten.KeyDown) {
+			dh++
+		}
+		if dw != 0 || dh != 0 {
+			nw := min(max(ed.m.W+dw, editorMinSize), editorMaxSize)
+			nh := min(max(ed.m.H+dh, editorMinSize), editorMaxSize)
+			if nw != ed.m.W || nh != ed.m.H {
+				ed.pushUndo()
+				ed.m.Resize(nw, nh)
+			}
+		}
+	}
+
+	// Cycle the completion rule
+	if inpututil.IsKeyJustPressed(ebiten.KeyC) {
+		ed.pushUndo()
+		ed.m.Complete = (ed.m.Complete + 1) % (sim.CompleteBoth + 1)
+	}
+
+	// Turn the player start
+	if inpututil.IsKeyJustPressed(ebiten.KeyF) {
+		ed.pushUndo()
+		ed.m.Angle += math.Pi / 2
+		if ed.m.Angle > math.Pi {
+			ed.m.Angle -= 2 * math.Pi
+		}
+	}
+
+	if inpututil.IsKeyJustPressed(ebiten.KeyF2) {
+		ed.editingPath = true
+		ed.pathInput = []rune(ed.path)
+		return
+	}
+
+	if inpututil.IsKeyJustPressed(ebiten.KeyP) {
+		g.startPlaytest()
+		return
+	}
+
+	// Paint with the left button, erase with the right; one undo step per stroke
+	left := ebiten.IsMouseButtonPressed(ebiten.MouseButtonLeft)
+	right := ebiten.IsMouseButtonPressed(ebiten.MouseButtonRight)
+	if !left && !right {
+		ed.stroke = false
+		return
+	}
+	if !ed.cursorIn {
+		return
+	}
+	if !ed.stroke {
+		ed.pushUndo()
+		ed.stroke = true
+	}
+	if left {
+		ed.paint(ed.cursorX, ed.cursorY)
+	} else {
+		ed.erase(ed.cursorX, ed.cursorY)
+	}
+}
+
+func (ed *mapEditor) undoEdit() {
+	if len(ed.undo) == 0 {
+		ed.message = "Nothing to undo"
+		return
+	}
+	ed.redo = append(ed.redo, ed.m)
+	ed.m = ed.undo[len(ed.undo)-1]
+	ed.undo = ed.undo[:len(ed.undo)-1]
+	ed.message = ""
+}
+
+func (ed *mapEditor) redoEdit() {
+	if len(ed.redo) == 0 {
+		ed.message = "Nothing to redo"
+		return
+	}
+	ed.undo = append(ed.undo, ed.m)
+	ed.m = ed.redo[len(ed.redo)-1]
+	ed.redo = ed.redo[:len(ed.redo)-1]
+	ed.message = ""
+}
+
+// paint applies the current tool to a tile
+func (ed *mapEditor) paint(x, y int) {
+	m := ed.m
+	idx := y*m.W + x
+	onStart := int(m.Start.X) == x && int(m.Start.Y) == y
+	if x == 0 || y == 0 || x == m.W-1 || y == m.H-1 {
+		if ed.tool != toolWall {
+			ed.message = "The map border must stay wall"
+		}
+		return
+	}
+
+	switch ed.tool {
+	case toolWall:
+		if onStart {
+			ed.message = "Move the player start before walling over it"
+			return
+		}
+		removeThingsAt(m, x, y)
+		m.Grid[idx] = sim.TileWall
+	case toolFloor:
+		removeDoorAt(m, x, y)
+		m.Grid[idx] = sim.TileEmpty
+	case toolStart:
+		removeThingsAt(m, x, y)
+		m.Grid[idx] = sim.TileEmpty
+		m.Start = tileCenter(x, y)
+	case toolExit:
+		if onStart {
+			return
+		}
+		removeThingsAt(m, x, y)
+		m.Grid[idx] = sim.TileExit
+	case toolSecret:
+		if onStart {
+			return
+		}
+		removeThingsAt(m, x, y)
+		m.Grid[idx] = sim.TileEmpty
+		m.Secrets = append(m.Secrets, sim.Secret{X: x, Y: y})
+	default:
+		if onStart {
+			return
+		}
+		removeThingsAt(m, x, y)
+		if key, ok := editorDoorTools[ed.tool]; ok {
+			m.Grid[idx] = sim.TileDoor
+			m.Doors = append(m.Doors, sim.Door{X: x, Y: y, Key: key})
+			return
+		}
+		m.Grid[idx] = sim.TileEmpty
+		if ed.tool == toolEnemy {
+			m.Enemies = append(m.Enemies, sim.Enemy{Pos: tileCenter(x, y), Type: ed.kind, HP: m.EnemyDef(ed.kind).HP})
+		}
+		if ed.tool == toolBarrel {
+			m.Barrels = append(m.Barrels, sim.NewBarrel(tileCenter(x, y)))
+		}
+		if kind, ok := editorPickupTools[ed.tool]; ok {
+			m.Pickups = append(m.Pickups, sim.Pickup{Pos: tileCenter(x, y), Type: kind})
+		}
+	}
+}
+
+// erase clears a tile back to bare floor
+func (ed *mapEditor) erase(x, y int) {
+	m := ed.m
+	if x == 0 || y == 0 || x == m.W-1 || y == m.H-1 {
+		return
+	}
+	removeThingsAt(m, x, y)
+	m.Grid[y*m.W+x] = sim.TileEmpty
+}
+
+func tileCenter(x, y int) sim.Vec2 {
+	return sim.Vec2{X: float64(x) + 0.5, Y: float64(y) + 0.5}
+}
+
+// removeThingsAt deletes every enemy, pickup, barrel, door and secret placed on a tile
+func removeThingsAt(m *sim.Map, x, y int) {
+	removeDoorAt(m, x, y)
+	m.Secrets = slices.DeleteFunc(m.Secrets, func(s sim.Secret) bool { return s.X == x && s.Y == y })
+	at := func(p sim.Vec2) bool { return int(p.X) == x && int(p.Y) == y }
+	enemies := m.Enemies[:0]
+	for _, e := range m.Enemies {
+		if !at(e.Pos) {
+			enemies = append(enemies, e)
+		}
+	}
+	m.Enemies = enemies
+	pickups := m.Pickups[:0]
+	for _, pk := range m.Pickups {
+		if !at(pk.Pos) {
+			pickups = append(pickups, pk)
+		}
+	}
+	m.Pickups = pickups
+	m.Barrels = slices.DeleteFunc(m.Barrels, func(b sim.Barrel) bool { return at(b.Pos) })
+}
+
+func removeDoorAt(m *sim.Map, x, y int) {
+	doors := m.Doors[:0]
+	for _, d := range m.Doors {
+		if d.X != x || d.Y != y {
+			doors = append(doors, d)
+		}
+	}
+	m.Doors = doors
+}
+
+// editorToolKey returns the number key selecting tool i; 0 comes after 9
+func editorToolKey(i int) ebiten.Key {
+	if i == 9 {
+		return ebiten.Key0
+	}
+	return ebiten.Key1 + ebiten.Key(i)
+}
+
+// save writes the map and reports the first problem the loader would reject it for
+func (ed *mapEditor) save() {
+	if err := ed.m.WriteFile(ed.path); err != nil {
+		log.Printf("Failed to save map: %v", err)
+		ed.message = "Save failed: " + firstError(err)
+		return
+	}
+	ed.message = "Saved " + ed.path
+	if _, err := sim.LoadMap(ed.path, ed.defs); err != nil {
+		ed.message = "Saved, but it will not load: " + firstError(err)
+	}
+}
+
+func (ed *mapEditor) load() {
+	m, err := sim.LoadMap(ed.path, ed.defs)
+	if err != nil {
+		log.Printf("Failed to load map: %v", err)
+		ed.message = "Load failed: " + firstError(err)
+		return
+	}
+	ed.pushUndo()
+	ed.m = m
+	ed.message = "Loaded " + ed.path
+}
+
+// firstError returns the first of a set of joined errors
+func firstError(err error) string {
+	var joined interface{ Unwrap() []error }
+	if errors.As(err, &joined) && len(joined.Unwrap()) > 0 {
+		return joined.Unwrap()[0].Error()
+	}
+	return err.Error()
+}
+
+// updatePathInput edits the map file path; Enter keeps it, Esc cancels
+func (ed *mapEditor) updatePathInput() {
+	ed.pathInput = ebiten.AppendInputChars(ed.pathInput)
+	if inpututil.IsKeyJustPressed(ebiten.KeyBackspace) && len(ed.pathInput) > 0 {
+		ed.pathInput = ed.pathInput[:len(ed.pathInput)-1]
+	}
+	if inpututil.IsKeyJustPressed(ebiten.KeyEnter) {
+		if p := strings.TrimSpace(string(ed.pathInput)); p != "" {
+			ed.path = p
+		}
+		ed.editingPath = false
+	}
+}
+
+// startPlaytest plays the map being edited, starting from the hovered tile when it is open floor
+func (g *Game) startPlaytest() {
+	ed := g.editor
+	m := ed.m.Clone()
+	if ed.cursorIn && m.Grid[ed.cursorY*m.W+ed.cursorX] == sim.TileEmpty {
+		m.Start = tileCenter(ed.cursorX, ed.cursorY)
+	}
+
+	g.saveDemo()
+	g.demoPlay = nil
+	g.world = sim.NewWorld(randomSeed(), 1, g.simSettings(), g.enemyDefs)
+	g.world.Campaign = &sim.Campaign{Name: "Play-test", Maps: []*sim.Map{m}}
+	g.world.SetupLevel(1, true)
+	g.playtest = true
+	g.pickupMessages = make([]pickupMessage, 0)
+	g.effects = g.effects[:0]
+	g.state = statePlaying
+	g.mouseGrabbed = true
+	ebiten.SetCursorMode(ebiten.CursorModeCaptured)
+	g.lastMouseX = 0
+}
+
+// endPlaytest returns from a play-test to the editor
+func (g *Game) endPlaytest(message string) {
+	g.playtest = false
+	g.editor.message = message
+	g.editor.stroke = true // ignore the button still held from play
+	g.state = stateEditor
+	g.mouseGrabbed = false
+	ebiten.SetCursorMode(ebiten.CursorModeVisible)
+}
+
+func (g *Game) drawEditor(dst *ebiten.Image) {
+	ed := g.editor
+	m := ed.m
+	drawRect(dst, g.pix, 0, 0, ScreenW, ScreenH, color.RGBA{8, 8, 10, 255})
+
+	ox, oy, cell := ed.layout()
+	gap := 0
+	if cell >= 8 {
+		gap = 1 // show the grid
+	}
+
+	// tiles
+	drawRect(dst, g.pix, ox-1, oy-1, m.W*cell+2, m.H*cell+2, uiAccent)
+	for y := 0; y < m.H; y++ {
+		for x := 0; x < m.W; x++ {
+			col := color.RGBA{18, 50, 18, 255}
+			switch m.Grid[y*m.W+x] {
+			case sim.TileWall:
+				col = color.RGBA{120, 120, 120, 255}
+			case sim.TileDoor:
+				col = keyColors[sim.KeyNone]
+			case sim.TileExit:
+				col = exitFloor
+			}
+			drawRect(dst, g.pix, ox+x*cell, oy+y*cell, cell-gap, cell-gap, col)
+		}
+	}
+
+	// things
+	mark := func(p sim.Vec2, col color.Color, label string) {
+		x, y := ox+int(p.X)*cell, oy+int(p.Y)*cell
+		pad := cell / 5
+		drawRect(dst, g.pix, x+pad, y+pad, cell-2*pad-gap, cell-2*pad-gap, col)
+		if cell >= 16 {
+			text.Draw(dst, label, g.face, x+cell/2-3, y+cell/2+4, black)
+		}
+	}
+	for _, e := range m.Enemies {
+		def := m.EnemyDef(e.Type)
+		mark(e.Pos, color.RGBA(def.Minimap), def.Char)
+	}
+	for _, d := range m.Doors {
+		mark(tileCenter(d.X, d.Y), keyColors[d.Key], "D")
+	}
+	for _, s := range m.Secrets {
+		mark(tileCenter(s.X, s.Y), magenta, "$")
+	}
+	for _, b := range m.Barrels {
+		mark(b.Pos, barrelColor, "B")
+	}
+	for _, pk := range m.Pickups {
+		switch {
+		case pk.Type == sim.PickupAmmo:
+			mark(pk.Pos, yellow, "A")
+		case pk.Type == sim.PickupShells:
+			mark(pk.Pos, yellow, "s")
+		case pk.Type == sim.PickupRockets:
+			mark(pk.Pos, yellow, "o")
+		case pk.Type == sim.PickupShotgun:
+			mark(pk.Pos, orange, "G")
+		case pk.Type == sim.PickupChaingun:
+			mark(pk.Pos, orange, "C")
+		case pk.Type == sim.PickupRocketLauncher:
+			mark(pk.Pos, orange, "L")
+		case pk.Type.Armor() != sim.ArmorNone:
+			mark(pk.Pos, armorColors[pk.Type.Armor()], "V")
+		case pk.Type.Key() != sim.KeyNone:
+			mark(pk.Pos, keyColors[pk.Type.Key()], "K")
+		default:
+			mark(pk.Pos, green, "H")
+		}
+	}
+
+	// player start and facing
+	mark(m.Start, uiAccent, "P")
+	sx := ox + int(m.Start.X*float64(cell))
+	sy := oy + int(m.Start.Y*float64(cell))
+	for i := 1; i <= 6; i++ {
+		t := float64(i) * float64(cell) / 4
+		drawRect(dst, g.pix, sx+int(math.Cos(m.Angle)*t)-1, sy+int(math.Sin(m.Angle)*t)-1, 3, 3, uiAccent)
+	}
+
+	// hovered tile
+	if ed.cursorIn {
+		cx, cy := ox+ed.cursorX*cell, oy+ed.cursorY*cell
+		drawRect(dst, g.pix, cx, cy, cell, 1, yellow)
+		drawRect(dst, g.pix, cx, cy+cell-1, cell, 1, yellow)
+		drawRect(dst, g.pix, cx, cy, 1, cell, yellow)
+		drawRect(dst, g.pix, cx+cell-1, cy, 1, cell, yellow)
+	}
+
+	// sidebar
+	px := ScreenW - editorSidebarW
+	drawRect(dst, g.pix, px, 0, editorSidebarW, ScreenH, uiBox)
+	drawRect(dst, g.pix, px, 0, 2, ScreenH, uiAccent)
+	lx := px + 18
+	ly := 40
+	text.Draw(dst, "MAP EDITOR", g.face, lx, ly, uiAccent)
+	ly += 26
+	if ed.editingPath {
+		text.Draw(dst, "File: "+string(ed.pathInput)+"_", g.face, lx, ly, yellow)
+	} else {
+		text.Draw(dst, "File: "+ed.path, g.face, lx, ly, white)
+	}
+	ly += 18
+	name := m.Name
+	if name == "" {
+		name = "(unnamed)"
+	}
+	text.Draw(dst, "Name: "+name, g.face, lx, ly, gray)
+	ly += 18
+	text.Draw(dst, fmt.Sprintf("Size: %d x %d", m.W, m.H), g.face, lx, ly, gray)
+	ly += 18
+	if ed.cursorIn {
+		text.Draw(dst, fmt.Sprintf("Tile: %d, %d", ed.cursorX, ed.cursorY), g.face, lx, ly, gray)
+	}
+	ly += 18
+	text.Draw(dst, fmt.Sprintf("Enemies: %d  Pickups: %d  Doors: %d", len(m.Enemies), len(m.Pickups), len(m.Doors)), g.face, lx, ly, gray)
+	ly += 18
+	text.Draw(dst, fmt.Sprintf("Secrets: %d  Complete: %s", len(m.Secrets), m.Complete), g.face, lx, ly, gray)
+	ly += 32
+
+	text.Draw(dst, "Tools", g.face, lx, ly, uiAccent)
+	ly += 22
+	for i, name := range editorToolNames {
+		col := white
+		if editorTool(i) == ed.tool {
+			col = yellow
+			text.Draw(dst, ">", g.face, lx-12, ly, col)
+		}
+		key := " "
+		if i < 10 {
+			key = fmt.Sprint((i + 1) % 10)
+		}
+		if editorTool(i) == toolEnemy {
+			name += ": " + ed.defs.Def(ed.kind).Name
+		}
+		text.Draw(dst, fmt.Sprintf("%s  %s", key, name), g.face, lx, ly, col)
+		ly += 18
+	}
+	ly += 20
+
+	help := []string{
+		"LMB paint, RMB erase",
+		"Tab/wheel cycle tools, [ ] pick enemy",
+		"F turn player start",
+		"C cycle completion rule",
+		"Shift+Arrows resize",
+		"Ctrl+Z undo, Ctrl+Y redo",
+		"Ctrl+S save, Ctrl+L load",
+		"Ctrl+N new map, F2 file name",
+		"P play-test from cursor, E opens doors",
+		"Esc back to main menu",
+	}
+	for _, line := range help {
+		text.Draw(dst, line, g.face, lx, ly, gray)
+		ly += 18
+	}
+
+	if ed.message != "" {
+		ly += 20
+		for _, line := range wrapText(ed.message, (editorSidebarW-36)/7) {
+			text.Draw(dst, line, g.face, lx, ly, green)
+			ly += 18
+		}
+	}
+}
+
+// wrapText breaks s into lines of at most width characters, splitting at spaces where possible
+func wrapText(s string, width int) []string {
+	var lines []string
+	for len(s) > width {
+		cut := strings.LastIndexByte(s[:width], ' ')
+		if cut <= 0 {
+			cut = width
+		}
+		lines = append(lines, s[:cut])
+		s = strings.TrimLeft(s[cut:], " ")
+	}
+	return append(lines, s)
+}
@@ -0,0 +1,190 @@
+package engine
+
+import (
+	"fmt"
+	"log"
+	"math"
+	"slices"
+
+	"doomlike/internal/sim"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+)
+
+// Gamepad buttons, named for what they do on Ebiten's standard layout
+const (
+	padConfirm  = ebiten.StandardGamepadButtonRightBottom // A / Cross
+	padBack     = ebiten.StandardGamepadButtonRightRight  // B / Circle
+	padDelete   = ebiten.StandardGamepadButtonRightLeft   // X / Square
+	padPause    = ebiten.StandardGamepadButtonCenterRight // Start / Options
+	padMap      = ebiten.StandardGamepadButtonCenterLeft  // Back / Share
+	padSprint   = ebiten.StandardGamepadButtonLeftStick
+	padPrevious = ebiten.StandardGamepadButtonFrontTopLeft
+	padNext     = ebiten.StandardGamepadButtonFrontTopRight
+	padFire     = ebiten.StandardGamepadButtonFrontBottomRight
+	padFireAlt  = ebiten.StandardGamepadButtonFrontBottomLeft
+	padUp       = ebiten.StandardGamepadButtonLeftTop
+	padDown     = ebiten.StandardGamepadButtonLeftBottom
+	padLeft     = ebiten.StandardGamepadButtonLeftLeft
+	padRight    = ebiten.StandardGamepadButtonLeftRight
+)
+
+// updateGamepads keeps track of gamepads as they are plugged in and pulled
+// out. Only those Ebiten can map to the standard layout are used, since the
+// button constants above mean nothing on a raw one.
+func (g *Game) updateGamepads() {
+	for _, id := range inpututil.AppendJustConnectedGamepadIDs(nil) {
+		name := ebiten.GamepadName(id)
+		if !ebiten.IsStandardGamepadLayoutAvailable(id) {
+			log.Printf("Gamepad %q connected but has no standard mapping; ignoring it", name)
+			g.showPadNotice(fmt.Sprintf("Unsupported gamepad: %s", name))
+			continue
+		}
+		g.gamepads = append(g.gamepads, id)
+		log.Printf("Gamepad %q connected", name)
+		g.showPadNotice(fmt.Sprintf("Gamepad connected: %s", name))
+	}
+
+	kept := g.gamepads[:0]
+	for _, id := range g.gamepads {
+		if inpututil.IsGamepadJustDisconnected(id) {
+			log.Printf("Gamepad %d disconnected", id)
+			g.showPadNotice("Gamepad disconnected")
+			continue
+		}
+		kept = append(kept, id)
+	}
+	g.gamepads = kept
+
+	if g.padNoticeTimer > 0 {
+		g.padNoticeTimer -= sim.TickDT
+		if g.padNoticeTimer <= 0 {
+			g.padNotice = ""
+		}
+	}
+}
+
+func (g *Game) showPadNotice(s string) {
+	g.padNotice = s
+	g.padNoticeTimer = padNoticeDuration
+}
+
+// padPressed reports whether b is held on any gamepad
+func (g *Game) padPressed(b ebiten.StandardGamepadButton) bool {
+	return slices.ContainsFunc(g.gamepads, func(id ebiten.GamepadID) bool {
+		return ebiten.IsStandardGamepadButtonPressed(id, b)
+	})
+}
+
+// padJustPressed reports whether b went down this tick on any gamepad
+func (g *Game) padJustPressed(b ebiten.StandardGamepadButton) bool {
+	return slices.ContainsFunc(g.gamepads, func(id ebiten.GamepadID) bool {
+		return inpututil.IsStandardGamepadButtonJustPressed(id, b)
+	})
+}
+
+// padStick reads a stick of the first gamepad that has it off centre,
+// through the deadzone and response curve
+func (g *Game) padStick(h, v ebiten.StandardGamepadAxis) (x, y float64) {
+	for _, id := range g.gamepads {
+		x, y = stickCurve(ebiten.StandardGamepadAxisValue(id, h), ebiten.StandardGamepadAxisValue(id, v),
+			g.settings.padDeadzone, g.settings.padCurve)
+		if x != 0 || y != 0 {
+			return x, y
+		}
+	}
+	return 0, 0
+}
+
+// stickCurve applies a radial deadzone to a stick position and raises what
+// is left, rescaled to 0..1, to the curve exponent. Keeping the direction and
+// only reshaping the length lets diagonals move as freely as the axes, while
+// the curve leaves fine aim near the centre and full speed at the rim.
+func stickCurve(x, y, deadzone, curve float64) (float64, float64) {
+	l := math.Hypot(x, y)
+	if l <= deadzone {
+		return 0, 0
+	}
+	scaled := math.Pow(min((l-deadzone)/(1-deadzone), 1), curve)
+	return x / l * scaled, y / l * scaled
+}
+
+// readGamepad adds the gamepads to one tick of simulation input. The left
+// stick moves and the right stick turns; sticks add to the keyboard so either
+// can be used at any moment, and the simulation clamps the sum.
+func (g *Game) readGamepad(in *sim.Input, dt float64) {
+	if len(g.gamepads) == 0 {
+		return
+	}
+
+	// Stick up reads negative, and up should move forward
+	sx, sy := g.padStick(ebiten.StandardGamepadAxisLeftStickHorizontal, ebiten.StandardGamepadAxisLeftStickVertical)
+	in.Forward -= sy
+	in.Strafe += sx
+
+	tx, _ := g.padStick(ebiten.StandardGamepadAxisRightStickHorizontal, ebiten.StandardGamepadAxisRightStickVertical)
+	in.Turn += tx * g.settings.padTurnSpeed * dt
+
+	if g.padPressed(padSprint) {
+		in.Sprint = true
+	}
+	if g.padPressed(padFire) || g.padPressed(padFireAlt) {
+		in.Fire = true
+	}
+	if g.padJustPressed(padConfirm) {
+		in.Use = true
+	}
+	if g.padJustPressed(padPrevious) {
+		in.CycleWeapon = -1
+	}
+	if g.padJustPressed(padNext) {
+		in.CycleWeapon = 1
+	}
+}
+
+// Menu navigation accepts the keyboard or the gamepad D-pad and buttons.
+
+func (g *Game) menuUp() bool {
+	return inpututil.IsKeyJustPressed(ebiten.KeyUp) || g.padJustPressed(padUp)
+}
+
+func (g *Game) menuDown() bool {
+	return inpututil.IsKeyJustPressed(ebiten.KeyDown) || g.padJustPressed(padDown)
+}
+
+func (g *Game) menuLeft() bool {
+	return inpututil.IsKeyJustPressed(ebiten.KeyLeft) || g.padJustPressed(padLeft)
+}
+
+func (g *Game) menuRight() bool {
+	return inpututil.IsKeyJustPressed(ebiten.KeyRight) || g.padJustPressed(padRight)
+}
+
+func (g *Game) menuConfirm() bool {
+	return inpututil.IsKeyJustPressed(ebiten.KeyEnter) || inpututil.IsKeyJustPressed(ebiten.KeyNumpadEnter) ||
+		g.padJustPressed(padConfirm)
+}
+
+// menuBack is Esc or B on the screens that handle leaving themselves rather
+// than through the global Esc
+func (g *Game) menuBack() bool {
+	return inpututil.IsKeyJustPressed(ebiten.KeyEscape) || g.padJustPressed(padBack)
+}
+
+// escapePressed reports the global Esc: the key itself, Start to pause and
+// resume play, or B to leave a menu
+func (g *Game) escapePressed() bool {
+	if inpututil.IsKeyJustPressed(ebiten.KeyEscape) {
+		return true
+	}
+	switch g.state {
+	case statePlaying:
+		return g.padJustPressed(padPause)
+	case stateInGameMenu:
+		return g.padJustPressed(padPause) || g.padJustPressed(padBack)
+	case stateOptions, stateSaveLoad, stateHighScores, stateStatistics:
+		return g.padJustPressed(padBack)
+	}
+	return false
+}
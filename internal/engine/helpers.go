@@ -1,6 +1,7 @@
 package engine
 
 import (
+	"fmt"
 	"image/color"
 	"math"
 
@@ -9,6 +10,20 @@ import (
 
 func deg2rad(d float64) float64 { return d * math.Pi / 180.0 }
 
+// formatClock renders seconds as m:ss
+func formatClock(seconds float64) string {
+	s := int(seconds)
+	return fmt.Sprintf("%d:%02d", s/60, s%60)
+}
+
+// onOff labels a toggle setting
+func onOff(b bool) string {
+	if b {
+		return "On"
+	}
+	return "Off"
+}
+
 func clamp01(v float64) float64 {
 	if v < 0 {
 		return 0
@@ -19,16 +34,6 @@ func clamp01(v float64) float64 {
 	return v
 }
 
-func clampF(v, lo, hi float64) float64 {
-	if v < lo {
-		return lo
-	}
-	if v > hi {
-		return hi
-	}
-	return v
-}
-
 func shade(c color.RGBA, mul float64) color.RGBA {
 	r := uint8(clamp01(float64(c.R)*mul/255.0) * 255.0)
 	g := uint8(clamp01(float64(c.G)*mul/255.0) * 255.0)
@@ -60,12 +65,6 @@ func drawRectHR(dst, pix *ebiten.Image, x, y, w, h int, clr color.Color) {
 	dst.DrawImage(pix, op)
 }
 
-func dist2(x1, y1, x2, y2 float64) float64 {
-	dx := x2 - x1
-	dy := y2 - y1
-	return dx*dx + dy*dy
-}
-
 func mapSideVertical(stepX int) int {
 	if stepX > 0 {
 		return 0
@@ -91,7 +90,7 @@ func normalizeAngle(a float64) float64 {
 }
 
 func hWorldCellAtRay(g *Game, angle float64, dist float64) (int, int) {
-	wx := g.p.pos.x + math.Cos(angle)*dist
-	wy := g.p.pos.y + math.Sin(angle)*dist
+	wx := g.world.Player.Pos.X + math.Cos(angle)*dist
+	wy := g.world.Player.Pos.Y + math.Sin(angle)*dist
 	return int(math.Floor(wx)), int(math.Floor(wy))
 }
@@ -0,0 +1,162 @@
+package engine
+
+import (
+	"fmt"
+	"image/color"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+	"github.com/hajimehoshi/ebiten/v2/text"
+)
+
+// openHighScores shows the high-score table from the main menu
+func (g *Game) openHighScores() {
+	g.refreshHighScores()
+	g.newScoreRank = -1
+	g.state = stateHighScores
+}
+
+// refreshHighScores reloads the table from the database
+func (g *Game) refreshHighScores() {
+	g.highScores = nil
+	if g.db == nil {
+		return
+	}
+	scores, err := g.db.HighScores(highScoreCount)
+	if err != nil {
+		log.Printf("Failed to load high scores: %v", err)
+		return
+	}
+	g.highScores = scores
+}
+
+// endRun is called as a run ends in a win or a death. A score good enough
+// for the table asks for a name first; play-tests and demo playback are
+// never recorded.
+func (g *Game) endRun(won bool) {
+	g.nameEntry = nil
+	g.newScoreRank = -1
+	if g.db == nil || g.playtest || g.demoPlay != nil {
+		return
+	}
+	g.refreshHighScores()
+	score := g.world.Player.Score
+	if score <= 0 {
+		return
+	}
+	if len(g.highScores) >= highScoreCount && score <= g.highScores[len(g.highScores)-1].score {
+		return
+	}
+	g.nameEntry = &highScoreEntry{
+		score:       score,
+		seed:        g.world.Seed,
+		level:       g.world.Level,
+		totalLevels: g.world.TotalLevels,
+		won:         won,
+		fireRate:    g.world.Settings.FireRate,
+		bulletSpeed: g.world.Settings.BulletSpeed,
+	}
+	// Offer the profile's name
+	g.nameInput = []rune(g.profileName)
+	if len(g.nameInput) > highScoreNameMax {
+		g.nameInput = g.nameInput[:highScoreNameMax]
+	}
+}
+
+// updateNameEntry edits the name for a new high score; Enter records it,
+// Esc leaves the score out of the table
+func (g *Game) updateNameEntry() {
+	for _, r := range ebiten.AppendInputChars(nil) {
+		if len(g.nameInput) < highScoreNameMax && r >= ' ' && r <= '~' {
+			g.nameInput = append(g.nameInput, r)
+		}
+	}
+	if inpututil.IsKeyJustPressed(ebiten.KeyBackspace) && len(g.nameInput) > 0 {
+		g.nameInput = g.nameInput[:len(g.nameInput)-1]
+	}
+	if g.menuBack() {
+		g.nameEntry = nil
+		return
+	}
+	if !g.menuConfirm() {
+		return
+	}
+
+	entry := *g.nameEntry
+	g.nameEntry = nil
+	entry.name = strings.TrimSpace(string(g.nameInput))
+	if entry.name == "" {
+		entry.name = defaultScoreName
+	}
+	entry.createdAt = time.Now()
+	if err := g.db.AddHighScore(entry); err != nil {
+		log.Printf("Failed to record high score: %v", err)
+		return
+	}
+	g.refreshHighScores()
+	for i, s := range g.highScores {
+		if s.name == entry.name && s.score == entry.score && s.createdAt.Equal(entry.createdAt) {
+			g.newScoreRank = i
+		}
+	}
+}
+
+func (g *Game) updateHighScores() {
+	if g.menuConfirm() {
+		g.state = stateMainMenu
+	}
+}
+
+func (g *Game) drawHighScores(dst *ebiten.Image) {
+	drawRect(dst, g.pix, 0, 0, ScreenW, ScreenH, color.RGBA{0, 0, 0, 180})
+
+	w, h := 620, 120+highScoreCount*20
+	x := (ScreenW - w) / 2
+	y := (ScreenH - h) / 2
+
+	drawRect(dst, g.pix, x, y, w, h, uiBox)
+	drawRect(dst, g.pix, x, y, w, 2, uiAccent)
+	drawRect(dst, g.pix, x, y+h-2, w, 2, uiAccent)
+	drawRect(dst, g.pix, x, y, 2, h, uiAccent)
+	drawRect(dst, g.pix, x+w-2, y, 2, h, uiAccent)
+
+	lx := x + 30
+	ly := y + 30
+	text.Draw(dst, "HIGH SCORES", g.face, lx, ly, uiAccent)
+	ly += 30
+	ly = g.drawHighScoreTable(dst, lx, ly)
+	ly += 20
+	text.Draw(dst, "Enter/Esc: Back", g.face, lx, ly, gray)
+}
+
+// drawHighScoreTable lists the table from the given top-left corner, with
+// the score just entered highlighted, and returns the y below it
+func (g *Game) drawHighScoreTable(dst *ebiten.Image, lx, ly int) int {
+	if len(g.highScores) == 0 {
+		msg := "No high scores yet"
+		if g.db == nil {
+			msg = "High scores are unavailable without a database"
+		}
+		text.Draw(dst, msg, g.face, lx, ly, gray)
+		return ly + 20
+	}
+	for i, s := range g.highScores {
+		col := white
+		if i == g.newScoreRank {
+			col = yellow
+		}
+		levels := fmt.Sprintf("%d/%d", s.level, s.totalLevels)
+		if s.won {
+			levels = fmt.Sprintf("all %d", s.totalLevels)
+		}
+		text.Draw(dst, fmt.Sprintf("%2d. %-16s %8d", i+1, s.name, s.score), g.face, lx, ly, col)
+		text.Draw(dst, levels, g.face, lx+280, ly, gray)
+		text.Draw(dst, fmt.Sprintf("seed %d", s.seed), g.face, lx+350, ly, gray)
+		text.Draw(dst, s.createdAt.Local().Format("2006-01-02"), g.face, lx+470, ly, gray)
+		ly += 20
+	}
+	return ly
+}
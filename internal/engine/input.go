@@ -0,0 +1,248 @@
+package engine
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"doomlike/internal/sim"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+)
+
+// action is something the player does in play that can be bound to keys or
+// mouse buttons. Weapon slots follow the fixed actions, one per weapon.
+type action int
+
+const (
+	actionMoveForward action = iota
+	actionMoveBackward
+	actionStrafeLeft
+	actionStrafeRight
+	actionTurnLeft
+	actionTurnRight
+	actionSprint
+	actionFire
+	actionUse
+	actionToggleMap
+	actionWeapon1
+)
+
+// bindingSlots is how many inputs each action can have
+const bindingSlots = 2
+
+// actionDef names an action for the controls page and for stored bindings,
+// and gives its default inputs
+type actionDef struct {
+	name     string // stored in the database and settings files
+	label    string
+	defaults [bindingSlots]binding
+}
+
+// actionDefs lists every action in the order the controls page shows them
+var actionDefs = buildActionDefs()
+
+func buildActionDefs() []actionDef {
+	defs := []actionDef{
+		{"move_forward", "Move Forward", [bindingSlots]binding{keyBinding(ebiten.KeyW)}},
+		{"move_backward", "Move Backward", [bindingSlots]binding{keyBinding(ebiten.KeyS)}},
+		{"strafe_left", "Strafe Left", [bindingSlots]binding{keyBinding(ebiten.KeyA)}},
+		{"strafe_right", "Strafe Right", [bindingSlots]binding{keyBinding(ebiten.KeyD)}},
+		{"turn_left", "Turn Left", [bindingSlots]binding{keyBinding(ebiten.KeyLeft)}},
+		{"turn_right", "Turn Right", [bindingSlots]binding{keyBinding(ebiten.KeyRight)}},
+		{"sprint", "Sprint", [bindingSlots]binding{keyBinding(ebiten.KeyShift)}},
+		{"fire", "Fire", [bindingSlots]binding{mouseBinding(ebiten.MouseButtonLeft), keyBinding(ebiten.KeySpace)}},
+		{"use", "Use", [bindingSlots]binding{keyBinding(ebiten.KeyE)}},
+		{"toggle_map", "Toggle Map", [bindingSlots]binding{keyBinding(ebiten.KeyM)}},
+	}
+	for i := range sim.WeaponCount {
+		defs = append(defs, actionDef{
+			name:     fmt.Sprintf("weapon_%d", i+1),
+			label:    sim.WeaponType(i).String(),
+			defaults: [bindingSlots]binding{keyBinding(ebiten.Key1 + ebiten.Key(i))},
+		})
+	}
+	return defs
+}
+
+// bindingKind tells what a binding refers to
+type bindingKind uint8
+
+const (
+	bindNone bindingKind = iota
+	bindKey
+	bindMouse
+)
+
+// binding is one key or mouse button, or nothing for an empty slot
+type binding struct {
+	kind   bindingKind
+	key    ebiten.Key
+	button ebiten.MouseButton
+}
+
+func keyBinding(k ebiten.Key) binding { return binding{kind: bindKey, key: k} }
+
+func mouseBinding(b ebiten.MouseButton) binding { return binding{kind: bindMouse, button: b} }
+
+// mouseButtonNames are the stored names of mouse buttons, by button number
+var mouseButtonNames = []string{"MouseLeft", "MouseMiddle", "MouseRight", "Mouse3", "Mouse4"}
+
+// String is the stored and displayed name of b, empty for an empty slot
+func (b binding) String() string {
+	switch b.kind {
+	case bindKey:
+		return b.key.String()
+	case bindMouse:
+		if int(b.button) < len(mouseButtonNames) {
+			return mouseButtonNames[b.button]
+		}
+	}
+	return ""
+}
+
+// parseBinding reads a name written by binding.String
+func parseBinding(s string) (binding, error) {
+	for i, name := range mouseButtonNames {
+		if strings.EqualFold(s, name) {
+			return mouseBinding(ebiten.MouseButton(i)), nil
+		}
+	}
+	var k ebiten.Key
+	if err := k.UnmarshalText([]byte(s)); err != nil {
+		return binding{}, fmt.Errorf("unknown key or button %q", s)
+	}
+	return keyBinding(k), nil
+}
+
+func (b binding) pressed() bool {
+	switch b.kind {
+	case bindKey:
+		return ebiten.IsKeyPressed(b.key)
+	case bindMouse:
+		return ebiten.IsMouseButtonPressed(b.button)
+	}
+	return false
+}
+
+func (b binding) justPressed() bool {
+	switch b.kind {
+	case bindKey:
+		return inpututil.IsKeyJustPressed(b.key)
+	case bindMouse:
+		return inpututil.IsMouseButtonJustPressed(b.button)
+	}
+	return false
+}
+
+// bindings holds the inputs of every action, indexed by action
+type bindings [][bindingSlots]binding
+
+func defaultBindings() bindings {
+	b := make(bindings, len(actionDefs))
+	for i, def := range actionDefs {
+		b[i] = def.defaults
+	}
+	return b
+}
+
+// actionPressed reports whether any input of a is held
+func (g *Game) actionPressed(a action) bool {
+	for _, b := range g.bindings[a] {
+		if b.pressed() {
+			return true
+		}
+	}
+	return false
+}
+
+// actionJustPressed reports whether any input of a went down this tick
+func (g *Game) actionJustPressed(a action) bool {
+	for _, b := range g.bindings[a] {
+		if b.justPressed() {
+			return true
+		}
+	}
+	return false
+}
+
+// bind puts in into slot of a. An input drives a single action, so it is
+// taken off any other action or slot it was bound to; the returned action
+// is that one, or -1.
+func (b bindings) bind(a action, slot int, in binding) action {
+	from := action(-1)
+	for i := range b {
+		for s := range b[i] {
+			if b[i][s] == in && (action(i) != a || s != slot) {
+				b[i][s] = binding{}
+				from = action(i)
+			}
+		}
+	}
+	b[a][slot] = in
+	return from
+}
+
+// encode turns b into action names and input names for storage. Every
+// action is listed so one cleared on purpose stays cleared.
+func (b bindings) encode() map[string][]string {
+	m := make(map[string][]string, len(b))
+	for i, slots := range b {
+		names := []string{}
+		for _, in := range slots {
+			if in.kind != bindNone {
+				names = append(names, in.String())
+			}
+		}
+		m[actionDefs[i].name] = names
+	}
+	return m
+}
+
+// decodeBindings reads stored bindings over base. Actions missing from m
+// keep their inputs from base; unknown actions and inputs are skipped and
+// reported, so a file from a later version still loads.
+func decodeBindings(m map[string][]string, base bindings) (bindings, []string) {
+	b := make(bindings, len(base))
+	copy(b, base)
+	index := make(map[string]action, len(actionDefs))
+	for i, def := range actionDefs {
+		index[def.name] = action(i)
+	}
+
+	// Sorted, so which action keeps an input listed twice does not vary
+	names := make([]string, 0, len(m))
+	for name := range m {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var notes []string
+	for _, name := range names {
+		inputs := m[name]
+		a, ok := index[name]
+		if !ok {
+			notes = append(notes, fmt.Sprintf("unknown action %q", name))
+			continue
+		}
+		b[a] = [bindingSlots]binding{}
+		slot := 0
+		for _, s := range inputs {
+			in, err := parseBinding(s)
+			if err != nil {
+				notes = append(notes, fmt.Sprintf("%s: %v", name, err))
+				continue
+			}
+			if slot == bindingSlots {
+				notes = append(notes, fmt.Sprintf("%s: only %d inputs kept", name, bindingSlots))
+				break
+			}
+			if from := b.bind(a, slot, in); from >= 0 {
+				notes = append(notes, fmt.Sprintf("%s taken from %s for %s", in, actionDefs[from].name, name))
+			}
+			slot++
+		}
+	}
+	return b, notes
+}
@@ -4,11 +4,14 @@ import (
 	"image/color"
 	"math"
 
+	"doomlike/internal/sim"
+
 	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/text"
 )
 
 func (g *Game) drawMinimap(dst *ebiten.Image) {
-	if g.mapW == 0 || g.mapH == 0 {
+	if g.world.W == 0 || g.world.H == 0 {
 		return
 	}
 
@@ -17,7 +20,7 @@ func (g *Game) drawMinimap(dst *ebiten.Image) {
 	minimapSize := int(float64(screenW) * 0.15) // 15% of screen width
 
 	// Calculate scale to fit the map in the minimap size
-	scale := minimapSize / g.mapW
+	scale := minimapSize / g.world.W
 	if scale < 1 {
 		scale = 1
 	}
@@ -28,44 +31,48 @@ func (g *Game) drawMinimap(dst *ebiten.Image) {
 	py := margin + 60 // Move down to avoid health/ammo display
 
 	// frame
-	drawRect(dst, g.pix, px-1, py-1, g.mapW*scale+2, g.mapH*scale+2, uiBox)
+	drawRect(dst, g.pix, px-1, py-1, g.world.W*scale+2, g.world.H*scale+2, uiBox)
 
 	// tiles
-	for y := 0; y < g.mapH; y++ {
-		for x := 0; x < g.mapW; x++ {
-			idx := y*g.mapW + x
-			if g.reachable != nil && !g.reachable[idx] {
+	for y := 0; y < g.world.H; y++ {
+		for x := 0; x < g.world.W; x++ {
+			idx := y*g.world.W + x
+			if g.world.Reachable != nil && !g.world.Reachable[idx] {
 				drawRect(dst, g.pix, px+x*scale, py+y*scale, scale, scale, color.RGBA{0, 0, 0, 255})
 				continue
 			}
-			t := g.world[idx]
+			t := g.world.Grid[idx]
 			col := color.RGBA{18, 50, 18, 255}
-			if t == tWall {
+			if t == sim.TileWall {
 				col = color.RGBA{120, 120, 120, 255}
+			} else if t == sim.TileExit && g.world.Rule.NeedsExit() {
+				col = exitFloor
+			} else if d := g.world.DoorAt(x, y); d != nil && d.Open < 1 {
+				col = keyColors[d.Key]
 			}
 			drawRect(dst, g.pix, px+x*scale, py+y*scale, scale, scale, col)
 		}
 	}
 
 	// player
-	cx := px + int(g.p.pos.x*float64(scale))
-	cy := py + int(g.p.pos.y*float64(scale))
+	cx := px + int(g.world.Player.Pos.X*float64(scale))
+	cy := py + int(g.world.Player.Pos.Y*float64(scale))
 	drawRect(dst, g.pix, cx-2, cy-2, 4, 4, uiAccent)
 
 	// aim direction (short pointer; stops at walls)
-	dirX := math.Cos(g.p.angle)
-	dirY := math.Sin(g.p.angle)
+	dirX := math.Cos(g.world.Player.Angle)
+	dirY := math.Sin(g.world.Player.Angle)
 	const arrowLen = 3.0 // tiles
 	steps := int(arrowLen * 6)
-	fx := g.p.pos.x
-	fy := g.p.pos.y
+	fx := g.world.Player.Pos.X
+	fy := g.world.Player.Pos.Y
 	for i := 1; i <= steps; i++ {
 		t := float64(i) / 6.0
 		wx := fx + dirX*t
 		wy := fy + dirY*t
 		ix := int(math.Floor(wx))
 		iy := int(math.Floor(wy))
-		if g.isSolid(ix, iy) {
+		if g.world.IsSolid(ix, iy) {
 			break
 		}
 		pixX := px + int(wx*float64(scale))
@@ -74,35 +81,84 @@ func (g *Game) drawMinimap(dst *ebiten.Image) {
 	}
 
 	// enemies
-	for _, e := range g.enemies {
-		if e.dead {
+	for _, e := range g.world.Enemies {
+		if e.Dead {
 			continue
 		}
-		ec := gray
-		switch e.etype {
-		case eZombie:
-			ec = gray
-		case eRunner:
-			ec = cyan
-		case eShooter:
-			ec = magenta
+		ec := color.RGBA(g.world.EnemyDef(e.Type).Minimap)
+		ex := px + int(e.Pos.X*float64(scale))
+		ey := py + int(e.Pos.Y*float64(scale))
+		if g.debugAI {
+			ec = aiStateColors[e.State]
+			g.drawMinimapAI(dst, px, py, scale, e)
 		}
-		ex := px + int(e.pos.x*float64(scale))
-		ey := py + int(e.pos.y*float64(scale))
 		drawRect(dst, g.pix, ex-2, ey-2, 4, 4, ec)
 	}
 
+	// barrels
+	for _, b := range g.world.Barrels {
+		if b.Exploded {
+			continue
+		}
+		bxx := px + int(b.Pos.X*float64(scale))
+		byy := py + int(b.Pos.Y*float64(scale))
+		drawRect(dst, g.pix, bxx-1, byy-1, 2, 2, barrelColor)
+	}
+
 	// pickups
-	for _, pk := range g.pickups {
-		if pk.took {
+	for _, pk := range g.world.Pickups {
+		if pk.Taken {
 			continue
 		}
 		pc := green
-		if pk.ptype == pickupAmmo {
+		if _, ok := pk.Type.Ammo(); ok {
 			pc = yellow
+		} else if _, ok := pk.Type.Weapon(); ok {
+			pc = orange
+		} else if a := pk.Type.Armor(); a != sim.ArmorNone {
+			pc = armorColors[a]
+		} else if k := pk.Type.Key(); k != sim.KeyNone {
+			pc = keyColors[k]
 		}
-		pxx := px + int(pk.pos.x*float64(scale))
-		pyy := py + int(pk.pos.y*float64(scale))
+		pxx := px + int(pk.Pos.X*float64(scale))
+		pyy := py + int(pk.Pos.Y*float64(scale))
 		drawRect(dst, g.pix, pxx-1, pyy-1, 2, 2, pc)
 	}
+
+	if g.debugAI {
+		g.drawAILegend(dst, px, py+g.world.H*scale+18)
+	}
+}
+
+// drawMinimapAI draws an enemy's facing and, while it is hunting or searching,
+// a dotted line to where it last saw the player.
+func (g *Game) drawMinimapAI(dst *ebiten.Image, px, py, scale int, e *sim.Enemy) {
+	col := aiStateColors[e.State]
+	const faceLen = 1.5 // tiles
+	for i := 1; i <= 4; i++ {
+		t := faceLen * float64(i) / 4
+		wx := e.Pos.X + math.Cos(e.Facing)*t
+		wy := e.Pos.Y + math.Sin(e.Facing)*t
+		drawRect(dst, g.pix, px+int(wx*float64(scale)), py+int(wy*float64(scale)), 1, 1, col)
+	}
+	if e.State != sim.AIAlert && e.State != sim.AIChase && e.State != sim.AISearch {
+		return
+	}
+	dx, dy := e.LastSeen.X-e.Pos.X, e.LastSeen.Y-e.Pos.Y
+	steps := int(math.Hypot(dx, dy) * 2)
+	for i := 1; i <= steps; i += 2 {
+		t := float64(i) / float64(steps)
+		wx := e.Pos.X + dx*t
+		wy := e.Pos.Y + dy*t
+		drawRect(dst, g.pix, px+int(wx*float64(scale)), py+int(wy*float64(scale)), 1, 1, col)
+	}
+}
+
+// drawAILegend lists the awareness state colours below the minimap.
+func (g *Game) drawAILegend(dst *ebiten.Image, x, y int) {
+	for s := sim.AIIdle; s <= sim.AISearch; s++ {
+		drawRect(dst, g.pix, x, y-8, 8, 8, aiStateColors[s])
+		text.Draw(dst, s.String(), g.face, x+12, y, white)
+		y += 16
+	}
 }
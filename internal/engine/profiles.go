@@ -0,0 +1,260 @@
+package engine
+
+import (
+	"fmt"
+	"image/color"
+	"log"
+	"strings"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+	"github.com/hajimehoshi/ebiten/v2/text"
+)
+
+// profileEditMode is what the name typed on the profile screen is for
+type profileEditMode int
+
+const (
+	profileEditNone profileEditMode = iota
+	profileEditCreate
+	profileEditRename
+)
+
+// openProfiles shows the profile screen with the profile in use selected
+func (g *Game) openProfiles() {
+	g.refreshProfiles()
+	g.profileEdit = profileEditNone
+	g.profileMessage = ""
+	g.deleteConfirm = false
+	g.state = stateProfiles
+}
+
+// refreshProfiles reloads the profile list and selects the one in use
+func (g *Game) refreshProfiles() {
+	g.profiles = nil
+	if g.db == nil {
+		return
+	}
+	profiles, err := g.db.ListProfiles()
+	if err != nil {
+		log.Printf("Failed to list profiles: %v", err)
+		g.profileMessage = "Could not read profiles"
+		return
+	}
+	g.profiles = profiles
+	for i, p := range profiles {
+		if p.id == g.db.profileID {
+			g.menu.selectedProfile = i
+		}
+	}
+}
+
+// useProfile switches to a profile and loads its settings
+func (g *Game) useProfile(p profileInfo) {
+	if err := g.db.UseProfile(p.id); err != nil {
+		log.Printf("Failed to switch profile: %v", err)
+		g.profileMessage = "Could not switch profile"
+		return
+	}
+	settings, err := g.db.LoadSettings()
+	if err != nil {
+		log.Printf("Failed to load settings for profile %q: %v", p.name, err)
+	} else {
+		g.settings = *settings
+	}
+	g.bindings = loadBindings(g.db)
+	g.profileName = p.name
+	g.state = stateMainMenu
+	log.Printf("Using profile %q", p.name)
+}
+
+func (g *Game) updateProfiles() {
+	if g.db == nil {
+		if g.menuConfirm() || g.menuBack() {
+			g.state = stateMainMenu
+		}
+		return
+	}
+	if g.deleteConfirm {
+		g.updateDeleteConfirm()
+		return
+	}
+	if g.profileEdit != profileEditNone {
+		g.updateProfileName()
+		return
+	}
+
+	// The row after the last profile creates a new one
+	rows := len(g.profiles) + 1
+	if g.menuUp() {
+		g.menu.selectedProfile = (g.menu.selectedProfile + rows - 1) % rows
+	}
+	if g.menuDown() {
+		g.menu.selectedProfile = (g.menu.selectedProfile + 1) % rows
+	}
+	selected := g.menu.selectedProfile < len(g.profiles)
+
+	switch {
+	case g.menuBack():
+		g.state = stateMainMenu
+	case g.menuConfirm() && selected:
+		g.useProfile(g.profiles[g.menu.selectedProfile])
+	case g.menuConfirm(), inpututil.IsKeyJustPressed(ebiten.KeyN):
+		g.profileEdit = profileEditCreate
+		g.profileInput = nil
+		g.profileMessage = ""
+	case inpututil.IsKeyJustPressed(ebiten.KeyR) && selected:
+		g.profileEdit = profileEditRename
+		g.profileInput = []rune(g.profiles[g.menu.selectedProfile].name)
+		g.profileMessage = ""
+	case (inpututil.IsKeyJustPressed(ebiten.KeyDelete) || g.padJustPressed(padDelete)) && selected:
+		if g.profiles[g.menu.selectedProfile].id == g.db.profileID {
+			g.profileMessage = "The profile in use cannot be deleted"
+			return
+		}
+		g.deleteConfirm = true
+		g.profileMessage = ""
+	}
+}
+
+// updateProfileName edits the name of a profile being created or renamed;
+// Enter keeps it, Esc cancels
+func (g *Game) updateProfileName() {
+	for _, r := range ebiten.AppendInputChars(nil) {
+		if len(g.profileInput) < profileNameMax && r >= ' ' && r <= '~' {
+			g.profileInput = append(g.profileInput, r)
+		}
+	}
+	if inpututil.IsKeyJustPressed(ebiten.KeyBackspace) && len(g.profileInput) > 0 {
+		g.profileInput = g.profileInput[:len(g.profileInput)-1]
+	}
+	if g.menuBack() {
+		g.profileEdit = profileEditNone
+		return
+	}
+	if !g.menuConfirm() {
+		return
+	}
+
+	name := strings.TrimSpace(string(g.profileInput))
+	if name == "" {
+		g.profileMessage = "Type a name first"
+		return
+	}
+	for i, p := range g.profiles {
+		if strings.EqualFold(p.name, name) && (g.profileEdit == profileEditCreate || i != g.menu.selectedProfile) {
+			g.profileMessage = fmt.Sprintf("There is already a profile called %q", p.name)
+			return
+		}
+	}
+
+	switch g.profileEdit {
+	case profileEditCreate:
+		p, err := g.db.CreateProfile(name)
+		if err != nil {
+			log.Printf("Failed to create profile: %v", err)
+			g.profileMessage = "Could not create the profile"
+			return
+		}
+		g.profileEdit = profileEditNone
+		g.useProfile(p)
+	case profileEditRename:
+		p := g.profiles[g.menu.selectedProfile]
+		if err := g.db.RenameProfile(p.id, name); err != nil {
+			log.Printf("Failed to rename profile: %v", err)
+			g.profileMessage = "Could not rename the profile"
+			return
+		}
+		if p.id == g.db.profileID {
+			g.profileName = name
+		}
+		g.profileEdit = profileEditNone
+		g.refreshProfiles()
+		g.profileMessage = fmt.Sprintf("Renamed %q to %q", p.name, name)
+	}
+}
+
+// updateDeleteConfirm asks before a profile and everything it owns is deleted
+func (g *Game) updateDeleteConfirm() {
+	switch {
+	case inpututil.IsKeyJustPressed(ebiten.KeyY), g.menuConfirm():
+		p := g.profiles[g.menu.selectedProfile]
+		g.deleteConfirm = false
+		if err := g.db.DeleteProfile(p.id); err != nil {
+			log.Printf("Failed to delete profile: %v", err)
+			g.profileMessage = "Could not delete the profile"
+			return
+		}
+		g.refreshProfiles()
+		g.profileMessage = fmt.Sprintf("Deleted %q", p.name)
+	case inpututil.IsKeyJustPressed(ebiten.KeyN), g.menuBack():
+		g.deleteConfirm = false
+	}
+}
+
+func (g *Game) drawProfiles(dst *ebiten.Image) {
+	drawRect(dst, g.pix, 0, 0, ScreenW, ScreenH, color.RGBA{0, 0, 0, 180})
+
+	w, h := 460, 170+(len(g.profiles)+1)*20
+	x := (ScreenW - w) / 2
+	y := (ScreenH - h) / 2
+
+	drawRect(dst, g.pix, x, y, w, h, uiBox)
+	drawRect(dst, g.pix, x, y, w, 2, uiAccent)
+	drawRect(dst, g.pix, x, y+h-2, w, 2, uiAccent)
+	drawRect(dst, g.pix, x, y, 2, h, uiAccent)
+	drawRect(dst, g.pix, x+w-2, y, 2, h, uiAccent)
+
+	lx := x + 30
+	ly := y + 30
+	text.Draw(dst, "WHO IS PLAYING?", g.face, lx, ly, uiAccent)
+	ly += 30
+
+	if g.db == nil {
+		text.Draw(dst, "Profiles are unavailable without a database", g.face, lx, ly, gray)
+		text.Draw(dst, "Enter: Continue", g.face, lx, y+h-20, gray)
+		return
+	}
+
+	for i := 0; i <= len(g.profiles); i++ {
+		col := white
+		if i == g.menu.selectedProfile {
+			col = yellow
+			text.Draw(dst, ">", g.face, lx-18, ly, col)
+		}
+		switch {
+		case i == len(g.profiles):
+			text.Draw(dst, "+ New Profile", g.face, lx, ly, col)
+		case g.profileEdit == profileEditRename && i == g.menu.selectedProfile:
+			drawRect(dst, g.pix, lx-4, ly-14, 200, 20, black)
+			text.Draw(dst, string(g.profileInput)+"_", g.face, lx, ly, white)
+		default:
+			p := g.profiles[i]
+			text.Draw(dst, p.name, g.face, lx, ly, col)
+			if p.id == g.db.profileID {
+				text.Draw(dst, "(in use)", g.face, lx+150, ly, green)
+			}
+			text.Draw(dst, "last played "+p.lastUsed.Local().Format("2006-01-02"), g.face, lx+240, ly, gray)
+		}
+		ly += 20
+	}
+
+	ly += 16
+	switch {
+	case g.profileEdit == profileEditCreate:
+		text.Draw(dst, "Name:", g.face, lx, ly, white)
+		drawRect(dst, g.pix, lx+46, ly-14, 200, 20, black)
+		text.Draw(dst, string(g.profileInput)+"_", g.face, lx+50, ly, white)
+	case g.deleteConfirm:
+		p := g.profiles[g.menu.selectedProfile]
+		text.Draw(dst, fmt.Sprintf("Delete %q with its saves and scores? Y/N (A/B)", p.name), g.face, lx, ly, red)
+	case g.profileMessage != "":
+		text.Draw(dst, g.profileMessage, g.face, lx, ly, yellow)
+	}
+
+	help := "Enter: Play  N: New  R: Rename  Del/X: Delete  Esc: Back"
+	if g.profileEdit != profileEditNone {
+		help = "Enter: Save  Esc: Cancel"
+	}
+	text.Draw(dst, help, g.face, lx, y+h-20, gray)
+}
@@ -3,20 +3,26 @@ package engine
 import (
 	"math"
 
+	"doomlike/internal/sim"
+
 	"github.com/hajimehoshi/ebiten/v2"
 )
 
 func (g *Game) drawFloorCeil(dst *ebiten.Image) {
-	h := float64(renderH)
+	h := float64(g.renderH)
 	half := h / 2.0
-	fov := deg2rad(fovDegrees)
+	fov := deg2rad(g.settings.fov)
 	planeLen := math.Tan(fov / 2.0)
-	dirX := math.Cos(g.p.angle)
-	dirY := math.Sin(g.p.angle)
+	dirX := math.Cos(g.world.Player.Angle)
+	dirY := math.Sin(g.world.Player.Angle)
 	planeX := -dirY * planeLen
 	planeY := dirX * planeLen
+	exitGlow := 0.0
+	if g.world.Rule.NeedsExit() {
+		exitGlow = 0.8 + 0.2*math.Sin(g.world.Time*4) // exit pads pulse
+	}
 
-	for sy := 0; sy < renderH; sy++ {
+	for sy := 0; sy < g.renderH; sy++ {
 		row := float64(sy) - half
 		if row == 0 {
 			row = 1e-6
@@ -32,13 +38,13 @@ func (g *Game) drawFloorCeil(dst *ebiten.Image) {
 		rayDirRX := dirX + planeX
 		rayDirRY := dirY + planeY
 
-		stepX := (rayDirRX - rayDirLX) * rowDist / float64(renderW)
-		stepY := (rayDirRY - rayDirLY) * rowDist / float64(renderW)
+		stepX := (rayDirRX - rayDirLX) * rowDist / float64(g.renderW)
+		stepY := (rayDirRY - rayDirLY) * rowDist / float64(g.renderW)
 
-		floorX := g.p.pos.x + rayDirLX*rowDist
-		floorY := g.p.pos.y + rayDirLY*rowDist
+		floorX := g.world.Player.Pos.X + rayDirLX*rowDist
+		floorY := g.world.Player.Pos.Y + rayDirLY*rowDist
 
-		for sx := 0; sx < renderW; sx++ {
+		for sx := 0; sx < g.renderW; sx++ {
 			wx := floorX
 			wy := floorY
 
@@ -53,8 +59,11 @@ func (g *Game) drawFloorCeil(dst *ebiten.Image) {
 			if !parity {
 				baseC = ceilB
 			}
+			if exitGlow > 0 && g.isExitTile(cellX, cellY) {
+				baseF = shade(exitFloor, exitGlow)
+			}
 
-			dist := math.Hypot(wx-g.p.pos.x, wy-g.p.pos.y)
+			dist := math.Hypot(wx-g.world.Player.Pos.X, wy-g.world.Player.Pos.Y)
 			fog := clamp01(dist / maxDepth)
 			ff := shade(baseF, 1.0-fog*0.7)
 			cc := shade(baseC, 1.0-fog*0.7)
@@ -73,3 +82,10 @@ func (g *Game) drawFloorCeil(dst *ebiten.Image) {
 		}
 	}
 }
+
+func (g *Game) isExitTile(x, y int) bool {
+	if x < 0 || y < 0 || x >= g.world.W || y >= g.world.H {
+		return false
+	}
+	return g.world.Grid[y*g.world.W+x] == sim.TileExit
+}
@@ -5,28 +5,19 @@ import (
 	"math"
 	"sort"
 
+	"doomlike/internal/sim"
+
 	"github.com/hajimehoshi/ebiten/v2"
 )
 
-func enemyMaxHP(e *enemy) int {
-	switch e.etype {
-	case eZombie:
-		return zombieHP
-	case eRunner:
-		return runnerHP
-	case eShooter:
-		return shooterHP
-	default:
-		return 1
-	}
-}
-
 type spriteKind int
 
 const (
 	spriteEnemy spriteKind = iota
 	spritePickup
 	spriteBullet
+	spriteEffect
+	spriteBarrel
 )
 
 type spriteRef struct {
@@ -36,49 +27,61 @@ type spriteRef struct {
 }
 
 func (g *Game) drawSprites(dst *ebiten.Image) {
-	refs := make([]spriteRef, 0, len(g.enemies)+len(g.pickups)+len(g.bullets))
+	refs := make([]spriteRef, 0, len(g.world.Enemies)+len(g.world.Pickups)+len(g.world.Bullets)+len(g.effects))
 
-	for i, e := range g.enemies {
-		if e.dead {
-			continue
-		}
-		dx := e.pos.x - g.p.pos.x
-		dy := e.pos.y - g.p.pos.y
+	for i, e := range g.world.Enemies {
+		dx := e.Pos.X - g.world.Player.Pos.X
+		dy := e.Pos.Y - g.world.Player.Pos.Y
 		refs = append(refs, spriteRef{kind: spriteEnemy, idx: i, dist: math.Hypot(dx, dy)})
 	}
 
-	for i, pk := range g.pickups {
-		if pk.took {
+	for i, pk := range g.world.Pickups {
+		if pk.Taken {
 			continue
 		}
-		dx := pk.pos.x - g.p.pos.x
-		dy := pk.pos.y - g.p.pos.y
+		dx := pk.Pos.X - g.world.Player.Pos.X
+		dy := pk.Pos.Y - g.world.Player.Pos.Y
 		refs = append(refs, spriteRef{kind: spritePickup, idx: i, dist: math.Hypot(dx, dy)})
 	}
 
-	for i := range g.bullets {
-		b := g.bullets[i]
-		dx := b.pos.x - g.p.pos.x
-		dy := b.pos.y - g.p.pos.y
+	for i := range g.world.Bullets {
+		b := g.world.Bullets[i]
+		dx := b.Pos.X - g.world.Player.Pos.X
+		dy := b.Pos.Y - g.world.Player.Pos.Y
 		refs = append(refs, spriteRef{kind: spriteBullet, idx: i, dist: math.Hypot(dx, dy)})
 	}
 
+	for i, b := range g.world.Barrels {
+		if b.Exploded {
+			continue
+		}
+		dx := b.Pos.X - g.world.Player.Pos.X
+		dy := b.Pos.Y - g.world.Player.Pos.Y
+		refs = append(refs, spriteRef{kind: spriteBarrel, idx: i, dist: math.Hypot(dx, dy)})
+	}
+
+	for i, fx := range g.effects {
+		dx := fx.pos.X - g.world.Player.Pos.X
+		dy := fx.pos.Y - g.world.Player.Pos.Y
+		refs = append(refs, spriteRef{kind: spriteEffect, idx: i, dist: math.Hypot(dx, dy)})
+	}
+
 	sort.Slice(refs, func(i, j int) bool { return refs[i].dist > refs[j].dist })
 
-	fov := deg2rad(fovDegrees)
-	centerY := renderH / 2
+	fov := deg2rad(g.settings.fov)
+	centerY := g.renderH / 2
 
 	for _, r := range refs {
 		switch r.kind {
 		case spriteEnemy:
-			e := g.enemies[r.idx]
-			dx := e.pos.x - g.p.pos.x
-			dy := e.pos.y - g.p.pos.y
+			e := g.world.Enemies[r.idx]
+			dx := e.Pos.X - g.world.Player.Pos.X
+			dy := e.Pos.Y - g.world.Player.Pos.Y
 			dist := math.Hypot(dx, dy)
 			if dist <= 0.001 {
 				continue
 			}
-			ang := math.Atan2(dy, dx) - g.p.angle
+			ang := math.Atan2(dy, dx) - g.world.Player.Angle
 			ang = normalizeAngle(ang)
 			if ang > math.Pi {
 				ang -= 2 * math.Pi
@@ -87,34 +90,28 @@ func (g *Game) drawSprites(dst *ebiten.Image) {
 				continue
 			}
 
-			size := int(float64(renderH) / dist * 0.55)
+			screenX := int((0.5 + (ang / fov)) * float64(g.renderW))
+			if e.Dead {
+				g.drawCorpseSprite(dst, e, screenX, centerY, dist)
+				continue
+			}
+			size := int(float64(g.renderH) / dist * 0.55)
 			if size < 2 {
 				size = 2
 			}
-			screenX := int((0.5 + (ang / fov)) * float64(renderW))
 			startX := screenX - size/3
 			endX := screenX + size/3
 			if startX < 0 {
 				startX = 0
 			}
-			if endX > renderW-1 {
-				endX = renderW - 1
+			if endX > g.renderW-1 {
+				endX = g.renderW - 1
 			}
 
-			bodyCol := gray
-			headCol := white
-			switch e.etype {
-			case eZombie:
-				bodyCol = gray
-				headCol = color.RGBA{210, 210, 210, 255}
-			case eRunner:
-				bodyCol = cyan
-				headCol = color.RGBA{220, 240, 255, 255}
-			case eShooter:
-				bodyCol = magenta
-				headCol = color.RGBA{250, 210, 255, 255}
-			}
-			if e.blink > 0 {
+			def := g.world.EnemyDef(e.Type)
+			bodyCol := color.RGBA(def.Body)
+			headCol := color.RGBA(def.Head)
+			if e.Blink > 0 {
 				bodyCol = white
 				headCol = white
 			}
@@ -140,7 +137,7 @@ func (g *Game) drawSprites(dst *ebiten.Image) {
 				}
 			}
 
-			hpMax := enemyMaxHP(e)
+			hpMax := g.world.EnemyDef(e.Type).HP
 			if hpMax < 1 {
 				hpMax = 1
 			}
@@ -159,12 +156,12 @@ func (g *Game) drawSprites(dst *ebiten.Image) {
 			}
 			if visible {
 				drawRectHR(dst, g.pix, barX, barY, barW, barH, black)
-				fillW := int(float64(barW) * clamp01(float64(e.hp)/float64(hpMax)))
+				fillW := int(float64(barW) * clamp01(float64(e.HP)/float64(hpMax)))
 				if fillW > 0 {
 					col := red
-					if e.hp >= (hpMax+1)/2 {
+					if e.HP >= (hpMax+1)/2 {
 						col = green
-					} else if e.hp > 1 {
+					} else if e.HP > 1 {
 						col = yellow
 					}
 					drawRectHR(dst, g.pix, barX, barY, fillW, barH, col)
@@ -172,14 +169,14 @@ func (g *Game) drawSprites(dst *ebiten.Image) {
 			}
 
 		case spritePickup:
-			pk := g.pickups[r.idx]
-			dx := pk.pos.x - g.p.pos.x
-			dy := pk.pos.y - g.p.pos.y
+			pk := g.world.Pickups[r.idx]
+			dx := pk.Pos.X - g.world.Player.Pos.X
+			dy := pk.Pos.Y - g.world.Player.Pos.Y
 			dist := math.Hypot(dx, dy)
 			if dist <= 0.001 {
 				continue
 			}
-			ang := math.Atan2(dy, dx) - g.p.angle
+			ang := math.Atan2(dy, dx) - g.world.Player.Angle
 			ang = normalizeAngle(ang)
 			if ang > math.Pi {
 				ang -= 2 * math.Pi
@@ -187,39 +184,51 @@ func (g *Game) drawSprites(dst *ebiten.Image) {
 			if math.Abs(ang) > fov {
 				continue
 			}
-			size := int(float64(renderH) / dist * 0.35)
+			size := int(float64(g.renderH) / dist * 0.35)
 			if size < 1 {
 				size = 1
 			}
-			screenX := int((0.5 + (ang / fov)) * float64(renderW))
+			screenX := int((0.5 + (ang / fov)) * float64(g.renderW))
 			startX := screenX - size/2
 			endX := screenX + size/2
 			if startX < 0 {
 				startX = 0
 			}
-			if endX > renderW-1 {
-				endX = renderW - 1
+			if endX > g.renderW-1 {
+				endX = g.renderW - 1
 			}
 			y := centerY - size/2
 
 			// Draw different sprites based on pickup type
-			if pk.ptype == pickupAmmo {
+			weapon, isWeapon := pk.Type.Weapon()
+			switch {
+			case pk.Type == sim.PickupAmmo:
 				// Draw bullet-like shape
 				g.drawBulletSprite(dst, startX, endX, y, size, dist)
-			} else {
+			case pk.Type == sim.PickupShells:
+				g.drawShellsSprite(dst, startX, endX, y, size, dist)
+			case pk.Type == sim.PickupRockets:
+				g.drawRocketsSprite(dst, startX, endX, y, size, dist)
+			case isWeapon:
+				g.drawWeaponSprite(dst, startX, endX, y, size, dist, weapon)
+			case pk.Type.Armor() != sim.ArmorNone:
+				g.drawArmorSprite(dst, startX, endX, y, size, dist, armorColors[pk.Type.Armor()])
+			case pk.Type.Key() != sim.KeyNone:
+				g.drawKeycardSprite(dst, startX, endX, y, size, dist, keyColors[pk.Type.Key()])
+			default:
 				// Draw first aid kit
 				g.drawMedkitSprite(dst, startX, endX, y, size, dist)
 			}
 
 		case spriteBullet:
-			b := g.bullets[r.idx]
-			dx := b.pos.x - g.p.pos.x
-			dy := b.pos.y - g.p.pos.y
+			b := g.world.Bullets[r.idx]
+			dx := b.Pos.X - g.world.Player.Pos.X
+			dy := b.Pos.Y - g.world.Player.Pos.Y
 			dist := math.Hypot(dx, dy)
 			if dist <= 0.001 {
 				continue
 			}
-			ang := math.Atan2(dy, dx) - g.p.angle
+			ang := math.Atan2(dy, dx) - g.world.Player.Angle
 			ang = normalizeAngle(ang)
 			if ang > math.Pi {
 				ang -= 2 * math.Pi
@@ -227,21 +236,29 @@ func (g *Game) drawSprites(dst *ebiten.Image) {
 			if math.Abs(ang) > fov {
 				continue
 			}
-			size := int(float64(renderH) / dist * 0.2)
+			size := int(float64(g.renderH) / dist * 0.2)
 			if size < 1 {
 				size = 1
 			}
-			screenX := int((0.5 + (ang / fov)) * float64(renderW))
-			startX := screenX - 1
-			endX := screenX + 1
+			screenX := int((0.5 + (ang / fov)) * float64(g.renderW))
+			half := 1
+			if b.Splash > 0 {
+				// Rockets are fatter than bullets
+				half = max(size/4, 1)
+			}
+			startX := screenX - half
+			endX := screenX + half
 			if startX < 0 {
 				startX = 0
 			}
-			if endX > renderW-1 {
-				endX = renderW - 1
+			if endX > g.renderW-1 {
+				endX = g.renderW - 1
 			}
 			c := yellow
-			if !b.friendly {
+			switch {
+			case b.Splash > 0:
+				c = color.RGBA{255, 140, 40, 255}
+			case !b.Friendly:
 				c = red
 			}
 			y := centerY - size/2
@@ -251,6 +268,115 @@ func (g *Game) drawSprites(dst *ebiten.Image) {
 				}
 				drawRectHR(dst, g.pix, x, y, 1, size, c)
 			}
+
+		case spriteEffect:
+			fx := g.effects[r.idx]
+			dx := fx.pos.X - g.world.Player.Pos.X
+			dy := fx.pos.Y - g.world.Player.Pos.Y
+			dist := math.Hypot(dx, dy)
+			if dist <= 0.001 {
+				continue
+			}
+			ang := math.Atan2(dy, dx) - g.world.Player.Angle
+			ang = normalizeAngle(ang)
+			if ang > math.Pi {
+				ang -= 2 * math.Pi
+			}
+			if math.Abs(ang) > fov {
+				continue
+			}
+			screenX := int((0.5 + (ang / fov)) * float64(g.renderW))
+			g.drawImpactSprite(dst, fx, screenX, centerY, dist)
+
+		case spriteBarrel:
+			b := g.world.Barrels[r.idx]
+			dx := b.Pos.X - g.world.Player.Pos.X
+			dy := b.Pos.Y - g.world.Player.Pos.Y
+			dist := math.Hypot(dx, dy)
+			if dist <= 0.001 {
+				continue
+			}
+			ang := math.Atan2(dy, dx) - g.world.Player.Angle
+			ang = normalizeAngle(ang)
+			if ang > math.Pi {
+				ang -= 2 * math.Pi
+			}
+			if math.Abs(ang) > fov {
+				continue
+			}
+			screenX := int((0.5 + (ang / fov)) * float64(g.renderW))
+			g.drawBarrelSprite(dst, b, screenX, centerY, dist)
+		}
+	}
+}
+
+// drawImpactSprite draws an impact effect: a grey puff that swells and thins
+// out on walls, red droplets that spray outward and fall on enemies, or a
+// fireball that flares and burns down to smoke
+func (g *Game) drawImpactSprite(dst *ebiten.Image, fx impactEffect, screenX, centerY int, dist float64) {
+	age := 1 - fx.timeLeft/effectDurations[fx.kind] // 0 when fresh, 1 when gone
+	scale := float64(g.renderH) / dist
+	alpha := uint8(230 * (1 - age))
+
+	switch fx.kind {
+	case effectPuff:
+		size := max(int(scale*(0.04+0.08*age)), 1)
+		col := color.RGBA{190, 185, 170, alpha}
+		for x := screenX - size/2; x <= screenX+size/2; x++ {
+			if x < 0 || x >= len(g.zbuf) || dist > g.zbuf[x] {
+				continue
+			}
+			// round the puff off by shortening the outer columns
+			edge := math.Abs(float64(x-screenX)) / float64(max(size/2, 1))
+			h := max(int(float64(size)*math.Sqrt(math.Max(0, 1-edge*edge))), 1)
+			drawRectHR(dst, g.pix, x, centerY-h/2-int(scale*0.03*age), 1, h, col)
+		}
+
+	case effectBlood:
+		drop := max(int(scale*0.025), 1)
+		col := color.RGBA{170, 10, 10, alpha}
+		spread := scale * 0.1 * age
+		fall := scale * 0.12 * age * age
+		for i := 0; i < 5; i++ {
+			a := float64(i)*1.3 - 2.6 // fan the droplets out sideways and upward
+			x := screenX + int(math.Sin(a)*spread)
+			y := centerY - int(math.Cos(a)*spread*0.6) + int(fall)
+			if x < 0 || x >= len(g.zbuf) || dist > g.zbuf[x] {
+				continue
+			}
+			drawRectHR(dst, g.pix, x, y, drop, drop, col)
+		}
+
+	case effectExplosion:
+		// The ball swells fast, then shrinks as it cools from a white-hot
+		// core through orange to smoke
+		radius := scale * 0.6 * math.Sqrt(math.Min(age/0.3, 1)) * (1 - 0.5*math.Max(0, age-0.3)/0.7)
+		layers := []struct {
+			size  float64
+			after float64 // age at which the layer shows up
+			col   color.RGBA
+		}{
+			{1.0, 0.3, color.RGBA{90, 80, 70, alpha}},
+			{0.85, 0, color.RGBA{230, 90, 20, alpha}},
+			{0.55, 0, color.RGBA{255, 180, 40, alpha}},
+			{0.3 * (1 - age), 0, color.RGBA{255, 250, 200, alpha}},
+		}
+		// the fireball rises a little as it burns
+		cy := centerY - int(scale*0.15*age)
+		for _, l := range layers {
+			if age < l.after {
+				continue
+			}
+			r := radius * l.size
+			for x := screenX - int(r); x <= screenX+int(r); x++ {
+				if x < 0 || x >= len(g.zbuf) || dist > g.zbuf[x] {
+					continue
+				}
+				dx := float64(x - screenX)
+				if h := int(2 * math.Sqrt(math.Max(0, r*r-dx*dx))); h > 0 {
+					drawRectHR(dst, g.pix, x, cy-h/2, 1, h, l.col)
+				}
+			}
 		}
 	}
 }
@@ -264,7 +390,7 @@ func (g *Game) drawBulletSprite(dst *ebiten.Image, startX, endX, y, size int, di
 	bulletRim := color.RGBA{80, 80, 80, 255}     // Dark rim
 
 	// Animation: bobbing up and down
-	bobOffset := int(math.Sin(g.gameTime*3.0) * 3.0) // 3 pixel bob, 3 cycles per second
+	bobOffset := int(math.Sin(g.world.Time*3.0) * 3.0) // 3 pixel bob, 3 cycles per second
 	animatedY := y + bobOffset
 
 	// Make the bullet skinny - reduce width to 1/3 of original
@@ -276,7 +402,7 @@ func (g *Game) drawBulletSprite(dst *ebiten.Image, startX, endX, y, size int, di
 	bulletEndX := bulletStartX + bulletWidth
 
 	// Spinning animation: rotate the bullet around its center
-	spinAngle := g.gameTime * 2.0 // 2 radians per second
+	spinAngle := g.world.Time * 2.0 // 2 radians per second
 
 	// Draw the bullet vertically - each horizontal slice represents a vertical section
 	for x := bulletStartX; x <= bulletEndX; x++ {
@@ -335,7 +461,7 @@ func (g *Game) drawMedkitSprite(dst *ebiten.Image, startX, endX, y, size int, di
 	kitShadow := color.RGBA{220, 220, 220, 255} // Light shadow
 
 	// Animation: bobbing up and down (same as bullets)
-	bobOffset := int(math.Sin(g.gameTime*3.0) * 3.0) // 3 pixel bob, 3 cycles per second
+	bobOffset := int(math.Sin(g.world.Time*3.0) * 3.0) // 3 pixel bob, 3 cycles per second
 	animatedY := y + bobOffset
 
 	// Calculate medkit dimensions based on 3x3x1 ratio
@@ -398,3 +524,226 @@ func (g *Game) drawMedkitSprite(dst *ebiten.Image, startX, endX, y, size int, di
 		}
 	}
 }
+
+// drawKeycardSprite draws a small colored keycard that bobs and glints
+func (g *Game) drawKeycardSprite(dst *ebiten.Image, startX, endX, y, size int, dist float64, col color.RGBA) {
+	bobOffset := int(math.Sin(g.world.Time*3.0) * 3.0)
+	cardH := size * 2 / 3
+	cardY := y + (size-cardH)/2 + bobOffset
+
+	// The card is narrower than the sprite box, so only use its middle half
+	w := endX - startX
+	left, right := startX+w/4, endX-w/4
+	glint := 0.5 + 0.5*math.Sin(g.world.Time*4.0)
+	for x := left; x <= right; x++ {
+		if x < 0 || x >= len(g.zbuf) {
+			continue
+		}
+		if dist > g.zbuf[x] {
+			continue
+		}
+		relPos := float64(x-left) / float64(max(right-left, 1))
+		body := col
+		if math.Abs(relPos-glint) < 0.12 {
+			body = shade(col, 1.4)
+		}
+		drawRectHR(dst, g.pix, x, cardY, 1, cardH, body)
+		// white label strip near the top of the card
+		drawRectHR(dst, g.pix, x, cardY+cardH/6, 1, max(cardH/6, 1), white)
+		if x == left || x == right {
+			drawRectHR(dst, g.pix, x, cardY, 1, cardH, shade(col, 0.6))
+		}
+	}
+}
+
+// drawShellsSprite draws a pair of red shotgun shells with brass bases
+func (g *Game) drawShellsSprite(dst *ebiten.Image, startX, endX, y, size int, dist float64) {
+	bobOffset := int(math.Sin(g.world.Time*3.0) * 3.0)
+	shellH := size / 2
+	shellY := y + size/2 + bobOffset
+	baseH := max(shellH/4, 1)
+	shellRed := color.RGBA{190, 40, 30, 255}
+	brass := color.RGBA{210, 170, 60, 255}
+
+	w := endX - startX
+	for i, left := range []int{startX + w/4, startX + w/2 + 1} {
+		right := left + max(w/5, 1)
+		top := shellY - i*shellH/6 // the second shell leans back a little
+		for x := left; x <= right; x++ {
+			if x < 0 || x >= len(g.zbuf) || dist > g.zbuf[x] {
+				continue
+			}
+			drawRectHR(dst, g.pix, x, top, 1, shellH-baseH, shellRed)
+			drawRectHR(dst, g.pix, x, top+shellH-baseH, 1, baseH, brass)
+			if x == left {
+				drawRectHR(dst, g.pix, x, top, 1, shellH-baseH, shade(shellRed, 1.3))
+			}
+		}
+	}
+}
+
+// drawRocketsSprite draws a standing rocket with a red warhead
+func (g *Game) drawRocketsSprite(dst *ebiten.Image, startX, endX, y, size int, dist float64) {
+	bobOffset := int(math.Sin(g.world.Time*3.0) * 3.0)
+	rocketY := y + size/6 + bobOffset
+	rocketH := size * 5 / 6
+	body := color.RGBA{150, 150, 140, 255}
+	head := color.RGBA{200, 60, 40, 255}
+	fins := color.RGBA{90, 90, 85, 255}
+
+	w := endX - startX
+	left, right := startX+w*3/8, endX-w*3/8
+	headH := rocketH / 4
+	finH := rocketH / 6
+	for x := left - w/8; x <= right+w/8; x++ {
+		if x < 0 || x >= len(g.zbuf) || dist > g.zbuf[x] {
+			continue
+		}
+		if x < left || x > right {
+			drawRectHR(dst, g.pix, x, rocketY+rocketH-finH, 1, finH, fins)
+			continue
+		}
+		drawRectHR(dst, g.pix, x, rocketY, 1, headH, head)
+		drawRectHR(dst, g.pix, x, rocketY+headH, 1, rocketH-headH, body)
+	}
+}
+
+// drawWeaponSprite draws a weapon lying on the floor, seen from the side
+func (g *Game) drawWeaponSprite(dst *ebiten.Image, startX, endX, y, size int, dist float64, t sim.WeaponType) {
+	bobOffset := int(math.Sin(g.world.Time*3.0) * 3.0)
+	metal := color.RGBA{90, 90, 95, 255}
+	accent := color.RGBA{130, 70, 25, 255} // wooden furniture
+	thick := max(size/8, 1)
+	switch t {
+	case sim.WeaponChaingun:
+		accent = color.RGBA{50, 50, 55, 255}
+		thick = max(size/5, 1)
+	case sim.WeaponRocketLauncher:
+		metal = color.RGBA{70, 90, 60, 255}
+		accent = color.RGBA{200, 60, 40, 255}
+		thick = max(size/4, 1)
+	}
+	gunY := y + size*2/3 + bobOffset
+
+	for x := startX; x <= endX; x++ {
+		if x < 0 || x >= len(g.zbuf) || dist > g.zbuf[x] {
+			continue
+		}
+		rel := float64(x-startX) / float64(max(endX-startX, 1))
+		drawRectHR(dst, g.pix, x, gunY, 1, thick, metal)
+		switch {
+		case rel < 0.3:
+			// stock, or the rocket launcher's exhaust end
+			drawRectHR(dst, g.pix, x, gunY, 1, thick+thick/2, accent)
+		case rel > 0.35 && rel < 0.45:
+			// grip
+			drawRectHR(dst, g.pix, x, gunY+thick, 1, thick, shade(metal, 0.7))
+		}
+	}
+}
+
+// drawArmorSprite draws a sleeveless vest in the armor's colour
+func (g *Game) drawArmorSprite(dst *ebiten.Image, startX, endX, y, size int, dist float64, col color.RGBA) {
+	bobOffset := int(math.Sin(g.world.Time*3.0) * 3.0)
+	vestH := size * 3 / 4
+	vestY := y + size/4 + bobOffset
+	shoulderH := vestH / 5
+	neckW := (endX - startX) / 5
+
+	w := endX - startX
+	left, right := startX+w/6, endX-w/6
+	mid := (left + right) / 2
+	for x := left; x <= right; x++ {
+		if x < 0 || x >= len(g.zbuf) || dist > g.zbuf[x] {
+			continue
+		}
+		rel := float64(x-left) / float64(max(right-left, 1))
+		top := vestY
+		switch {
+		case x > mid-neckW/2 && x < mid+neckW/2:
+			top += shoulderH * 2 // neck opening
+		case rel < 0.15 || rel > 0.85:
+			top += shoulderH // armholes cut the sides lower
+		}
+		body := col
+		if rel < 0.3 {
+			body = shade(col, 1.25)
+		} else if rel > 0.7 {
+			body = shade(col, 0.75)
+		}
+		drawRectHR(dst, g.pix, x, top, 1, vestY+vestH-top, body)
+		// a darker band across the chest plate
+		drawRectHR(dst, g.pix, x, vestY+vestH/2, 1, max(vestH/10, 1), shade(col, 0.6))
+	}
+}
+
+// drawBarrelSprite draws an explosive barrel standing on the floor. A lit
+// barrel glows and shakes for the moment before it goes off.
+// drawCorpseSprite draws a dead enemy. For deathAnimDuration after the kill
+// it folds down onto the floor, widening and darkening as it goes, and
+// spills blood; after that it stays as a flat corpse.
+func (g *Game) drawCorpseSprite(dst *ebiten.Image, e *sim.Enemy, screenX, centerY int, dist float64) {
+	scale := float64(g.renderH) / dist
+	t := clamp01(e.Dying / deathAnimDuration)
+	def := g.world.EnemyDef(e.Type)
+	body := shade(color.RGBA(def.Body), 1-0.45*t)
+	head := shade(color.RGBA(def.Head), 1-0.45*t)
+
+	floor := centerY + int(scale*0.275)
+	height := max(int(scale*(0.55-0.45*t)), 1)
+	halfW := max(int(scale*(0.18+0.2*t)), 1)
+	headW := int(float64(halfW) * 0.6 * t) // the head ends up at one end of the heap
+	poolW := int(float64(halfW) * 1.3 * t)
+	poolH := max(int(scale*0.04*t), 1)
+
+	for x := screenX - max(halfW, poolW); x <= screenX+max(halfW, poolW); x++ {
+		if x < 0 || x >= len(g.zbuf) || dist > g.zbuf[x] {
+			continue
+		}
+		if t > 0 && x >= screenX-poolW && x <= screenX+poolW {
+			drawRectHR(dst, g.pix, x, floor-poolH/2, 1, poolH, bloodColor)
+		}
+		if x < screenX-halfW || x > screenX+halfW {
+			continue
+		}
+		// round the heap off towards its ends
+		rel := float64(x-screenX) / float64(halfW)
+		h := max(int(float64(height)*math.Sqrt(1-0.7*rel*rel)), 1)
+		if t > 0.5 && x < screenX-halfW+2*headW {
+			drawRectHR(dst, g.pix, x, floor-h, 1, h, head)
+			continue
+		}
+		headH := int(float64(h) * 0.3 * (1 - t))
+		drawRectHR(dst, g.pix, x, floor-h, 1, headH, head)
+		drawRectHR(dst, g.pix, x, floor-h+headH, 1, h-headH, body)
+	}
+}
+
+func (g *Game) drawBarrelSprite(dst *ebiten.Image, b *sim.Barrel, screenX, centerY int, dist float64) {
+	scale := float64(g.renderH) / dist
+	width := max(int(scale*0.5), 2)
+	height := max(int(scale*0.55), 2)
+	floor := centerY + int(scale*0.5)
+	top := floor - height
+	body := barrelColor
+	if b.Lit {
+		body = color.RGBA{230, 120, 40, 255}
+		screenX += int(math.Sin(g.world.Time*90) * scale * 0.02)
+	}
+	band := shade(body, 0.55)
+	bandH := max(height/10, 1)
+
+	left := screenX - width/2
+	for x := left; x <= left+width; x++ {
+		if x < 0 || x >= len(g.zbuf) || dist > g.zbuf[x] {
+			continue
+		}
+		// light the drum as a cylinder: bright a third of the way across
+		rel := float64(x-left)/float64(width)*2 - 1
+		lit := 0.55 + 0.6*math.Max(0, 1-math.Abs(rel+0.3))
+		drawRectHR(dst, g.pix, x, top, 1, height, shade(body, lit))
+		drawRectHR(dst, g.pix, x, top+height/5, 1, bandH, band)
+		drawRectHR(dst, g.pix, x, top+height*4/5, 1, bandH, band)
+		drawRectHR(dst, g.pix, x, top, 1, bandH, shade(body, 0.4)) // rim
+	}
+}
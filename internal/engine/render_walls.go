@@ -4,6 +4,8 @@ import (
 	"image"
 	"math"
 
+	"doomlike/internal/sim"
+
 	"github.com/hajimehoshi/ebiten/v2"
 )
 
@@ -12,15 +14,17 @@ type hitInfo struct {
 	side int
 	hx   float64
 	hy   float64
+	door *sim.Door // set when the ray stopped on a door slab
+	tex  float64   // texture column across the door slab
 }
 
 func (g *Game) drawWalls(dst *ebiten.Image) {
-	fov := deg2rad(fovDegrees)
+	fov := deg2rad(g.settings.fov)
 	halfFov := fov / 2.0
 
-	for x := 0; x < renderW; x++ {
-		alpha := (float64(x)/float64(renderW))*fov - halfFov
-		rayAng := normalizeAngle(g.p.angle + alpha)
+	for x := 0; x < g.renderW; x++ {
+		alpha := (float64(x)/float64(g.renderW))*fov - halfFov
+		rayAng := normalizeAngle(g.world.Player.Angle + alpha)
 		h := g.castRay(rayAng)
 
 		corrected := h.dist * math.Cos(alpha)
@@ -29,13 +33,15 @@ func (g *Game) drawWalls(dst *ebiten.Image) {
 		}
 		g.zbuf[x] = corrected
 
-		lineH := int(float64(renderH) / corrected * WallScale)
-		start := renderH/2 - lineH/2
+		lineH := int(float64(g.renderH) / corrected * WallScale)
+		start := g.renderH/2 - lineH/2
 
 		var txf float64
 		sinA := math.Sin(rayAng)
 		cosA := math.Cos(rayAng)
-		if h.side == 0 || h.side == 1 {
+		if h.door != nil {
+			txf = h.tex
+		} else if h.side == 0 || h.side == 1 {
 			txf = h.hy - math.Floor(h.hy)
 			if cosA > 0 {
 				txf = 1 - txf
@@ -54,7 +60,11 @@ func (g *Game) drawWalls(dst *ebiten.Image) {
 			tx = g.texW - 1
 		}
 
-		src := g.wallTex.SubImage(image.Rect(tx, 0, tx+1, g.texH)).(*ebiten.Image)
+		tex := g.wallTex
+		if h.door != nil {
+			tex = g.doorTex[h.door.Key]
+		}
+		src := tex.SubImage(image.Rect(tx, 0, tx+1, g.texH)).(*ebiten.Image)
 		op := &ebiten.DrawImageOptions{}
 		op.GeoM.Scale(1, float64(lineH)/float64(g.texH))
 		op.GeoM.Translate(float64(x), float64(start))
@@ -73,65 +83,69 @@ func (g *Game) drawWalls(dst *ebiten.Image) {
 func (g *Game) castRay(angle float64) hitInfo {
 	sinA := math.Sin(angle)
 	cosA := math.Cos(angle)
-	mapX := int(math.Floor(g.p.pos.x))
-	mapY := int(math.Floor(g.p.pos.y))
-
-	var stepX, stepY int
-	var sideDistX, sideDistY float64
-	deltaDistX := math.Abs(1 / cosA)
-	deltaDistY := math.Abs(1 / sinA)
-	if math.IsInf(deltaDistX, 0) {
-		deltaDistX = 1e30
-	}
-	if math.IsInf(deltaDistY, 0) {
-		deltaDistY = 1e30
-	}
-	if cosA < 0 {
-		stepX = -1
-		sideDistX = (g.p.pos.x - float64(mapX)) * deltaDistX
-	} else {
-		stepX = 1
-		sideDistX = (float64(mapX+1) - g.p.pos.x) * deltaDistX
-	}
-	if sinA < 0 {
-		stepY = -1
-		sideDistY = (g.p.pos.y - float64(mapY)) * deltaDistY
-	} else {
-		stepY = 1
-		sideDistY = (float64(mapY+1) - g.p.pos.y) * deltaDistY
-	}
+	r := sim.NewGridRay(g.world.Player.Pos, cosA, sinA)
 
 	h := hitInfo{dist: 0, side: -1}
 	for i := 0; i < 4096; i++ {
-		if sideDistX < sideDistY {
-			sideDistX += deltaDistX
-			mapX += stepX
-			h.side = mapSideVertical(stepX)
+		r.Next()
+		if r.Vertical {
+			h.side = mapSideVertical(r.StepX)
 		} else {
-			sideDistY += deltaDistY
-			mapY += stepY
-			h.side = mapSideHorizontal(stepY)
+			h.side = mapSideHorizontal(r.StepY)
 		}
-		if mapX < 0 || mapY < 0 || mapX >= g.mapW || mapY >= g.mapH {
+		if r.X < 0 || r.Y < 0 || r.X >= g.world.W || r.Y >= g.world.H {
 			h.dist = maxDepth
 			break
 		}
-		if g.world[mapY*g.mapW+mapX] == tWall {
-			if h.side == 0 || h.side == 1 {
-				h.dist = (float64(mapX) - g.p.pos.x + (1.0 - float64((stepX+1)/2))) / cosA
-			} else {
-				h.dist = (float64(mapY) - g.p.pos.y + (1.0 - float64((stepY+1)/2))) / sinA
+		if d := g.world.DoorAt(r.X, r.Y); d != nil {
+			if g.hitDoor(d, cosA, sinA, &h) {
+				break
 			}
+			continue
+		}
+		if g.world.Grid[r.Y*g.world.W+r.X] == sim.TileWall {
+			h.dist = r.Dist
 			if h.dist < 0.0001 {
 				h.dist = 0.0001
 			}
 			if h.dist > maxDepth {
 				h.dist = maxDepth
 			}
-			h.hx = g.p.pos.x + cosA*h.dist
-			h.hy = g.p.pos.y + sinA*h.dist
+			h.hx = g.world.Player.Pos.X + cosA*h.dist
+			h.hy = g.world.Player.Pos.Y + sinA*h.dist
 			break
 		}
 	}
 	return h
 }
+
+// hitDoor intersects a ray with the slab of a door, which sits recessed in
+// the middle of its cell and slides sideways by Open as it opens.
+func (g *Game) hitDoor(d *sim.Door, cosA, sinA float64, h *hitInfo) bool {
+	p := g.world.Player.Pos
+	var dist, along float64
+	if d.Horizontal {
+		if math.Abs(sinA) < 1e-9 {
+			return false
+		}
+		dist = (float64(d.Y) + 0.5 - p.Y) / sinA
+		along = p.X + cosA*dist - float64(d.X)
+		h.side = mapSideHorizontal(int(math.Copysign(1, sinA)))
+	} else {
+		if math.Abs(cosA) < 1e-9 {
+			return false
+		}
+		dist = (float64(d.X) + 0.5 - p.X) / cosA
+		along = p.Y + sinA*dist - float64(d.Y)
+		h.side = mapSideVertical(int(math.Copysign(1, cosA)))
+	}
+	if dist <= 0 || along < d.Open || along >= 1 {
+		return false
+	}
+	h.dist = math.Min(math.Max(dist, 0.0001), maxDepth)
+	h.hx = p.X + cosA*h.dist
+	h.hy = p.Y + sinA*h.dist
+	h.door = d
+	h.tex = along - d.Open
+	return true
+}
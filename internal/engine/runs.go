@@ -0,0 +1,201 @@
+package engine
+
+import (
+	"fmt"
+	"image/color"
+	"log"
+	"strings"
+
+	"doomlike/ent/run"
+	"doomlike/internal/sim"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/text"
+)
+
+// beginRun starts recording the run just set up in g.world, first closing
+// any run still being recorded as quit. Play-tests and demo playback are
+// not recorded.
+func (g *Game) beginRun(resumed bool) {
+	g.endRunRecord(run.OutcomeQuit)
+	if g.db == nil || g.playtest || g.demoPlay != nil {
+		return
+	}
+	info := runInfo{
+		seed:        g.world.Seed,
+		totalLevels: g.world.TotalLevels,
+		fireRate:    g.world.Settings.FireRate,
+		bulletSpeed: g.world.Settings.BulletSpeed,
+		resumed:     resumed,
+	}
+	if g.world.Campaign != nil {
+		info.campaign = g.world.Campaign.Path
+	}
+	id, err := g.db.StartRun(info)
+	if err != nil {
+		log.Printf("Failed to record run: %v", err)
+		return
+	}
+	g.runID = id
+	g.runCleared = 0
+}
+
+// recordLevel stores the tally of the level being played in the run record
+func (g *Game) recordLevel(cleared bool) {
+	if g.runID == 0 {
+		return
+	}
+	st := g.world.Stats()
+	p := &g.world.Player
+	res := levelResult{
+		level:      g.world.Level,
+		mapName:    g.world.MapName,
+		cleared:    cleared,
+		kills:      make(map[string]int),
+		enemyTotal: st.KillTotal,
+		shots:      p.Shots,
+		hits:       p.Hits,
+		dealt:      p.Dealt,
+		taken:      p.Hurt,
+		pickups:    st.Collected,
+		time:       st.Time,
+		par:        st.Par,
+		score:      p.Score,
+	}
+	for t, n := range st.TypeKills {
+		if n > 0 {
+			res.kills[g.world.EnemyDef(sim.EnemyType(t)).Name] = n
+		}
+	}
+	if cleared {
+		g.runCleared++
+	}
+	if err := g.db.RecordLevel(g.runID, res); err != nil {
+		log.Printf("Failed to record level: %v", err)
+	}
+}
+
+// endRunRecord closes the run being recorded, if any. A run left mid-level
+// gets the level so far recorded too.
+func (g *Game) endRunRecord(outcome run.Outcome) {
+	if g.runID == 0 {
+		return
+	}
+	if outcome == run.OutcomeQuit && g.state != stateLevelClear && g.state != stateWin {
+		g.recordLevel(false)
+	}
+	if err := g.db.FinishRun(g.runID, outcome, g.world.Player.Score, g.runCleared); err != nil {
+		log.Printf("Failed to record run end: %v", err)
+	}
+	g.runID = 0
+}
+
+func (g *Game) openStatistics() {
+	g.lifetime = nil
+	if g.db != nil {
+		st, err := g.db.LifetimeStats(statsRecentRuns)
+		if err != nil {
+			log.Printf("Failed to load statistics: %v", err)
+		}
+		g.lifetime = st
+	}
+	g.state = stateStatistics
+}
+
+func (g *Game) drawStatistics(dst *ebiten.Image) {
+	drawRect(dst, g.pix, 0, 0, ScreenW, ScreenH, color.RGBA{0, 0, 0, 180})
+
+	w, h := 620, 440
+	x := (ScreenW - w) / 2
+	y := (ScreenH - h) / 2
+
+	drawRect(dst, g.pix, x, y, w, h, uiBox)
+	drawRect(dst, g.pix, x, y, w, 2, uiAccent)
+	drawRect(dst, g.pix, x, y+h-2, w, 2, uiAccent)
+	drawRect(dst, g.pix, x, y, 2, h, uiAccent)
+	drawRect(dst, g.pix, x+w-2, y, 2, h, uiAccent)
+
+	lx := x + 30
+	ly := y + 30
+	text.Draw(dst, "STATISTICS", g.face, lx, ly, uiAccent)
+	ly += 30
+
+	st := g.lifetime
+	if st == nil || st.runs == 0 {
+		msg := "No runs recorded yet"
+		if g.db == nil {
+			msg = "Statistics are unavailable without a database"
+		}
+		text.Draw(dst, msg, g.face, lx, ly, gray)
+		text.Draw(dst, "Enter/Esc: Back", g.face, lx, y+h-20, gray)
+		return
+	}
+
+	// Totals in two columns
+	accuracy := 0
+	if st.shots > 0 {
+		accuracy = st.hits * 100 / st.shots
+	}
+	totalKills := 0
+	for _, n := range st.kills {
+		totalKills += n
+	}
+	left := []struct{ label, value string }{
+		{"Runs", fmt.Sprintf("%d", st.runs)},
+		{"Wins", fmt.Sprintf("%d  (%d%%)", st.wins, sim.Percent(st.wins, st.runs))},
+		{"Deaths", fmt.Sprintf("%d", st.deaths)},
+		{"Quit", fmt.Sprintf("%d", st.quits)},
+		{"Levels cleared", fmt.Sprintf("%d", st.levelsCleared)},
+		{"Favorite length", fmt.Sprintf("%d levels", st.favoriteLevels)},
+		{"Time played", formatClock(st.playTime)},
+	}
+	right := []struct{ label, value string }{
+		{"Best score", fmt.Sprintf("%d", st.bestScore)},
+		{"Kills", fmt.Sprintf("%d", totalKills)},
+		{"Accuracy", fmt.Sprintf("%d%%  (%d / %d)", accuracy, st.hits, st.shots)},
+		{"Damage dealt", fmt.Sprintf("%d", st.dealt)},
+		{"Damage taken", fmt.Sprintf("%d", st.taken)},
+		{"Pickups", fmt.Sprintf("%d", st.pickups)},
+	}
+	for i, r := range left {
+		text.Draw(dst, r.label, g.face, lx, ly+i*20, white)
+		text.Draw(dst, r.value, g.face, lx+130, ly+i*20, yellow)
+	}
+	for i, r := range right {
+		text.Draw(dst, r.label, g.face, lx+290, ly+i*20, white)
+		text.Draw(dst, r.value, g.face, lx+400, ly+i*20, yellow)
+	}
+	ly += len(left)*20 + 10
+
+	// Kills by enemy type, in registry order
+	var byType []string
+	for _, def := range g.enemyDefs {
+		if n := st.kills[def.Name]; n > 0 {
+			byType = append(byType, fmt.Sprintf("%s %d", def.Name, n))
+		}
+	}
+	if len(byType) > 0 {
+		text.Draw(dst, strings.Join(byType, "   "), g.face, lx, ly, gray)
+	}
+	ly += 30
+
+	// Accuracy of the latest runs, one bar each, oldest on the left
+	text.Draw(dst, fmt.Sprintf("Accuracy, last %d runs", len(st.recentAccuracy)), g.face, lx, ly, uiAccent)
+	ly += 10
+	chartH := 100
+	drawRect(dst, g.pix, lx, ly, w-60, chartH, color.RGBA{20, 20, 30, 220})
+	drawRect(dst, g.pix, lx, ly+chartH/2, w-60, 1, color.RGBA{60, 60, 70, 255}) // 50% line
+	if n := len(st.recentAccuracy); n > 0 {
+		barW := min((w-60)/statsRecentRuns, 24)
+		for i, acc := range st.recentAccuracy {
+			bh := max(acc*chartH/100, 1)
+			col := yellow
+			if i == n-1 {
+				col = uiAccent
+			}
+			drawRect(dst, g.pix, lx+i*barW+2, ly+chartH-bh, barW-4, bh, col)
+		}
+	}
+
+	text.Draw(dst, "Enter/Esc: Back", g.face, lx, y+h-20, gray)
+}
@@ -0,0 +1,222 @@
+package engine
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"log"
+
+	"doomlike/ent/run"
+	"doomlike/internal/sim"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/text"
+)
+
+// openSaveLoad shows the slot list for saving (from the in-game menu) or loading
+func (g *Game) openSaveLoad(saving bool) {
+	g.saving = saving
+	g.saveReturn = g.state
+	g.saveMessage = ""
+	g.menu.selectedSlot = 0
+	g.refreshSaveSlots()
+	g.state = stateSaveLoad
+}
+
+// refreshSaveSlots reloads slot metadata and thumbnails from the database
+func (g *Game) refreshSaveSlots() {
+	g.saveSlots = make(map[int]saveSlotInfo)
+	g.saveThumbs = make(map[int]*ebiten.Image)
+	if g.db == nil {
+		g.saveMessage = "Saving is unavailable without a database"
+		return
+	}
+
+	infos, err := g.db.ListSaves()
+	if err != nil {
+		log.Printf("Failed to list saves: %v", err)
+		g.saveMessage = "Could not read save slots"
+		return
+	}
+	for _, info := range infos {
+		g.saveSlots[info.slot] = info
+		if len(info.thumbnail) == 0 {
+			continue
+		}
+		img, err := png.Decode(bytes.NewReader(info.thumbnail))
+		if err != nil {
+			log.Printf("Failed to decode thumbnail for slot %d: %v", info.slot, err)
+			continue
+		}
+		g.saveThumbs[info.slot] = ebiten.NewImageFromImage(img)
+	}
+}
+
+func (g *Game) updateSaveLoad() {
+	if g.menuUp() {
+		g.menu.selectedSlot--
+		if g.menu.selectedSlot < 0 {
+			g.menu.selectedSlot = saveSlotCount - 1 // Wrap to last slot
+		}
+	}
+	if g.menuDown() {
+		g.menu.selectedSlot++
+		if g.menu.selectedSlot >= saveSlotCount {
+			g.menu.selectedSlot = 0 // Wrap to first slot
+		}
+	}
+
+	if !g.menuConfirm() || g.db == nil {
+		return
+	}
+
+	slot := g.menu.selectedSlot + 1
+	if g.saving {
+		g.saveToSlot(slot)
+		return
+	}
+	if _, ok := g.saveSlots[slot]; !ok {
+		g.saveMessage = fmt.Sprintf("Slot %d is empty", slot)
+		return
+	}
+	g.loadFromSlot(slot)
+}
+
+// saveToSlot stores the current run together with a thumbnail of the last rendered frame
+func (g *Game) saveToSlot(slot int) {
+	if err := g.db.SaveGame(slot, g.world.Snapshot(), g.captureThumbnail()); err != nil {
+		log.Printf("Failed to save game: %v", err)
+		g.saveMessage = "Save failed"
+		return
+	}
+	g.refreshSaveSlots()
+	g.saveMessage = fmt.Sprintf("Saved to slot %d", slot)
+}
+
+// loadFromSlot replaces the current run with a saved one and resumes play
+func (g *Game) loadFromSlot(slot int) {
+	snap, err := g.db.LoadGame(slot)
+	if err != nil {
+		log.Printf("Failed to load game: %v", err)
+		g.saveMessage = "Load failed"
+		return
+	}
+
+	var campaign *sim.Campaign
+	if snap.Campaign != "" {
+		if campaign, err = g.findCampaign(snap.Campaign); err != nil {
+			log.Printf("Failed to load campaign for slot %d: %v", slot, err)
+			g.saveMessage = "Load failed: campaign is missing or invalid"
+			return
+		}
+	}
+
+	// A demo can only replay a run from its start, so stop recording here.
+	g.saveDemo()
+	g.demoPlay = nil
+	g.endRunRecord(run.OutcomeQuit)
+
+	g.world = sim.FromSnapshot(snap, g.enemyDefs)
+	g.world.Campaign = campaign
+	g.settings.fireRate = snap.Settings.FireRate
+	g.settings.bulletSpeed = snap.Settings.BulletSpeed
+	g.pickupMessages = make([]pickupMessage, 0)
+	g.effects = g.effects[:0]
+	g.menu.selectedInGameOption = 0
+	g.state = statePlaying
+	g.mouseGrabbed = true
+	ebiten.SetCursorMode(ebiten.CursorModeCaptured)
+	g.lastMouseX = 0
+	g.beginRun(true)
+	log.Printf("Loaded slot %d (level %d/%d, seed %d)", slot, snap.Level, snap.TotalLevels, snap.Seed)
+}
+
+// captureThumbnail downscales the last rendered frame and encodes it as PNG
+func (g *Game) captureThumbnail() []byte {
+	thumb := ebiten.NewImage(thumbW, thumbH)
+	defer thumb.Deallocate()
+
+	op := &ebiten.DrawImageOptions{}
+	op.GeoM.Scale(float64(thumbW)/float64(g.renderW), float64(thumbH)/float64(g.renderH))
+	op.Filter = ebiten.FilterLinear
+	thumb.DrawImage(g.fb, op)
+
+	rgba := image.NewRGBA(image.Rect(0, 0, thumbW, thumbH))
+	thumb.ReadPixels(rgba.Pix)
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, rgba); err != nil {
+		log.Printf("Failed to encode thumbnail: %v", err)
+		return nil
+	}
+	return buf.Bytes()
+}
+
+func (g *Game) drawSaveLoad(dst *ebiten.Image) {
+	drawRect(dst, g.pix, 0, 0, ScreenW, ScreenH, color.RGBA{0, 0, 0, 180})
+
+	rowH := thumbH + 16
+	w, h := 620, 110+saveSlotCount*rowH
+	x := (ScreenW - w) / 2
+	y := (ScreenH - h) / 2
+
+	drawRect(dst, g.pix, x, y, w, h, uiBox)
+	drawRect(dst, g.pix, x, y, w, 2, uiAccent)
+	drawRect(dst, g.pix, x, y+h-2, w, 2, uiAccent)
+	drawRect(dst, g.pix, x, y, 2, h, uiAccent)
+	drawRect(dst, g.pix, x+w-2, y, 2, h, uiAccent)
+
+	// Title
+	lx := x + 30
+	ly := y + 30
+	title := "LOAD GAME"
+	if g.saving {
+		title = "SAVE GAME"
+	}
+	text.Draw(dst, title, g.face, lx, ly, uiAccent)
+	ly += 20
+
+	for i := 0; i < saveSlotCount; i++ {
+		slot := i + 1
+		col := white
+		if i == g.menu.selectedSlot {
+			col = yellow
+			// Draw selection indicator
+			text.Draw(dst, ">", g.face, lx-18, ly+thumbH/2+5, col)
+		}
+
+		// Thumbnail frame
+		drawRect(dst, g.pix, lx-1, ly-1, thumbW+2, thumbH+2, gray)
+		drawRect(dst, g.pix, lx, ly, thumbW, thumbH, black)
+		if img, ok := g.saveThumbs[slot]; ok {
+			op := &ebiten.DrawImageOptions{}
+			op.GeoM.Translate(float64(lx), float64(ly))
+			dst.DrawImage(img, op)
+		}
+
+		tx := lx + thumbW + 18
+		info, ok := g.saveSlots[slot]
+		if !ok {
+			text.Draw(dst, fmt.Sprintf("Slot %d", slot), g.face, tx, ly+20, col)
+			text.Draw(dst, "Empty", g.face, tx, ly+40, gray)
+		} else {
+			text.Draw(dst, fmt.Sprintf("Slot %d  -  Level %d / %d", slot, info.level, info.totalLevels), g.face, tx, ly+20, col)
+			text.Draw(dst, info.updatedAt.Local().Format("2006-01-02 15:04:05"), g.face, tx, ly+40, gray)
+			text.Draw(dst, fmt.Sprintf("Time %s  Seed %d", formatClock(info.playTime), info.seed), g.face, tx, ly+60, gray)
+		}
+		ly += rowH
+	}
+
+	ly += 10
+	if g.saveMessage != "" {
+		text.Draw(dst, g.saveMessage, g.face, lx, ly, green)
+	}
+	ly += 20
+	action := "load"
+	if g.saving {
+		action = "save"
+	}
+	text.Draw(dst, fmt.Sprintf("↑/↓ to choose a slot, Enter to %s, Esc to go back", action), g.face, lx, ly, gray)
+}
@@ -0,0 +1,212 @@
+package engine
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// settingsFileVersion is written into exported settings so later formats can
+// recognise older files
+const settingsFileVersion = 1
+
+// settingsFile is the portable, hand-editable form of a profile's settings.
+// Fields missing from a file leave the current value alone, so a shared
+// config can set just the values it cares about.
+type settingsFile struct {
+	Version     int      `json:"version"`
+	FireRate    *float64 `json:"fire_rate,omitempty"`
+	BulletSpeed *float64 `json:"bullet_speed,omitempty"`
+	LevelCount  *int     `json:"level_count,omitempty"`
+	Seed        *int64   `json:"seed,omitempty"`
+
+	MouseSensitivity *float64 `json:"mouse_sensitivity,omitempty"`
+	InvertMouse      *bool    `json:"invert_mouse,omitempty"`
+	TurnSpeed        *float64 `json:"turn_speed,omitempty"`
+	FOV              *float64 `json:"fov,omitempty"`
+	RenderWidth      *int     `json:"render_width,omitempty"`
+	RenderHeight     *int     `json:"render_height,omitempty"`
+
+	GamepadTurnSpeed *float64 `json:"gamepad_turn_speed,omitempty"`
+	GamepadDeadzone  *float64 `json:"gamepad_deadzone,omitempty"`
+	GamepadCurve     *float64 `json:"gamepad_curve,omitempty"`
+
+	KeyBindings map[string][]string `json:"key_bindings,omitempty"`
+}
+
+// settingsFileKeys are the keys importSettings understands; others are
+// reported and ignored
+var settingsFileKeys = map[string]bool{
+	"version": true, "fire_rate": true, "bullet_speed": true, "level_count": true, "seed": true,
+	"mouse_sensitivity": true, "invert_mouse": true, "turn_speed": true, "fov": true,
+	"render_width": true, "render_height": true, "gamepad_turn_speed": true, "gamepad_deadzone": true,
+	"gamepad_curve": true, "key_bindings": true,
+}
+
+// defaultSettingsPath is where the options screen exports and imports settings
+func defaultSettingsPath() string {
+	return filepath.Join(gameDataDir(), "settings.json")
+}
+
+// exportSettings writes s and keys to path as indented JSON
+func exportSettings(path string, s gameSettings, keys bindings) error {
+	f := settingsFile{
+		Version:     settingsFileVersion,
+		FireRate:    &s.fireRate,
+		BulletSpeed: &s.bulletSpeed,
+		LevelCount:  &s.levelCount,
+		Seed:        &s.seed,
+
+		MouseSensitivity: &s.mouseSens,
+		InvertMouse:      &s.invertMouse,
+		TurnSpeed:        &s.turnSpeed,
+		FOV:              &s.fov,
+		RenderWidth:      &s.renderW,
+		RenderHeight:     &s.renderH,
+
+		GamepadTurnSpeed: &s.padTurnSpeed,
+		GamepadDeadzone:  &s.padDeadzone,
+		GamepadCurve:     &s.padCurve,
+
+		KeyBindings: keys.encode(),
+	}
+	data, err := json.MarshalIndent(f, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode settings: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to create settings directory: %w", err)
+	}
+	if err := os.WriteFile(path, append(data, '\n'), 0o644); err != nil {
+		return fmt.Errorf("failed to write settings: %w", err)
+	}
+	return nil
+}
+
+// importSettings reads the settings file at path over s and keys. Values
+// outside the ranges the options screen allows are clamped; the returned
+// notes say which ones were, and which keys were not understood.
+func importSettings(path string, s gameSettings, keys bindings) (gameSettings, bindings, []string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return s, keys, nil, fmt.Errorf("failed to read settings: %w", err)
+	}
+	var f settingsFile
+	if err := json.Unmarshal(data, &f); err != nil {
+		return s, keys, nil, fmt.Errorf("failed to parse settings %s: %w", path, err)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return s, keys, nil, fmt.Errorf("failed to parse settings %s: %w", path, err)
+	}
+
+	var notes []string
+	if f.Version > settingsFileVersion {
+		notes = append(notes, fmt.Sprintf("file is version %d, newer than this game's %d", f.Version, settingsFileVersion))
+	}
+	var unknown []string
+	for key := range fields {
+		if !settingsFileKeys[key] {
+			unknown = append(unknown, key)
+		}
+	}
+	sort.Strings(unknown)
+	if len(unknown) > 0 {
+		notes = append(notes, "ignored "+strings.Join(unknown, ", "))
+	}
+
+	if f.FireRate != nil {
+		s.fireRate = clampSetting("fire_rate", *f.FireRate, minFireRate, maxFireRate, &notes)
+	}
+	if f.BulletSpeed != nil {
+		s.bulletSpeed = clampSetting("bullet_speed", *f.BulletSpeed, minBulletSpeed, maxBulletSpeed, &notes)
+	}
+	if f.LevelCount != nil {
+		s.levelCount = int(clampSetting("level_count", float64(*f.LevelCount), minLevelCount, maxLevelCount, &notes))
+	}
+	if f.Seed != nil {
+		s.seed = int64(clampSetting("seed", float64(*f.Seed), 0, maxSeed, &notes))
+	}
+	if f.MouseSensitivity != nil {
+		s.mouseSens = clampSetting("mouse_sensitivity", *f.MouseSensitivity, minMouseSens, maxMouseSens, &notes)
+	}
+	if f.InvertMouse != nil {
+		s.invertMouse = *f.InvertMouse
+	}
+	if f.TurnSpeed != nil {
+		s.turnSpeed = clampSetting("turn_speed", *f.TurnSpeed, minTurnSpeed, maxTurnSpeed, &notes)
+	}
+	if f.FOV != nil {
+		s.fov = clampSetting("fov", *f.FOV, minFOV, maxFOV, &notes)
+	}
+	if f.RenderWidth != nil {
+		s.renderW = int(clampSetting("render_width", float64(*f.RenderWidth), minRenderW, maxRenderW, &notes))
+	}
+	if f.RenderHeight != nil {
+		s.renderH = int(clampSetting("render_height", float64(*f.RenderHeight), minRenderH, maxRenderH, &notes))
+	}
+	if f.GamepadTurnSpeed != nil {
+		s.padTurnSpeed = clampSetting("gamepad_turn_speed", *f.GamepadTurnSpeed, minPadTurn, maxPadTurn, &notes)
+	}
+	if f.GamepadDeadzone != nil {
+		s.padDeadzone = clampSetting("gamepad_deadzone", *f.GamepadDeadzone, minPadDeadzone, maxPadDeadzone, &notes)
+	}
+	if f.GamepadCurve != nil {
+		s.padCurve = clampSetting("gamepad_curve", *f.GamepadCurve, minPadCurve, maxPadCurve, &notes)
+	}
+	if f.KeyBindings != nil {
+		var bindNotes []string
+		keys, bindNotes = decodeBindings(f.KeyBindings, keys)
+		notes = append(notes, bindNotes...)
+	}
+	return s, keys, notes, nil
+}
+
+// clampSetting keeps v within [lo, hi], noting the change when it has to
+func clampSetting(name string, v, lo, hi float64, notes *[]string) float64 {
+	switch {
+	case v < lo:
+		*notes = append(*notes, fmt.Sprintf("%s %g raised to %g", name, v, lo))
+		return lo
+	case v > hi:
+		*notes = append(*notes, fmt.Sprintf("%s %g lowered to %g", name, v, hi))
+		return hi
+	}
+	return v
+}
+
+// exportSettingsTo writes the current settings to path, reporting the result
+// on the options screen
+func (g *Game) exportSettingsTo(path string) {
+	if err := exportSettings(path, g.settings, g.bindings); err != nil {
+		log.Printf("Failed to export settings: %v", err)
+		g.settingsReport = []string{"Export failed: " + err.Error()}
+		return
+	}
+	log.Printf("Exported settings to %s", path)
+	g.settingsReport = []string{"Exported to " + path}
+}
+
+// importSettingsFrom loads path into the current profile's settings,
+// reporting what was clamped on the options screen
+func (g *Game) importSettingsFrom(path string) {
+	s, keys, notes, err := importSettings(path, g.settings, g.bindings)
+	if err != nil {
+		log.Printf("Failed to import settings: %v", err)
+		g.settingsReport = []string{"Import failed: " + err.Error()}
+		return
+	}
+	g.settings = s
+	g.bindings = keys
+	g.saveSettings()
+	g.saveBindings()
+	log.Printf("Imported settings from %s", path)
+	for _, note := range notes {
+		log.Printf("Settings import: %s", note)
+	}
+	g.settingsReport = append([]string{"Imported from " + path}, notes...)
+}
@@ -7,6 +7,8 @@ import (
 	"math"
 	"math/rand"
 
+	"doomlike/internal/sim"
+
 	"github.com/hajimehoshi/ebiten/v2"
 )
 
@@ -19,6 +21,54 @@ func (g *Game) initTextures() {
 	makeRuggedRock(img)
 	g.wallTex = ebiten.NewImageFromImage(img)
 	g.texW, g.texH = tw, th
+
+	g.doorTex = make(map[sim.KeyColor]*ebiten.Image)
+	for k, stripe := range keyColors {
+		door := image.NewRGBA(image.Rect(0, 0, tw, th))
+		makeDoorPanel(door, stripe)
+		g.doorTex[k] = ebiten.NewImageFromImage(door)
+	}
+}
+
+// makeDoorPanel fills an image with a riveted steel door slab crossed by a
+// painted band, so keycard doors read at a glance.
+func makeDoorPanel(dst *image.RGBA, stripe color.RGBA) {
+	w, h := dst.Bounds().Dx(), dst.Bounds().Dy()
+	rng := rand.New(rand.NewSource(4242))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			// brushed metal: vertical streaks plus a little grain
+			n := 0.5 + 0.5*valueNoise2D(float64(x)/3.0, float64(y)/48.0, rng)
+			v := 0.28 + 0.14*n
+			// recessed panels with a bevelled edge
+			px, py := x%(w/2), y%(h/3)
+			edge := min(px, py, w/2-1-px, h/3-1-py)
+			switch {
+			case edge < 3:
+				v *= 0.55
+			case edge < 6:
+				v *= 1.25
+			}
+			c := color.RGBA{uint8(clamp01(v) * 255), uint8(clamp01(v*1.02) * 255), uint8(clamp01(v*1.1) * 255), 255}
+			// painted band across the middle
+			if y > h*9/20 && y < h*11/20 && edge >= 3 {
+				c = shade(stripe, 0.75+0.25*n)
+			}
+			dst.SetRGBA(x, y, c)
+		}
+	}
+	// rivets along the panel seams
+	for y := h / 12; y < h; y += h / 6 {
+		for x := w / 16; x < w; x += w / 8 {
+			for dy := -2; dy <= 2; dy++ {
+				for dx := -2; dx <= 2; dx++ {
+					if dx*dx+dy*dy <= 4 {
+						dst.SetRGBA(x+dx, y+dy, color.RGBA{150, 150, 160, 255})
+					}
+				}
+			}
+		}
+	}
 }
 
 // makeRuggedRock fills an image with a dark, menacing rock-like texture using value noise,
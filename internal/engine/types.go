@@ -3,65 +3,13 @@ package engine
 import (
 	"image/color"
 
+	"doomlike/internal/sim"
+
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/hajimehoshi/ebiten/v2/audio"
 	"golang.org/x/image/font"
 )
 
-type vec2 struct{ x, y float64 }
-
-type player struct {
-	pos        vec2
-	angle      float64
-	hp         int
-	ammo       int
-	cooldown   float64
-	muzzleTime float64
-	score      int
-}
-
-type enemyType int
-
-const (
-	eZombie enemyType = iota
-	eRunner
-	eShooter
-)
-
-type enemy struct {
-	pos    vec2
-	hp     int
-	etype  enemyType
-	dead   bool
-	blink  float64
-	aiTime float64
-}
-
-type pickupType int
-
-const (
-	pickupMedkit pickupType = iota
-	pickupAmmo
-)
-
-type pickup struct {
-	pos   vec2
-	ptype pickupType
-	took  bool
-}
-
-type projectile struct {
-	pos        vec2
-	vel        vec2
-	ttl        float64
-	friendly   bool
-	radius     float64
-	damage     int
-	whizPlayed bool    // Track if whiz sound has been played for this bullet
-	curveAngle float64 // Random angle for bullet curving
-	curveRate  float64 // How fast the bullet curves
-}
-
 type gameState int
 
 const (
@@ -74,11 +22,12 @@ const (
 	stateLevelClear
 	stateGameOver
 	stateWin
-)
-
-const (
-	tEmpty = 0
-	tWall  = 1
+	stateSaveLoad
+	stateEditor
+	stateHighScores
+	stateStatistics
+	stateProfiles
+	stateControls
 )
 
 type pickupMessage struct {
@@ -87,45 +36,106 @@ type pickupMessage struct {
 	timeLeft float64
 }
 
+type effectKind int
+
+const (
+	effectPuff      effectKind = iota // dust where a hitscan shot struck a wall
+	effectBlood                       // blood where a hitscan shot struck an enemy
+	effectExplosion                   // fireball of a rocket or barrel
+)
+
+// impactEffect is a short-lived sprite left where something hit or blew up
+type impactEffect struct {
+	kind     effectKind
+	pos      sim.Vec2
+	timeLeft float64
+}
+
 type gameSettings struct {
 	fireRate    float64
 	bulletSpeed float64
 	levelCount  int
+	seed        int64 // 0 picks a fresh random seed for every run
+
+	mouseSens   float64 // radians per pixel
+	invertMouse bool
+	turnSpeed   float64 // keyboard turning, radians per second
+	fov         float64 // horizontal, in degrees
+	renderW     int     // internal render resolution
+	renderH     int
+
+	padTurnSpeed float64 // right stick turning at full deflection, radians per second
+	padDeadzone  float64
+	padCurve     float64 // exponent of the stick response; 1 is linear
+}
+
+// defaultGameSettings are the settings of a new profile
+func defaultGameSettings() gameSettings {
+	return gameSettings{
+		fireRate:    defaultFireRate,
+		bulletSpeed: defaultBulletSpeed,
+		levelCount:  defaultLevelCount,
+		mouseSens:   defaultMouseSens,
+		turnSpeed:   defaultTurnSpeed,
+		fov:         defaultFOV,
+		renderW:     defaultRenderW,
+		renderH:     defaultRenderH,
+
+		padTurnSpeed: defaultPadTurn,
+		padDeadzone:  defaultPadDeadzone,
+		padCurve:     defaultPadCurve,
+	}
 }
 
 type menuState struct {
 	selectedOption       int
 	selectedSetting      int
 	selectedInGameOption int
+	selectedSlot         int
+	selectedProfile      int
+	selectedAction       int
+	selectedBinding      int
 }
 
+// Options screen rows, in the order they are drawn. Level count and seed
+// come last because they are only offered from the main menu.
+const (
+	settingFireRate = iota
+	settingBulletSpeed
+	settingMouseSens
+	settingInvertMouse
+	settingTurnSpeed
+	settingFOV
+	settingRenderSize
+	settingPadTurnSpeed
+	settingPadDeadzone
+	settingPadCurve
+	settingControls
+	settingLevelCount
+	settingSeed
+)
+
 type Game struct {
-	mapW, mapH int
-	world      []int
-	reachable  []bool
-
-	p       player
-	enemies []*enemy
-	pickups []*pickup
-	bullets []*projectile
-
-	level           int
-	totalLevels     int
-	defeated        int
-	levelEnemyTotal int
-
-	fb     *ebiten.Image
-	pix    *ebiten.Image
-	scaleX float64
-	scaleY float64
-	zbuf   []float64
+	// Simulation state for the current run; the Game only adapts it to Ebiten.
+	world *sim.World
+
+	// fb and zbuf are sized to the render resolution they were made for
+	fb      *ebiten.Image
+	renderW int
+	renderH int
+	pix     *ebiten.Image
+	scaleX  float64
+	scaleY  float64
+	zbuf    []float64
 
 	wallTex *ebiten.Image
+	doorTex map[sim.KeyColor]*ebiten.Image
 	texW    int
 	texH    int
 
 	state        gameState
 	minimap      bool
+	debugAI      bool // minimap shows enemy awareness states
 	mouseGrabbed bool
 	lastMouseX   int
 	mouseX       int
@@ -136,28 +146,103 @@ type Game struct {
 	// Temporary pickup messages
 	pickupMessages []pickupMessage
 
+	// Hitscan impacts still fading out
+	effects []impactEffect
+
 	// Game settings and menu state
 	settings      gameSettings
 	menu          menuState
 	shouldQuit    bool
 	previousState gameState
 
-	// Database for persistent settings
-	db *Database
-
-	// Audio
-	audioContext    *audio.Context
-	bulletSoundData []byte
-	coinSoundData   []byte
-	reloadSoundData []byte
-	oneUpSoundData  []byte
-	bulletWhizData  []byte
-	zombieGrumbler  *audio.Player
-	runnerGrumbler  *audio.Player
-	shooterGrumbler *audio.Player
-
-	// Animation
-	gameTime float64
+	// Database for persistent settings, and why it is not the file asked
+	// for when the game fell back to one in memory
+	db       *Database
+	dbNotice string
+
+	// settingsReport is the outcome of the last settings export or import,
+	// shown on the options screen
+	settingsReport []string
+
+	// Key bindings of the current profile, and the controls page: whether it
+	// waits for an input to bind and what the last rebind displaced
+	bindings         bindings
+	capturingBinding bool
+	controlsMessage  string
+
+	// Gamepads with the standard layout, in the order they were connected,
+	// and the notice shown when one comes or goes
+	gamepads       []ebiten.GamepadID
+	padNotice      string
+	padNoticeTimer float64
+
+	// Construction-time options (command-line overrides)
+	opts Options
+
+	// Enemy definitions every run is played with, and the authored maps for
+	// new runs, or nil to generate every level
+	enemyDefs sim.EnemyDefs
+	campaign  *sim.Campaign
+
+	// Level editor, and whether the current run is a play-test started from it
+	editor   *mapEditor
+	playtest bool
+
+	// Demo recording of the current run, or playback of a recorded one
+	demoPath   string
+	demoRec    *sim.DemoRecorder
+	demoPlay   *sim.DemoPlayer
+	demoStatus string
+
+	// Save/load screen: whether it saves, where Esc returns to, and cached slots
+	saving      bool
+	saveReturn  gameState
+	saveSlots   map[int]saveSlotInfo
+	saveThumbs  map[int]*ebiten.Image
+	saveMessage string
+
+	// High-score table, the finished run waiting for a name, and the row just added
+	highScores   []highScoreEntry
+	nameEntry    *highScoreEntry
+	nameInput    []rune
+	newScoreRank int
+
+	// Run being recorded (0 for none) and the levels it has cleared, and the
+	// lifetime totals shown on the statistics screen
+	runID      int
+	runCleared int
+	lifetime   *lifetimeStats
+
+	// Profile screen: the profiles, the name of the one in use, and the name
+	// being typed or the profile waiting to be deleted
+	profiles       []profileInfo
+	profileName    string
+	profileEdit    profileEditMode
+	profileInput   []rune
+	profileMessage string
+	deleteConfirm  bool
+
+	gameAudio
+}
+
+// gameAudio is the audio context and the sounds made for it. Ebiten allows a
+// single audio context per process, so a restart carries these over.
+type gameAudio struct {
+	audioContext       *audio.Context
+	bulletSoundData    []byte
+	coinSoundData      []byte
+	reloadSoundData    []byte
+	oneUpSoundData     []byte
+	bulletWhizData     []byte
+	doorSoundData      []byte
+	lockedSoundData    []byte
+	shotgunSoundData   []byte
+	chaingunSoundData  []byte
+	rocketSoundData    []byte
+	explosionSoundData []byte
+	zombieGrumbler     *audio.Player
+	runnerGrumbler     *audio.Player
+	shooterGrumbler    *audio.Player
 }
 
 var _ ebiten.Game = (*Game)(nil)
@@ -3,20 +3,24 @@ package engine
 import (
 	"fmt"
 	"image/color"
+	"path/filepath"
+
+	"doomlike/internal/sim"
 
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/hajimehoshi/ebiten/v2/text"
 )
 
 func (g *Game) Draw(screen *ebiten.Image) {
+	g.resizeRender()
 	g.drawScene(g.fb)
 	op := &ebiten.DrawImageOptions{}
 	op.GeoM.Scale(g.scaleX, g.scaleY)
 
 	// Center the scaled image on the screen
 	screenW, screenH := screen.Bounds().Dx(), screen.Bounds().Dy()
-	scaledW := int(float64(renderW) * g.scaleX)
-	scaledH := int(float64(renderH) * g.scaleY)
+	scaledW := int(float64(g.renderW) * g.scaleX)
+	scaledH := int(float64(g.renderH) * g.scaleY)
 	offsetX := (screenW - scaledW) / 2
 	offsetY := (screenH - scaledH) / 2
 	op.GeoM.Translate(float64(offsetX), float64(offsetY))
@@ -26,8 +30,10 @@ func (g *Game) Draw(screen *ebiten.Image) {
 	// Draw reticle on screen coordinates
 	if g.state == statePlaying {
 		c := uiAccent
-		h := int(2.5 * g.scaleX) // Quarter size: 10/4 = 2.5
-		w := int(0.5 * g.scaleX) // Quarter size: 2/4 = 0.5
+		// Sized against the default render width so it keeps its size at any render scale
+		px := g.scaleX * float64(g.renderW) / defaultRenderW
+		h := int(2.5 * px) // Quarter size: 10/4 = 2.5
+		w := int(0.5 * px) // Quarter size: 2/4 = 0.5
 		cx := screenW / 2
 		cy := screenH / 2
 		drawRect(screen, g.pix, cx-w/2, cy-h, w, h*2, c)
@@ -45,6 +51,18 @@ func (g *Game) Draw(screen *ebiten.Image) {
 		g.drawInGameMenu(screen)
 	case stateOptions:
 		g.drawOptionsMenu(screen)
+	case stateSaveLoad:
+		g.drawSaveLoad(screen)
+	case stateEditor:
+		g.drawEditor(screen)
+	case stateHighScores:
+		g.drawHighScores(screen)
+	case stateStatistics:
+		g.drawStatistics(screen)
+	case stateProfiles:
+		g.drawProfiles(screen)
+	case stateControls:
+		g.drawControls(screen)
 	case stateStart:
 		g.drawStart(screen)
 	case stateMenu:
@@ -56,6 +74,10 @@ func (g *Game) Draw(screen *ebiten.Image) {
 	case stateWin:
 		g.drawStateOverlay(screen, "YOU WIN!", uiAccent)
 	}
+
+	if g.padNotice != "" {
+		text.Draw(screen, g.padNotice, g.face, ScreenW-len(g.padNotice)*7-30, ScreenH-30, cyan)
+	}
 }
 
 func (g *Game) drawScene(dst *ebiten.Image) {
@@ -69,8 +91,8 @@ func (g *Game) drawScene(dst *ebiten.Image) {
 
 func (g *Game) drawHUD(dst *ebiten.Image) {
 	if g.state == statePlaying {
-		if g.p.muzzleTime > 0 {
-			a := uint8(80 * g.p.muzzleTime / 0.06)
+		if g.world.Player.MuzzleTime > 0 {
+			a := uint8(80 * g.world.Player.MuzzleTime / 0.06)
 			drawRect(dst, g.pix, 0, 0, ScreenW, ScreenH, color.RGBA{255, 255, 200, a})
 		}
 	}
@@ -85,39 +107,107 @@ func (g *Game) drawHUD(dst *ebiten.Image) {
 	by := 16
 	drawRect(dst, g.pix, bx-2, by-2, barW+4, barH+4, black)
 	drawRect(dst, g.pix, bx, by, barW, barH, color.RGBA{60, 20, 20, 220})
-	fill := int(float64(barW) * clamp01(float64(g.p.hp)/float64(playerMaxHP)))
+	fill := int(float64(barW) * clamp01(float64(g.world.Player.HP)/float64(sim.PlayerMaxHP)))
 	if fill > 0 {
 		col := red
-		if g.p.hp >= playerMaxHP/2 {
+		if g.world.Player.HP >= sim.PlayerMaxHP/2 {
 			col = green
-		} else if g.p.hp > 20 {
+		} else if g.world.Player.HP > 20 {
 			col = yellow
 		}
 		drawRect(dst, g.pix, bx, by, fill, barH, col)
 	}
-	text.Draw(dst, fmt.Sprintf("HP: %d / %d", g.p.hp, playerMaxHP), g.face, bx, by+barH+14, white)
-	text.Draw(dst, fmt.Sprintf("Ammo: %d", g.p.ammo), g.face, bx, by+barH+30, yellow)
+	text.Draw(dst, fmt.Sprintf("HP: %d / %d", g.world.Player.HP, sim.PlayerMaxHP), g.face, bx, by+barH+14, white)
+
+	// armor bar beside the health bar, tinted by the armor worn
+	ax := bx + barW + 16
+	armorW := barW / 2
+	drawRect(dst, g.pix, ax-2, by-2, armorW+4, barH+4, black)
+	drawRect(dst, g.pix, ax, by, armorW, barH, color.RGBA{20, 20, 40, 220})
+	if fill := int(float64(armorW) * clamp01(float64(g.world.Player.Armor)/float64(sim.PlayerMaxArmor))); fill > 0 {
+		drawRect(dst, g.pix, ax, by, fill, barH, armorColors[g.world.Player.ArmorClass])
+	}
+	text.Draw(dst, fmt.Sprintf("Armor: %d", g.world.Player.Armor), g.face, ax, by+barH+14, white)
+	text.Draw(dst, fmt.Sprintf("Ammo: %d", *g.world.Player.AmmoFor(g.world.Player.Weapon.Def().Ammo)), g.face, bx, by+barH+30, yellow)
+
+	// held keycards, one slot per color next to the ammo counter
+	for i, k := range sim.KeyColors {
+		kx := bx + 120 + i*18
+		ky := by + barH + 19
+		drawRect(dst, g.pix, kx, ky, 12, 14, color.RGBA{40, 40, 40, 200})
+		if g.world.Player.HasKey(k) {
+			drawRect(dst, g.pix, kx+1, ky+1, 10, 12, keyColors[k])
+		}
+	}
 
 	// level & counters
 	lx := ScreenW - 260
 	ly := 20
-	drawRect(dst, g.pix, lx-10, ly-16, 240, 56, color.RGBA{0, 0, 0, 160})
-	text.Draw(dst, fmt.Sprintf("Level: %d / %d", g.level, g.totalLevels), g.face, lx, ly, uiAccent)
+	boxH := 110
+	if g.world.MapName != "" {
+		boxH += 18
+	}
+	drawRect(dst, g.pix, lx-10, ly-16, 240, boxH, color.RGBA{0, 0, 0, 160})
+	text.Draw(dst, fmt.Sprintf("Level: %d / %d", g.world.Level, g.world.TotalLevels), g.face, lx, ly, uiAccent)
 	ly += 18
-	remaining := 0
-	for _, e := range g.enemies {
-		if !e.dead {
-			remaining++
-		}
+	text.Draw(dst, fmt.Sprintf("Score: %d", g.world.Player.Score), g.face, lx, ly, yellow)
+	if p := &g.world.Player; p.Combo > 1 && p.ComboTime > 0 {
+		text.Draw(dst, fmt.Sprintf("COMBO x%d", min(p.Combo, sim.ComboMaxMultiplier)), g.face, lx+120, ly, orange)
 	}
-	text.Draw(dst, fmt.Sprintf("Defeated: %d", g.defeated), g.face, lx, ly, white)
+	ly += 18
+	remaining := g.world.EnemiesLeft()
+	text.Draw(dst, fmt.Sprintf("Defeated: %d", g.world.Defeated), g.face, lx, ly, white)
 	ly += 18
 	text.Draw(dst, fmt.Sprintf("Remaining: %d", remaining), g.face, lx, ly, white)
+	ly += 18
+	text.Draw(dst, "Goal: "+completionGoals[g.world.Rule], g.face, lx, ly, white)
+	ly += 18
+	text.Draw(dst, fmt.Sprintf("Seed: %d", g.world.Seed), g.face, lx, ly, gray)
+	if g.world.MapName != "" {
+		ly += 18
+		text.Draw(dst, g.world.MapName, g.face, lx, ly, gray)
+	}
+
+	// The exit stays shut while enemies remain under the kill-everything-then-exit rule
+	if g.state == statePlaying && g.world.Rule == sim.CompleteBoth && remaining > 0 && g.world.OnExit() {
+		text.Draw(dst, fmt.Sprintf("Kill every enemy to exit (%d left)", remaining), g.face, ScreenW/2-120, ScreenH/2-60, red)
+	}
+
+	if g.demoPlay != nil && g.state == statePlaying {
+		label := fmt.Sprintf("DEMO PLAYBACK  tick %d  (Esc to stop)", g.demoPlay.Ticks())
+		text.Draw(dst, label, g.face, ScreenW/2-130, 40, yellow)
+		if g.demoStatus != "" {
+			text.Draw(dst, g.demoStatus, g.face, ScreenW/2-130, 58, red)
+		}
+	}
+
+	g.drawWeaponPanel(dst)
 
 	// Draw pickup messages
 	g.drawPickupMessages(dst)
 }
 
+// drawWeaponPanel shows the weapon slots and every ammo count in the bottom
+// left corner. Carried weapons are lit and the one in hand is highlighted.
+func (g *Game) drawWeaponPanel(dst *ebiten.Image) {
+	p := &g.world.Player
+	x, y := 12, ScreenH-70
+	drawRect(dst, g.pix, x-6, y-18, 190, 64, color.RGBA{0, 0, 0, 160})
+	text.Draw(dst, p.Weapon.String(), g.face, x, y, uiAccent)
+	for i := range sim.WeaponCount {
+		t := sim.WeaponType(i)
+		col := color.RGBA{70, 70, 70, 255}
+		switch {
+		case t == p.Weapon:
+			col = yellow
+		case p.HasWeapon(t):
+			col = white
+		}
+		text.Draw(dst, fmt.Sprint(i+1), g.face, x+i*16, y+18, col)
+	}
+	text.Draw(dst, fmt.Sprintf("Bul %d  Shl %d  Rkt %d", p.Ammo, p.Shells, p.Rockets), g.face, x, y+36, gray)
+}
+
 func (g *Game) drawPickupMessages(dst *ebiten.Image) {
 	if len(g.pickupMessages) == 0 {
 		return
@@ -143,12 +233,52 @@ func (g *Game) drawPickupMessages(dst *ebiten.Image) {
 	}
 }
 
+// mainMenuOptions lists the main menu entries in the order selectMainMenuOption handles them
+var mainMenuOptions = []string{"Start Game", "Load Game", "Play Demo", "Map Editor", "High Scores", "Statistics", "Options", "Profile", "Quit"}
+
+// inGameMenuOptions lists the pause menu entries in the order selectInGameMenuOption handles them
+var inGameMenuOptions = []string{"Resume Game", "Save Game", "Load Game", "Options", "Quit Game"}
+
+// Layout of the main and pause menus, shared by drawing and mouse hit testing.
+// Entries are text baselines menuFirstRow below the top of the box.
+const (
+	menuW        = 300
+	mainMenuH    = 280
+	inGameMenuH  = 200
+	menuMargin   = 15
+	menuTitleRow = 25
+	menuFirstRow = 60
+	menuRowH     = 20
+)
+
+// menuBox returns the top-left corner of a centred menu box of height h
+func menuBox(h int) (x, y int) {
+	return (ScreenW - menuW) / 2, (ScreenH - h) / 2
+}
+
+// menuOptionAt returns the entry of a menu box of height h with n entries
+// under the mouse, or -1. Each entry's row runs from a line's height above
+// its baseline to just below it.
+func menuOptionAt(mouseX, mouseY, h, n int) int {
+	x, y := menuBox(h)
+	if mouseX < x || mouseX > x+menuW {
+		return -1
+	}
+	top := y + menuFirstRow - menuRowH + 5
+	if mouseY < top {
+		return -1
+	}
+	if i := (mouseY - top) / menuRowH; i < n {
+		return i
+	}
+	return -1
+}
+
 func (g *Game) drawMainMenu(dst *ebiten.Image) {
 	drawRect(dst, g.pix, 0, 0, ScreenW, ScreenH, color.RGBA{0, 0, 0, 180})
 
-	w, h := 300, 180
-	x := (ScreenW - w) / 2
-	y := (ScreenH - h) / 2
+	w, h := menuW, mainMenuH
+	x, y := menuBox(h)
 
 	drawRect(dst, g.pix, x, y, w, h, uiBox)
 	drawRect(dst, g.pix, x, y, w, 2, uiAccent)
@@ -157,14 +287,16 @@ func (g *Game) drawMainMenu(dst *ebiten.Image) {
 	drawRect(dst, g.pix, x+w-2, y, 2, h, uiAccent)
 
 	// Title
-	lx := x + 15
-	ly := y + 25
+	lx := x + menuMargin
+	ly := y + menuTitleRow
 	text.Draw(dst, "DOOMLIKE", g.face, lx, ly, uiAccent)
-	ly += 35
+	if g.profileName != "" {
+		text.Draw(dst, g.profileName, g.face, x+w-menuMargin-len(g.profileName)*7, ly, gray)
+	}
+	ly = y + menuFirstRow
 
 	// Menu options
-	options := []string{"Start Game", "Options", "Quit"}
-	for i, option := range options {
+	for i, option := range mainMenuOptions {
 		color := white
 		if i == g.menu.selectedOption {
 			color = yellow
@@ -172,16 +304,19 @@ func (g *Game) drawMainMenu(dst *ebiten.Image) {
 			text.Draw(dst, ">", g.face, lx-15, ly, color)
 		}
 		text.Draw(dst, option, g.face, lx, ly, color)
-		ly += 20
+		ly += menuRowH
+	}
+
+	if g.dbNotice != "" {
+		text.Draw(dst, g.dbNotice, g.face, (ScreenW-len(g.dbNotice)*7)/2, y+h+25, red)
 	}
 }
 
 func (g *Game) drawInGameMenu(dst *ebiten.Image) {
 	drawRect(dst, g.pix, 0, 0, ScreenW, ScreenH, color.RGBA{0, 0, 0, 180})
 
-	w, h := 300, 160
-	x := (ScreenW - w) / 2
-	y := (ScreenH - h) / 2
+	w, h := menuW, inGameMenuH
+	x, y := menuBox(h)
 
 	drawRect(dst, g.pix, x, y, w, h, uiBox)
 	drawRect(dst, g.pix, x, y, w, 2, uiAccent)
@@ -190,14 +325,13 @@ func (g *Game) drawInGameMenu(dst *ebiten.Image) {
 	drawRect(dst, g.pix, x+w-2, y, 2, h, uiAccent)
 
 	// Title
-	lx := x + 15
-	ly := y + 25
+	lx := x + menuMargin
+	ly := y + menuTitleRow
 	text.Draw(dst, "PAUSED", g.face, lx, ly, uiAccent)
-	ly += 35
+	ly = y + menuFirstRow
 
 	// Menu options
-	options := []string{"Resume Game", "Options", "Quit Game"}
-	for i, option := range options {
+	for i, option := range inGameMenuOptions {
 		color := white
 		if i == g.menu.selectedInGameOption {
 			color = yellow
@@ -205,16 +339,45 @@ func (g *Game) drawInGameMenu(dst *ebiten.Image) {
 			text.Draw(dst, ">", g.face, lx-15, ly, color)
 		}
 		text.Draw(dst, option, g.face, lx, ly, color)
-		ly += 20
+		ly += menuRowH
 	}
 }
 
+// Layout of the options screen, shared by drawing and mouse hit testing. The
+// fire rate slider sits optionsSliderRow below the title; its track is drawn
+// sliderTrackRow below the slider's label.
+const (
+	optionsW          = 500
+	optionsH          = 725
+	optionsReportRowH = 18
+	optionsMargin     = 18
+	optionsTitleRow   = 40
+	optionsSliderRow  = 50
+	sliderW           = 400
+	sliderValueW      = 200 // right of the track, for the value
+	sliderTrackRow    = 20
+	sliderTrackH      = 8
+)
+
+// optionsBox returns the top-left corner and height of the options screen's
+// box, which grows with the settings report at its foot
+func (g *Game) optionsBox() (x, y, h int) {
+	h = optionsH + optionsReportRowH*len(g.settingsReport)
+	return (ScreenW - optionsW) / 2, (ScreenH - h) / 2, h
+}
+
+// fireRateTrack returns the left end, top and width of the fire rate
+// slider's track
+func (g *Game) fireRateTrack() (x, y, w int) {
+	bx, by, _ := g.optionsBox()
+	return bx + optionsMargin, by + optionsTitleRow + optionsSliderRow + sliderTrackRow, sliderW - sliderValueW
+}
+
 func (g *Game) drawOptionsMenu(dst *ebiten.Image) {
 	drawRect(dst, g.pix, 0, 0, ScreenW, ScreenH, color.RGBA{0, 0, 0, 180})
 
-	w, h := 500, 350
-	x := (ScreenW - w) / 2
-	y := (ScreenH - h) / 2
+	w := optionsW
+	x, y, h := g.optionsBox()
 
 	drawRect(dst, g.pix, x, y, w, h, uiBox)
 	drawRect(dst, g.pix, x, y, w, 2, uiAccent)
@@ -223,13 +386,13 @@ func (g *Game) drawOptionsMenu(dst *ebiten.Image) {
 	drawRect(dst, g.pix, x+w-2, y, 2, h, uiAccent)
 
 	// Title
-	lx := x + 18
-	ly := y + 40
+	lx := x + optionsMargin
+	ly := y + optionsTitleRow
 	text.Draw(dst, "OPTIONS", g.face, lx, ly, uiAccent)
-	ly += 50
+	ly += optionsSliderRow
 
 	// Fire Rate Slider
-	g.drawSlider(dst, lx, ly, 400, 50, minFireRate, maxFireRate, g.settings.fireRate, "Fire Rate:", g.menu.selectedSetting == 0)
+	g.drawSlider(dst, lx, ly, sliderW, 50, minFireRate, maxFireRate, g.settings.fireRate, "Fire Rate:", g.menu.selectedSetting == settingFireRate)
 	ly += 60
 
 	// Other settings (non-slider) - conditionally show level count
@@ -238,6 +401,15 @@ func (g *Game) drawOptionsMenu(dst *ebiten.Image) {
 		value string
 	}{
 		{"Bullet Speed:", fmt.Sprintf("%.0f", g.settings.bulletSpeed)},
+		{"Mouse Sensitivity:", fmt.Sprintf("%.2f", g.settings.mouseSens*1000)},
+		{"Invert Mouse:", onOff(g.settings.invertMouse)},
+		{"Turn Speed:", fmt.Sprintf("%.1f", g.settings.turnSpeed)},
+		{"Field of View:", fmt.Sprintf("%.0f deg", g.settings.fov)},
+		{"Render Size:", fmt.Sprintf("%dx%d", g.settings.renderW, g.settings.renderH)},
+		{"Pad Turn Speed:", fmt.Sprintf("%.2f", g.settings.padTurnSpeed)},
+		{"Pad Deadzone:", fmt.Sprintf("%.0f%%", g.settings.padDeadzone*100)},
+		{"Pad Response:", fmt.Sprintf("%.1f", g.settings.padCurve)},
+		{"Controls:", "Enter to rebind"},
 	}
 
 	// Only show level count if we came from the main menu
@@ -246,6 +418,15 @@ func (g *Game) drawOptionsMenu(dst *ebiten.Image) {
 			name  string
 			value string
 		}{"Level Count:", fmt.Sprintf("%d", g.settings.levelCount)})
+
+		seedLabel := "Random"
+		if g.settings.seed != 0 {
+			seedLabel = fmt.Sprintf("%d", g.settings.seed)
+		}
+		otherSettings = append(otherSettings, struct {
+			name  string
+			value string
+		}{"Seed:", seedLabel})
 	}
 
 	for i, setting := range otherSettings {
@@ -263,11 +444,26 @@ func (g *Game) drawOptionsMenu(dst *ebiten.Image) {
 
 	// Instructions
 	ly += 30
-	text.Draw(dst, "Use ↑/↓ to navigate, ←/→ to adjust", g.face, lx, ly, gray)
+	text.Draw(dst, "Use ↑/↓ or the D-pad to navigate, ←/→ to adjust", g.face, lx, ly, gray)
 	ly += 20
 	text.Draw(dst, "Click on fire rate slider to set value", g.face, lx, ly, gray)
 	ly += 20
+	if g.previousState == stateMainMenu {
+		text.Draw(dst, "Seed: type digits, Backspace erases, R random, 0 = new per run", g.face, lx, ly, gray)
+		ly += 20
+	}
+	text.Draw(dst, "E exports, I imports "+filepath.Base(defaultSettingsPath()), g.face, lx, ly, gray)
+	ly += 20
 	text.Draw(dst, "Esc to return to main menu", g.face, lx, ly, gray)
+
+	for i, line := range g.settingsReport {
+		col := yellow
+		if i == 0 {
+			col = uiAccent
+		}
+		ly += 18
+		text.Draw(dst, line, g.face, lx, ly, col)
+	}
 }
 
 // drawSlider draws a slider with ticks at 10% increments
@@ -282,9 +478,9 @@ func (g *Game) drawSlider(dst *ebiten.Image, x, y, width, height int, minVal, ma
 	text.Draw(dst, label, g.face, x, y, labelColor)
 
 	// Calculate slider position
-	sliderY := y + 20
-	sliderHeight := 8
-	sliderWidth := width - 200 // Leave space for value display
+	sliderY := y + sliderTrackRow
+	sliderHeight := sliderTrackH
+	sliderWidth := width - sliderValueW // Leave space for value display
 
 	// Draw slider track background
 	drawRect(dst, g.pix, x, sliderY, sliderWidth, sliderHeight, color.RGBA{60, 60, 60, 255})
@@ -338,6 +534,19 @@ func (g *Game) drawDetailedGun(dst *ebiten.Image) {
 	wx := (ScreenW - gw) / 2
 	wy := ScreenH - gh - 8
 
+	switch g.world.Player.Weapon {
+	case sim.WeaponShotgun:
+		g.drawShotgun(dst, wx, wy)
+	case sim.WeaponChaingun:
+		g.drawChaingun(dst, wx, wy)
+	case sim.WeaponRocketLauncher:
+		g.drawRocketLauncher(dst, wx, wy)
+	default:
+		g.drawPistol(dst, wx, wy)
+	}
+}
+
+func (g *Game) drawPistol(dst *ebiten.Image, wx, wy int) {
 	// Gun colors
 	gunMetal := color.RGBA{80, 80, 80, 255}      // Dark gray metal
 	gunDark := color.RGBA{50, 50, 50, 255}       // Darker metal
@@ -388,3 +597,86 @@ func (g *Game) drawDetailedGun(dst *ebiten.Image) {
 	drawRect(dst, g.pix, wx+20, wy+45, 120, 2, color.RGBA{0, 0, 0, 100})
 	drawRect(dst, g.pix, wx+20, wy+20, 2, 25, color.RGBA{0, 0, 0, 100})
 }
+
+func (g *Game) drawShotgun(dst *ebiten.Image, wx, wy int) {
+	gunMetal := color.RGBA{70, 70, 75, 255}
+	gunDark := color.RGBA{35, 35, 40, 255}
+	gunLight := color.RGBA{130, 130, 135, 255}
+	gunWood := color.RGBA{120, 60, 20, 255}
+
+	// Long wooden stock and pump
+	drawRect(dst, g.pix, wx, wy+30, 40, 18, gunWood)
+	drawRect(dst, g.pix, wx+70, wy+38, 45, 12, gunWood)
+
+	// Receiver
+	drawRect(dst, g.pix, wx+35, wy+24, 40, 24, gunMetal)
+
+	// Two barrels side by side
+	drawRect(dst, g.pix, wx+75, wy+24, 85, 8, gunDark)
+	drawRect(dst, g.pix, wx+75, wy+33, 85, 5, gunMetal)
+	drawRect(dst, g.pix, wx+156, wy+24, 4, 14, gunLight)
+
+	// Grip and trigger
+	drawRect(dst, g.pix, wx+40, wy+48, 14, 22, gunWood)
+	drawRect(dst, g.pix, wx+55, wy+48, 3, 8, gunLight)
+
+	// Highlights and shadow
+	drawRect(dst, g.pix, wx+75, wy+24, 85, 1, gunLight)
+	drawRect(dst, g.pix, wx+35, wy+48, 80, 2, color.RGBA{0, 0, 0, 100})
+}
+
+func (g *Game) drawChaingun(dst *ebiten.Image, wx, wy int) {
+	gunMetal := color.RGBA{75, 75, 80, 255}
+	gunDark := color.RGBA{40, 40, 45, 255}
+	gunLight := color.RGBA{140, 140, 145, 255}
+
+	// Heavy body with carry handle
+	drawRect(dst, g.pix, wx+10, wy+22, 70, 32, gunMetal)
+	drawRect(dst, g.pix, wx+30, wy+14, 30, 4, gunLight)
+	drawRect(dst, g.pix, wx+30, wy+14, 3, 8, gunLight)
+	drawRect(dst, g.pix, wx+57, wy+14, 3, 8, gunLight)
+
+	// Barrel cluster; the stripes slide while firing so the barrels seem to spin
+	drawRect(dst, g.pix, wx+80, wy+24, 75, 28, gunDark)
+	spin := 0
+	if g.world.Player.MuzzleTime > 0 {
+		spin = int(g.world.Player.MuzzleTime*200) % 7
+	}
+	for i := 0; i < 4; i++ {
+		drawRect(dst, g.pix, wx+80, wy+25+(i*7+spin)%28, 75, 2, gunLight)
+	}
+	drawRect(dst, g.pix, wx+100, wy+22, 6, 32, gunMetal)
+	drawRect(dst, g.pix, wx+150, wy+22, 8, 32, gunMetal)
+
+	// Grips
+	drawRect(dst, g.pix, wx+20, wy+54, 14, 18, gunDark)
+	drawRect(dst, g.pix, wx+60, wy+54, 12, 14, gunDark)
+
+	drawRect(dst, g.pix, wx+10, wy+54, 145, 2, color.RGBA{0, 0, 0, 100})
+}
+
+func (g *Game) drawRocketLauncher(dst *ebiten.Image, wx, wy int) {
+	tube := color.RGBA{70, 90, 60, 255}
+	tubeDark := color.RGBA{45, 60, 40, 255}
+	gunLight := color.RGBA{150, 150, 140, 255}
+	warhead := color.RGBA{200, 90, 40, 255}
+
+	// Wide launch tube
+	drawRect(dst, g.pix, wx, wy+18, 150, 32, tube)
+	drawRect(dst, g.pix, wx, wy+18, 150, 4, tubeDark)
+	drawRect(dst, g.pix, wx, wy+46, 150, 4, tubeDark)
+
+	// Muzzle ring with the next rocket peeking out
+	drawRect(dst, g.pix, wx+148, wy+16, 10, 36, gunLight)
+	if g.world.Player.Rockets > 0 {
+		drawRect(dst, g.pix, wx+152, wy+26, 6, 16, warhead)
+	}
+
+	// Sight and grips
+	drawRect(dst, g.pix, wx+60, wy+8, 20, 10, tubeDark)
+	drawRect(dst, g.pix, wx+64, wy+10, 6, 6, color.RGBA{120, 200, 255, 255})
+	drawRect(dst, g.pix, wx+40, wy+50, 14, 22, tubeDark)
+	drawRect(dst, g.pix, wx+95, wy+50, 12, 14, tubeDark)
+
+	drawRect(dst, g.pix, wx, wy+50, 150, 2, color.RGBA{0, 0, 0, 100})
+}
@@ -3,6 +3,9 @@ package engine
 import (
 	"fmt"
 	"image/color"
+	"strings"
+
+	"doomlike/internal/sim"
 
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/hajimehoshi/ebiten/v2/text"
@@ -25,7 +28,7 @@ func (g *Game) drawStart(dst *ebiten.Image) {
 	ly := y + 46
 	text.Draw(dst, "DOOMLIKE", g.face, lx, ly, uiAccent)
 	ly += 26
-	text.Draw(dst, fmt.Sprintf("Select number of levels:  %d", g.totalLevels), g.face, lx, ly, white)
+	text.Draw(dst, fmt.Sprintf("Select number of levels:  %d", g.world.TotalLevels), g.face, lx, ly, white)
 	ly += 22
 	text.Draw(dst, fmt.Sprintf("Min 1, Max %d", MaxLevelCap), g.face, lx, ly, gray)
 	ly += 22
@@ -53,13 +56,23 @@ func (g *Game) drawMenu(dst *ebiten.Image) {
 	ly += 20
 	text.Draw(dst, "Esc or Q: Quit Game", g.face, lx, ly, white)
 	ly += 20
-	text.Draw(dst, "WASD/Mouse | LMB/Space Shoot | M Minimap", g.face, lx, ly, white)
+	move := g.bindingLabel(actionMoveForward) + g.bindingLabel(actionStrafeLeft) +
+		g.bindingLabel(actionMoveBackward) + g.bindingLabel(actionStrafeRight)
+	weapons := ""
+	for i := range sim.WeaponCount {
+		weapons += g.bindingLabel(actionWeapon1 + action(i))
+	}
+	text.Draw(dst, fmt.Sprintf("%s/Mouse | %s Shoot | %s/Wheel Weapon | %s Use | %s Minimap | F3 AI",
+		move, g.bindingLabel(actionFire), weapons, g.bindingLabel(actionUse), g.bindingLabel(actionToggleMap)), g.face, lx, ly, white)
 }
 
 func (g *Game) drawStateOverlay(dst *ebiten.Image, title string, titleCol color.Color) {
 	drawRect(dst, g.pix, 0, 0, ScreenW, ScreenH, color.RGBA{0, 0, 0, 160})
 
-	w, h := 520, 200
+	w, h := 620, 200
+	if g.nameEntry != nil || len(g.highScores) > 0 {
+		h = 250 + highScoreCount*20
+	}
 	x := (ScreenW - w) / 2
 	y := (ScreenH - h) / 2
 
@@ -72,7 +85,24 @@ func (g *Game) drawStateOverlay(dst *ebiten.Image, title string, titleCol color.
 	lx := x + 18
 	ly := y + 48
 	text.Draw(dst, title, g.face, lx, ly, titleCol)
-	ly += 36
+	ly += 28
+	text.Draw(dst, fmt.Sprintf("Score: %d", g.world.Player.Score), g.face, lx, ly, yellow)
+	ly += 30
+
+	if g.nameEntry != nil {
+		text.Draw(dst, "NEW HIGH SCORE! Enter your name:", g.face, lx, ly, uiAccent)
+		ly += 26
+		drawRect(dst, g.pix, lx-4, ly-16, 220, 22, black)
+		text.Draw(dst, string(g.nameInput)+"_", g.face, lx, ly, white)
+		ly += 30
+		text.Draw(dst, "Enter: Record   Esc: Skip", g.face, lx, ly, gray)
+		return
+	}
+	if len(g.highScores) > 0 {
+		text.Draw(dst, "HIGH SCORES", g.face, lx, ly, uiAccent)
+		ly += 24
+		ly = g.drawHighScoreTable(dst, lx, ly) + 16
+	}
 	if title == "YOU WIN!" {
 		text.Draw(dst, "Press Enter to return to main menu", g.face, lx, ly, white)
 	} else {
@@ -83,7 +113,7 @@ func (g *Game) drawStateOverlay(dst *ebiten.Image, title string, titleCol color.
 func (g *Game) drawLevelClear(dst *ebiten.Image) {
 	drawRect(dst, g.pix, 0, 0, ScreenW, ScreenH, color.RGBA{0, 0, 0, 180})
 
-	w, h := 560, 220
+	w, h := 560, 404
 	x := (ScreenW - w) / 2
 	y := (ScreenH - h) / 2
 
@@ -95,15 +125,90 @@ func (g *Game) drawLevelClear(dst *ebiten.Image) {
 
 	lx := x + 18
 	ly := y + 44
-	title := fmt.Sprintf("LEVEL %d CLEARED!", g.level)
+	title := fmt.Sprintf("LEVEL %d CLEARED!", g.world.Level)
+	if g.world.MapName != "" {
+		title = fmt.Sprintf("LEVEL %d CLEARED: %s", g.world.Level, g.world.MapName)
+	}
 	text.Draw(dst, title, g.face, lx, ly, uiAccent)
 
-	ly += 26
-	text.Draw(dst, fmt.Sprintf("Defeated this run: %d", g.defeated), g.face, lx, ly, white)
+	// Intermission tally
+	st := g.world.Stats()
+	ly += 32
+	tally := []struct {
+		label       string
+		done, total int
+	}{
+		{"Kills", st.Kills, st.KillTotal},
+		{"Items", st.Items, st.ItemTotal},
+		{"Secrets", st.Secrets, st.SecretTotal},
+	}
+	for _, t := range tally {
+		text.Draw(dst, t.label, g.face, lx, ly, white)
+		text.Draw(dst, fmt.Sprintf("%3d%%", sim.Percent(t.done, t.total)), g.face, lx+120, ly, yellow)
+		text.Draw(dst, fmt.Sprintf("%d / %d", t.done, t.total), g.face, lx+200, ly, gray)
+		ly += 20
+	}
+
+	// kills by enemy type, for the types the level had
+	var byType []string
+	for t, total := range st.TypeTotals {
+		if total > 0 {
+			byType = append(byType, fmt.Sprintf("%s %d/%d", g.world.EnemyDef(sim.EnemyType(t)).Name, st.TypeKills[t], total))
+		}
+	}
+	if len(byType) > 0 {
+		text.Draw(dst, strings.Join(byType, "   "), g.face, lx+20, ly, gray)
+		ly += 20
+	}
+	ly += 6
+	text.Draw(dst, "Time", g.face, lx, ly, white)
+	timeCol := yellow
+	if st.Par > 0 && st.Time <= st.Par {
+		timeCol = green
+	}
+	text.Draw(dst, formatClock(st.Time), g.face, lx+120, ly, timeCol)
+	ly += 20
+	text.Draw(dst, "Par", g.face, lx, ly, white)
+	if st.Par > 0 {
+		text.Draw(dst, formatClock(st.Par), g.face, lx+120, ly, yellow)
+	} else {
+		text.Draw(dst, "--", g.face, lx+120, ly, gray)
+	}
+	ly += 20
+	p := &g.world.Player
+	text.Draw(dst, "Accuracy", g.face, lx, ly, white)
+	text.Draw(dst, fmt.Sprintf("%3d%%", p.Accuracy()), g.face, lx+120, ly, yellow)
+	text.Draw(dst, fmt.Sprintf("%d / %d", p.Hits, p.Shots), g.face, lx+200, ly, gray)
+
+	// Level bonus, already added to the score, in a column of its own
+	bonus := g.world.LevelBonus()
+	bx, by := lx+330, y+76
+	text.Draw(dst, "Bonus", g.face, bx, by, uiAccent)
+	for _, b := range []struct {
+		label  string
+		points int
+	}{
+		{"Clear", bonus.Clear},
+		{"Accuracy", bonus.Accuracy},
+		{"Time", bonus.Time},
+		{"Health", bonus.Health},
+		{"Total", bonus.Total()},
+	} {
+		by += 20
+		text.Draw(dst, b.label, g.face, bx, by, white)
+		text.Draw(dst, fmt.Sprintf("%6d", b.points), g.face, bx+110, by, yellow)
+	}
+
+	ly += 32
+	text.Draw(dst, fmt.Sprintf("Defeated this run: %d", g.world.Defeated), g.face, lx, ly, white)
+	ly += 22
+	text.Draw(dst, fmt.Sprintf("Score: %d", g.world.Player.Score), g.face, lx, ly, yellow)
+	ly += 22
+	text.Draw(dst, fmt.Sprintf("Seed: %d", g.world.Seed), g.face, lx, ly, gray)
 
 	ly += 26
-	if g.level < g.totalLevels {
-		text.Draw(dst, fmt.Sprintf("Up next: Level %d / %d", g.level+1, g.totalLevels), g.face, lx, ly, white)
+	if g.world.Level < g.world.TotalLevels {
+		text.Draw(dst, fmt.Sprintf("Up next: Level %d / %d", g.world.Level+1, g.world.TotalLevels), g.face, lx, ly, white)
 		ly += 22
 		text.Draw(dst, "Press Enter to begin the next level", g.face, lx, ly, yellow)
 	} else {
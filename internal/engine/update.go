@@ -2,9 +2,13 @@ package engine
 
 import (
 	"fmt"
+	"image"
 	"log"
 	"math"
 
+	"doomlike/ent/run"
+	"doomlike/internal/sim"
+
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/hajimehoshi/ebiten/v2/inpututil"
 )
@@ -12,8 +16,8 @@ import (
 func (g *Game) Layout(outW, outH int) (int, int) {
 	if outW > 0 && outH > 0 {
 		// Use uniform scaling to maintain aspect ratio
-		scaleX := float64(outW) / float64(renderW)
-		scaleY := float64(outH) / float64(renderH)
+		scaleX := float64(outW) / float64(g.renderW)
+		scaleY := float64(outH) / float64(g.renderH)
 		// Use the smaller scale to fit the entire image
 		if scaleX < scaleY {
 			g.scaleX = scaleX
@@ -32,10 +36,20 @@ func (g *Game) Update() error {
 		return ebiten.Termination
 	}
 
+	g.updateGamepads()
+
 	// Global Esc behavior
-	if inpututil.IsKeyJustPressed(ebiten.KeyEscape) {
+	if g.escapePressed() {
 		switch g.state {
 		case statePlaying:
+			if g.demoPlay != nil {
+				g.resetToMainMenu()
+				return nil
+			}
+			if g.playtest {
+				g.endPlaytest("")
+				return nil
+			}
 			g.state = stateInGameMenu
 			g.mouseGrabbed = false
 			ebiten.SetCursorMode(ebiten.CursorModeVisible)
@@ -51,6 +65,18 @@ func (g *Game) Update() error {
 			// Return to the previous state
 			g.state = g.previousState
 			g.menu.selectedSetting = 0
+			g.settingsReport = nil
+		case stateSaveLoad:
+			g.state = g.saveReturn
+		case stateHighScores, stateStatistics:
+			g.state = stateMainMenu
+		case stateEditor:
+			if g.editor.editingPath {
+				g.editor.editingPath = false
+				return nil
+			}
+			g.resetToMainMenu()
+			return nil
 		}
 	}
 
@@ -67,16 +93,42 @@ func (g *Game) Update() error {
 		g.updateOptionsMenu()
 		return nil
 
+	case stateSaveLoad:
+		g.updateSaveLoad()
+		return nil
+
+	case stateEditor:
+		g.updateEditor()
+		return nil
+
+	case stateHighScores:
+		g.updateHighScores()
+		return nil
+
+	case stateProfiles:
+		g.updateProfiles()
+		return nil
+
+	case stateControls:
+		g.updateControls()
+		return nil
+
+	case stateStatistics:
+		if g.menuConfirm() {
+			g.state = stateMainMenu
+		}
+		return nil
+
 	case stateStart:
 		// Choose total levels before starting
-		if inpututil.IsKeyJustPressed(ebiten.KeyUp) || inpututil.IsKeyJustPressed(ebiten.KeyRight) {
-			if g.totalLevels < MaxLevelCap {
-				g.totalLevels++
+		if g.menuUp() || g.menuRight() {
+			if g.world.TotalLevels < MaxLevelCap {
+				g.world.TotalLevels++
 			}
 		}
-		if inpututil.IsKeyJustPressed(ebiten.KeyDown) || inpututil.IsKeyJustPressed(ebiten.KeyLeft) {
-			if g.totalLevels > 1 {
-				g.totalLevels--
+		if g.menuDown() || g.menuLeft() {
+			if g.world.TotalLevels > 1 {
+				g.world.TotalLevels--
 			}
 		}
 		// Digit keys quick-set
@@ -89,22 +141,17 @@ func (g *Game) Update() error {
 				if n > MaxLevelCap {
 					n = MaxLevelCap
 				}
-				g.totalLevels = n
+				g.world.TotalLevels = n
 			}
 		}
 		// Enter to begin
-		if inpututil.IsKeyJustPressed(ebiten.KeyEnter) || inpututil.IsKeyJustPressed(ebiten.KeyNumpadEnter) {
-			g.level = 1
-			g.setupLevel(g.level, true)
-			g.state = statePlaying
-			g.mouseGrabbed = true
-			ebiten.SetCursorMode(ebiten.CursorModeCaptured)
-			g.lastMouseX = 0
+		if g.menuConfirm() {
+			g.startRun(g.world.TotalLevels)
 		}
 		return nil
 
 	case stateMenu:
-		if inpututil.IsKeyJustPressed(ebiten.KeyEnter) || inpututil.IsKeyJustPressed(ebiten.KeyNumpadEnter) {
+		if g.menuConfirm() {
 			g.state = statePlaying
 			g.mouseGrabbed = true
 			ebiten.SetCursorMode(ebiten.CursorModeCaptured)
@@ -116,13 +163,14 @@ func (g *Game) Update() error {
 		return nil
 
 	case stateLevelClear:
-		if inpututil.IsKeyJustPressed(ebiten.KeyEnter) || inpututil.IsKeyJustPressed(ebiten.KeyNumpadEnter) {
-			g.level++
-			if g.level > g.totalLevels {
+		if g.menuConfirm() {
+			if g.world.Level+1 > g.world.TotalLevels {
 				g.state = stateWin
+				g.endRun(true)
 				return nil
 			}
-			g.setupLevel(g.level, false)
+			g.world.SetupLevel(g.world.Level+1, false)
+			g.effects = g.effects[:0]
 			g.state = statePlaying
 			g.mouseGrabbed = true
 			ebiten.SetCursorMode(ebiten.CursorModeCaptured)
@@ -131,210 +179,223 @@ func (g *Game) Update() error {
 		return nil
 
 	case stateGameOver:
-		if inpututil.IsKeyJustPressed(ebiten.KeyEnter) || inpututil.IsKeyJustPressed(ebiten.KeyNumpadEnter) {
+		if g.nameEntry != nil {
+			g.updateNameEntry()
+			return nil
+		}
+		if g.menuConfirm() {
 			g.reset()
 		}
 		return nil
 
 	case stateWin:
-		if inpututil.IsKeyJustPressed(ebiten.KeyEnter) || inpututil.IsKeyJustPressed(ebiten.KeyNumpadEnter) {
+		if g.nameEntry != nil {
+			g.updateNameEntry()
+			return nil
+		}
+		if g.menuConfirm() {
 			g.resetToMainMenu()
 		}
 		return nil
 
 	case statePlaying:
-		dt := 1.0 / 60.0
-		g.gameTime += dt
-
-		if g.p.cooldown > 0 {
-			g.p.cooldown -= dt
-			if g.p.cooldown < 0 {
-				g.p.cooldown = 0
-			}
-		}
-		if g.p.muzzleTime > 0 {
-			g.p.muzzleTime -= dt
-			if g.p.muzzleTime < 0 {
-				g.p.muzzleTime = 0
-			}
-		}
-
-		for _, e := range g.enemies {
-			if e.blink > 0 {
-				e.blink -= dt
-				if e.blink < 0 {
-					e.blink = 0
-				}
-			}
-			if !e.dead {
-				e.aiTime += dt
-			}
-		}
+		dt := sim.TickDT
 
-		g.updateProjectiles(dt)
-		g.updateGrumblingSounds()
-
-		if inpututil.IsKeyJustPressed(ebiten.KeyM) {
+		if g.actionJustPressed(actionToggleMap) || g.padJustPressed(padMap) {
 			g.minimap = !g.minimap
 		}
-
-		if !g.mouseGrabbed {
-			g.mouseGrabbed = true
-			ebiten.SetCursorMode(ebiten.CursorModeCaptured)
-		}
-
-		if g.mouseGrabbed {
-			x, _ := ebiten.CursorPosition()
-			if g.lastMouseX != 0 {
-				dx := x - g.lastMouseX
-				g.p.angle += float64(dx) * mouseSens
-				g.p.angle = normalizeAngle(g.p.angle)
-			}
-			g.lastMouseX = x
-		} else {
-			g.lastMouseX = 0
+		if inpututil.IsKeyJustPressed(ebiten.KeyF3) {
+			g.debugAI = !g.debugAI
 		}
 
-		if ebiten.IsKeyPressed(ebiten.KeyLeft) {
-			g.p.angle -= rotSpeed * dt
-		}
-		if ebiten.IsKeyPressed(ebiten.KeyRight) {
-			g.p.angle += rotSpeed * dt
+		if g.demoPlay != nil {
+			return g.updateDemoPlayback()
 		}
-		g.p.angle = normalizeAngle(g.p.angle)
 
-		forward, side := 0.0, 0.0
-		if ebiten.IsKeyPressed(ebiten.KeyW) {
-			forward++
-		}
-		if ebiten.IsKeyPressed(ebiten.KeyS) {
-			forward--
-		}
-		if ebiten.IsKeyPressed(ebiten.KeyA) {
-			side--
-		}
-		if ebiten.IsKeyPressed(ebiten.KeyD) {
-			side++
+		if s := g.simSettings(); s != g.world.Settings {
+			g.world.Settings = s
+			if g.demoRec != nil {
+				g.demoRec.Settings(s)
+			}
 		}
-		speed := moveSpeed
-		if ebiten.IsKeyPressed(ebiten.KeyShift) {
-			speed *= sprintMul
+		in := g.readPlayerInput(dt)
+		if g.demoRec != nil {
+			in = g.demoRec.Tick(in)
 		}
-		if forward != 0 || side != 0 {
-			l := math.Hypot(forward, side)
-			forward /= l
-			side /= l
-			fx := math.Cos(g.p.angle)
-			fy := math.Sin(g.p.angle)
-			rx := -fy
-			ry := fx
-			vx := (fx*forward + rx*side) * speed * dt
-			vy := (fy*forward + ry*side) * speed * dt
-			g.moveWithCollision(vx, vy)
-		}
-
-		if (ebiten.IsMouseButtonPressed(ebiten.MouseButtonLeft) || ebiten.IsKeyPressed(ebiten.KeySpace)) &&
-			g.p.cooldown <= 0 && g.p.ammo > 0 {
-			g.p.cooldown = g.settings.fireRate
-			g.p.muzzleTime = 0.06
-			g.p.ammo--
-			g.firePlayerShot()
-		}
-
-		for _, e := range g.enemies {
-			if e.dead {
-				continue
-			}
-			switch e.etype {
-			case eZombie:
-				g.seekEnemy(e, zombieSpeed, dt)
-				if dist2(e.pos.x, e.pos.y, g.p.pos.x, g.p.pos.y) < (0.25+0.25)*(0.25+0.25) {
-					g.p.hp -= int(touchDPS * dt)
-					if g.p.hp < 0 {
-						g.p.hp = 0
-					}
-				}
-			case eRunner:
-				g.seekEnemy(e, runnerSpeed, dt)
-				if dist2(e.pos.x, e.pos.y, g.p.pos.x, g.p.pos.y) < (0.25+0.25)*(0.25+0.25) {
-					g.p.hp -= int(touchDPS * dt)
-					if g.p.hp < 0 {
-						g.p.hp = 0
-					}
-				}
-			case eShooter:
-				g.shooterAI(e, dt)
-			}
+		outcome := g.world.Step(in)
+		if g.demoRec != nil {
+			g.demoRec.Checkpoint(g.world)
 		}
+		g.handleWorldEvents()
+		g.updateGrumblingSounds()
 
-		for _, pk := range g.pickups {
-			if pk.took {
-				continue
-			}
-			if dist2(pk.pos.x, pk.pos.y, g.p.pos.x, g.p.pos.y) < 0.5*0.5 {
-				switch pk.ptype {
-				case pickupMedkit:
-					if g.p.hp < playerMaxHP {
-						g.p.hp += medkitHeal
-						if g.p.hp > playerMaxHP {
-							g.p.hp = playerMaxHP
-						}
-						pk.took = true
-						g.playOneUpSound() // Play 1-up sound for health pickup
-						// Add pickup message
-						g.pickupMessages = append(g.pickupMessages, pickupMessage{
-							text:     fmt.Sprintf("+%d Health", medkitHeal),
-							color:    green,
-							timeLeft: pickupMessageDuration,
-						})
-					}
-				case pickupAmmo:
-					g.p.ammo += ammoPickupAmt
-					pk.took = true
-					g.playReloadSound() // Play reload sound for ammo pickup
-					// Add pickup message
-					g.pickupMessages = append(g.pickupMessages, pickupMessage{
-						text:     fmt.Sprintf("+%d Ammo", ammoPickupAmt),
-						color:    yellow,
-						timeLeft: pickupMessageDuration,
-					})
-				}
+		if g.playtest && outcome != sim.Running {
+			if outcome == sim.PlayerDied {
+				g.endPlaytest("Play-test over: you died")
+			} else {
+				g.endPlaytest("Play-test over: level cleared")
 			}
+			return nil
 		}
 
-		if g.p.hp <= 0 {
+		switch outcome {
+		case sim.PlayerDied:
+			g.saveDemo()
+			g.recordLevel(false)
+			g.endRunRecord(run.OutcomeDeath)
 			g.state = stateGameOver
+			g.endRun(false)
 			g.mouseGrabbed = false
 			ebiten.SetCursorMode(ebiten.CursorModeVisible)
 			return nil
-		}
-
-		allDead := true
-		for _, e := range g.enemies {
-			if !e.dead {
-				allDead = false
-				break
-			}
-		}
-		if allDead {
+		case sim.LevelCleared:
+			g.recordLevel(true)
 			g.advanceLevelOrWin()
 			return nil
 		}
 
-		// Update pickup messages
+		// Update pickup messages and impact effects
 		g.updatePickupMessages(dt)
+		g.updateEffects(dt)
 	}
 	return nil
 }
 
-func (g *Game) moveWithCollision(dx, dy float64) {
-	newX := g.p.pos.x + dx
-	newY := g.p.pos.y + dy
-	if !g.isSolid(int(math.Floor(newX)), int(math.Floor(g.p.pos.y))) {
-		g.p.pos.x = newX
+// readPlayerInput samples keyboard, mouse and gamepads into one tick of simulation input.
+func (g *Game) readPlayerInput(dt float64) sim.Input {
+	var in sim.Input
+
+	if !g.mouseGrabbed {
+		g.mouseGrabbed = true
+		ebiten.SetCursorMode(ebiten.CursorModeCaptured)
 	}
-	if !g.isSolid(int(math.Floor(g.p.pos.x)), int(math.Floor(newY))) {
-		g.p.pos.y = newY
+
+	if g.mouseGrabbed {
+		x, _ := ebiten.CursorPosition()
+		if g.lastMouseX != 0 {
+			dx := x - g.lastMouseX
+			turn := float64(dx) * g.settings.mouseSens
+			if g.settings.invertMouse {
+				turn = -turn
+			}
+			in.Turn += turn
+		}
+		g.lastMouseX = x
+	} else {
+		g.lastMouseX = 0
+	}
+
+	if g.actionPressed(actionTurnLeft) {
+		in.Turn -= g.settings.turnSpeed * dt
+	}
+	if g.actionPressed(actionTurnRight) {
+		in.Turn += g.settings.turnSpeed * dt
+	}
+
+	if g.actionPressed(actionMoveForward) {
+		in.Forward++
+	}
+	if g.actionPressed(actionMoveBackward) {
+		in.Forward--
+	}
+	if g.actionPressed(actionStrafeLeft) {
+		in.Strafe--
+	}
+	if g.actionPressed(actionStrafeRight) {
+		in.Strafe++
+	}
+	in.Sprint = g.actionPressed(actionSprint)
+	in.Fire = g.actionPressed(actionFire)
+	in.Use = g.actionJustPressed(actionUse)
+
+	for i := range sim.WeaponCount {
+		if g.actionJustPressed(actionWeapon1 + action(i)) {
+			in.SelectWeapon = i + 1
+		}
+	}
+	// Wheel down moves to the next weapon, like scrolling down a list.
+	if _, wy := ebiten.Wheel(); wy < 0 {
+		in.CycleWeapon = 1
+	} else if wy > 0 {
+		in.CycleWeapon = -1
+	}
+
+	g.readGamepad(&in, dt)
+	return in
+}
+
+// handleWorldEvents turns simulation side effects into sounds and HUD messages.
+func (g *Game) handleWorldEvents() {
+	for _, ev := range g.world.Events() {
+		switch ev.Kind {
+		case sim.EventShot:
+			g.playWeaponSound(sim.WeaponType(ev.Item))
+		case sim.EventExplosion:
+			g.playExplosionSound()
+			g.addEffect(effectExplosion, ev.Pos)
+		case sim.EventPuff:
+			g.addEffect(effectPuff, ev.Pos)
+		case sim.EventBlood:
+			g.addEffect(effectBlood, ev.Pos)
+		case sim.EventKill:
+			g.playCoinSound() // Play coin sound when enemy dies
+		case sim.EventWhiz:
+			g.playBulletWhizSound()
+		case sim.EventHeal:
+			g.playOneUpSound() // Play 1-up sound for health pickup
+			g.pickupMessages = append(g.pickupMessages, pickupMessage{
+				text:     fmt.Sprintf("+%d Health", ev.Amount),
+				color:    green,
+				timeLeft: pickupMessageDuration,
+			})
+		case sim.EventArmor:
+			g.playOneUpSound()
+			class := sim.ArmorClass(ev.Item)
+			g.pickupMessages = append(g.pickupMessages, pickupMessage{
+				text:     fmt.Sprintf("Picked up the %s armor (%d)", class, ev.Amount),
+				color:    armorColors[class],
+				timeLeft: pickupMessageDuration,
+			})
+		case sim.EventAmmo:
+			g.playReloadSound() // Play reload sound for ammo pickup
+			g.pickupMessages = append(g.pickupMessages, pickupMessage{
+				text:     fmt.Sprintf("+%d %s", ev.Amount, sim.AmmoType(ev.Item)),
+				color:    yellow,
+				timeLeft: pickupMessageDuration,
+			})
+		case sim.EventWeapon:
+			g.playReloadSound()
+			g.pickupMessages = append(g.pickupMessages, pickupMessage{
+				text:     fmt.Sprintf("You got the %s!", sim.WeaponType(ev.Item)),
+				color:    uiAccent,
+				timeLeft: pickupMessageDuration,
+			})
+		case sim.EventKey:
+			g.playOneUpSound()
+			key := sim.KeyColor(ev.Amount)
+			g.pickupMessages = append(g.pickupMessages, pickupMessage{
+				text:     fmt.Sprintf("Picked up the %s keycard", key),
+				color:    keyColors[key],
+				timeLeft: pickupMessageDuration,
+			})
+		case sim.EventDoor:
+			g.playDoorSound()
+		case sim.EventSecret:
+			g.playOneUpSound()
+			g.pickupMessages = append(g.pickupMessages, pickupMessage{
+				text:     "A secret is revealed!",
+				color:    magenta,
+				timeLeft: pickupMessageDuration,
+			})
+		case sim.EventLocked:
+			g.playLockedSound()
+			key := sim.KeyColor(ev.Amount)
+			g.pickupMessages = append(g.pickupMessages, pickupMessage{
+				text:     fmt.Sprintf("You need the %s keycard", key),
+				color:    keyColors[key],
+				timeLeft: pickupMessageDuration,
+			})
+		}
 	}
 }
 
@@ -350,20 +411,35 @@ func (g *Game) updatePickupMessages(dt float64) {
 	g.pickupMessages = nm
 }
 
+func (g *Game) addEffect(kind effectKind, pos sim.Vec2) {
+	g.effects = append(g.effects, impactEffect{kind: kind, pos: pos, timeLeft: effectDurations[kind]})
+}
+
+func (g *Game) updateEffects(dt float64) {
+	ne := g.effects[:0]
+	for _, fx := range g.effects {
+		fx.timeLeft -= dt
+		if fx.timeLeft > 0 {
+			ne = append(ne, fx)
+		}
+	}
+	g.effects = ne
+}
+
 func (g *Game) updateMainMenu() {
 	// Update mouse position
 	g.mouseX, g.mouseY = ebiten.CursorPosition()
 
-	// Navigate menu options with keyboard
-	if inpututil.IsKeyJustPressed(ebiten.KeyUp) {
+	// Navigate menu options with keyboard or D-pad
+	if g.menuUp() {
 		g.menu.selectedOption--
 		if g.menu.selectedOption < 0 {
-			g.menu.selectedOption = 2 // Wrap to last option
+			g.menu.selectedOption = len(mainMenuOptions) - 1 // Wrap to last option
 		}
 	}
-	if inpututil.IsKeyJustPressed(ebiten.KeyDown) {
+	if g.menuDown() {
 		g.menu.selectedOption++
-		if g.menu.selectedOption > 2 {
+		if g.menu.selectedOption >= len(mainMenuOptions) {
 			g.menu.selectedOption = 0 // Wrap to first option
 		}
 	}
@@ -378,8 +454,8 @@ func (g *Game) updateMainMenu() {
 		}
 	}
 
-	// Select option with Enter key
-	if inpututil.IsKeyJustPressed(ebiten.KeyEnter) {
+	// Select option with Enter or A
+	if g.menuConfirm() {
 		g.selectMainMenuOption()
 	}
 }
@@ -388,16 +464,16 @@ func (g *Game) updateInGameMenu() {
 	// Update mouse position
 	g.mouseX, g.mouseY = ebiten.CursorPosition()
 
-	// Navigate menu options with keyboard
-	if inpututil.IsKeyJustPressed(ebiten.KeyUp) {
+	// Navigate menu options with keyboard or D-pad
+	if g.menuUp() {
 		g.menu.selectedInGameOption--
 		if g.menu.selectedInGameOption < 0 {
-			g.menu.selectedInGameOption = 2 // Wrap to last option
+			g.menu.selectedInGameOption = len(inGameMenuOptions) - 1 // Wrap to last option
 		}
 	}
-	if inpututil.IsKeyJustPressed(ebiten.KeyDown) {
+	if g.menuDown() {
 		g.menu.selectedInGameOption++
-		if g.menu.selectedInGameOption > 2 {
+		if g.menu.selectedInGameOption >= len(inGameMenuOptions) {
 			g.menu.selectedInGameOption = 0 // Wrap to first option
 		}
 	}
@@ -412,8 +488,8 @@ func (g *Game) updateInGameMenu() {
 		}
 	}
 
-	// Select option with Enter key
-	if inpututil.IsKeyJustPressed(ebiten.KeyEnter) {
+	// Select option with Enter or A
+	if g.menuConfirm() {
 		g.selectInGameMenuOption()
 	}
 }
@@ -423,9 +499,9 @@ func (g *Game) updateOptionsMenu() {
 	g.mouseX, g.mouseY = ebiten.CursorPosition()
 
 	// Calculate max setting index based on context
-	maxSetting := 1 // Fire rate (0) + Bullet speed (1)
+	maxSetting := settingControls
 	if g.previousState == stateMainMenu {
-		maxSetting = 2 // Fire rate (0) + Bullet speed (1) + Level count (2)
+		maxSetting = settingSeed // Level count and seed only apply to a new run
 	}
 
 	// Ensure selected setting is valid for current context
@@ -434,13 +510,13 @@ func (g *Game) updateOptionsMenu() {
 	}
 
 	// Navigate settings
-	if inpututil.IsKeyJustPressed(ebiten.KeyUp) {
+	if g.menuUp() {
 		g.menu.selectedSetting--
 		if g.menu.selectedSetting < 0 {
 			g.menu.selectedSetting = maxSetting // Wrap to last setting
 		}
 	}
-	if inpututil.IsKeyJustPressed(ebiten.KeyDown) {
+	if g.menuDown() {
 		g.menu.selectedSetting++
 		if g.menu.selectedSetting > maxSetting {
 			g.menu.selectedSetting = 0 // Wrap to first setting
@@ -448,19 +524,19 @@ func (g *Game) updateOptionsMenu() {
 	}
 
 	// Handle mouse clicks on fire rate slider
-	if inpututil.IsMouseButtonJustPressed(ebiten.MouseButtonLeft) && g.menu.selectedSetting == 0 {
+	if inpututil.IsMouseButtonJustPressed(ebiten.MouseButtonLeft) && g.menu.selectedSetting == settingFireRate {
 		g.handleSliderClick()
 	}
 
 	// Adjust settings
-	if inpututil.IsKeyJustPressed(ebiten.KeyLeft) || inpututil.IsKeyJustPressed(ebiten.KeyRight) {
+	if left := g.menuLeft(); left || g.menuRight() {
 		delta := 1.0
-		if inpututil.IsKeyJustPressed(ebiten.KeyLeft) {
+		if left {
 			delta = -1.0
 		}
 
 		switch g.menu.selectedSetting {
-		case 0: // Fire Rate (reversed: left = faster, right = slower)
+		case settingFireRate: // reversed: left = faster, right = slower
 			g.settings.fireRate -= delta * 0.05
 			if g.settings.fireRate < minFireRate {
 				g.settings.fireRate = minFireRate
@@ -469,7 +545,7 @@ func (g *Game) updateOptionsMenu() {
 				g.settings.fireRate = maxFireRate
 			}
 			g.saveSettings()
-		case 1: // Bullet Speed
+		case settingBulletSpeed:
 			g.settings.bulletSpeed += delta * 2.0
 			if g.settings.bulletSpeed < minBulletSpeed {
 				g.settings.bulletSpeed = minBulletSpeed
@@ -478,7 +554,33 @@ func (g *Game) updateOptionsMenu() {
 				g.settings.bulletSpeed = maxBulletSpeed
 			}
 			g.saveSettings()
-		case 2: // Level Count (only available from main menu)
+		case settingMouseSens:
+			g.settings.mouseSens = min(max(g.settings.mouseSens+delta*0.00025, minMouseSens), maxMouseSens)
+			g.saveSettings()
+		case settingInvertMouse:
+			g.settings.invertMouse = !g.settings.invertMouse
+			g.saveSettings()
+		case settingTurnSpeed:
+			g.settings.turnSpeed = min(max(g.settings.turnSpeed+delta*0.2, minTurnSpeed), maxTurnSpeed)
+			g.saveSettings()
+		case settingFOV:
+			g.settings.fov = min(max(g.settings.fov+delta*5, minFOV), maxFOV)
+			g.saveSettings()
+		case settingRenderSize:
+			// Takes effect on the next frame, so the scene behind the menu previews it
+			size := stepRenderSize(g.settings.renderW, int(delta))
+			g.settings.renderW, g.settings.renderH = size.X, size.Y
+			g.saveSettings()
+		case settingPadTurnSpeed:
+			g.settings.padTurnSpeed = min(max(g.settings.padTurnSpeed+delta*0.25, minPadTurn), maxPadTurn)
+			g.saveSettings()
+		case settingPadDeadzone:
+			g.settings.padDeadzone = min(max(g.settings.padDeadzone+delta*0.05, minPadDeadzone), maxPadDeadzone)
+			g.saveSettings()
+		case settingPadCurve:
+			g.settings.padCurve = min(max(g.settings.padCurve+delta*0.1, minPadCurve), maxPadCurve)
+			g.saveSettings()
+		case settingLevelCount: // only available from main menu
 			if g.previousState == stateMainMenu {
 				g.settings.levelCount += int(delta)
 				if g.settings.levelCount < minLevelCount {
@@ -489,8 +591,80 @@ func (g *Game) updateOptionsMenu() {
 				}
 				g.saveSettings()
 			}
+		case settingSeed: // only available from main menu
+			if g.previousState == stateMainMenu {
+				g.settings.seed += int64(delta)
+				if g.settings.seed < 0 {
+					g.settings.seed = 0
+				}
+				if g.settings.seed > maxSeed {
+					g.settings.seed = maxSeed
+				}
+				g.saveSettings()
+			}
 		}
 	}
+
+	if g.menu.selectedSetting == settingSeed && g.previousState == stateMainMenu {
+		g.updateSeedEntry()
+	}
+
+	if g.menu.selectedSetting == settingControls && g.menuConfirm() {
+		g.openControls()
+		return
+	}
+
+	if inpututil.IsKeyJustPressed(ebiten.KeyE) {
+		g.exportSettingsTo(defaultSettingsPath())
+	}
+	if inpututil.IsKeyJustPressed(ebiten.KeyI) {
+		g.importSettingsFrom(defaultSettingsPath())
+	}
+}
+
+// updateSeedEntry lets the seed be typed in digit by digit so shared seeds can be entered directly
+func (g *Game) updateSeedEntry() {
+	changed := false
+	for key := ebiten.Key0; key <= ebiten.Key9; key++ {
+		if inpututil.IsKeyJustPressed(key) {
+			next := g.settings.seed*10 + int64(key-ebiten.Key0)
+			if next <= maxSeed {
+				g.settings.seed = next
+				changed = true
+			}
+		}
+	}
+	if inpututil.IsKeyJustPressed(ebiten.KeyBackspace) {
+		g.settings.seed /= 10
+		changed = true
+	}
+	if inpututil.IsKeyJustPressed(ebiten.KeyR) {
+		g.settings.seed = randomSeed()
+		changed = true
+	}
+	if changed {
+		g.saveSettings()
+	}
+}
+
+// stepRenderSize moves from the render width in use to the next preset in
+// renderSizes in direction dir, starting from the nearest one
+func stepRenderSize(width, dir int) image.Point {
+	nearest, best := 0, math.MaxInt
+	for i, s := range renderSizes {
+		d := s.X - width
+		if d < 0 {
+			d = -d
+		}
+		if d < best {
+			nearest, best = i, d
+		}
+	}
+	// A width between presets steps to the closest one first
+	if renderSizes[nearest].X == width {
+		nearest += dir
+	}
+	return renderSizes[min(max(nearest, 0), len(renderSizes)-1)]
 }
 
 // saveSettings saves the current settings to the database
@@ -504,15 +678,11 @@ func (g *Game) saveSettings() {
 
 // handleSliderClick handles mouse clicks on the fire rate slider
 func (g *Game) handleSliderClick() {
-	// Calculate slider bounds (matching the drawSlider function)
-	// These coordinates should match the slider position in drawOptionsMenu
-	sliderX := 18 + 18       // lx from drawOptionsMenu
-	sliderY := 40 + 50 + 20  // ly + 20 from drawOptionsMenu
-	sliderWidth := 400 - 200 // width - 200 from drawSlider
+	sliderX, sliderY, sliderWidth := g.fireRateTrack()
 
 	// Check if click is within slider bounds
 	if g.mouseX >= sliderX && g.mouseX <= sliderX+sliderWidth &&
-		g.mouseY >= sliderY && g.mouseY <= sliderY+8 {
+		g.mouseY >= sliderY && g.mouseY <= sliderY+sliderTrackH {
 
 		// Calculate new value based on click position (center-out)
 		clickPos := float64(g.mouseX - sliderX)
@@ -535,46 +705,31 @@ func (g *Game) handleSliderClick() {
 func (g *Game) selectMainMenuOption() {
 	switch g.menu.selectedOption {
 	case 0: // Start Game
-		g.totalLevels = g.settings.levelCount
-		g.level = 1
-		g.setupLevel(g.level, true)
-		g.state = statePlaying
-		g.mouseGrabbed = true
-		ebiten.SetCursorMode(ebiten.CursorModeCaptured)
-		g.lastMouseX = 0
-	case 1: // Options
+		g.startRun(g.settings.levelCount)
+	case 1: // Load Game
+		g.openSaveLoad(false)
+	case 2: // Play Demo
+		g.startDemoPlayback(g.demoPath)
+	case 3: // Map Editor
+		g.openEditor()
+	case 4: // High Scores
+		g.openHighScores()
+	case 5: // Statistics
+		g.openStatistics()
+	case 6: // Options
 		g.previousState = stateMainMenu
 		g.state = stateOptions
 		g.menu.selectedSetting = 0
-	case 2: // Quit
+	case 7: // Profile
+		g.openProfiles()
+	case 8: // Quit
 		g.shouldQuit = true
 	}
 }
 
 // getMainMenuOptionAt returns the menu option index at the given mouse coordinates, or -1 if none
 func (g *Game) getMainMenuOptionAt(mouseX, mouseY int) int {
-	// Menu dimensions and position (same as in drawMainMenu)
-	w, h := 400, 300
-	x := (ScreenW - w) / 2
-	y := (ScreenH - h) / 2
-
-	// Check if click is within menu bounds
-	if mouseX < x || mouseX > x+w || mouseY < y || mouseY > y+h {
-		return -1
-	}
-
-	// Calculate option positions
-	ly := y + 40 + 50 // Start after title
-
-	// Check each option (3 options, 30 pixels apart)
-	for i := 0; i < 3; i++ {
-		optionY := ly + i*30
-		if mouseY >= optionY-15 && mouseY <= optionY+15 {
-			return i
-		}
-	}
-
-	return -1
+	return menuOptionAt(mouseX, mouseY, mainMenuH, len(mainMenuOptions))
 }
 
 // selectInGameMenuOption handles the in-game menu option selection
@@ -585,37 +740,20 @@ func (g *Game) selectInGameMenuOption() {
 		g.mouseGrabbed = true
 		ebiten.SetCursorMode(ebiten.CursorModeCaptured)
 		g.lastMouseX = 0
-	case 1: // Options
+	case 1: // Save Game
+		g.openSaveLoad(true)
+	case 2: // Load Game
+		g.openSaveLoad(false)
+	case 3: // Options
 		g.previousState = stateInGameMenu
 		g.state = stateOptions
 		g.menu.selectedSetting = 0 // Reset to fire rate (valid for both contexts)
-	case 2: // Quit Game
+	case 4: // Quit Game
 		g.resetToMainMenu()
 	}
 }
 
 // getInGameMenuOptionAt returns the in-game menu option index at the given mouse coordinates, or -1 if none
 func (g *Game) getInGameMenuOptionAt(mouseX, mouseY int) int {
-	// Menu dimensions and position (same as in drawInGameMenu)
-	w, h := 400, 250
-	x := (ScreenW - w) / 2
-	y := (ScreenH - h) / 2
-
-	// Check if click is within menu bounds
-	if mouseX < x || mouseX > x+w || mouseY < y || mouseY > y+h {
-		return -1
-	}
-
-	// Calculate option positions
-	ly := y + 40 + 50 // Start after title
-
-	// Check each option (3 options, 30 pixels apart)
-	for i := 0; i < 3; i++ {
-		optionY := ly + i*30
-		if mouseY >= optionY-15 && mouseY <= optionY+15 {
-			return i
-		}
-	}
-
-	return -1
+	return menuOptionAt(mouseX, mouseY, inGameMenuH, len(inGameMenuOptions))
 }
@@ -2,29 +2,100 @@ package engine
 
 import (
 	"log"
-	"math"
 	"math/rand"
 	"time"
 
+	"doomlike/ent/run"
+	"doomlike/internal/sim"
+
 	"github.com/hajimehoshi/ebiten/v2"
 	"golang.org/x/image/font/basicfont"
 )
 
-func NewGame() *Game {
-	// Initialize database
-	db, err := NewDatabase()
+// Options carries construction-time overrides, usually parsed from command-line flags.
+type Options struct {
+	// Seed replaces the persisted run seed when SeedSet is true.
+	Seed    int64
+	SeedSet bool
+
+	// RecordDemo is where runs are recorded; empty uses the default path.
+	RecordDemo string
+	// PlayDemo starts playback of this demo file instead of the main menu.
+	PlayDemo string
+	// Campaign is a manifest of authored maps to play instead of generated levels.
+	Campaign string
+	// EditMap opens this map file in the level editor on startup.
+	EditMap string
+	// Enemies is an enemy definitions file that replaces or adds to the built-in monsters.
+	Enemies string
+	// Database is the database file, or MemoryDatabase; empty resolves it
+	// from $DOOMLIKE_DB or the user data directory.
+	Database string
+	// ImportSettings is a settings file loaded into the current profile on startup.
+	ImportSettings string
+	// ExportSettings is where the current profile's settings are written on startup.
+	ExportSettings string
+}
+
+func NewGame(opts Options) *Game {
+	db, dbNotice := openDatabase(opts.Database)
+	g := newGame(opts, db, dbNotice)
+
+	// Initialize audio
+	if err := g.initAudio(); err != nil {
+		log.Printf("Failed to initialize audio: %v", err)
+		// Continue without audio if initialization fails
+	}
+
+	if opts.PlayDemo != "" {
+		g.startDemoPlayback(opts.PlayDemo)
+	} else if opts.EditMap != "" {
+		g.openEditor()
+	} else if db != nil {
+		// Ask who is playing before the main menu
+		g.openProfiles()
+	}
+
+	return g
+}
+
+// openDatabase opens the database named on the command line. When it cannot
+// be opened for writing it tries the same file read-only, so the player keeps
+// their profiles and settings, and only then falls back to one kept in
+// memory. It returns the notice to show when it had to fall back.
+func openDatabase(name string) (*Database, string) {
+	dbPath := resolveDatabasePath(name)
+	db, err := NewDatabase(dbPath)
+	if err == nil {
+		log.Printf("Using database %s", dbPath)
+		return db, ""
+	}
+	log.Printf("Failed to initialize database %s: %v", dbPath, err)
+
+	if dbPath != MemoryDatabase {
+		db, err = NewReadOnlyDatabase(dbPath)
+		if err == nil {
+			log.Printf("Using database %s read-only", dbPath)
+			return db, "Database opened read-only - progress will not be saved"
+		}
+		log.Printf("Failed to open database %s read-only: %v", dbPath, err)
+	}
+
+	// Leave the file alone and play on defaults kept in memory
+	db, err = NewDatabase(MemoryDatabase)
 	if err != nil {
-		log.Printf("Failed to initialize database: %v", err)
+		log.Printf("Failed to initialize in-memory database: %v", err)
 		// Continue with default settings if database fails
-		db = nil
+		return nil, "Database unavailable - progress will not be kept"
 	}
+	return db, "Database unavailable - progress kept in memory until exit"
+}
 
+// newGame builds a game at the main menu on an open database, applying the
+// command-line overrides still in opts
+func newGame(opts Options, db *Database, dbNotice string) *Game {
 	// Load settings from database or use defaults
-	settings := gameSettings{
-		fireRate:    defaultFireRate,
-		bulletSpeed: defaultBulletSpeed,
-		levelCount:  defaultLevelCount,
-	}
+	settings := defaultGameSettings()
 
 	if db != nil {
 		if loadedSettings, err := db.LoadSettings(); err == nil {
@@ -34,11 +105,53 @@ func NewGame() *Game {
 		}
 	}
 
+	keys := loadBindings(db)
+
+	// An explicit -seed still wins over an imported one
+	if opts.ImportSettings != "" {
+		if s, k, notes, err := importSettings(opts.ImportSettings, settings, keys); err == nil {
+			settings, keys = s, k
+			log.Printf("Imported settings from %s", opts.ImportSettings)
+			for _, note := range notes {
+				log.Printf("Settings import: %s", note)
+			}
+			if db != nil {
+				if err := db.SaveSettings(&settings); err != nil {
+					log.Printf("Failed to save imported settings: %v", err)
+				}
+				if err := db.SaveBindings(keys.encode()); err != nil {
+					log.Printf("Failed to save imported key bindings: %v", err)
+				}
+			}
+		} else {
+			log.Printf("Failed to import settings: %v", err)
+		}
+	}
+
+	if opts.SeedSet {
+		// Same range the options screen allows, so a seed behaves alike either way
+		settings.seed = min(max(opts.Seed, 0), maxSeed)
+		if settings.seed != opts.Seed {
+			log.Printf("Seed %d is outside 0..%d; using %d", opts.Seed, int64(maxSeed), settings.seed)
+		}
+		if db != nil {
+			if err := db.SaveSettings(&settings); err != nil {
+				log.Printf("Failed to save seed from command line: %v", err)
+			}
+		}
+	}
+
+	if opts.ExportSettings != "" {
+		if err := exportSettings(opts.ExportSettings, settings, keys); err == nil {
+			log.Printf("Exported settings to %s", opts.ExportSettings)
+		} else {
+			log.Printf("Failed to export settings: %v", err)
+		}
+	}
+
 	g := &Game{
 		state:          stateMainMenu,
 		face:           basicfont.Face7x13,
-		level:          1,
-		totalLevels:    DefaultLevels,
 		minimap:        true,
 		pickupMessages: make([]pickupMessage, 0),
 		settings:       settings,
@@ -47,30 +160,76 @@ func NewGame() *Game {
 			selectedSetting:      0,
 			selectedInGameOption: 0,
 		},
-		db: db,
+		db:       db,
+		dbNotice: dbNotice,
+		bindings: keys,
+		opts:     opts,
+	}
+	g.demoPath = defaultDemoPath()
+	if opts.RecordDemo != "" {
+		g.demoPath = opts.RecordDemo
+	}
+	// Enemy definitions come first: map files place enemies by their chars
+	g.enemyDefs = sim.BuiltinEnemyDefs()
+	if opts.Enemies != "" {
+		if defs, err := sim.LoadEnemyDefs(opts.Enemies); err == nil {
+			g.enemyDefs = defs
+			log.Printf("Loaded %d enemy definitions from %s", len(defs), opts.Enemies)
+		} else {
+			// Continue with the built-in enemies if the file is invalid
+			log.Printf("Failed to load enemy definitions: %v", err)
+		}
+	}
+	if opts.Campaign != "" {
+		if c, err := sim.LoadCampaign(opts.Campaign, g.enemyDefs); err == nil {
+			g.campaign = c
+			log.Printf("Loaded campaign %q (%d levels) from %s", c.Name, c.Levels(), opts.Campaign)
+		} else {
+			// Continue with generated levels if the campaign is invalid
+			log.Printf("Failed to load campaign: %v", err)
+		}
 	}
-	g.fb = ebiten.NewImage(renderW, renderH)
+	g.world = sim.NewWorld(0, DefaultLevels, g.simSettings(), g.enemyDefs)
+	g.resizeRender()
 	g.pix = ebiten.NewImage(1, 1)
 	g.pix.Fill(white)
-	g.scaleX = float64(ScreenW) / float64(renderW)
-	g.scaleY = float64(ScreenH) / float64(renderH)
-	g.zbuf = make([]float64, renderW)
 	g.mouseGrabbed = false                         // start screen: mouse free
 	ebiten.SetCursorMode(ebiten.CursorModeVisible) // ensure cursor is visible in menus
 
 	g.initTextures()
 
-	// Initialize audio
-	if err := g.initAudio(); err != nil {
-		log.Printf("Failed to initialize audio: %v", err)
-		// Continue without audio if initialization fails
+	if db != nil {
+		if p, err := db.CurrentProfile(); err == nil {
+			g.profileName = p.name
+		} else {
+			log.Printf("Failed to load profile: %v", err)
+		}
 	}
 
 	return g
 }
 
+// resizeRender reallocates the framebuffer and zbuffer when the render
+// resolution setting no longer matches the ones in use
+func (g *Game) resizeRender() {
+	w, h := g.settings.renderW, g.settings.renderH
+	if g.fb != nil && w == g.renderW && h == g.renderH {
+		return
+	}
+	if g.fb != nil {
+		g.fb.Deallocate()
+	}
+	g.renderW, g.renderH = w, h
+	g.fb = ebiten.NewImage(w, h)
+	g.zbuf = make([]float64, w)
+	g.scaleX = float64(ScreenW) / float64(w)
+	g.scaleY = float64(ScreenH) / float64(h)
+}
+
 // Close cleans up resources when the game exits
 func (g *Game) Close() {
+	g.saveDemo()
+	g.endRunRecord(run.OutcomeQuit)
 	if g.db != nil {
 		if err := g.db.Close(); err != nil {
 			log.Printf("Failed to close database: %v", err)
@@ -78,120 +237,84 @@ func (g *Game) Close() {
 	}
 }
 
-// Piecewise scale: level 1 => 0.5x, middle => 1.0x, last => 3.0x
-func scaleForLevel(level, total int) float64 {
-	if total <= 1 {
-		return 1.0
-	}
-	mid := (total + 1) / 2 // middle index (1-based)
-	if level <= mid {
-		// 1..mid maps 0.5 -> 1.0
-		t := float64(level-1) / float64(mid-1)
-		if mid == 1 {
-			t = 1
-		}
-		return 0.5 + t*(1.0-0.5)
-	}
-	// mid..total maps 1.0 -> 3.0
-	t := float64(level-mid) / float64(total-mid)
-	if total == mid {
-		t = 1
-	}
-	return 1.0 + t*(3.0-1.0)
-}
+// maxSeed keeps seeds short enough to read off the HUD and type back in.
+const maxSeed = 999_999_999_999
 
-// Randomize within ±pct (e.g., pct=0.30 => ±30%)
-func jitter(val float64, pct float64, rng *rand.Rand) float64 {
-	delta := (rng.Float64()*2 - 1) * pct
-	return val * (1 + delta)
+// randomSeed returns a fresh, non-zero seed small enough to share by hand.
+func randomSeed() int64 {
+	return rand.New(rand.NewSource(time.Now().UnixNano())).Int63n(maxSeed) + 1
 }
 
-// clamp ints to >=1
-func maxInt(a, b int) int {
-	if a > b {
-		return a
+// chooseRunSeed picks the seed for a new run: the configured seed, or a random
+// one when the setting is 0.
+func (g *Game) chooseRunSeed() int64 {
+	if g.settings.seed != 0 {
+		return g.settings.seed
 	}
-	return b
+	return randomSeed()
 }
 
-// setupLevel now uses piecewise scaling + jitter for map dims, enemies, and food
-func (g *Game) setupLevel(level int, fresh bool) {
-	if level < 1 {
-		level = 1
-	}
-	if level > g.totalLevels {
-		level = g.totalLevels
+// simSettings converts the persisted settings into the simulation's view of them.
+func (g *Game) simSettings() sim.Settings {
+	return sim.Settings{
+		FireRate:    g.settings.fireRate,
+		BulletSpeed: g.settings.bulletSpeed,
 	}
+}
 
-	rng := rand.New(rand.NewSource(time.Now().UnixNano()))
-
-	// Map dimensions
-	scale := scaleForLevel(level, g.totalLevels)
-	targetW := jitter(float64(MaxMapW)*scale, 0.30, rng)
-	targetH := jitter(float64(MaxMapH)*scale, 0.30, rng)
-	w := maxInt(int(targetW+0.5), BaseMapW/2) // keep reasonable minimums
-	h := maxInt(int(targetH+0.5), BaseMapH/2)
-
-	// Enemy total (we'll split by type later)
-	targetEnemies := jitter(float64(BaseEnemyValue)*scale, 0.30, rng)
-	totalEnemies := maxInt(int(targetEnemies+0.5), 1)
-
-	// Food total (medkits + ammo)
-	targetFood := jitter(float64(BaseFoodValue)*scale, 0.30, rng)
-	totalFood := maxInt(int(targetFood+0.5), 1)
-
-	// Split enemies by type (Zombies 60%, Runners 25%, Shooters 15%)
-	ez := int(float64(totalEnemies) * 0.60)
-	er := int(float64(totalEnemies) * 0.25)
-	es := totalEnemies - ez - er
-	if ez < 0 {
-		ez = 0
-	}
-	if er < 0 {
-		er = 0
+// startRun generates level 1 of a new run and hands control to the player.
+func (g *Game) startRun(totalLevels int) {
+	// A campaign always gets to play every map it lists.
+	if n := g.campaign.Levels(); n > totalLevels {
+		totalLevels = n
 	}
-	if es < 0 {
-		es = 0
+	g.world = sim.NewWorld(g.chooseRunSeed(), totalLevels, g.simSettings(), g.enemyDefs)
+	g.world.Campaign = g.campaign
+	g.world.SetupLevel(1, true)
+	g.effects = g.effects[:0]
+	g.demoPlay = nil
+	header := sim.DemoHeader{
+		Seed:       g.world.Seed,
+		LevelCount: totalLevels,
+		Settings:   g.world.Settings,
+		Enemies:    g.enemyDefs.Hash(),
 	}
-
-	// Split food 50/50 into medkits and ammo
-	med := totalFood / 2
-	amm := totalFood - med
-
-	grid, spawn, enemies, pickups := generateMap(w, h, rng, ez, er, es, med, amm)
-
-	g.mapW, g.mapH = w, h
-	g.world = grid
-	g.enemies = enemies
-	g.pickups = pickups
-	g.levelEnemyTotal = len(enemies)
-
-	if fresh {
-		g.p = player{pos: spawn, angle: -math.Pi / 2, hp: playerStartHP, ammo: playerStartAmmo}
-		g.defeated = 0
-	} else {
-		g.p.pos = spawn
-		g.p.angle = -math.Pi / 2
+	if g.campaign != nil {
+		header.Campaign = g.campaign.Path
 	}
-
-	sx, sy := int(math.Floor(spawn.x)), int(math.Floor(spawn.y))
-	g.reachable = floodFillReachable(g.world, g.mapW, g.mapH, sx, sy)
+	g.demoRec = sim.NewDemoRecorder(header)
+	g.beginRun(false)
+	g.state = statePlaying
+	g.mouseGrabbed = true
+	ebiten.SetCursorMode(ebiten.CursorModeCaptured)
+	g.lastMouseX = 0
 }
 
 func (g *Game) reset() {
-	ng := NewGame()
+	// Command-line overrides were already applied and persisted on first start.
+	opts := g.opts
+	opts.SeedSet = false
+	opts.PlayDemo = ""
+	opts.EditMap = ""
+	opts.ImportSettings = ""
+	opts.ExportSettings = ""
+	// The database, audio and gamepads outlive the run; the player was
+	// already picked when the game started
+	ng := newGame(opts, g.db, g.dbNotice)
+	ng.gameAudio = g.gameAudio
+	ng.gamepads = g.gamepads
 	*g = *ng
 }
 
 func (g *Game) resetToMainMenu() {
+	g.saveDemo()
+	g.endRunRecord(run.OutcomeQuit)
+	g.demoPlay = nil
+
 	// Save current settings before reset
 	currentSettings := g.settings
 
 	// Reset game state
-	g.level = 1
-	g.p.score = 0
-	g.p.hp = 100
-	g.p.ammo = 30
 	g.state = stateMainMenu
 	g.mouseGrabbed = false
 	ebiten.SetCursorMode(ebiten.CursorModeVisible)
@@ -202,70 +325,35 @@ func (g *Game) resetToMainMenu() {
 	g.menu.selectedInGameOption = 0
 	g.shouldQuit = false
 
-	// Clear pickup messages
+	// Clear pickup messages and impact effects
 	g.pickupMessages = make([]pickupMessage, 0)
+	g.effects = g.effects[:0]
 
 	// Restore settings
 	g.settings = currentSettings
 
 	// Setup first level
-	g.setupLevel(g.level, true)
+	g.world = sim.NewWorld(g.world.Seed, g.world.TotalLevels, g.simSettings(), g.enemyDefs)
+	g.world.Campaign = g.campaign
+	g.world.SetupLevel(1, true)
 }
 
+// advanceLevelOrWin shows the intermission for the level just cleared; the
+// last level's intermission leads on to the win screen.
 func (g *Game) advanceLevelOrWin() {
-	if g.level >= g.totalLevels {
-		g.state = stateWin
-		g.mouseGrabbed = false
-		ebiten.SetCursorMode(ebiten.CursorModeVisible)
-		return
+	if g.world.Level >= g.world.TotalLevels {
+		g.saveDemo()
+		g.endRunRecord(run.OutcomeWin)
 	}
 	g.state = stateLevelClear
 	g.mouseGrabbed = false
 	ebiten.SetCursorMode(ebiten.CursorModeVisible)
 }
 
-func (g *Game) isSolid(ix, iy int) bool {
-	if ix < 0 || iy < 0 || ix >= g.mapW || iy >= g.mapH {
-		return true
-	}
-	return g.world[iy*g.mapW+ix] == tWall
-}
-
-func (g *Game) isSolidAtFloat(x, y float64) bool {
-	return g.isSolid(int(math.Floor(x)), int(math.Floor(y)))
-}
-
-func floodFillReachable(grid []int, w, h, sx, sy int) []bool {
-	reach := make([]bool, w*h)
-	if sx < 0 || sy < 0 || sx >= w || sy >= h {
-		return reach
-	}
-	if grid[sy*w+sx] == tWall {
-		return reach
-	}
-	qx := make([]int, 0, w*h/4)
-	qy := make([]int, 0, w*h/4)
-	push := func(x, y int) {
-		idx := y*w + x
-		if x < 0 || y < 0 || x >= w || y >= h {
-			return
-		}
-		if grid[idx] == tWall || reach[idx] {
-			return
-		}
-		reach[idx] = true
-		qx = append(qx, x)
-		qy = append(qy, y)
-	}
-	push(sx, sy)
-	head := 0
-	for head < len(qx) {
-		cx, cy := qx[head], qy[head]
-		head++
-		push(cx+1, cy)
-		push(cx-1, cy)
-		push(cx, cy+1)
-		push(cx, cy-1)
+// findCampaign returns the campaign at path, reusing the one already loaded when it matches.
+func (g *Game) findCampaign(path string) (*sim.Campaign, error) {
+	if g.campaign != nil && g.campaign.Path == path {
+		return g.campaign, nil
 	}
-	return reach
+	return sim.LoadCampaign(path, g.enemyDefs)
 }
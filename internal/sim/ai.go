@@ -0,0 +1,116 @@
+package sim
+
+import "math"
+
+const stepSize = 0.08
+
+// Move an enemy with circle-vs-grid collision using swept steps.
+func (w *World) moveEnemyCircle(e *Enemy, dx, dy, radius float64) {
+	steps := int(math.Ceil(math.Max(math.Abs(dx), math.Abs(dy)) / stepSize))
+	if steps < 1 {
+		steps = 1
+	}
+	sx := dx / float64(steps)
+	sy := dy / float64(steps)
+	for i := 0; i < steps; i++ {
+		nx := e.Pos.X + sx
+		if !w.circleHitsSolid(nx, e.Pos.Y, radius) {
+			e.Pos.X = nx
+		}
+		ny := e.Pos.Y + sy
+		if !w.circleHitsSolid(e.Pos.X, ny, radius) {
+			e.Pos.Y = ny
+		}
+	}
+}
+
+// Circle vs grid check by sampling around the circle.
+func (w *World) circleHitsSolid(cx, cy, r float64) bool {
+	if w.IsSolidAtFloat(cx-r, cy) {
+		return true
+	}
+	if w.IsSolidAtFloat(cx+r, cy) {
+		return true
+	}
+	if w.IsSolidAtFloat(cx, cy-r) {
+		return true
+	}
+	if w.IsSolidAtFloat(cx, cy+r) {
+		return true
+	}
+	return w.IsSolidAtFloat(cx, cy)
+}
+
+// seekEnemy walks a melee enemy toward the player along the navigation field,
+// or straight at the player when there is no way through.
+func (w *World) seekEnemy(e *Enemy, def *EnemyDef, dt float64) {
+	target, ok := w.navTarget(e.Pos, def.Radius)
+	if !ok {
+		target = w.Player.Pos
+	}
+	dx := target.X - e.Pos.X
+	dy := target.Y - e.Pos.Y
+	dist := math.Hypot(dx, dy)
+	if dist < 1e-6 {
+		return
+	}
+	vx := (dx / dist) * def.Speed * dt
+	vy := (dy / dist) * def.Speed * dt
+	e.Facing = math.Atan2(dy, dx)
+	w.moveEnemyCircle(e, vx, vy, def.Radius)
+}
+
+func (w *World) shooterAI(e *Enemy, def *EnemyDef, dt float64) {
+	r := def.Ranged
+	dx := w.Player.Pos.X - e.Pos.X
+	dy := w.Player.Pos.Y - e.Pos.Y
+	dist := math.Hypot(dx, dy)
+	dirx := dx / (dist + 1e-6)
+	diry := dy / (dist + 1e-6)
+	e.Facing = math.Atan2(dy, dx)
+
+	err := dist - r.KeepDistance
+	move := clampF(err*0.7, -1.5, 1.5)
+	tx := dirx*move - diry*r.Strafe
+	ty := diry*move + dirx*r.Strafe
+
+	w.moveEnemyCircle(e, tx*def.Speed*dt, ty*def.Speed*dt, def.Radius)
+
+	if e.AITime >= r.Cooldown && w.HasLineOfSight(e.Pos, w.Player.Pos) {
+		e.AITime = 0
+		v := Vec2{dirx * r.Speed, diry * r.Speed}
+		w.Bullets = append(w.Bullets, &Projectile{
+			Pos:        Vec2{e.Pos.X + dirx*0.3, e.Pos.Y + diry*0.3},
+			Vel:        v,
+			TTL:        r.TTL,
+			Friendly:   false,
+			Radius:     0.05,
+			Damage:     r.Damage,
+			CurveAngle: (w.rng.Float64() - 0.5) * 0.2, // Random curve between -0.1 and 0.1 radians
+			CurveRate:  0.3 + w.rng.Float64()*0.4,     // Curve rate between 0.3 and 0.7
+		})
+	}
+}
+
+// HasLineOfSight is a DDA line-of-sight test: only true if we reach the target cell before hitting a wall/closed door.
+func (w *World) HasLineOfSight(a, b Vec2) bool {
+	dx, dy := b.X-a.X, b.Y-a.Y
+	dist := math.Hypot(dx, dy)
+	if dist < 1e-6 {
+		return true
+	}
+	endX := int(math.Floor(b.X))
+	endY := int(math.Floor(b.Y))
+
+	r := NewGridRay(a, dx/dist, dy/dist)
+	for i := 0; i < 4096; i++ {
+		if r.X == endX && r.Y == endY {
+			return true
+		}
+		r.Next()
+		if w.IsSolid(r.X, r.Y) {
+			return false
+		}
+	}
+	return false
+}
@@ -0,0 +1,78 @@
+package sim
+
+// ArmorClass is the kind of armor the player wears, which decides how much
+// of every hit it soaks up.
+type ArmorClass int
+
+const (
+	ArmorNone  ArmorClass = iota
+	ArmorGreen            // absorbs a third of each hit
+	ArmorBlue             // absorbs half of each hit
+)
+
+var armorNames = []string{"none", "green", "blue"}
+
+func (c ArmorClass) String() string {
+	if c < 0 || int(c) >= len(armorNames) {
+		return "unknown"
+	}
+	return armorNames[c]
+}
+
+// armorDivisors is the share of each hit an armor class absorbs, as the
+// divisor Doom uses: the armor takes damage/divisor, rounded down.
+var armorDivisors = []int{
+	ArmorGreen: 3,
+	ArmorBlue:  2,
+}
+
+// armorPoints is how much armor a pickup of each class gives.
+var armorPoints = []int{
+	ArmorGreen: greenArmorPoints,
+	ArmorBlue:  blueArmorPoints,
+}
+
+// damagePlayer is the one way the player gets hurt. Worn armor takes its
+// share of the hit first and wears away, and the class is lost along with
+// the last point of armor; the rest comes off health.
+func (w *World) damagePlayer(dmg int) {
+	if dmg <= 0 {
+		return
+	}
+	p := &w.Player
+	if p.Armor > 0 && p.ArmorClass != ArmorNone {
+		saved := dmg / armorDivisors[p.ArmorClass]
+		if saved >= p.Armor {
+			saved = p.Armor
+			p.ArmorClass = ArmorNone
+		}
+		p.Armor -= saved
+		dmg -= saved
+	}
+	dmg = min(dmg, p.HP)
+	p.HP -= dmg
+	p.Hurt += dmg
+}
+
+// takeArmor puts on armor from a pickup. Like Doom, a pickup is only taken
+// when it would leave the player with more armor than they wear now, and it
+// replaces the worn armor outright rather than adding to it.
+func (w *World) takeArmor(class ArmorClass) bool {
+	p := &w.Player
+	points := armorPoints[class]
+	if p.Armor >= points {
+		return false
+	}
+	p.Armor, p.ArmorClass = points, class
+	return true
+}
+
+// levelArmor picks the armor pickups for a generated level: a green armor on
+// every level, and a blue one from blueArmorLevel on.
+func levelArmor(level int) []PickupType {
+	items := []PickupType{PickupArmorGreen}
+	if level >= blueArmorLevel {
+		items = append(items, PickupArmorBlue)
+	}
+	return items
+}
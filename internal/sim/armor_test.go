@@ -0,0 +1,68 @@
+package sim
+
+import "testing"
+
+func TestDamagePlayer(t *testing.T) {
+	tests := []struct {
+		name      string
+		hp, armor int
+		class     ArmorClass
+		dmg       int
+		wantHP    int
+		wantArmor int
+		wantClass ArmorClass
+	}{
+		{"no armor", 100, 0, ArmorNone, 30, 70, 0, ArmorNone},
+		{"green takes a third", 100, 100, ArmorGreen, 30, 80, 90, ArmorGreen},
+		{"blue takes half", 100, 200, ArmorBlue, 30, 85, 185, ArmorBlue},
+		{"third rounds down", 100, 100, ArmorGreen, 5, 96, 99, ArmorGreen},
+		{"last armor point lost", 100, 4, ArmorGreen, 30, 74, 0, ArmorNone},
+		{"class without points", 100, 0, ArmorBlue, 30, 70, 0, ArmorBlue},
+		{"hp stops at zero", 20, 0, ArmorNone, 50, 0, 0, ArmorNone},
+		{"no damage", 100, 100, ArmorGreen, 0, 100, 100, ArmorGreen},
+		{"negative damage", 100, 100, ArmorGreen, -10, 100, 100, ArmorGreen},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := &World{Player: Player{HP: tt.hp, Armor: tt.armor, ArmorClass: tt.class}}
+			w.damagePlayer(tt.dmg)
+			p := w.Player
+			if p.HP != tt.wantHP || p.Armor != tt.wantArmor || p.ArmorClass != tt.wantClass {
+				t.Errorf("hp %d armor %d %s, want hp %d armor %d %s",
+					p.HP, p.Armor, p.ArmorClass, tt.wantHP, tt.wantArmor, tt.wantClass)
+			}
+			if want := tt.hp - tt.wantHP; p.Hurt != want {
+				t.Errorf("hurt %d, want %d", p.Hurt, want)
+			}
+		})
+	}
+}
+
+func TestTakeArmor(t *testing.T) {
+	tests := []struct {
+		name      string
+		armor     int
+		class     ArmorClass
+		pickup    ArmorClass
+		taken     bool
+		wantArmor int
+		wantClass ArmorClass
+	}{
+		{"green when bare", 0, ArmorNone, ArmorGreen, true, greenArmorPoints, ArmorGreen},
+		{"blue over green", greenArmorPoints, ArmorGreen, ArmorBlue, true, blueArmorPoints, ArmorBlue},
+		{"green over full green", greenArmorPoints, ArmorGreen, ArmorGreen, false, greenArmorPoints, ArmorGreen},
+		{"green over worn blue", 50, ArmorBlue, ArmorGreen, true, greenArmorPoints, ArmorGreen},
+		{"green over strong blue", 150, ArmorBlue, ArmorGreen, false, 150, ArmorBlue},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := &World{Player: Player{Armor: tt.armor, ArmorClass: tt.class}}
+			if got := w.takeArmor(tt.pickup); got != tt.taken {
+				t.Errorf("taken %v, want %v", got, tt.taken)
+			}
+			if p := w.Player; p.Armor != tt.wantArmor || p.ArmorClass != tt.wantClass {
+				t.Errorf("armor %d %s, want %d %s", p.Armor, p.ArmorClass, tt.wantArmor, tt.wantClass)
+			}
+		})
+	}
+}
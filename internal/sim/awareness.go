@@ -0,0 +1,188 @@
+package sim
+
+import "math"
+
+// AIState is where an enemy is in its awareness of the player.
+type AIState int
+
+const (
+	AIIdle   AIState = iota // standing still, watching where it faces
+	AIPatrol                // wandering between points near Home
+	AIAlert                 // noticed something, turning to face it
+	AIChase                 // hunting the player
+	AISearch                // lost the player, checking LastSeen
+)
+
+var aiStateNames = []string{"idle", "patrol", "alert", "chase", "search"}
+
+func (s AIState) String() string {
+	if s < 0 || int(s) >= len(aiStateNames) {
+		return "unknown"
+	}
+	return aiStateNames[s]
+}
+
+// initAwareness puts freshly placed enemies on watch: each faces a random
+// direction and either stands idle or patrols around where it was placed.
+func (w *World) initAwareness() {
+	for _, e := range w.Enemies {
+		e.Home, e.Waypoint = e.Pos, e.Pos
+		e.Facing = w.rng.Float64() * 2 * math.Pi
+		e.State = AIIdle
+		if w.rng.Float64() < patrolChance {
+			e.State = AIPatrol
+		}
+		e.StateTime = 0
+	}
+}
+
+func (e *Enemy) setState(s AIState) {
+	e.State = s
+	e.StateTime = 0
+}
+
+// canSee reports whether an enemy can see the player: within sight range,
+// inside its view cone when cone is set (unless the player is close enough
+// to be sensed anyway), and not behind a wall or closed door.
+func (w *World) canSee(e *Enemy, cone bool) bool {
+	dx, dy := w.Player.Pos.X-e.Pos.X, w.Player.Pos.Y-e.Pos.Y
+	d := math.Hypot(dx, dy)
+	if d > sightRange {
+		return false
+	}
+	if cone && d > senseRange && math.Abs(angleDiff(math.Atan2(dy, dx), e.Facing)) > viewCone/2 {
+		return false
+	}
+	return w.HasLineOfSight(e.Pos, w.Player.Pos)
+}
+
+// alert tells an enemy the player is at a known position. Enemies already
+// chasing just update where they last saw the player.
+func (w *World) alert(e *Enemy, at Vec2) {
+	e.LastSeen = at
+	if e.State != AIChase && e.State != AIAlert {
+		e.setState(AIAlert)
+	}
+}
+
+// makeNoise alerts every enemy within hearRange steps of the player. The
+// distance is measured along open paths, so walls and closed doors muffle it.
+func (w *World) makeNoise() {
+	w.updateNav()
+	for _, e := range w.Enemies {
+		if e.Dead || e.State == AIChase {
+			continue
+		}
+		ix, iy := int(math.Floor(e.Pos.X)), int(math.Floor(e.Pos.Y))
+		if ix < 0 || iy < 0 || ix >= w.W || iy >= w.H {
+			continue
+		}
+		if d := w.nav.dist[iy*w.W+ix]; d >= 0 && d <= hearRange {
+			w.alert(e, w.Player.Pos)
+		}
+	}
+}
+
+// updateAwareness advances an enemy's state machine by one tick. While
+// chasing, StateTime counts the seconds since the player was last seen.
+func (w *World) updateAwareness(e *Enemy, dt float64) {
+	e.StateTime += dt
+	switch e.State {
+	case AIIdle, AIPatrol, AISearch:
+		if w.canSee(e, true) {
+			w.alert(e, w.Player.Pos)
+			return
+		}
+		if e.State == AISearch && e.StateTime >= searchTime {
+			e.setState(AIPatrol)
+			e.Waypoint = e.Pos
+		}
+	case AIAlert:
+		e.Facing = turnToward(e.Facing, math.Atan2(e.LastSeen.Y-e.Pos.Y, e.LastSeen.X-e.Pos.X), turnRate*dt)
+		if e.StateTime >= alertDelay {
+			e.setState(AIChase)
+		}
+	case AIChase:
+		if w.canSee(e, false) {
+			e.LastSeen = w.Player.Pos
+			e.StateTime = 0
+		} else if e.StateTime >= chaseMemory {
+			e.setState(AISearch)
+		}
+	}
+}
+
+// roam moves an enemy that is not chasing: patrollers walk between points
+// near home and pause at each, searchers walk to where they last saw the
+// player and look around, and the rest stand still.
+func (w *World) roam(e *Enemy, def *EnemyDef, dt float64) {
+	switch e.State {
+	case AIPatrol:
+		if math.Hypot(e.Waypoint.X-e.Pos.X, e.Waypoint.Y-e.Pos.Y) > waypointReach {
+			if w.walkToward(e, e.Waypoint, def.Speed*patrolSpeedMul, def.Radius, dt) {
+				e.StateTime = 0 // time the pause from arrival
+				return
+			}
+			e.Waypoint = e.Pos // blocked, give up on this point
+		}
+		if e.StateTime >= patrolPause {
+			e.Waypoint = w.pickWaypoint(e, def.Radius)
+			e.StateTime = 0
+		}
+	case AISearch:
+		if math.Hypot(e.LastSeen.X-e.Pos.X, e.LastSeen.Y-e.Pos.Y) > waypointReach &&
+			w.walkToward(e, e.LastSeen, def.Speed*searchSpeedMul, def.Radius, dt) {
+			return
+		}
+		e.LastSeen = e.Pos
+		e.Facing = normalizeAngle(e.Facing + lookAroundRate*dt)
+	}
+}
+
+// walkToward steps an enemy toward a point and faces it the way it walks.
+// It reports whether the enemy got anywhere.
+func (w *World) walkToward(e *Enemy, to Vec2, speed, radius, dt float64) bool {
+	dx, dy := to.X-e.Pos.X, to.Y-e.Pos.Y
+	d := math.Hypot(dx, dy)
+	if d < 1e-6 {
+		return false
+	}
+	step := math.Min(speed*dt, d)
+	before := e.Pos
+	w.moveEnemyCircle(e, dx/d*step, dy/d*step, radius)
+	e.Facing = math.Atan2(dy, dx)
+	moved := math.Hypot(e.Pos.X-before.X, e.Pos.Y-before.Y)
+	return moved > step*0.3
+}
+
+// pickWaypoint chooses a patrol point near home that the enemy can walk to
+// in a straight line, or its own position when none turns up.
+func (w *World) pickWaypoint(e *Enemy, radius float64) Vec2 {
+	for try := 0; try < waypointTries; try++ {
+		ang := w.rng.Float64() * 2 * math.Pi
+		r := 1 + w.rng.Float64()*(patrolRadius-1)
+		p := Vec2{e.Home.X + math.Cos(ang)*r, e.Home.Y + math.Sin(ang)*r}
+		if w.IsSolidAtFloat(p.X, p.Y) {
+			continue
+		}
+		if w.HasLineOfSight(e.Pos, p) && w.clearPath(e.Pos, p, radius) {
+			return p
+		}
+	}
+	return e.Pos
+}
+
+// angleDiff returns a-b folded into -pi..pi.
+func angleDiff(a, b float64) float64 {
+	d := normalizeAngle(a - b)
+	if d > math.Pi {
+		d -= 2 * math.Pi
+	}
+	return d
+}
+
+// turnToward rotates from toward to by at most maxStep radians.
+func turnToward(from, to, maxStep float64) float64 {
+	d := angleDiff(to, from)
+	return normalizeAngle(from + clampF(d, -maxStep, maxStep))
+}
@@ -0,0 +1,72 @@
+package sim
+
+import "math"
+
+// Barrel is an explosive barrel. Running out of hit points lights a short
+// fuse rather than blowing it up on the spot, so a chain reaction ripples
+// through a cluster of barrels instead of landing all on one tick.
+type Barrel struct {
+	Pos      Vec2
+	HP       int
+	Fuse     float64 // seconds left before a lit barrel explodes
+	Lit      bool
+	Exploded bool
+}
+
+// NewBarrel returns an unharmed barrel standing at a point.
+func NewBarrel(at Vec2) Barrel {
+	return Barrel{Pos: at, HP: barrelHP}
+}
+
+// updateBarrels burns down lit fuses and sets off the barrels whose fuse
+// has run out.
+func (w *World) updateBarrels(dt float64) {
+	for _, b := range w.Barrels {
+		if !b.Lit || b.Exploded {
+			continue
+		}
+		b.Fuse -= dt
+		if b.Fuse <= 0 {
+			b.Exploded = true
+			w.explode(b.Pos, barrelSplash, barrelDamage)
+		}
+	}
+}
+
+// hurtBarrel applies damage from any source and lights the fuse once the
+// barrel has taken enough.
+func (w *World) hurtBarrel(b *Barrel, damage int) {
+	if b.Lit {
+		return
+	}
+	b.HP -= damage
+	if b.HP <= 0 {
+		b.Lit = true
+		b.Fuse = barrelFuse
+	}
+}
+
+// barrelAt returns the standing barrel a circle of the given radius at p
+// touches, or nil.
+func (w *World) barrelAt(p Vec2, radius float64) *Barrel {
+	for _, b := range w.Barrels {
+		if b.Exploded {
+			continue
+		}
+		if reach := barrelRadius + radius; dist2(p.X, p.Y, b.Pos.X, b.Pos.Y) < reach*reach {
+			return b
+		}
+	}
+	return nil
+}
+
+// barrelBlocks reports whether a barrel stops the player stepping from one
+// point to another. Steps away from a barrel the player already overlaps
+// are allowed, so they can never be wedged in place.
+func (w *World) barrelBlocks(from, to Vec2) bool {
+	b := w.barrelAt(to, playerRadius)
+	if b == nil {
+		return false
+	}
+	return math.Hypot(to.X-b.Pos.X, to.Y-b.Pos.Y) < math.Hypot(from.X-b.Pos.X, from.Y-b.Pos.Y)
+}
@@ -0,0 +1,99 @@
+package sim
+
+import "math"
+
+const (
+	// TickDT is the fixed simulation step in seconds.
+	TickDT = 1.0 / 60.0
+
+	// Map-size reference points (the algorithm scales from these)
+	BaseMapW = 48
+	BaseMapH = 36
+	MaxMapW  = 96
+	MaxMapH  = 72
+
+	// Generation controls
+	MaxRooms        = 32
+	RoomMinSize     = 4
+	RoomMaxSize     = 10
+	MinRoomSpacing  = 1
+	SpawnSafeRadius = 6.0
+
+	BaseEnemyValue = 14 // baseline used by scaling algorithm (middle level ≈ this)
+	BaseFoodValue  = 16 // baseline pickups count (med+ammo) at middle level
+
+	moveSpeed = 3.2
+	sprintMul = 1.8
+
+	PlayerMaxHP     = 100
+	playerStartHP   = 85
+	playerStartAmmo = 120
+	medkitHeal      = 25
+	ammoPickupAmt   = 32
+
+	greenArmorPoints = 100
+	blueArmorPoints  = 200
+	PlayerMaxArmor   = blueArmorPoints
+	blueArmorLevel   = 3 // first generated level with a blue armor
+
+	touchInterval = 0.5 // seconds between the hits of an enemy touching the player
+	dropSpread    = 0.3 // tiles a second or third drop lands from the corpse
+
+	comboWindow        = 2.5 // seconds after a kill in which the next one extends the combo
+	ComboMaxMultiplier = 4
+	levelClearBonus    = 500 // per level number, so later levels are worth more
+	accuracyBonus      = 1000
+	timeBonusRate      = 10 // points per second under par
+	healthBonus        = 500
+	hurtPenalty        = 5 // points off healthBonus per point of health lost
+
+	barrelHP     = 2
+	barrelRadius = 0.3
+	barrelFuse   = 0.15 // seconds between a barrel giving out and exploding
+	barrelSplash = 3.0  // radius of a barrel's explosion in tiles
+	barrelDamage = 6    // damage at the centre of a barrel's explosion
+
+	barrelClusterMax = 3 // most barrels generated standing together
+	barrelsPerEnemy  = 3 // generated levels get one barrel per this many enemies
+
+	playerShotTTL = 1.0
+
+	// Settings that weapon cooldowns and projectile speeds are given at
+	DefaultFireRate    = 0.275
+	DefaultBulletSpeed = 22.0
+
+	enemyHitPad    = 0.1  // how far outside an enemy's radius a bullet still hits
+	impactStandoff = 0.05 // tiles a wall puff is pulled back toward the shooter
+
+	playerRadius = 0.2  // how much room the player takes up in a doorway
+	useRange     = 1.5  // how far away a door can be used from
+	doorSpeed    = 1.6  // fraction of a door opened or closed per second
+	doorWait     = 3.0  // seconds a door stays open before closing
+	doorChance   = 0.25 // chance a generated room gets plain doors
+
+	secretTries   = 40  // attempts at digging a secret stash into a room wall
+	barrelTries   = 60  // attempts at finding room for a cluster of barrels
+	parWalkFactor = 3.0 // par allows this many times the straight walk to the exit
+	parPerEnemy   = 3.0 // seconds of par per enemy on the level
+	parRound      = 5.0 // par times are rounded up to this many seconds
+
+	navLookahead  = 6    // cells down the distance field checked when smoothing a path
+	navSampleStep = 0.25 // spacing of the collision samples along a smoothed path
+
+	sightRange     = 14.0            // tiles an enemy can see the player from
+	viewCone       = math.Pi * 2 / 3 // full width of an enemy's field of view
+	senseRange     = 1.5             // tiles within which the player is noticed from any side
+	hearRange      = 18              // path steps a gunshot carries
+	alertDelay     = 0.35            // seconds an alerted enemy takes to react
+	chaseMemory    = 3.0             // seconds a chase goes on after losing sight of the player
+	searchTime     = 8.0             // seconds spent searching before going back to patrol
+	turnRate       = 4.0             // radians per second an alerted enemy turns
+	lookAroundRate = 1.5             // radians per second a searching enemy looks around
+	patrolChance   = 0.5             // chance a placed enemy patrols rather than stands idle
+	patrolRadius   = 5.0             // tiles from home a patrol wanders
+	patrolPause    = 1.5             // seconds a patroller waits at each point
+	patrolSpeedMul = 0.45            // patrolling speed relative to chasing
+	searchSpeedMul = 0.75            // searching speed relative to chasing
+	waypointReach  = 0.3             // tiles from a point that count as arriving
+	waypointTries  = 8               // attempts at finding a patrol point per pause
+)
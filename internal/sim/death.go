@@ -0,0 +1,38 @@
+package sim
+
+import "math"
+
+// killEnemy is the one place an enemy dies, whatever killed it. The corpse
+// stays in Enemies so saves, stats and the front end still see it; the kill
+// is credited to the run and scored, and the enemy's drops are rolled.
+func (w *World) killEnemy(e *Enemy) {
+	def := w.EnemyDef(e.Type)
+	e.Dead = true
+	e.Dying = 0
+	w.Defeated++
+	points := w.scoreKill(def)
+	w.dropLoot(e, def)
+	w.emitItem(EventKill, points, int(e.Type))
+}
+
+// dropLoot rolls each of an enemy's drops and leaves the ones that come up
+// as pickups where it fell. Extra drops are fanned out around the corpse so
+// they do not stack, falling back to the corpse itself next to a wall.
+func (w *World) dropLoot(e *Enemy, def *EnemyDef) {
+	n := 0
+	for _, drop := range def.Drops {
+		if w.rng.Float64() >= drop.Chance {
+			continue
+		}
+		at := e.Pos
+		if n > 0 {
+			a := float64(n) * 2 * math.Pi / 3
+			p := Vec2{e.Pos.X + math.Cos(a)*dropSpread, e.Pos.Y + math.Sin(a)*dropSpread}
+			if !w.IsSolidAtFloat(p.X, p.Y) {
+				at = p
+			}
+		}
+		n++
+		w.Pickups = append(w.Pickups, &Pickup{Pos: at, Type: mapPickupTypes[drop.Item], Dropped: true})
+	}
+}
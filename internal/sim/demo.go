@@ -0,0 +1,440 @@
+package sim
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+)
+
+// Demo files record a run as its seed, settings and per-tick input stream, in
+// the spirit of Doom's .lmp files. Replaying the stream through Step must
+// reproduce the run exactly; periodic state hashes catch any desync.
+//
+// Layout (little endian): the header below, then tagged records until demoEnd.
+const (
+	demoMagic   = "DLMP"
+	DemoVersion = 14
+
+	// DemoHashInterval is how many ticks pass between recorded state hashes.
+	DemoHashInterval = 35
+
+	maxDemoCampaignPath = 4096
+)
+
+const (
+	demoTick     byte = 0x01 // buttons, forward, strafe (int8), turn (float32), weapon
+	demoSettings byte = 0x02 // fire rate, bullet speed (float64)
+	demoHash     byte = 0x03 // tick (uvarint), state hash (uint64)
+	demoEnd      byte = 0xFF
+)
+
+const (
+	demoButtonSprint byte = 1 << iota
+	demoButtonFire
+	demoButtonUse
+)
+
+// The weapon byte of a tick holds the selected slot in its low bits.
+const (
+	demoWeaponSlot byte = 0x0F
+	demoWeaponNext byte = 0x10
+	demoWeaponPrev byte = 0x20
+)
+
+// DemoHeader describes how the recorded run was started.
+type DemoHeader struct {
+	Seed       int64
+	LevelCount int
+	Settings   Settings
+	Campaign   string // campaign manifest path, empty for procedural runs
+	Enemies    uint64 // EnemyDefs.Hash of the definitions the run was played with
+}
+
+// QuantizeInput rounds an input to what a demo can store, so the recorder and
+// the live game feed the simulation identical values.
+func QuantizeInput(in Input) Input {
+	q := func(v float64) float64 {
+		return float64(int8(math.Round(clampF(v, -1, 1)*127))) / 127
+	}
+	in.Forward = q(in.Forward)
+	in.Strafe = q(in.Strafe)
+	in.Turn = float64(float32(in.Turn))
+	if in.SelectWeapon < 0 || in.SelectWeapon > int(demoWeaponSlot) {
+		in.SelectWeapon = 0
+	}
+	switch {
+	case in.CycleWeapon > 0:
+		in.CycleWeapon = 1
+	case in.CycleWeapon < 0:
+		in.CycleWeapon = -1
+	}
+	return in
+}
+
+// DemoRecorder accumulates a demo in memory while a run is played.
+type DemoRecorder struct {
+	buf   bytes.Buffer
+	ticks int
+}
+
+func NewDemoRecorder(h DemoHeader) *DemoRecorder {
+	r := &DemoRecorder{}
+	r.buf.WriteString(demoMagic)
+	r.buf.WriteByte(DemoVersion)
+	r.putU64(uint64(h.Seed))
+	r.putUvarint(uint64(h.LevelCount))
+	r.putF64(h.Settings.FireRate)
+	r.putF64(h.Settings.BulletSpeed)
+	r.putUvarint(uint64(len(h.Campaign)))
+	r.buf.WriteString(h.Campaign)
+	r.putU64(h.Enemies)
+	return r
+}
+
+// Tick quantizes and records one tick of input and returns the value that
+// must be passed to Step.
+func (r *DemoRecorder) Tick(in Input) Input {
+	in = QuantizeInput(in)
+	var buttons byte
+	if in.Sprint {
+		buttons |= demoButtonSprint
+	}
+	if in.Fire {
+		buttons |= demoButtonFire
+	}
+	if in.Use {
+		buttons |= demoButtonUse
+	}
+	r.buf.WriteByte(demoTick)
+	r.buf.WriteByte(buttons)
+	r.buf.WriteByte(byte(int8(math.Round(in.Forward * 127))))
+	r.buf.WriteByte(byte(int8(math.Round(in.Strafe * 127))))
+	var b [4]byte
+	binary.LittleEndian.PutUint32(b[:], math.Float32bits(float32(in.Turn)))
+	r.buf.Write(b[:])
+	weapon := byte(in.SelectWeapon) & demoWeaponSlot
+	switch {
+	case in.CycleWeapon > 0:
+		weapon |= demoWeaponNext
+	case in.CycleWeapon < 0:
+		weapon |= demoWeaponPrev
+	}
+	r.buf.WriteByte(weapon)
+	r.ticks++
+	return in
+}
+
+// Settings records a mid-run settings change; it applies from the next tick.
+func (r *DemoRecorder) Settings(s Settings) {
+	r.buf.WriteByte(demoSettings)
+	r.putF64(s.FireRate)
+	r.putF64(s.BulletSpeed)
+}
+
+// Checkpoint records the world hash every DemoHashInterval ticks. Call it
+// after each Step.
+func (r *DemoRecorder) Checkpoint(w *World) {
+	if r.ticks%DemoHashInterval != 0 {
+		return
+	}
+	r.buf.WriteByte(demoHash)
+	r.putUvarint(uint64(r.ticks))
+	r.putU64(w.Hash())
+}
+
+// Ticks returns the number of ticks recorded so far.
+func (r *DemoRecorder) Ticks() int { return r.ticks }
+
+// WriteFile terminates the demo and writes it to path, creating directories.
+func (r *DemoRecorder) WriteFile(path string) error {
+	data := append(bytes.Clone(r.buf.Bytes()), demoEnd)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to create demo directory: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write demo: %w", err)
+	}
+	return nil
+}
+
+func (r *DemoRecorder) putU64(v uint64) {
+	var b [8]byte
+	binary.LittleEndian.PutUint64(b[:], v)
+	r.buf.Write(b[:])
+}
+
+func (r *DemoRecorder) putF64(v float64) { r.putU64(math.Float64bits(v)) }
+
+func (r *DemoRecorder) putUvarint(v uint64) {
+	var b [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(b[:], v)
+	r.buf.Write(b[:n])
+}
+
+// ErrDesync is returned by DemoPlayer.Verify when the replayed world diverges
+// from the recording.
+var ErrDesync = errors.New("demo desynced")
+
+// DemoPlayer replays a recorded demo one tick at a time.
+type DemoPlayer struct {
+	Header DemoHeader
+
+	r      *bufio.Reader
+	ticks  int
+	hashes map[int]uint64
+	done   bool
+}
+
+// LoadDemo reads and validates a demo file header.
+func LoadDemo(path string) (*DemoPlayer, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read demo: %w", err)
+	}
+	return NewDemoPlayer(bytes.NewReader(data))
+}
+
+func NewDemoPlayer(src io.Reader) (*DemoPlayer, error) {
+	p := &DemoPlayer{r: bufio.NewReader(src), hashes: make(map[int]uint64)}
+	magic := make([]byte, len(demoMagic))
+	if _, err := io.ReadFull(p.r, magic); err != nil || string(magic) != demoMagic {
+		return nil, errors.New("not a demo file")
+	}
+	version, err := p.r.ReadByte()
+	if err != nil {
+		return nil, fmt.Errorf("truncated demo header: %w", err)
+	}
+	if version != DemoVersion {
+		return nil, fmt.Errorf("unsupported demo version %d (want %d)", version, DemoVersion)
+	}
+	seed, err := p.u64()
+	if err != nil {
+		return nil, fmt.Errorf("truncated demo header: %w", err)
+	}
+	levels, err := binary.ReadUvarint(p.r)
+	if err != nil {
+		return nil, fmt.Errorf("truncated demo header: %w", err)
+	}
+	fireRate, err := p.f64()
+	if err != nil {
+		return nil, fmt.Errorf("truncated demo header: %w", err)
+	}
+	bulletSpeed, err := p.f64()
+	if err != nil {
+		return nil, fmt.Errorf("truncated demo header: %w", err)
+	}
+	campaignLen, err := binary.ReadUvarint(p.r)
+	if err != nil {
+		return nil, fmt.Errorf("truncated demo header: %w", err)
+	}
+	if campaignLen > maxDemoCampaignPath {
+		return nil, fmt.Errorf("corrupt demo header: campaign path of %d bytes", campaignLen)
+	}
+	campaign := make([]byte, campaignLen)
+	if _, err := io.ReadFull(p.r, campaign); err != nil {
+		return nil, fmt.Errorf("truncated demo header: %w", err)
+	}
+	enemies, err := p.u64()
+	if err != nil {
+		return nil, fmt.Errorf("truncated demo header: %w", err)
+	}
+	p.Header = DemoHeader{
+		Seed:       int64(seed),
+		LevelCount: int(levels),
+		Settings:   Settings{FireRate: fireRate, BulletSpeed: bulletSpeed},
+		Campaign:   string(campaign),
+		Enemies:    enemies,
+	}
+	return p, nil
+}
+
+// Next returns the input for the next tick, applying any settings change that
+// precedes it to w. ok is false once the recording is exhausted.
+func (p *DemoPlayer) Next(w *World) (in Input, ok bool, err error) {
+	for !p.done {
+		tag, err := p.r.ReadByte()
+		if err != nil {
+			p.done = true
+			return Input{}, false, fmt.Errorf("truncated demo: %w", err)
+		}
+		switch tag {
+		case demoTick:
+			var b [8]byte
+			if _, err := io.ReadFull(p.r, b[:]); err != nil {
+				p.done = true
+				return Input{}, false, fmt.Errorf("truncated demo tick: %w", err)
+			}
+			in.Sprint = b[0]&demoButtonSprint != 0
+			in.Fire = b[0]&demoButtonFire != 0
+			in.Use = b[0]&demoButtonUse != 0
+			in.Forward = float64(int8(b[1])) / 127
+			in.Strafe = float64(int8(b[2])) / 127
+			in.Turn = float64(math.Float32frombits(binary.LittleEndian.Uint32(b[3:7])))
+			in.SelectWeapon = int(b[7] & demoWeaponSlot)
+			switch {
+			case b[7]&demoWeaponNext != 0:
+				in.CycleWeapon = 1
+			case b[7]&demoWeaponPrev != 0:
+				in.CycleWeapon = -1
+			}
+			p.ticks++
+			return in, true, nil
+		case demoSettings:
+			fireRate, err1 := p.f64()
+			bulletSpeed, err2 := p.f64()
+			if err := errors.Join(err1, err2); err != nil {
+				p.done = true
+				return Input{}, false, fmt.Errorf("truncated demo settings: %w", err)
+			}
+			w.Settings = Settings{FireRate: fireRate, BulletSpeed: bulletSpeed}
+		case demoHash:
+			if err := p.readHash(); err != nil {
+				return Input{}, false, err
+			}
+		case demoEnd:
+			p.done = true
+		default:
+			p.done = true
+			return Input{}, false, fmt.Errorf("corrupt demo: unknown record 0x%02x", tag)
+		}
+	}
+	return Input{}, false, nil
+}
+
+// Verify compares w against the hash recorded for the current tick, if any.
+// Call it after each Step.
+func (p *DemoPlayer) Verify(w *World) error {
+	if p.ticks%DemoHashInterval != 0 {
+		return nil
+	}
+	// The hash for a tick is written right after its input record.
+	if tag, err := p.r.Peek(1); err == nil && tag[0] == demoHash {
+		p.r.ReadByte()
+		if err := p.readHash(); err != nil {
+			return err
+		}
+	}
+	want, ok := p.hashes[p.ticks]
+	if !ok {
+		return nil
+	}
+	delete(p.hashes, p.ticks)
+	if got := w.Hash(); got != want {
+		return fmt.Errorf("%w at tick %d: state hash %016x, recorded %016x", ErrDesync, p.ticks, got, want)
+	}
+	return nil
+}
+
+// Ticks returns the number of ticks replayed so far.
+func (p *DemoPlayer) Ticks() int { return p.ticks }
+
+func (p *DemoPlayer) readHash() error {
+	tick, err1 := binary.ReadUvarint(p.r)
+	hash, err2 := p.u64()
+	if err := errors.Join(err1, err2); err != nil {
+		p.done = true
+		return fmt.Errorf("truncated demo hash: %w", err)
+	}
+	p.hashes[int(tick)] = hash
+	return nil
+}
+
+func (p *DemoPlayer) u64() (uint64, error) {
+	var b [8]byte
+	if _, err := io.ReadFull(p.r, b[:]); err != nil {
+		return 0, err
+	}
+	return binary.LittleEndian.Uint64(b[:]), nil
+}
+
+func (p *DemoPlayer) f64() (float64, error) {
+	v, err := p.u64()
+	return math.Float64frombits(v), err
+}
+
+// Hash summarizes the gameplay-relevant world state for desync detection.
+func (w *World) Hash() uint64 {
+	h := fnv.New64a()
+	var b [8]byte
+	put := func(v uint64) {
+		binary.LittleEndian.PutUint64(b[:], v)
+		h.Write(b[:])
+	}
+	f := func(v float64) { put(math.Float64bits(v)) }
+	i := func(v int) { put(uint64(v)) }
+	flag := func(v bool) {
+		if v {
+			put(1)
+		} else {
+			put(0)
+		}
+	}
+
+	i(w.Level)
+	i(w.Defeated)
+	f(w.LevelTime)
+	p := &w.Player
+	f(p.Pos.X)
+	f(p.Pos.Y)
+	f(p.Angle)
+	i(p.HP)
+	i(p.Armor)
+	i(int(p.ArmorClass))
+	i(p.Ammo)
+	i(p.Shells)
+	i(p.Rockets)
+	i(int(p.Weapon))
+	i(int(p.Weapons))
+	f(p.Cooldown)
+	i(int(p.Keys))
+	i(p.Score)
+	i(p.Combo)
+	f(p.ComboTime)
+	i(p.Shots)
+	i(p.Hits)
+	i(p.Hurt)
+	i(p.Dealt)
+	for _, e := range w.Enemies {
+		f(e.Pos.X)
+		f(e.Pos.Y)
+		i(e.HP)
+		flag(e.Dead)
+		f(e.AITime)
+		f(e.Bite)
+		i(int(e.State))
+		f(e.StateTime)
+		f(e.Facing)
+	}
+	i(len(w.Pickups))
+	for _, pk := range w.Pickups {
+		flag(pk.Taken)
+	}
+	for _, b := range w.Bullets {
+		f(b.Pos.X)
+		f(b.Pos.Y)
+		f(b.Vel.X)
+		f(b.Vel.Y)
+		f(b.TTL)
+	}
+	for _, d := range w.Doors {
+		i(int(d.State))
+		f(d.Open)
+	}
+	for _, s := range w.Secrets {
+		flag(s.Found)
+	}
+	for _, b := range w.Barrels {
+		i(b.HP)
+		f(b.Fuse)
+		flag(b.Lit)
+		flag(b.Exploded)
+	}
+	return h.Sum64()
+}
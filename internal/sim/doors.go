@@ -0,0 +1,170 @@
+package sim
+
+import "math"
+
+// KeyColor identifies a keycard; KeyNone marks a door anyone can open.
+type KeyColor int
+
+const (
+	KeyNone KeyColor = iota
+	KeyRed
+	KeyBlue
+	KeyYellow
+)
+
+// KeyColors lists the keycards in HUD order.
+var KeyColors = []KeyColor{KeyRed, KeyBlue, KeyYellow}
+
+func (k KeyColor) String() string {
+	switch k {
+	case KeyRed:
+		return "red"
+	case KeyBlue:
+		return "blue"
+	case KeyYellow:
+		return "yellow"
+	default:
+		return "none"
+	}
+}
+
+// HasKey reports whether the player holds a keycard; everyone holds KeyNone.
+func (p *Player) HasKey(k KeyColor) bool {
+	return k == KeyNone || p.Keys&(1<<k) != 0
+}
+
+type DoorState int
+
+const (
+	DoorClosed DoorState = iota
+	DoorOpening
+	DoorOpen
+	DoorClosing
+)
+
+// Door is a sliding door filling one TileDoor cell. The slab sits in the
+// middle of the cell and slides sideways into the wall as it opens.
+type Door struct {
+	X, Y  int
+	Key   KeyColor
+	State DoorState
+	Open  float64 // 0 closed .. 1 fully open
+	Wait  float64 // seconds left before an open door starts to close
+
+	// Horizontal doors run east-west between walls on their left and right,
+	// so they are passed north-south; the rest run north-south.
+	Horizontal bool
+}
+
+// DoorAt returns the door in a cell, or nil.
+func (w *World) DoorAt(ix, iy int) *Door {
+	if ix < 0 || iy < 0 || ix >= w.W || iy >= w.H {
+		return nil
+	}
+	return w.doorIdx[iy*w.W+ix]
+}
+
+// indexDoors rebuilds the cell lookup after Doors is replaced.
+func (w *World) indexDoors() {
+	w.doorIdx = make(map[int]*Door, len(w.Doors))
+	w.nav.stale = true // called whenever the level changes
+	for _, d := range w.Doors {
+		d.Horizontal = doorHorizontal(w.Grid, w.W, w.H, d.X, d.Y)
+		w.doorIdx[d.Y*w.W+d.X] = d
+	}
+}
+
+// doorHorizontal reports whether the door at x,y has walls to its left and right.
+func doorHorizontal(grid []int, w, h, x, y int) bool {
+	wall := func(x, y int) bool {
+		return x < 0 || y < 0 || x >= w || y >= h || grid[y*w+x] == TileWall
+	}
+	return wall(x-1, y) && wall(x+1, y)
+}
+
+// doorFramed reports whether a door cell sits between two walls with open
+// cells on either side of it, so it renders as a slab and can be walked through.
+func doorFramed(grid []int, w, h, x, y int) bool {
+	wall := func(x, y int) bool {
+		return x < 0 || y < 0 || x >= w || y >= h || grid[y*w+x] == TileWall
+	}
+	if wall(x-1, y) && wall(x+1, y) {
+		return !wall(x, y-1) && !wall(x, y+1)
+	}
+	return wall(x, y-1) && wall(x, y+1) && !wall(x-1, y) && !wall(x+1, y)
+}
+
+// updateDoors animates doors and closes them again once nothing stands in the way.
+func (w *World) updateDoors(dt float64) {
+	for _, d := range w.Doors {
+		switch d.State {
+		case DoorOpening:
+			d.Open += dt * doorSpeed
+			if d.Open >= 1 {
+				d.Open = 1
+				d.State = DoorOpen
+				d.Wait = doorWait
+				w.nav.stale = true
+			}
+		case DoorOpen:
+			d.Wait -= dt
+			if d.Wait > 0 {
+				break
+			}
+			if w.doorBlocked(d) {
+				d.Wait = doorWait / 4
+				break
+			}
+			d.State = DoorClosing
+			w.nav.stale = true
+		case DoorClosing:
+			d.Open -= dt * doorSpeed
+			if d.Open <= 0 {
+				d.Open = 0
+				d.State = DoorClosed
+			}
+		}
+	}
+}
+
+// doorBlocked reports whether the player or a live enemy overlaps the door's cell.
+func (w *World) doorBlocked(d *Door) bool {
+	overlaps := func(p Vec2, r float64) bool {
+		return p.X+r > float64(d.X) && p.X-r < float64(d.X+1) &&
+			p.Y+r > float64(d.Y) && p.Y-r < float64(d.Y+1)
+	}
+	if overlaps(w.Player.Pos, playerRadius) {
+		return true
+	}
+	for _, e := range w.Enemies {
+		if !e.Dead && overlaps(e.Pos, w.EnemyDef(e.Type).Radius) {
+			return true
+		}
+	}
+	return false
+}
+
+// useDoor opens the first door in front of the player within reach.
+func (w *World) useDoor() {
+	p := &w.Player
+	dirX, dirY := math.Cos(p.Angle), math.Sin(p.Angle)
+	for t := 0.1; t <= useRange; t += 0.1 {
+		ix := int(math.Floor(p.Pos.X + dirX*t))
+		iy := int(math.Floor(p.Pos.Y + dirY*t))
+		if d := w.DoorAt(ix, iy); d != nil {
+			if d.State == DoorOpening || d.State == DoorOpen {
+				return
+			}
+			if !p.HasKey(d.Key) {
+				w.emit(EventLocked, int(d.Key))
+				return
+			}
+			d.State = DoorOpening
+			w.emit(EventDoor, 1)
+			return
+		}
+		if w.IsSolid(ix, iy) {
+			return
+		}
+	}
+}
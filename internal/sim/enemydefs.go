@@ -0,0 +1,360 @@
+package sim
+
+import (
+	_ "embed"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"image/color"
+	"math"
+	"os"
+	"slices"
+	"strconv"
+)
+
+// Enemy types index the enemy registry. The built-in definitions come first,
+// in the order of enemies.json, so their numbers never change; definitions
+// added by a mod file follow them.
+type EnemyType int
+
+const (
+	EnemyZombie EnemyType = iota
+	EnemyRunner
+	EnemyShooter
+)
+
+// Behavior is the archetype that drives an enemy once it is chasing.
+type Behavior int
+
+const (
+	BehaviorSeeker  Behavior = iota // walks the navigation field and hurts on contact
+	BehaviorShooter                 // keeps its distance, strafes and fires
+)
+
+var behaviorNames = []string{"seeker", "shooter"}
+
+func (b Behavior) String() string {
+	if b < 0 || int(b) >= len(behaviorNames) {
+		return "unknown"
+	}
+	return behaviorNames[b]
+}
+
+func (b Behavior) MarshalText() ([]byte, error) { return []byte(b.String()), nil }
+
+func (b *Behavior) UnmarshalText(text []byte) error {
+	for i, name := range behaviorNames {
+		if string(text) == name {
+			*b = Behavior(i)
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown behavior %q", text)
+}
+
+// Color is an opaque RGB colour written as "#rrggbb".
+type Color color.RGBA
+
+func (c Color) MarshalText() ([]byte, error) {
+	return []byte(fmt.Sprintf("#%02x%02x%02x", c.R, c.G, c.B)), nil
+}
+
+func (c *Color) UnmarshalText(text []byte) error {
+	if len(text) != 7 || text[0] != '#' {
+		return fmt.Errorf("colour %q must look like #rrggbb", text)
+	}
+	v, err := strconv.ParseUint(string(text[1:]), 16, 32)
+	if err != nil {
+		return fmt.Errorf("colour %q must look like #rrggbb", text)
+	}
+	*c = Color{R: uint8(v >> 16), G: uint8(v >> 8), B: uint8(v), A: 255}
+	return nil
+}
+
+// RangedAttack is how a shooter fires at the player.
+type RangedAttack struct {
+	Cooldown     float64 `json:"cooldown"`      // seconds between shots
+	Speed        float64 `json:"speed"`         // bullet speed in tiles per second
+	Damage       int     `json:"damage"`        // HP taken from the player per hit
+	TTL          float64 `json:"ttl"`           // seconds a bullet flies
+	KeepDistance float64 `json:"keep_distance"` // tiles the shooter tries to stay from the player
+	Strafe       float64 `json:"strafe"`        // sideways speed relative to Speed
+}
+
+// Drop is a pickup an enemy may leave behind where it dies.
+type Drop struct {
+	Item   string  `json:"item"`   // pickup kind as named in map legends: ammo, medkit, shells...
+	Chance float64 `json:"chance"` // probability of the drop, 0 to 1
+}
+
+// EnemyDef describes one kind of enemy.
+type EnemyDef struct {
+	Name        string        `json:"name"`
+	Char        string        `json:"char"` // tile character that places it in map files
+	HP          int           `json:"hp"`
+	Speed       float64       `json:"speed"`        // tiles per second while chasing
+	Radius      float64       `json:"radius"`       // collision radius in tiles
+	TouchDamage float64       `json:"touch_damage"` // HP per second drained while touching the player
+	Behavior    Behavior      `json:"behavior"`
+	Ranged      *RangedAttack `json:"ranged,omitempty"`
+	Body        Color         `json:"body"`
+	Head        Color         `json:"head"`
+	Minimap     Color         `json:"minimap"`
+	SpawnWeight float64       `json:"spawn_weight"`      // share of the enemies on generated levels
+	Ambient     string        `json:"ambient,omitempty"` // looping sound played nearby: zombie, runner or shooter
+	Score       int           `json:"score"`             // points awarded for the kill
+	Drops       []Drop        `json:"drops,omitempty"`   // rolled independently when it dies
+}
+
+type enemyDefsFile struct {
+	Enemies []EnemyDef `json:"enemies"`
+}
+
+//go:embed enemies.json
+var builtinEnemyData []byte
+
+// EnemyDefs is an enemy registry, indexed by EnemyType. Each World carries
+// its own, so runs with different definitions never share one.
+type EnemyDefs []EnemyDef
+
+// builtinEnemyDefs is parsed once and never changed; BuiltinEnemyDefs hands
+// out copies.
+var builtinEnemyDefs = mustParseBuiltinEnemies()
+
+func mustParseBuiltinEnemies() EnemyDefs {
+	defs, err := ParseEnemyDefs("enemies.json", builtinEnemyData, nil)
+	if err != nil {
+		panic(err)
+	}
+	return defs
+}
+
+// BuiltinEnemyDefs returns the enemy definitions shipped with the game.
+func BuiltinEnemyDefs() EnemyDefs { return slices.Clone(builtinEnemyDefs) }
+
+// Def returns the definition of an enemy type. Unknown types fall back to the
+// first definition so a stale save cannot crash the game.
+func (defs EnemyDefs) Def(t EnemyType) *EnemyDef {
+	if t < 0 || int(t) >= len(defs) {
+		return &defs[0]
+	}
+	return &defs[t]
+}
+
+// Named looks an enemy type up by its definition name.
+func (defs EnemyDefs) Named(name string) (EnemyType, bool) {
+	for i := range defs {
+		if defs[i].Name == name {
+			return EnemyType(i), true
+		}
+	}
+	return 0, false
+}
+
+// Names lists the definition names, indexed by EnemyType.
+func (defs EnemyDefs) Names() []string {
+	names := make([]string, len(defs))
+	for i := range defs {
+		names[i] = defs[i].Name
+	}
+	return names
+}
+
+// UnmarshalJSON reads an enemy type by number, or by name as saves stored
+// it before snapshots listed their enemy types. Such names are looked up among
+// the built-in definitions.
+func (t *EnemyType) UnmarshalJSON(data []byte) error {
+	var n int
+	if err := json.Unmarshal(data, &n); err == nil {
+		*t = EnemyType(n)
+		return nil
+	}
+	var name string
+	if err := json.Unmarshal(data, &name); err != nil {
+		return fmt.Errorf("enemy type must be a number or a name: %s", data)
+	}
+	et, ok := builtinEnemyDefs.Named(name)
+	if !ok {
+		return fmt.Errorf("unknown enemy type %q", name)
+	}
+	*t = et
+	return nil
+}
+
+// LoadEnemyDefs reads an enemy definitions file over the built-in ones.
+// Definitions named like a built-in one replace it; the rest are added after
+// the built-ins.
+func LoadEnemyDefs(path string) (EnemyDefs, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read enemy definitions: %w", err)
+	}
+	return ParseEnemyDefs(path, data, builtinEnemyDefs)
+}
+
+// ParseEnemyDefs validates an enemy definitions file and merges it over base.
+// Every problem found is returned, joined.
+func ParseEnemyDefs(path string, data []byte, base EnemyDefs) (EnemyDefs, error) {
+	var f enemyDefsFile
+	if err := json.Unmarshal(data, &f); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	if len(f.Enemies) == 0 {
+		return nil, fmt.Errorf("%s: no enemies defined", path)
+	}
+
+	defs := slices.Clone(base)
+	index := make(map[string]int, len(defs))
+	for i, d := range defs {
+		index[d.Name] = i
+	}
+	var errs []error
+	seen := make(map[string]bool, len(f.Enemies))
+	for _, d := range f.Enemies {
+		if seen[d.Name] {
+			errs = append(errs, fmt.Errorf("%s: enemy %q is defined twice", path, d.Name))
+			continue
+		}
+		seen[d.Name] = true
+		if i, ok := index[d.Name]; ok {
+			defs[i] = d
+			continue
+		}
+		index[d.Name] = len(defs)
+		defs = append(defs, d)
+	}
+
+	chars := make(map[string]string, len(defs))
+	weight := 0.0
+	for _, d := range defs {
+		fail := func(format string, args ...any) {
+			errs = append(errs, fmt.Errorf("%s: enemy %q: %s", path, d.Name, fmt.Sprintf(format, args...)))
+		}
+		if d.Name == "" {
+			errs = append(errs, fmt.Errorf("%s: enemy without a name", path))
+			continue
+		}
+		if reservedMapKind(d.Name) {
+			fail("name is already used by a map tile kind")
+		}
+		switch {
+		case len(d.Char) != 1:
+			fail("char must be a single character")
+		case defaultLegend[d.Char[0]] != "":
+			fail("char %q is already used by %s tiles", d.Char, defaultLegend[d.Char[0]])
+		case chars[d.Char] != "":
+			fail("char %q is already used by %q", d.Char, chars[d.Char])
+		default:
+			chars[d.Char] = d.Name
+		}
+		if d.HP <= 0 {
+			fail("hp must be positive")
+		}
+		if d.Speed < 0 {
+			fail("speed must not be negative")
+		}
+		if d.Radius <= 0 || d.Radius >= 0.5 {
+			fail("radius must be between 0 and 0.5 so it fits through a corridor")
+		}
+		if d.TouchDamage < 0 {
+			fail("touch_damage must not be negative")
+		}
+		if d.SpawnWeight < 0 {
+			fail("spawn_weight must not be negative")
+		}
+		weight += d.SpawnWeight
+		if d.Score < 0 {
+			fail("score must not be negative")
+		}
+		for _, drop := range d.Drops {
+			t, ok := mapPickupTypes[drop.Item]
+			switch {
+			case !ok:
+				fail("unknown drop item %q", drop.Item)
+			case t.Key() != KeyNone:
+				fail("drop item %q is a key; keys are placed, not dropped", drop.Item)
+			}
+			if drop.Chance < 0 || drop.Chance > 1 {
+				fail("drop chance for %q must be between 0 and 1", drop.Item)
+			}
+		}
+		if d.Behavior == BehaviorShooter {
+			r := d.Ranged
+			switch {
+			case r == nil:
+				fail("shooter needs a ranged attack")
+			case r.Cooldown <= 0 || r.Speed <= 0 || r.TTL <= 0:
+				fail("ranged cooldown, speed and ttl must be positive")
+			case r.Damage < 0 || r.KeepDistance < 0:
+				fail("ranged damage and keep_distance must not be negative")
+			}
+		}
+	}
+	if weight <= 0 {
+		errs = append(errs, fmt.Errorf("%s: at least one enemy needs a spawn_weight", path))
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return defs, nil
+}
+
+// Hash fingerprints the gameplay side of the registry, so a demo can refuse
+// to play back against different definitions.
+func (defs EnemyDefs) Hash() uint64 {
+	h := fnv.New64a()
+	var b [8]byte
+	put := func(v uint64) {
+		binary.LittleEndian.PutUint64(b[:], v)
+		h.Write(b[:])
+	}
+	for _, d := range defs {
+		h.Write([]byte(d.Name))
+		put(uint64(d.HP))
+		put(math.Float64bits(d.Speed))
+		put(math.Float64bits(d.Radius))
+		put(math.Float64bits(d.TouchDamage))
+		put(uint64(d.Behavior))
+		put(math.Float64bits(d.SpawnWeight))
+		put(uint64(d.Score))
+		for _, drop := range d.Drops {
+			h.Write([]byte(drop.Item))
+			put(math.Float64bits(drop.Chance))
+		}
+		if r := d.Ranged; r != nil {
+			put(math.Float64bits(r.Cooldown))
+			put(math.Float64bits(r.Speed))
+			put(uint64(r.Damage))
+			put(math.Float64bits(r.TTL))
+			put(math.Float64bits(r.KeepDistance))
+			put(math.Float64bits(r.Strafe))
+		}
+	}
+	return h.Sum64()
+}
+
+// spawnCounts splits total enemies between the types by spawn weight. The
+// rounding remainder goes to the last type that spawns at all.
+func (defs EnemyDefs) spawnCounts(total int) []int {
+	counts := make([]int, len(defs))
+	sum := 0.0
+	last := 0
+	for i, d := range defs {
+		sum += d.SpawnWeight
+		if d.SpawnWeight > 0 {
+			last = i
+		}
+	}
+	left := total
+	for i, d := range defs {
+		if i == last {
+			continue
+		}
+		counts[i] = int(float64(total) * d.SpawnWeight / sum)
+		left -= counts[i]
+	}
+	counts[last] = max(left, 0)
+	return counts
+}
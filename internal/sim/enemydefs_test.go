@@ -0,0 +1,62 @@
+package sim
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// modDefs returns the built-in enemies followed by seekers with the given
+// names, each placed by the first letter of its name in upper case
+func modDefs(t *testing.T, names ...string) EnemyDefs {
+	t.Helper()
+	var entries []string
+	for _, name := range names {
+		entries = append(entries, fmt.Sprintf(`{"name": %q, "char": %q, "hp": 50, "speed": 1, "radius": 0.3,
+			"behavior": "seeker", "body": "#808080", "head": "#808080", "minimap": "#808080"}`,
+			name, strings.ToUpper(name[:1])))
+	}
+	data := `{"enemies": [` + strings.Join(entries, ",") + `]}`
+	defs, err := ParseEnemyDefs("mod.json", []byte(data), BuiltinEnemyDefs())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return defs
+}
+
+func TestWorldEnemyDefs(t *testing.T) {
+	settings := Settings{FireRate: DefaultFireRate, BulletSpeed: DefaultBulletSpeed}
+	modded := NewWorld(1, 1, settings, modDefs(t, "imp"))
+	builtin := NewWorld(1, 1, settings, BuiltinEnemyDefs())
+
+	imp, ok := modded.EnemyDefs.Named("imp")
+	if !ok {
+		t.Fatal("modded registry has no imp")
+	}
+	if _, ok := builtin.EnemyDefs.Named("imp"); ok {
+		t.Error("a mod loaded for one world reached another")
+	}
+	if got := builtin.EnemyDef(imp).Name; got != builtin.EnemyDefs[0].Name {
+		t.Errorf("unknown type resolves to %q, want the first definition %q", got, builtin.EnemyDefs[0].Name)
+	}
+	if modded.EnemyDefs.Hash() == builtin.EnemyDefs.Hash() {
+		t.Error("different registries hash the same")
+	}
+}
+
+func TestSnapshotEnemyTypes(t *testing.T) {
+	settings := Settings{FireRate: DefaultFireRate, BulletSpeed: DefaultBulletSpeed}
+	saved := modDefs(t, "imp")
+	w := NewWorld(1, 1, settings, saved)
+	imp, _ := saved.Named("imp")
+	w.Enemies = []*Enemy{{Pos: Vec2{2.5, 2.5}, Type: imp, HP: 50}, {Pos: Vec2{3.5, 2.5}, Type: EnemyRunner, HP: 1}}
+
+	// The imp has moved down the registry since the save was made
+	loaded := modDefs(t, "ogre", "imp")
+	restored := FromSnapshot(w.Snapshot(), loaded)
+	for i, want := range []string{"imp", "runner"} {
+		if got := restored.EnemyDef(restored.Enemies[i].Type).Name; got != want {
+			t.Errorf("enemy %d restored as %q, want %q", i, got, want)
+		}
+	}
+}
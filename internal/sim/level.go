@@ -0,0 +1,161 @@
+package sim
+
+import "math"
+
+// Completion is the rule that ends a level.
+type Completion int
+
+const (
+	CompleteExit  Completion = iota // step on the exit
+	CompleteKills                   // kill every enemy
+	CompleteBoth                    // kill every enemy, then step on the exit
+)
+
+var completionNames = []string{"exit", "kills", "both"}
+
+func (c Completion) String() string {
+	if c < 0 || int(c) >= len(completionNames) {
+		return "unknown"
+	}
+	return completionNames[c]
+}
+
+// NeedsExit reports whether the rule can only be met on an exit tile.
+func (c Completion) NeedsExit() bool { return c != CompleteKills }
+
+// parseCompletion returns the rule with the given name.
+func parseCompletion(s string) (Completion, bool) {
+	for i, name := range completionNames {
+		if s == name {
+			return Completion(i), true
+		}
+	}
+	return 0, false
+}
+
+// Secret is a hidden tile that counts toward the level's secrets once the
+// player steps on it.
+type Secret struct {
+	X, Y  int
+	Found bool
+}
+
+// LevelStats is the tally shown on the intermission screen.
+type LevelStats struct {
+	Kills, KillTotal     int
+	Items, ItemTotal     int
+	Secrets, SecretTotal int
+	Time, Par            float64
+	Collected            int // every pickup taken, keycards and drops included
+
+	// Kills and enemies on the level by type, indexed by EnemyType
+	TypeKills, TypeTotals []int
+}
+
+// Stats tallies the level being played. Keycards and enemy drops are not
+// counted as items.
+func (w *World) Stats() LevelStats {
+	s := LevelStats{
+		KillTotal:   w.LevelEnemyTotal,
+		SecretTotal: len(w.Secrets),
+		Time:        w.LevelTime,
+		Par:         w.Par,
+		TypeKills:   make([]int, len(w.EnemyDefs)),
+		TypeTotals:  make([]int, len(w.EnemyDefs)),
+	}
+	for _, e := range w.Enemies {
+		t := e.Type
+		if t < 0 || int(t) >= len(w.EnemyDefs) {
+			t = 0
+		}
+		s.TypeTotals[t]++
+		if e.Dead {
+			s.Kills++
+			s.TypeKills[t]++
+		}
+	}
+	for _, pk := range w.Pickups {
+		if pk.Taken {
+			s.Collected++
+		}
+		if pk.Type.Key() != KeyNone || pk.Dropped {
+			continue
+		}
+		s.ItemTotal++
+		if pk.Taken {
+			s.Items++
+		}
+	}
+	for _, sc := range w.Secrets {
+		if sc.Found {
+			s.Secrets++
+		}
+	}
+	return s
+}
+
+// Percent returns done out of total as a whole percentage; an empty tally
+// counts as complete.
+func Percent(done, total int) int {
+	if total <= 0 {
+		return 100
+	}
+	return done * 100 / total
+}
+
+// OnExit reports whether the player is standing on an exit tile.
+func (w *World) OnExit() bool {
+	ix, iy := int(math.Floor(w.Player.Pos.X)), int(math.Floor(w.Player.Pos.Y))
+	if ix < 0 || iy < 0 || ix >= w.W || iy >= w.H {
+		return false
+	}
+	return w.Grid[iy*w.W+ix] == TileExit
+}
+
+// EnemiesLeft counts the enemies still alive.
+func (w *World) EnemiesLeft() int {
+	n := 0
+	for _, e := range w.Enemies {
+		if !e.Dead {
+			n++
+		}
+	}
+	return n
+}
+
+// levelDone reports whether the level's completion rule has been met.
+func (w *World) levelDone() bool {
+	switch w.Rule {
+	case CompleteKills:
+		return w.EnemiesLeft() == 0
+	case CompleteBoth:
+		return w.OnExit() && w.EnemiesLeft() == 0
+	default:
+		return w.OnExit()
+	}
+}
+
+// findSecrets marks the secret under the player as found.
+func (w *World) findSecrets() {
+	ix, iy := int(math.Floor(w.Player.Pos.X)), int(math.Floor(w.Player.Pos.Y))
+	for _, s := range w.Secrets {
+		if !s.Found && s.X == ix && s.Y == iy {
+			s.Found = true
+			w.emit(EventSecret, 1)
+		}
+	}
+}
+
+// generatedPar estimates a par time for a generated level from the walk to
+// the exit and the number of enemies, rounded up to parRound seconds.
+func generatedPar(grid []int, w, h, sx, sy, enemies int) float64 {
+	dist := distanceField(grid, w, h, sx, sy, func(idx int) bool { return grid[idx] == TileWall })
+	far := 0
+	for idx, t := range grid {
+		if t == TileExit && dist[idx] > far {
+			far = dist[idx]
+		}
+	}
+	par := float64(far)/moveSpeed*parWalkFactor + float64(enemies)*parPerEnemy
+	return math.Ceil(par/parRound) * parRound
+}
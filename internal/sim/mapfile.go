@@ -0,0 +1,772 @@
+package sim
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strconv"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// Map is a hand-authored level, parsed and validated from a map file.
+type Map struct {
+	Path    string
+	Name    string
+	Par     float64 // par time in seconds, 0 when the file sets none
+	W, H    int
+	Grid    []int
+	Start   Vec2
+	Angle   float64
+	Enemies []Enemy
+	Pickups []Pickup
+	Doors   []Door
+	Secrets []Secret
+	Barrels []Barrel
+
+	// Complete is the level's completion rule; files that leave it out
+	// finish at the exit when they have one and on the last kill otherwise.
+	Complete Completion
+
+	// defs is the enemy registry the enemies' types index into
+	defs EnemyDefs
+}
+
+// mapFile is the on-disk JSON form of a Map. Tiles are rows of legend
+// characters; the file's legend extends or overrides defaultLegend.
+type mapFile struct {
+	Name     string            `json:"name"`
+	Par      float64           `json:"par"`
+	Facing   string            `json:"facing"`
+	Complete string            `json:"complete,omitempty"` // exit, kills or both
+	Legend   map[string]string `json:"legend,omitempty"`
+	Tiles    []string          `json:"tiles"`
+}
+
+// defaultLegend maps tile characters to what they place. Enemies are placed
+// by the char of their definition.
+var defaultLegend = map[byte]string{
+	'#': "wall",
+	' ': "wall",
+	'.': "floor",
+	'P': "start",
+	'H': "medkit",
+	'A': "ammo",
+	'D': "door",
+	'1': "red_door",
+	'2': "blue_door",
+	'3': "yellow_door",
+	'r': "red_key",
+	'b': "blue_key",
+	'y': "yellow_key",
+	's': "shells",
+	'o': "rockets",
+	'G': "shotgun",
+	'C': "chaingun",
+	'L': "rocket_launcher",
+	'g': "green_armor",
+	'u': "blue_armor",
+	'B': "barrel",
+	'X': "exit",
+	'$': "secret",
+}
+
+var facingAngles = map[string]float64{
+	"":      -math.Pi / 2,
+	"north": -math.Pi / 2,
+	"east":  0,
+	"south": math.Pi / 2,
+	"west":  math.Pi,
+}
+
+// MapError is a problem in a map file. Line and Col are 1-based and zero when
+// the problem has no single location.
+type MapError struct {
+	Path string
+	Line int
+	Col  int
+	Msg  string
+}
+
+func (e *MapError) Error() string {
+	if e.Line > 0 {
+		return fmt.Sprintf("%s:%d:%d: %s", e.Path, e.Line, e.Col, e.Msg)
+	}
+	return fmt.Sprintf("%s: %s", e.Path, e.Msg)
+}
+
+// maxMapErrors caps how many problems one file reports.
+const maxMapErrors = 20
+
+// LoadMap reads and validates a map file, placing enemies from defs.
+func LoadMap(path string, defs EnemyDefs) (*Map, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read map: %w", err)
+	}
+	return ParseMap(path, data, defs)
+}
+
+// ParseMap validates a map file's contents, placing enemies by the chars of
+// defs. Every problem found is returned, joined, as a *MapError pointing at
+// the offending line and column.
+func ParseMap(path string, data []byte, defs EnemyDefs) (*Map, error) {
+	var f mapFile
+	if err := json.Unmarshal(data, &f); err != nil {
+		me := &MapError{Path: path, Msg: err.Error()}
+		var syn *json.SyntaxError
+		var typ *json.UnmarshalTypeError
+		switch {
+		case errors.As(err, &syn):
+			me.Line, me.Col = lineCol(data, int(syn.Offset))
+		case errors.As(err, &typ):
+			me.Line, me.Col = lineCol(data, int(typ.Offset))
+		}
+		return nil, me
+	}
+
+	var errs []error
+	rowOffsets := tileRowOffsets(data)
+	fail := func(row, col int, format string, args ...any) {
+		me := &MapError{Path: path, Msg: fmt.Sprintf(format, args...)}
+		switch {
+		case row >= 0 && row < len(rowOffsets):
+			start := rowOffsets[row]
+			me.Line, me.Col = lineCol(data, start+rawOffset(data[start:], col))
+		case row >= 0:
+			me.Msg = fmt.Sprintf("tile row %d, column %d: %s", row+1, col+1, me.Msg)
+		}
+		errs = append(errs, me)
+	}
+
+	legend := make(map[byte]string, len(defaultLegend)+len(defs))
+	for ch, kind := range defaultLegend {
+		legend[ch] = kind
+	}
+	for _, d := range defs {
+		legend[d.Char[0]] = d.Name
+	}
+	keys := make([]string, 0, len(f.Legend))
+	for k := range f.Legend {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		kind := f.Legend[k]
+		if len(k) != 1 {
+			fail(-1, 0, "legend key %q must be a single character", k)
+			continue
+		}
+		if !validMapKind(kind, defs) {
+			fail(-1, 0, "legend %q: unknown kind %q", k, kind)
+			continue
+		}
+		legend[k[0]] = kind
+	}
+
+	angle, ok := facingAngles[f.Facing]
+	if !ok {
+		fail(-1, 0, "facing %q must be north, east, south or west", f.Facing)
+	}
+	if f.Par < 0 {
+		fail(-1, 0, "par time must not be negative")
+	}
+	complete, ok := parseCompletion(f.Complete)
+	if f.Complete != "" && !ok {
+		fail(-1, 0, "complete %q must be exit, kills or both", f.Complete)
+	}
+	if len(f.Tiles) == 0 {
+		fail(-1, 0, "map has no tiles")
+		return nil, errors.Join(errs...)
+	}
+
+	m := &Map{
+		Path:  path,
+		Name:  f.Name,
+		Par:   f.Par,
+		W:     len(f.Tiles[0]),
+		H:     len(f.Tiles),
+		Angle: angle,
+		defs:  defs,
+	}
+	m.Grid = make([]int, m.W*m.H)
+
+	type thing struct{ row, col int }
+	var things []thing
+	starts, exits := 0, 0
+	for y, row := range f.Tiles {
+		if len(row) != m.W {
+			fail(y, 0, "row is %d tiles wide, expected %d like the first row", len(row), m.W)
+			continue
+		}
+		for x := 0; x < len(row); x++ {
+			kind, ok := legend[row[x]]
+			if !ok {
+				fail(y, x, "unknown tile %q", row[x])
+				m.Grid[y*m.W+x] = TileWall
+				continue
+			}
+			if kind == "wall" {
+				m.Grid[y*m.W+x] = TileWall
+				continue
+			}
+			if key, ok := mapDoorKeys[kind]; ok {
+				m.Grid[y*m.W+x] = TileDoor
+				m.Doors = append(m.Doors, Door{X: x, Y: y, Key: key})
+			}
+			if x == 0 || y == 0 || x == m.W-1 || y == m.H-1 {
+				fail(y, x, "%s on the map edge; the border must be wall", kind)
+			}
+			pos := Vec2{float64(x) + 0.5, float64(y) + 0.5}
+			switch kind {
+			case "start":
+				starts++
+				if starts > 1 {
+					fail(y, x, "second player start")
+				}
+				m.Start = pos
+			case "floor", "door", "red_door", "blue_door", "yellow_door":
+				// nothing stands on the tile
+			case "medkit", "ammo", "red_key", "blue_key", "yellow_key",
+				"shells", "rockets", "shotgun", "chaingun", "rocket_launcher",
+				"green_armor", "blue_armor":
+				m.Pickups = append(m.Pickups, Pickup{Pos: pos, Type: mapPickupTypes[kind]})
+				things = append(things, thing{y, x})
+			case "exit":
+				m.Grid[y*m.W+x] = TileExit
+				exits++
+				things = append(things, thing{y, x})
+			case "secret":
+				m.Secrets = append(m.Secrets, Secret{X: x, Y: y})
+				things = append(things, thing{y, x})
+			case "barrel":
+				m.Barrels = append(m.Barrels, NewBarrel(pos))
+				things = append(things, thing{y, x})
+			default:
+				et, _ := defs.Named(kind)
+				m.Enemies = append(m.Enemies, Enemy{Pos: pos, Type: et, HP: defs.Def(et).HP})
+				things = append(things, thing{y, x})
+			}
+		}
+		if len(errs) >= maxMapErrors {
+			return nil, errors.Join(errs...)
+		}
+	}
+	if starts == 0 {
+		fail(-1, 0, "map has no player start")
+	}
+	switch {
+	case f.Complete == "" && exits == 0:
+		m.Complete = CompleteKills
+	case f.Complete == "":
+		m.Complete = CompleteExit
+	default:
+		m.Complete = complete
+		if complete.NeedsExit() && exits == 0 {
+			fail(-1, 0, "complete is %q but the map has no exit", f.Complete)
+		}
+	}
+	for _, d := range m.Doors {
+		if !doorFramed(m.Grid, m.W, m.H, d.X, d.Y) {
+			fail(d.Y, d.X, "door needs walls on two opposite sides and open tiles on the other two")
+		}
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	// Every locked door needs its key somewhere the player can get to first.
+	for _, key := range m.unreachableKeys() {
+		for _, d := range m.Doors {
+			if d.Key == key {
+				fail(d.Y, d.X, "the %s key cannot be reached without opening this door", key)
+				break
+			}
+		}
+	}
+
+	// Everything placed must be reachable, and so must every floor tile:
+	// sealed-off areas are almost always a mistake in the layout.
+	sx, sy := int(m.Start.X), int(m.Start.Y)
+	reach := floodFillReachable(m.Grid, m.W, m.H, sx, sy)
+	for _, t := range things {
+		if !reach[t.row*m.W+t.col] {
+			fail(t.row, t.col, "%q cannot be reached from the player start", f.Tiles[t.row][t.col])
+		}
+	}
+	for i, tile := range m.Grid {
+		if tile == TileWall || reach[i] {
+			continue
+		}
+		area := floodFillReachable(m.Grid, m.W, m.H, i%m.W, i/m.W)
+		size := 0
+		for j, in := range area {
+			if in {
+				reach[j] = true // report each sealed area once
+				size++
+			}
+		}
+		fail(i/m.W, i%m.W, "%d-tile area cannot be reached from the player start", size)
+		if len(errs) >= maxMapErrors {
+			break
+		}
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return m, nil
+}
+
+var mapPickupTypes = map[string]PickupType{
+	"medkit":     PickupMedkit,
+	"ammo":       PickupAmmo,
+	"red_key":    PickupKeyRed,
+	"blue_key":   PickupKeyBlue,
+	"yellow_key": PickupKeyYellow,
+
+	"shells":          PickupShells,
+	"rockets":         PickupRockets,
+	"shotgun":         PickupShotgun,
+	"chaingun":        PickupChaingun,
+	"rocket_launcher": PickupRocketLauncher,
+	"green_armor":     PickupArmorGreen,
+	"blue_armor":      PickupArmorBlue,
+}
+
+var mapDoorKeys = map[string]KeyColor{
+	"door":        KeyNone,
+	"red_door":    KeyRed,
+	"blue_door":   KeyBlue,
+	"yellow_door": KeyYellow,
+}
+
+func validMapKind(kind string, defs EnemyDefs) bool {
+	_, enemy := defs.Named(kind)
+	return enemy || reservedMapKind(kind)
+}
+
+// reservedMapKind reports whether kind is a map tile kind other than an
+// enemy, so no enemy definition can take its name.
+func reservedMapKind(kind string) bool {
+	switch kind {
+	case "wall", "floor", "start", "exit", "secret", "barrel":
+		return true
+	}
+	_, pickup := mapPickupTypes[kind]
+	_, door := mapDoorKeys[kind]
+	return pickup || door
+}
+
+// unreachableKeys plays the map through: starting with no keys, it collects
+// every key reachable behind doors it can open until nothing changes, and
+// returns the door colors whose key was never found.
+func (m *Map) unreachableKeys() []KeyColor {
+	var held Player
+	sx, sy := int(m.Start.X), int(m.Start.Y)
+	for {
+		reach := floodFill(m.Grid, m.W, m.H, sx, sy, func(idx int) bool {
+			if m.Grid[idx] == TileWall {
+				return true
+			}
+			for _, d := range m.Doors {
+				if d.Y*m.W+d.X == idx {
+					return !held.HasKey(d.Key)
+				}
+			}
+			return false
+		})
+		found := false
+		for _, pk := range m.Pickups {
+			k := pk.Type.Key()
+			if k != KeyNone && !held.HasKey(k) && reach[int(pk.Pos.Y)*m.W+int(pk.Pos.X)] {
+				held.Keys |= 1 << k
+				found = true
+			}
+		}
+		if !found {
+			break
+		}
+	}
+
+	var missing []KeyColor
+	for _, k := range KeyColors {
+		if held.HasKey(k) {
+			continue
+		}
+		for _, d := range m.Doors {
+			if d.Key == k {
+				missing = append(missing, k)
+				break
+			}
+		}
+	}
+	return missing
+}
+
+// tileRowOffsets finds where each "tiles" string starts in the raw file, so
+// grid positions can be reported as file lines and columns.
+func tileRowOffsets(data []byte) []int {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if t, err := dec.Token(); err != nil || t != json.Delim('{') {
+		return nil
+	}
+	for dec.More() {
+		key, err := dec.Token()
+		if err != nil {
+			return nil
+		}
+		if key != "tiles" {
+			var skip json.RawMessage
+			if err := dec.Decode(&skip); err != nil {
+				return nil
+			}
+			continue
+		}
+		if t, err := dec.Token(); err != nil || t != json.Delim('[') {
+			return nil
+		}
+		var offsets []int
+		for dec.More() {
+			start := int(dec.InputOffset())
+			if _, err := dec.Token(); err != nil {
+				return nil
+			}
+			for start < len(data) && data[start] != '"' {
+				start++
+			}
+			offsets = append(offsets, start+1)
+		}
+		return offsets
+	}
+	return nil
+}
+
+// rawOffset finds where the byte at index col of a decoded JSON string lies
+// in the raw text, given the raw text from just after the opening quote, so
+// escapes before it do not shift the reported column.
+func rawOffset(raw []byte, col int) int {
+	i := 0
+	for decoded := 0; decoded < col && i < len(raw); {
+		if raw[i] != '\\' {
+			i++
+			decoded++
+			continue
+		}
+		if i+1 >= len(raw) || raw[i+1] != 'u' {
+			i += 2
+			decoded++
+			continue
+		}
+		// \uXXXX decodes to a rune's UTF-8 bytes; a surrogate pair takes two
+		r, n := hexRune(raw[i:]), 6
+		if utf16.IsSurrogate(r) && i+n < len(raw) {
+			if low := hexRune(raw[i+n:]); low >= 0 {
+				r, n = utf16.DecodeRune(r, low), 12
+			}
+		}
+		size := utf8.RuneLen(r)
+		if size < 0 {
+			size = 3 // the decoder substitutes U+FFFD
+		}
+		i += n
+		decoded += size
+	}
+	return i
+}
+
+// hexRune reads a \uXXXX escape at the start of raw, or returns -1.
+func hexRune(raw []byte) rune {
+	if len(raw) < 6 || raw[0] != '\\' || raw[1] != 'u' {
+		return -1
+	}
+	v, err := strconv.ParseUint(string(raw[2:6]), 16, 16)
+	if err != nil {
+		return -1
+	}
+	return rune(v)
+}
+
+// lineCol converts a byte offset into a 1-based line and column.
+func lineCol(data []byte, offset int) (line, col int) {
+	if offset > len(data) {
+		offset = len(data)
+	}
+	line = 1 + bytes.Count(data[:offset], []byte("\n"))
+	col = offset - bytes.LastIndexByte(data[:offset], '\n')
+	return line, col
+}
+
+// Campaign is an ordered list of levels; levels it does not define are
+// generated procedurally.
+type Campaign struct {
+	Path string
+	Name string
+	Maps []*Map // indexed by level-1; nil entries are generated
+}
+
+type campaignFile struct {
+	Name   string   `json:"name"`
+	Levels []string `json:"levels"` // map paths relative to the manifest, "" to generate
+}
+
+// LoadCampaign reads a campaign manifest and every map it lists, placing
+// enemies from defs.
+func LoadCampaign(path string, defs EnemyDefs) (*Campaign, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read campaign: %w", err)
+	}
+	var f campaignFile
+	if err := json.Unmarshal(data, &f); err != nil {
+		return nil, fmt.Errorf("failed to parse campaign %s: %w", path, err)
+	}
+
+	c := &Campaign{Path: path, Name: f.Name, Maps: make([]*Map, len(f.Levels))}
+	var errs []error
+	dir := filepath.Dir(path)
+	for i, name := range f.Levels {
+		if name == "" {
+			continue
+		}
+		m, err := LoadMap(filepath.Join(dir, name), defs)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		c.Maps[i] = m
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return c, nil
+}
+
+// MapFor returns the authored map for a level, or nil when the level is
+// generated.
+func (c *Campaign) MapFor(level int) *Map {
+	if c == nil || level < 1 || level > len(c.Maps) {
+		return nil
+	}
+	return c.Maps[level-1]
+}
+
+// Levels returns how many levels the campaign lists.
+func (c *Campaign) Levels() int {
+	if c == nil {
+		return 0
+	}
+	return len(c.Maps)
+}
+
+// NewMap returns an empty walled room with the player start in the middle,
+// as a starting point for the editor. Enemies placed on it are from defs.
+func NewMap(w, h int, defs EnemyDefs) *Map {
+	m := &Map{W: w, H: h, Grid: make([]int, w*h), Angle: facingAngles["north"], Complete: CompleteKills, defs: defs}
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			if x == 0 || y == 0 || x == w-1 || y == h-1 {
+				m.Grid[y*w+x] = TileWall
+			}
+		}
+	}
+	m.Start = Vec2{float64(w/2) + 0.5, float64(h/2) + 0.5}
+	return m
+}
+
+// EnemyDef returns the definition of an enemy type in the map's registry.
+func (m *Map) EnemyDef(t EnemyType) *EnemyDef { return m.defs.Def(t) }
+
+// Clone returns a deep copy of the map.
+func (m *Map) Clone() *Map {
+	c := *m
+	c.Grid = append([]int(nil), m.Grid...)
+	c.Enemies = append([]Enemy(nil), m.Enemies...)
+	c.Pickups = append([]Pickup(nil), m.Pickups...)
+	c.Doors = append([]Door(nil), m.Doors...)
+	c.Secrets = append([]Secret(nil), m.Secrets...)
+	c.Barrels = append([]Barrel(nil), m.Barrels...)
+	return &c
+}
+
+// Resize changes the map to w x h tiles, keeping the top-left content. The
+// border is walled in again and anything left outside or on it is dropped.
+func (m *Map) Resize(w, h int) {
+	grid := make([]int, w*h)
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			switch {
+			case x == 0 || y == 0 || x == w-1 || y == h-1:
+				grid[y*w+x] = TileWall
+			case x < m.W-1 && y < m.H-1:
+				grid[y*w+x] = m.Grid[y*m.W+x]
+			}
+		}
+	}
+	m.W, m.H, m.Grid = w, h, grid
+
+	inside := func(p Vec2) bool {
+		return p.X > 1 && p.Y > 1 && p.X < float64(w-1) && p.Y < float64(h-1)
+	}
+	enemies := m.Enemies[:0]
+	for _, e := range m.Enemies {
+		if inside(e.Pos) {
+			enemies = append(enemies, e)
+		}
+	}
+	m.Enemies = enemies
+	pickups := m.Pickups[:0]
+	for _, pk := range m.Pickups {
+		if inside(pk.Pos) {
+			pickups = append(pickups, pk)
+		}
+	}
+	m.Pickups = pickups
+	barrels := m.Barrels[:0]
+	for _, b := range m.Barrels {
+		if inside(b.Pos) {
+			barrels = append(barrels, b)
+		}
+	}
+	m.Barrels = barrels
+	doors := m.Doors[:0]
+	for _, d := range m.Doors {
+		if d.X < w-1 && d.Y < h-1 {
+			doors = append(doors, d)
+		}
+	}
+	m.Doors = doors
+	secrets := m.Secrets[:0]
+	for _, s := range m.Secrets {
+		if s.X < w-1 && s.Y < h-1 {
+			secrets = append(secrets, s)
+		}
+	}
+	m.Secrets = secrets
+	if !inside(m.Start) {
+		m.Start = Vec2{float64(w/2) + 0.5, float64(h/2) + 0.5}
+		m.Grid[(h/2)*w+w/2] = TileEmpty
+		m.Enemies = slices.DeleteFunc(m.Enemies, func(e Enemy) bool { return e.Pos == m.Start })
+		m.Pickups = slices.DeleteFunc(m.Pickups, func(pk Pickup) bool { return pk.Pos == m.Start })
+		m.Barrels = slices.DeleteFunc(m.Barrels, func(b Barrel) bool { return b.Pos == m.Start })
+	}
+}
+
+// Encode renders the map in the map file format using the default legend.
+// A tile holds one character, so two things on the same tile, or a thing on
+// an exit or wall, are an error rather than one of them being dropped.
+func (m *Map) Encode() ([]byte, error) {
+	rows := make([][]byte, m.H)
+	for y := range rows {
+		rows[y] = make([]byte, m.W)
+		for x := range rows[y] {
+			switch m.Grid[y*m.W+x] {
+			case TileWall:
+				rows[y][x] = '#'
+			case TileExit:
+				rows[y][x] = 'X'
+			default:
+				rows[y][x] = '.'
+			}
+		}
+	}
+	var errs []error
+	put := func(p Vec2, ch byte) {
+		x, y := int(p.X), int(p.Y)
+		if x < 0 || y < 0 || x >= m.W || y >= m.H {
+			return
+		}
+		if prev := rows[y][x]; prev != '.' {
+			errs = append(errs, fmt.Errorf("tile %d,%d would hold both %q and %q", x, y, prev, ch))
+			return
+		}
+		rows[y][x] = ch
+	}
+	for _, e := range m.Enemies {
+		put(e.Pos, m.defs.Def(e.Type).Char[0])
+	}
+	for _, pk := range m.Pickups {
+		put(pk.Pos, mapPickupChars[pk.Type])
+	}
+	for _, d := range m.Doors {
+		put(Vec2{float64(d.X), float64(d.Y)}, mapDoorChars[d.Key])
+	}
+	for _, s := range m.Secrets {
+		put(Vec2{float64(s.X), float64(s.Y)}, '$')
+	}
+	for _, b := range m.Barrels {
+		put(b.Pos, 'B')
+	}
+	put(m.Start, 'P')
+	if len(errs) > 0 {
+		return nil, fmt.Errorf("failed to encode map: %w", errors.Join(errs...))
+	}
+
+	f := mapFile{Name: m.Name, Par: m.Par, Facing: facingName(m.Angle), Complete: m.Complete.String()}
+	for _, row := range rows {
+		f.Tiles = append(f.Tiles, string(row))
+	}
+	data, err := json.MarshalIndent(f, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode map: %w", err)
+	}
+	return append(data, '\n'), nil
+}
+
+// WriteFile encodes the map to path, creating directories.
+func (m *Map) WriteFile(path string) error {
+	data, err := m.Encode()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to create map directory: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write map: %w", err)
+	}
+	return nil
+}
+
+var mapPickupChars = map[PickupType]byte{
+	PickupMedkit:    'H',
+	PickupAmmo:      'A',
+	PickupKeyRed:    'r',
+	PickupKeyBlue:   'b',
+	PickupKeyYellow: 'y',
+
+	PickupShells:         's',
+	PickupRockets:        'o',
+	PickupShotgun:        'G',
+	PickupChaingun:       'C',
+	PickupRocketLauncher: 'L',
+	PickupArmorGreen:     'g',
+	PickupArmorBlue:      'u',
+}
+
+var mapDoorChars = map[KeyColor]byte{
+	KeyNone:   'D',
+	KeyRed:    '1',
+	KeyBlue:   '2',
+	KeyYellow: '3',
+}
+
+// facingName returns the compass direction closest to angle.
+func facingName(angle float64) string {
+	best, bestDiff := "north", math.Inf(1)
+	for _, name := range []string{"north", "east", "south", "west"} {
+		diff := normalizeAngle(angle - facingAngles[name])
+		if diff > math.Pi {
+			diff = 2*math.Pi - diff
+		}
+		if diff < bestDiff {
+			best, bestDiff = name, diff
+		}
+	}
+	return best
+}
@@ -0,0 +1,101 @@
+package sim
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// roundTripMap places one of everything a map file can hold
+const roundTripMap = `{
+  "name": "round trip",
+  "par": 45,
+  "facing": "west",
+  "complete": "both",
+  "tiles": [
+    "###########",
+    "#P.Z.H.A.r#",
+    "#.R.S.s.o.#",
+    "#G.C.L.g.u#",
+    "#.B.$.....#",
+    "####D######",
+    "#........X#",
+    "###########"
+  ]
+}`
+
+func TestMapRoundTrip(t *testing.T) {
+	defs := BuiltinEnemyDefs()
+	m, err := ParseMap("round.json", []byte(roundTripMap), defs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := m.Encode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	again, err := ParseMap("round.json", data, defs)
+	if err != nil {
+		t.Fatalf("encoded map does not parse: %v\n%s", err, data)
+	}
+	if !reflect.DeepEqual(again, m) {
+		t.Errorf("map changed through Encode and ParseMap:\n%s", data)
+	}
+}
+
+func TestMapEncodeCoincident(t *testing.T) {
+	m, err := ParseMap("round.json", []byte(roundTripMap), BuiltinEnemyDefs())
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name  string
+		place func(m *Map)
+	}{
+		{"pickup on enemy", func(m *Map) { m.Pickups = append(m.Pickups, Pickup{Pos: m.Enemies[0].Pos, Type: PickupKeyRed}) }},
+		{"barrel on pickup", func(m *Map) { m.Barrels = append(m.Barrels, NewBarrel(m.Pickups[0].Pos)) }},
+		{"enemy on start", func(m *Map) { m.Enemies = append(m.Enemies, Enemy{Pos: m.Start, Type: EnemyZombie}) }},
+		{"pickup on exit", func(m *Map) { m.Pickups = append(m.Pickups, Pickup{Pos: Vec2{9.5, 6.5}, Type: PickupMedkit}) }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := m.Clone()
+			tt.place(c)
+			if _, err := c.Encode(); err == nil {
+				t.Error("Encode dropped one of two things on a tile without an error")
+			}
+		})
+	}
+}
+
+func TestMapErrorColumn(t *testing.T) {
+	tests := []struct {
+		name string
+		row  string // the second tile row as written in the file; ? is the bad tile and / is floor
+	}{
+		{"plain", `#..?#`},
+		{"escaped floor", `#\u002e.?#`},
+		{"escaped wall and floors", `\u0023\u002e\u002e?#`},
+		{"short escape", `#\/.?#`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := `{"legend": {"/": "floor"}, "tiles": [
+"#####",
+"` + tt.row + `",
+"#.P.#",
+"#####"
+]}`
+			_, err := ParseMap("col.json", []byte(data), BuiltinEnemyDefs())
+			var me *MapError
+			if !errors.As(err, &me) {
+				t.Fatalf("got %v, want a *MapError", err)
+			}
+			want := strings.Index(`"`+tt.row, "?") + 1
+			if me.Line != 3 || me.Col != want {
+				t.Errorf("reported %d:%d, want 3:%d (%s)", me.Line, me.Col, want, me.Msg)
+			}
+		})
+	}
+}
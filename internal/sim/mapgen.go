@@ -0,0 +1,426 @@
+package sim
+
+import (
+	"math"
+	"math/rand"
+)
+
+type rect struct{ x, y, w, h int }
+
+func (r rect) center() (int, int) { return r.x + r.w/2, r.y + r.h/2 }
+func (r rect) intersects(o rect, padding int) bool {
+	return r.x < o.x+o.w+padding &&
+		r.x+r.w+padding > o.x &&
+		r.y < o.y+o.h+padding &&
+		r.y+r.h+padding > o.y
+}
+
+// generateMap builds a room/corridor map with an exit in the room farthest
+// from spawn, puts doors on some rooms (locking up to locks of them behind
+// keycards), digs a secret stash and scatters enemies/pickups based on inputs.
+// spawns holds how many enemies of each type to place, indexed by EnemyType;
+// items lists the weapon and ammo pickups.
+func generateMap(w, h int, rng *rand.Rand, defs EnemyDefs, spawns []int, medkits int, items []PickupType, locks, barrelCount int) (grid []int, spawn Vec2, enemies []*Enemy, pickups []*Pickup, doors []*Door, secrets []*Secret, barrels []*Barrel) {
+	grid = make([]int, w*h)
+	for i := range grid {
+		grid[i] = TileWall
+	}
+
+	rooms := make([]rect, 0, MaxRooms)
+
+	tryRooms := MaxRooms * 3
+	for i := 0; i < tryRooms && len(rooms) < MaxRooms; i++ {
+		rw := rng.Intn(RoomMaxSize-RoomMinSize+1) + RoomMinSize
+		rh := rng.Intn(RoomMaxSize-RoomMinSize+1) + RoomMinSize
+		rx := rng.Intn(w-rw-2) + 1
+		ry := rng.Intn(h-rh-2) + 1
+		rc := rect{rx, ry, rw, rh}
+		ok := true
+		for _, other := range rooms {
+			if rc.intersects(other, MinRoomSpacing) {
+				ok = false
+				break
+			}
+		}
+		if !ok {
+			continue
+		}
+		digRoom(grid, w, h, rc)
+		rooms = append(rooms, rc)
+
+		if len(rooms) > 1 {
+			x1, y1 := rooms[len(rooms)-2].center()
+			x2, y2 := rc.center()
+			if rng.Intn(2) == 0 {
+				digH2(grid, w, h, x1, x2, y1)
+				digV2(grid, w, h, y1, y2, x2)
+			} else {
+				digV2(grid, w, h, y1, y2, x1)
+				digH2(grid, w, h, x1, x2, y2)
+			}
+		}
+	}
+
+	if len(rooms) == 0 {
+		r := rect{w / 2, h / 2, 5, 5}
+		digRoom(grid, w, h, r)
+		digH2(grid, w, h, 2, w-3, r.y+r.h/2)
+		digV2(grid, w, h, 2, h-3, r.x+r.w/2)
+		rooms = append(rooms, r)
+	}
+
+	sx, sy := rooms[0].center()
+	spawn = Vec2{float64(sx) + 0.5, float64(sy) + 0.5}
+
+	ex, ey := farthestRoom(grid, w, h, rooms, sx, sy)
+	grid[ey*w+ex] = TileExit
+
+	doors, keys := placeDoors(grid, w, h, rng, rooms, sx, sy, locks)
+	pickups = append(pickups, keys...)
+
+	if x, y, ok := placeSecret(grid, w, h, rng, rooms); ok {
+		secrets = append(secrets, &Secret{X: x, Y: y})
+		bonus := PickupMedkit
+		if rng.Intn(2) == 0 {
+			bonus = PickupAmmo
+		}
+		pickups = append(pickups, &Pickup{Pos: Vec2{float64(x) + 0.5, float64(y) + 0.5}, Type: bonus})
+	}
+
+	spreadEnemy := func(count int, kind EnemyType) {
+		for placed := 0; placed < count; {
+			x := rng.Intn(w-2) + 1
+			y := rng.Intn(h-2) + 1
+			if grid[y*w+x] != TileEmpty {
+				continue
+			}
+			if math.Hypot(float64(x)-spawn.X+0.5, float64(y)-spawn.Y+0.5) < SpawnSafeRadius {
+				continue
+			}
+			enemies = append(enemies, &Enemy{
+				Pos:  Vec2{float64(x) + 0.5, float64(y) + 0.5},
+				HP:   defs.Def(kind).HP,
+				Type: kind,
+			})
+			placed++
+		}
+	}
+	for kind, count := range spawns {
+		spreadEnemy(count, EnemyType(kind))
+	}
+
+	placePickup := func(count int, pt PickupType) {
+		for placed := 0; placed < count; {
+			x := rng.Intn(w-2) + 1
+			y := rng.Intn(h-2) + 1
+			if grid[y*w+x] != TileEmpty {
+				continue
+			}
+			if math.Hypot(float64(x)-spawn.X+0.5, float64(y)-spawn.Y+0.5) < 3.5 {
+				continue
+			}
+			pickups = append(pickups, &Pickup{
+				Pos:  Vec2{float64(x) + 0.5, float64(y) + 0.5},
+				Type: pt,
+			})
+			placed++
+		}
+	}
+	placePickup(medkits, PickupMedkit)
+	for _, it := range items {
+		placePickup(1, it)
+	}
+
+	barrels = placeBarrels(grid, w, h, rng, rooms, spawn, barrelCount, enemies, pickups)
+
+	return grid, spawn, enemies, pickups, doors, secrets, barrels
+}
+
+// placeBarrels stands barrels in small clusters, so one going off sets off
+// its neighbours. They only go on tiles with open floor all around, so they
+// never block a corridor or doorway, and never share a tile with an enemy or
+// pickup.
+func placeBarrels(grid []int, w, h int, rng *rand.Rand, rooms []rect, spawn Vec2, count int, enemies []*Enemy, pickups []*Pickup) []*Barrel {
+	var barrels []*Barrel
+	taken := make(map[int]bool)
+	for _, e := range enemies {
+		taken[int(e.Pos.Y)*w+int(e.Pos.X)] = true
+	}
+	for _, pk := range pickups {
+		taken[int(pk.Pos.Y)*w+int(pk.Pos.X)] = true
+	}
+	open := func(x, y int) bool {
+		if taken[y*w+x] || math.Hypot(float64(x)-spawn.X+0.5, float64(y)-spawn.Y+0.5) < 3.5 {
+			return false
+		}
+		for dy := -1; dy <= 1; dy++ {
+			for dx := -1; dx <= 1; dx++ {
+				cx, cy := x+dx, y+dy
+				if cx < 1 || cy < 1 || cx >= w-1 || cy >= h-1 || grid[cy*w+cx] != TileEmpty {
+					return false
+				}
+			}
+		}
+		return true
+	}
+
+	for try := 0; try < barrelTries && len(barrels) < count; try++ {
+		r := rooms[rng.Intn(len(rooms))]
+		x, y := r.x+rng.Intn(r.w), r.y+rng.Intn(r.h)
+		size := min(1+rng.Intn(barrelClusterMax), count-len(barrels))
+		for k := 0; k < size; k++ {
+			// fill the cluster's 2x2 block in turn
+			bx, by := x+k%2, y+k/2
+			if !open(bx, by) {
+				continue
+			}
+			taken[by*w+bx] = true
+			b := NewBarrel(Vec2{float64(bx) + 0.5, float64(by) + 0.5})
+			barrels = append(barrels, &b)
+		}
+	}
+	return barrels
+}
+
+// farthestRoom returns the center of the room the longest walk from spawn.
+// With a single room it falls back to the farthest tile.
+func farthestRoom(grid []int, w, h int, rooms []rect, sx, sy int) (int, int) {
+	dist := distanceField(grid, w, h, sx, sy, func(idx int) bool { return grid[idx] == TileWall })
+	bx, by, best := sx, sy, 0
+	for _, r := range rooms[1:] {
+		cx, cy := r.center()
+		if d := dist[cy*w+cx]; d > best {
+			bx, by, best = cx, cy, d
+		}
+	}
+	if best > 0 {
+		return bx, by
+	}
+	for idx, d := range dist {
+		if d > best {
+			bx, by, best = idx%w, idx/w, d
+		}
+	}
+	return bx, by
+}
+
+// placeSecret digs a dead-end passage out of a room wall ending in a one-tile
+// stash, and returns the stash. The passage must be surrounded by solid rock
+// so it never joins up with the rest of the map.
+func placeSecret(grid []int, w, h int, rng *rand.Rand, rooms []rect) (x, y int, ok bool) {
+	const depth = 3 // passage tiles, the last one being the stash
+	for try := 0; try < secretTries; try++ {
+		r := rooms[rng.Intn(len(rooms))]
+		// start is the room tile the passage leaves from, d the way out
+		var start [2]int
+		var d [2]int
+		switch rng.Intn(4) {
+		case 0:
+			start, d = [2]int{r.x + rng.Intn(r.w), r.y}, [2]int{0, -1}
+		case 1:
+			start, d = [2]int{r.x + rng.Intn(r.w), r.y + r.h - 1}, [2]int{0, 1}
+		case 2:
+			start, d = [2]int{r.x, r.y + rng.Intn(r.h)}, [2]int{-1, 0}
+		default:
+			start, d = [2]int{r.x + r.w - 1, r.y + rng.Intn(r.h)}, [2]int{1, 0}
+		}
+		if grid[start[1]*w+start[0]] != TileEmpty {
+			continue
+		}
+		side := [2]int{d[1], d[0]}
+		solid := true
+		for k := 1; k <= depth+1 && solid; k++ {
+			for s := -1; s <= 1; s++ {
+				cx := start[0] + k*d[0] + s*side[0]
+				cy := start[1] + k*d[1] + s*side[1]
+				if cx < 1 || cy < 1 || cx >= w-1 || cy >= h-1 || grid[cy*w+cx] != TileWall {
+					solid = false
+					break
+				}
+			}
+		}
+		if !solid {
+			continue
+		}
+		for k := 1; k <= depth; k++ {
+			grid[(start[1]+k*d[1])*w+start[0]+k*d[0]] = TileEmpty
+		}
+		return start[0] + depth*d[0], start[1] + depth*d[1], true
+	}
+	return 0, 0, false
+}
+
+// placeDoors closes off the entrances of some rooms with doors. The first
+// locks rooms that take a door get keycard doors, each with its key placed
+// where it can be reached without any keys. Rooms whose entrances cannot be
+// cleanly doored, or where doors would cut anything off, are left open.
+func placeDoors(grid []int, w, h int, rng *rand.Rand, rooms []rect, sx, sy, locks int) (doors []*Door, keys []*Pickup) {
+	if len(rooms) < 2 {
+		return nil, nil
+	}
+	order := rng.Perm(len(rooms) - 1)
+	for _, i := range order {
+		r := rooms[i+1] // never the spawn room
+		key := KeyNone
+		if len(keys) < locks {
+			key = KeyColors[len(keys)]
+		} else if rng.Float64() >= doorChance {
+			continue
+		}
+
+		before := append([]int(nil), grid...)
+		added := doorRoom(grid, w, h, r, key)
+		if added == nil || !doorsKeepConnected(before, grid, w, h, sx, sy) {
+			copy(grid, before)
+			continue
+		}
+
+		if key != KeyNone {
+			// The locked room itself must be sealed off until the key is found.
+			locked := append(doors, added...)
+			free := floodFill(grid, w, h, sx, sy, func(idx int) bool {
+				if grid[idx] == TileWall {
+					return true
+				}
+				for _, d := range locked {
+					if d.Key != KeyNone && d.Y*w+d.X == idx {
+						return true
+					}
+				}
+				return false
+			})
+			cx, cy := r.center()
+			pos, ok := pickKeySpot(grid, w, h, rng, free, sx, sy)
+			if free[cy*w+cx] || !ok {
+				copy(grid, before)
+				continue
+			}
+			keys = append(keys, &Pickup{Pos: pos, Type: KeyPickup(key)})
+		}
+		doors = append(doors, added...)
+	}
+	return doors, keys
+}
+
+// doorRoom puts a door in each opening of the ring around a room and walls up
+// the rest of each opening. It returns nil if any door would not be framed.
+func doorRoom(grid []int, w, h int, r rect, key KeyColor) []*Door {
+	var doors []*Door
+	side := func(x0, y0, dx, dy, n int) bool {
+		if x0 <= 0 || y0 <= 0 || x0+dx*(n-1) >= w-1 || y0+dy*(n-1) >= h-1 {
+			return true // rooms touching the border have no ring on that side
+		}
+		run := false
+		for i := 0; i < n; i++ {
+			x, y := x0+dx*i, y0+dy*i
+			if grid[y*w+x] == TileWall {
+				run = false
+				continue
+			}
+			if run {
+				grid[y*w+x] = TileWall
+				continue
+			}
+			run = true
+			grid[y*w+x] = TileDoor
+			doors = append(doors, &Door{X: x, Y: y, Key: key})
+		}
+		return true
+	}
+	side(r.x, r.y-1, 1, 0, r.w)
+	side(r.x, r.y+r.h, 1, 0, r.w)
+	side(r.x-1, r.y, 0, 1, r.h)
+	side(r.x+r.w, r.y, 0, 1, r.h)
+
+	if len(doors) == 0 {
+		return nil
+	}
+	for _, d := range doors {
+		if !doorFramed(grid, w, h, d.X, d.Y) {
+			return nil
+		}
+	}
+	return doors
+}
+
+// doorsKeepConnected reports whether every open cell reachable before doors
+// were added is still reachable, walking through doors.
+func doorsKeepConnected(before, after []int, w, h, sx, sy int) bool {
+	was := floodFillReachable(before, w, h, sx, sy)
+	now := floodFillReachable(after, w, h, sx, sy)
+	for i := range was {
+		if was[i] && after[i] != TileWall && !now[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// pickKeySpot chooses an empty cell in the free region, away from the spawn.
+func pickKeySpot(grid []int, w, h int, rng *rand.Rand, free []bool, sx, sy int) (Vec2, bool) {
+	var cells []int
+	for idx, ok := range free {
+		if !ok || grid[idx] != TileEmpty {
+			continue
+		}
+		x, y := idx%w, idx/w
+		if math.Hypot(float64(x-sx), float64(y-sy)) < SpawnSafeRadius {
+			continue
+		}
+		cells = append(cells, idx)
+	}
+	if len(cells) == 0 {
+		return Vec2{}, false
+	}
+	idx := cells[rng.Intn(len(cells))]
+	return Vec2{float64(idx%w) + 0.5, float64(idx/w) + 0.5}, true
+}
+
+func digRoom(grid []int, w, h int, r rect) {
+	for y := r.y; y < r.y+r.h; y++ {
+		for x := r.x; x < r.x+r.w; x++ {
+			if x > 0 && y > 0 && x < w-1 && y < h-1 {
+				grid[y*w+x] = TileEmpty
+			}
+		}
+	}
+}
+
+// 2-wide corridors (already in place previously)
+func digH2(grid []int, w, h, x1, x2, y int) {
+	if x1 > x2 {
+		x1, x2 = x2, x1
+	}
+	for x := x1; x <= x2; x++ {
+		if x <= 0 || x >= w-1 {
+			continue
+		}
+		if y > 0 && y < h-1 {
+			grid[y*w+x] = TileEmpty
+		}
+		if y+1 > 0 && y+1 < h-1 {
+			grid[(y+1)*w+x] = TileEmpty
+		} else if y-1 > 0 && y-1 < h-1 {
+			grid[(y-1)*w+x] = TileEmpty
+		}
+	}
+}
+
+func digV2(grid []int, w, h, y1, y2, x int) {
+	if y1 > y2 {
+		y1, y2 = y2, y1
+	}
+	for y := y1; y <= y2; y++ {
+		if y <= 0 || y >= h-1 {
+			continue
+		}
+		if x > 0 && x < w-1 {
+			grid[y*w+x] = TileEmpty
+		}
+		if x+1 > 0 && x+1 < w-1 {
+			grid[y*w+(x+1)] = TileEmpty
+		} else if x-1 > 0 && x-1 < w-1 {
+			grid[y*w+(x-1)] = TileEmpty
+		}
+	}
+}
@@ -0,0 +1,145 @@
+package sim
+
+import "math"
+
+// navField is a breadth-first distance field over the tile grid, measured in
+// steps from the player's cell. Melee enemies walk down it to find their way
+// around walls. It is a cache derived from the grid, doors and player cell,
+// so it is neither saved nor hashed; it is rebuilt when the player moves to
+// another cell or a door opens or starts closing.
+type navField struct {
+	w, h  int
+	goal  int // cell the field was built from, -1 when the player is off the grid
+	dist  []int32
+	queue []int32
+	stale bool
+}
+
+// updateNav rebuilds the field if the player has changed cell or the
+// walkable area has changed since it was last built.
+func (w *World) updateNav() {
+	n := &w.nav
+	goal := -1
+	px, py := int(math.Floor(w.Player.Pos.X)), int(math.Floor(w.Player.Pos.Y))
+	if px >= 0 && py >= 0 && px < w.W && py < w.H {
+		goal = py*w.W + px
+	}
+	if !n.stale && n.goal == goal && n.w == w.W && n.h == w.H && n.dist != nil {
+		return
+	}
+	n.w, n.h, n.goal, n.stale = w.W, w.H, goal, false
+	if len(n.dist) != w.W*w.H {
+		n.dist = make([]int32, w.W*w.H)
+		n.queue = make([]int32, 0, w.W*w.H)
+	}
+	for i := range n.dist {
+		n.dist[i] = -1
+	}
+	if goal < 0 {
+		return
+	}
+
+	n.dist[goal] = 0
+	n.queue = append(n.queue[:0], int32(goal))
+	for head := 0; head < len(n.queue); head++ {
+		idx := int(n.queue[head])
+		x, y := idx%w.W, idx/w.W
+		for _, d := range [4][2]int{{1, 0}, {-1, 0}, {0, 1}, {0, -1}} {
+			nx, ny := x+d[0], y+d[1]
+			if w.IsSolid(nx, ny) {
+				continue
+			}
+			next := ny*w.W + nx
+			if n.dist[next] >= 0 {
+				continue
+			}
+			n.dist[next] = n.dist[idx] + 1
+			n.queue = append(n.queue, int32(next))
+		}
+	}
+}
+
+// downhill returns the neighbour of a cell that is closest to the player,
+// or -1 at the goal or where the player cannot be reached. Diagonal steps
+// are only taken when both cells beside them are open, so paths never clip
+// a wall corner.
+func (w *World) downhill(idx int) int {
+	n := &w.nav
+	if n.dist[idx] <= 0 {
+		return -1
+	}
+	x, y := idx%w.W, idx/w.W
+	best, bestDist := -1, n.dist[idx]
+	for _, d := range [8][2]int{{1, 0}, {-1, 0}, {0, 1}, {0, -1}, {1, 1}, {-1, 1}, {1, -1}, {-1, -1}} {
+		nx, ny := x+d[0], y+d[1]
+		if w.IsSolid(nx, ny) {
+			continue
+		}
+		if d[0] != 0 && d[1] != 0 && (w.IsSolid(x+d[0], y) || w.IsSolid(x, y+d[1])) {
+			continue
+		}
+		next := ny*w.W + nx
+		if nd := n.dist[next]; nd >= 0 && nd < bestDist {
+			best, bestDist = next, nd
+		}
+	}
+	return best
+}
+
+// navTarget returns the point an enemy of the given radius at p should head
+// for to reach the player. It walks down the distance field and keeps the
+// farthest cell centre that can be reached in a straight line without the
+// enemy's body touching a wall, which smooths the path around corners.
+// ok is false when the player cannot be reached from p.
+func (w *World) navTarget(p Vec2, radius float64) (target Vec2, ok bool) {
+	if w.HasLineOfSight(p, w.Player.Pos) && w.clearPath(p, w.Player.Pos, radius) {
+		return w.Player.Pos, true
+	}
+	w.updateNav()
+	ix, iy := int(math.Floor(p.X)), int(math.Floor(p.Y))
+	if ix < 0 || iy < 0 || ix >= w.W || iy >= w.H {
+		return Vec2{}, false
+	}
+	cur := iy*w.W + ix
+	if w.nav.dist[cur] < 0 {
+		return Vec2{}, false
+	}
+
+	// Always take the first step, even if the body is pressed into a corner;
+	// moveEnemyCircle slides along the wall.
+	next := w.downhill(cur)
+	if next < 0 {
+		return w.Player.Pos, true
+	}
+	target = cellCenter(next, w.W)
+	for i := 1; i < navLookahead; i++ {
+		next = w.downhill(next)
+		if next < 0 {
+			break
+		}
+		c := cellCenter(next, w.W)
+		if !w.clearPath(p, c, radius) {
+			break
+		}
+		target = c
+	}
+	return target, true
+}
+
+// clearPath reports whether a circle of the given radius can travel in a
+// straight line from a to b without touching anything solid.
+func (w *World) clearPath(a, b Vec2, radius float64) bool {
+	dx, dy := b.X-a.X, b.Y-a.Y
+	steps := int(math.Ceil(math.Hypot(dx, dy) / navSampleStep))
+	for i := 1; i <= steps; i++ {
+		t := float64(i) / float64(steps)
+		if w.circleHitsSolid(a.X+dx*t, a.Y+dy*t, radius) {
+			return false
+		}
+	}
+	return true
+}
+
+func cellCenter(idx, w int) Vec2 {
+	return Vec2{float64(idx%w) + 0.5, float64(idx/w) + 0.5}
+}
@@ -0,0 +1,53 @@
+package sim
+
+import (
+	"math"
+	"testing"
+)
+
+// benchWorld generates the last level of a five-level run, the largest a
+// default run plays, with every enemy already chasing the player so each melee
+// enemy routes along the distance field. The player cannot die, so a long
+// benchmark keeps measuring the same work.
+func benchWorld(b *testing.B) *World {
+	b.Helper()
+	const levels = 5
+	w := NewWorld(1, levels, Settings{FireRate: DefaultFireRate, BulletSpeed: DefaultBulletSpeed}, BuiltinEnemyDefs())
+	w.SetupLevel(levels, true)
+	w.Player.HP = math.MaxInt32
+	for _, e := range w.Enemies {
+		e.State, e.LastSeen = AIChase, w.Player.Pos
+	}
+	b.Logf("%dx%d level, %d enemies", w.W, w.H, len(w.Enemies))
+	return w
+}
+
+func BenchmarkStep(b *testing.B) {
+	w := benchWorld(b)
+	// Walk in a slow circle so the player keeps changing cell
+	in := Input{Forward: 1, Turn: 0.02}
+	b.ResetTimer()
+	for range b.N {
+		w.Step(in)
+	}
+}
+
+func BenchmarkNavRebuild(b *testing.B) {
+	w := benchWorld(b)
+	b.ResetTimer()
+	for range b.N {
+		w.nav.stale = true
+		w.updateNav()
+	}
+}
+
+func BenchmarkNavTarget(b *testing.B) {
+	w := benchWorld(b)
+	w.updateNav()
+	b.ResetTimer()
+	for range b.N {
+		for _, e := range w.Enemies {
+			w.navTarget(e.Pos, w.EnemyDef(e.Type).Radius)
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package sim
+
+import "math"
+
+func (w *World) updateProjectiles(dt float64) {
+	nb := w.Bullets[:0]
+	for _, b := range w.Bullets {
+		b.TTL -= dt
+		if b.TTL <= 0 {
+			continue
+		}
+
+		// Apply curving effect - gradually change velocity direction
+		// The curve becomes more pronounced as the bullet travels further
+		curveStrength := (playerShotTTL - b.TTL) * b.CurveRate * 0.05 // Increases over time
+
+		// Apply curve perpendicular to current velocity direction
+		velLen := math.Hypot(b.Vel.X, b.Vel.Y)
+		if velLen > 0 {
+			// Get perpendicular direction (rotate 90 degrees)
+			perpX := -b.Vel.Y / velLen
+			perpY := b.Vel.X / velLen
+
+			// Apply curve in the perpendicular direction
+			curveX := perpX * curveStrength * math.Cos(b.CurveAngle)
+			curveY := perpY * curveStrength * math.Sin(b.CurveAngle)
+
+			// Add curve to velocity
+			b.Vel.X += curveX * dt
+			b.Vel.Y += curveY * dt
+		}
+
+		steps := int(math.Ceil(math.Max(math.Abs(b.Vel.X*dt), math.Abs(b.Vel.Y*dt)) / 0.05))
+		if steps < 1 {
+			steps = 1
+		}
+		sx := (b.Vel.X * dt) / float64(steps)
+		sy := (b.Vel.Y * dt) / float64(steps)
+		hitWall := false
+
+		for i := 0; i < steps; i++ {
+			nx := b.Pos.X + sx
+			ny := b.Pos.Y + sy
+			if w.IsSolidAtFloat(nx, ny) {
+				hitWall = true
+				break
+			}
+			b.Pos.X, b.Pos.Y = nx, ny
+
+			// Barrels stop shots from either side
+			if bar := w.barrelAt(b.Pos, b.Radius); bar != nil {
+				w.hurtBarrel(bar, b.Damage)
+				b.TTL = 0
+				goto bulletDone
+			}
+
+			if b.Friendly {
+				for _, e := range w.Enemies {
+					if e.Dead {
+						continue
+					}
+					reach := w.EnemyDef(e.Type).Radius + enemyHitPad
+					if dist2(b.Pos.X, b.Pos.Y, e.Pos.X, e.Pos.Y) < reach*reach {
+						w.Player.Hits++
+						w.hurtEnemy(e, b.Damage)
+						b.TTL = 0
+						goto bulletDone
+					}
+				}
+			} else {
+				// Check for bullet whiz sound (enemy bullets passing close to player)
+				if !b.WhizPlayed {
+					distToPlayer := math.Hypot(b.Pos.X-w.Player.Pos.X, b.Pos.Y-w.Player.Pos.Y)
+					if distToPlayer < 1.5 && distToPlayer > 0.5 { // Close but not hitting
+						w.emit(EventWhiz, 1)
+						b.WhizPlayed = true
+					}
+				}
+
+				if dist2(b.Pos.X, b.Pos.Y, w.Player.Pos.X, w.Player.Pos.Y) < 0.35*0.35 {
+					w.damagePlayer(b.Damage)
+					b.TTL = 0
+					goto bulletDone
+				}
+			}
+		}
+
+	bulletDone:
+		if !hitWall && b.TTL > 0 {
+			nb = append(nb, b)
+		} else if b.Splash > 0 {
+			w.explode(b.Pos, b.Splash, b.SplashDamage)
+		}
+	}
+	w.Bullets = nb
+}
@@ -0,0 +1,78 @@
+package sim
+
+import "math"
+
+// GridRay walks the grid cells a ray passes through, nearest first. It is the
+// DDA walk shared by the wall renderer, line-of-sight checks and hitscan
+// weapons, so all three agree on which cell a ray meets.
+type GridRay struct {
+	X, Y         int     // cell the ray is in
+	Dist         float64 // distance along the ray to where it entered the cell
+	Vertical     bool    // the ray entered through an edge of constant X
+	StepX, StepY int     // direction of travel across the grid, -1 or 1
+
+	sideX, sideY   float64 // distance to the next vertical and horizontal edges
+	deltaX, deltaY float64 // distance between edges of each kind
+}
+
+// NewGridRay starts a walk at from along the unit direction (dirX, dirY).
+func NewGridRay(from Vec2, dirX, dirY float64) GridRay {
+	r := GridRay{
+		X:      int(math.Floor(from.X)),
+		Y:      int(math.Floor(from.Y)),
+		StepX:  1,
+		StepY:  1,
+		deltaX: math.Abs(1 / dirX),
+		deltaY: math.Abs(1 / dirY),
+	}
+	if math.IsInf(r.deltaX, 0) {
+		r.deltaX = 1e30
+	}
+	if math.IsInf(r.deltaY, 0) {
+		r.deltaY = 1e30
+	}
+	if dirX < 0 {
+		r.StepX = -1
+		r.sideX = (from.X - float64(r.X)) * r.deltaX
+	} else {
+		r.sideX = (float64(r.X+1) - from.X) * r.deltaX
+	}
+	if dirY < 0 {
+		r.StepY = -1
+		r.sideY = (from.Y - float64(r.Y)) * r.deltaY
+	} else {
+		r.sideY = (float64(r.Y+1) - from.Y) * r.deltaY
+	}
+	return r
+}
+
+// Next moves the ray into the following cell.
+func (r *GridRay) Next() {
+	if r.sideX < r.sideY {
+		r.Dist = r.sideX
+		r.sideX += r.deltaX
+		r.X += r.StepX
+		r.Vertical = true
+	} else {
+		r.Dist = r.sideY
+		r.sideY += r.deltaY
+		r.Y += r.StepY
+		r.Vertical = false
+	}
+}
+
+// wallDistance returns how far a ray from p along a unit direction travels
+// before it enters a solid cell. hit is false when nothing solid is within
+// reach, and the distance is then reach.
+func (w *World) wallDistance(p Vec2, dirX, dirY, reach float64) (dist float64, hit bool) {
+	r := NewGridRay(p, dirX, dirY)
+	for {
+		r.Next()
+		if r.Dist >= reach {
+			return reach, false
+		}
+		if w.IsSolid(r.X, r.Y) {
+			return r.Dist, true
+		}
+	}
+}
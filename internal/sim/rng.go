@@ -0,0 +1,26 @@
+package sim
+
+import "math/rand"
+
+// rngSource is a splitmix64 generator. Unlike math/rand's default source its
+// whole state is a single word, so a run can be saved and resumed mid-level
+// without disturbing the random sequence.
+type rngSource struct{ state uint64 }
+
+func (s *rngSource) Uint64() uint64 {
+	s.state += 0x9E3779B97F4A7C15
+	z := s.state
+	z = (z ^ (z >> 30)) * 0xBF58476D1CE4E5B9
+	z = (z ^ (z >> 27)) * 0x94D049BB133111EB
+	return z ^ (z >> 31)
+}
+
+func (s *rngSource) Int63() int64    { return int64(s.Uint64() >> 1) }
+func (s *rngSource) Seed(seed int64) { s.state = uint64(seed) }
+
+// newRNG returns a generator together with its source so the state can be
+// read back later.
+func newRNG(state uint64) (*rand.Rand, *rngSource) {
+	src := &rngSource{state: state}
+	return rand.New(src), src
+}
@@ -0,0 +1,88 @@
+package sim
+
+import "math"
+
+// LevelBonus is the score awarded for clearing a level, itemised for the
+// intermission screen.
+type LevelBonus struct {
+	Clear    int // flat award for finishing the level
+	Accuracy int // share of accuracyBonus by the fraction of rounds that hit
+	Time     int // timeBonusRate for every second under par
+	Health   int // healthBonus less hurtPenalty for every point of health lost
+}
+
+// Total adds the parts of the bonus up.
+func (b LevelBonus) Total() int { return b.Clear + b.Accuracy + b.Time + b.Health }
+
+// LevelBonus works out the bonus for the level being played, as it stands.
+// The bonus is added to the score on the tick the level is cleared; the
+// level's tallies are left alone after that, so the intermission can call
+// this again to show where the points came from.
+func (w *World) LevelBonus() LevelBonus {
+	p := &w.Player
+	b := LevelBonus{
+		Clear:  levelClearBonus * w.Level,
+		Health: max(healthBonus-hurtPenalty*p.Hurt, 0),
+	}
+	if p.Shots > 0 {
+		b.Accuracy = accuracyBonus * p.Hits / p.Shots
+	}
+	if w.Par > 0 && w.LevelTime < w.Par {
+		b.Time = int(math.Round((w.Par - w.LevelTime) * timeBonusRate))
+	}
+	return b
+}
+
+// Accuracy returns the share of the rounds fired on this level that hit an
+// enemy, as a whole percentage.
+func (p *Player) Accuracy() int {
+	if p.Shots == 0 {
+		return 0
+	}
+	return p.Hits * 100 / p.Shots
+}
+
+// ComboMultiplier is what the next kill's score would be multiplied by if it
+// came now.
+func (p *Player) ComboMultiplier() int {
+	if p.ComboTime <= 0 {
+		return 1
+	}
+	return min(p.Combo+1, ComboMaxMultiplier)
+}
+
+// scoreKill awards the score for a kill. Kills that come within comboWindow
+// of each other chain into a combo, and each kill in a combo is worth its
+// place in the chain times the enemy's score, up to ComboMaxMultiplier.
+func (w *World) scoreKill(def *EnemyDef) int {
+	p := &w.Player
+	mul := p.ComboMultiplier()
+	if p.ComboTime > 0 {
+		p.Combo++
+	} else {
+		p.Combo = 1
+	}
+	p.ComboTime = comboWindow
+	points := def.Score * mul
+	p.Score += points
+	return points
+}
+
+// updateCombo runs down the time left to chain another kill.
+func (w *World) updateCombo(dt float64) {
+	p := &w.Player
+	if p.ComboTime > 0 {
+		p.ComboTime -= dt
+		if p.ComboTime <= 0 {
+			p.ComboTime, p.Combo = 0, 0
+		}
+	}
+}
+
+// startLevelTally clears the per-level parts of the player's record when a
+// new level begins; the score carries on.
+func (p *Player) startLevelTally() {
+	p.Keys = 0
+	p.Combo, p.ComboTime = 0, 0
+	p.Shots, p.Hits, p.Hurt, p.Dealt = 0, 0, 0, 0
+}
@@ -0,0 +1,134 @@
+package sim
+
+import "math"
+
+// Snapshot is a self-contained copy of a run in progress: everything needed to
+// resume it exactly, including the random generator's position.
+type Snapshot struct {
+	Level           int
+	TotalLevels     int
+	Seed            int64
+	Time            float64
+	W, H            int
+	Grid            []int
+	Player          Player
+	Enemies         []Enemy
+	Pickups         []Pickup
+	Bullets         []Projectile
+	Doors           []Door
+	Secrets         []Secret
+	Barrels         []Barrel
+	Defeated        int
+	LevelEnemyTotal int
+	LevelTime       float64
+	Rule            Completion
+	Settings        Settings
+	RNGState        uint64
+
+	// EnemyTypes names the run's enemy types, indexed by EnemyType, so a
+	// snapshot survives definitions being added or reordered.
+	EnemyTypes []string
+
+	// Campaign is the manifest path of the run's campaign, if any; the caller
+	// loads it and sets World.Campaign after FromSnapshot.
+	Campaign string
+	MapName  string
+	Par      float64
+}
+
+// Snapshot copies the current state of the world.
+func (w *World) Snapshot() Snapshot {
+	s := Snapshot{
+		Level:           w.Level,
+		TotalLevels:     w.TotalLevels,
+		Seed:            w.Seed,
+		Time:            w.Time,
+		W:               w.W,
+		H:               w.H,
+		Grid:            append([]int(nil), w.Grid...),
+		Player:          w.Player,
+		Defeated:        w.Defeated,
+		LevelEnemyTotal: w.LevelEnemyTotal,
+		LevelTime:       w.LevelTime,
+		Rule:            w.Rule,
+		Settings:        w.Settings,
+		EnemyTypes:      w.EnemyDefs.Names(),
+		MapName:         w.MapName,
+		Par:             w.Par,
+	}
+	if w.Campaign != nil {
+		s.Campaign = w.Campaign.Path
+	}
+	if w.rngSrc != nil {
+		s.RNGState = w.rngSrc.state
+	}
+	for _, e := range w.Enemies {
+		s.Enemies = append(s.Enemies, *e)
+	}
+	for _, pk := range w.Pickups {
+		s.Pickups = append(s.Pickups, *pk)
+	}
+	for _, b := range w.Bullets {
+		s.Bullets = append(s.Bullets, *b)
+	}
+	for _, d := range w.Doors {
+		s.Doors = append(s.Doors, *d)
+	}
+	for _, sc := range w.Secrets {
+		s.Secrets = append(s.Secrets, *sc)
+	}
+	for _, b := range w.Barrels {
+		s.Barrels = append(s.Barrels, *b)
+	}
+	return s
+}
+
+// FromSnapshot rebuilds a world from a snapshot taken by Snapshot, playing
+// it with defs. Enemy types are matched to defs by name.
+func FromSnapshot(s Snapshot, defs EnemyDefs) *World {
+	w := NewWorld(s.Seed, s.TotalLevels, s.Settings, defs)
+	w.Level = s.Level
+	w.Time = s.Time
+	w.W, w.H = s.W, s.H
+	w.Grid = append([]int(nil), s.Grid...)
+	w.Player = s.Player
+	w.Defeated = s.Defeated
+	w.LevelEnemyTotal = s.LevelEnemyTotal
+	w.LevelTime, w.Rule = s.LevelTime, s.Rule
+	w.MapName, w.Par = s.MapName, s.Par
+	w.rng, w.rngSrc = newRNG(s.RNGState)
+	for i := range s.Enemies {
+		e := s.Enemies[i]
+		if int(e.Type) >= 0 && int(e.Type) < len(s.EnemyTypes) {
+			if t, ok := defs.Named(s.EnemyTypes[e.Type]); ok {
+				e.Type = t
+			}
+		}
+		w.Enemies = append(w.Enemies, &e)
+	}
+	for i := range s.Pickups {
+		pk := s.Pickups[i]
+		w.Pickups = append(w.Pickups, &pk)
+	}
+	for i := range s.Bullets {
+		b := s.Bullets[i]
+		w.Bullets = append(w.Bullets, &b)
+	}
+	for i := range s.Doors {
+		d := s.Doors[i]
+		w.Doors = append(w.Doors, &d)
+	}
+	w.indexDoors()
+	for i := range s.Secrets {
+		sc := s.Secrets[i]
+		w.Secrets = append(w.Secrets, &sc)
+	}
+	for i := range s.Barrels {
+		b := s.Barrels[i]
+		w.Barrels = append(w.Barrels, &b)
+	}
+	// The player is always inside the region reachable from spawn.
+	px, py := int(math.Floor(w.Player.Pos.X)), int(math.Floor(w.Player.Pos.Y))
+	w.Reachable = floodFillReachable(w.Grid, w.W, w.H, px, py)
+	return w
+}
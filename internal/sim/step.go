@@ -0,0 +1,199 @@
+package sim
+
+import "math"
+
+// Step advances the world by one TickDT using the given input and reports
+// whether the level is still running. Side effects for the front end are
+// available from Events until the next call.
+func (w *World) Step(in Input) Outcome {
+	dt := TickDT
+	w.events = w.events[:0]
+	w.Time += dt
+	w.LevelTime += dt
+
+	p := &w.Player
+	if p.Cooldown > 0 {
+		p.Cooldown -= dt
+		if p.Cooldown < 0 {
+			p.Cooldown = 0
+		}
+	}
+	if p.MuzzleTime > 0 {
+		p.MuzzleTime -= dt
+		if p.MuzzleTime < 0 {
+			p.MuzzleTime = 0
+		}
+	}
+
+	for _, e := range w.Enemies {
+		if e.Blink > 0 {
+			e.Blink -= dt
+			if e.Blink < 0 {
+				e.Blink = 0
+			}
+		}
+		if e.Dead {
+			e.Dying += dt
+		} else {
+			e.AITime += dt
+		}
+	}
+
+	w.updateCombo(dt)
+	w.updateDoors(dt)
+	w.updateProjectiles(dt)
+	w.updateBarrels(dt)
+
+	p.Angle = normalizeAngle(p.Angle + in.Turn)
+
+	forward, side := clampF(in.Forward, -1, 1), clampF(in.Strafe, -1, 1)
+	speed := moveSpeed
+	if in.Sprint {
+		speed *= sprintMul
+	}
+	if forward != 0 || side != 0 {
+		if l := math.Hypot(forward, side); l > 1 {
+			forward /= l
+			side /= l
+		}
+		fx := math.Cos(p.Angle)
+		fy := math.Sin(p.Angle)
+		rx := -fy
+		ry := fx
+		vx := (fx*forward + rx*side) * speed * dt
+		vy := (fy*forward + ry*side) * speed * dt
+		w.moveWithCollision(vx, vy)
+	}
+
+	if in.Use {
+		w.useDoor()
+	}
+
+	w.switchWeapon(in)
+	if ammo := p.AmmoFor(p.Weapon.Def().Ammo); in.Fire && p.Cooldown <= 0 && *ammo > 0 {
+		p.Cooldown = p.Weapon.Def().Cooldown * w.Settings.FireRate / DefaultFireRate
+		p.MuzzleTime = 0.06
+		*ammo--
+		w.fireWeapon()
+	}
+
+	for _, e := range w.Enemies {
+		if e.Dead {
+			continue
+		}
+		def := w.EnemyDef(e.Type)
+		w.updateAwareness(e, dt)
+		switch {
+		case e.State != AIChase:
+			w.roam(e, def, dt)
+		case def.Behavior == BehaviorShooter:
+			w.shooterAI(e, def, dt)
+		default:
+			w.seekEnemy(e, def, dt)
+		}
+		if def.TouchDamage > 0 {
+			w.touchDamage(e, def, dt)
+		}
+	}
+
+	w.collectPickups()
+	w.findSecrets()
+
+	if p.HP <= 0 {
+		return PlayerDied
+	}
+	if w.levelDone() {
+		p.Score += w.LevelBonus().Total()
+		return LevelCleared
+	}
+	return Running
+}
+
+// Events returns the side effects produced by the most recent Step.
+func (w *World) Events() []Event { return w.events }
+
+func (w *World) emit(kind EventKind, amount int) {
+	w.events = append(w.events, Event{Kind: kind, Amount: amount})
+}
+
+func (w *World) emitItem(kind EventKind, amount, item int) {
+	w.events = append(w.events, Event{Kind: kind, Amount: amount, Item: item})
+}
+
+func (w *World) emitAt(kind EventKind, at Vec2) {
+	w.events = append(w.events, Event{Kind: kind, Amount: 1, Pos: at})
+}
+
+// touchDamage hurts the player while an enemy touches them. The damage comes
+// as a bite every touchInterval, so TouchDamage is still HP per second but
+// each hit is big enough for armor to take its share.
+func (w *World) touchDamage(e *Enemy, def *EnemyDef, dt float64) {
+	p := &w.Player
+	e.Bite = math.Max(e.Bite-dt, 0)
+	if reach := 0.25 + def.Radius; dist2(e.Pos.X, e.Pos.Y, p.Pos.X, p.Pos.Y) < reach*reach {
+		w.alert(e, p.Pos)
+		if e.Bite <= 0 {
+			w.damagePlayer(int(math.Round(def.TouchDamage * touchInterval)))
+			e.Bite = touchInterval
+		}
+	}
+}
+
+func (w *World) collectPickups() {
+	p := &w.Player
+	for _, pk := range w.Pickups {
+		if pk.Taken {
+			continue
+		}
+		if dist2(pk.Pos.X, pk.Pos.Y, p.Pos.X, p.Pos.Y) < 0.5*0.5 {
+			switch pk.Type {
+			case PickupMedkit:
+				if p.HP < PlayerMaxHP {
+					p.HP += medkitHeal
+					if p.HP > PlayerMaxHP {
+						p.HP = PlayerMaxHP
+					}
+					pk.Taken = true
+					w.emit(EventHeal, medkitHeal)
+				}
+			case PickupAmmo, PickupShells, PickupRockets:
+				t, _ := pk.Type.Ammo()
+				n := ammoPickupAmounts[t]
+				*p.AmmoFor(t) += n
+				pk.Taken = true
+				w.emitItem(EventAmmo, n, int(t))
+			case PickupShotgun, PickupChaingun, PickupRocketLauncher:
+				t, _ := pk.Type.Weapon()
+				def := t.Def()
+				*p.AmmoFor(def.Ammo) += def.Pickup
+				if !p.HasWeapon(t) {
+					p.Weapons |= 1 << t
+					p.Weapon = t
+				}
+				pk.Taken = true
+				w.emitItem(EventWeapon, def.Pickup, int(t))
+			case PickupArmorGreen, PickupArmorBlue:
+				if w.takeArmor(pk.Type.Armor()) {
+					pk.Taken = true
+					w.emitItem(EventArmor, p.Armor, int(p.ArmorClass))
+				}
+			case PickupKeyRed, PickupKeyBlue, PickupKeyYellow:
+				p.Keys |= 1 << pk.Type.Key()
+				pk.Taken = true
+				w.emit(EventKey, int(pk.Type.Key()))
+			}
+		}
+	}
+}
+
+func (w *World) moveWithCollision(dx, dy float64) {
+	p := &w.Player
+	newX := p.Pos.X + dx
+	newY := p.Pos.Y + dy
+	if !w.IsSolid(int(math.Floor(newX)), int(math.Floor(p.Pos.Y))) && !w.barrelBlocks(p.Pos, Vec2{newX, p.Pos.Y}) {
+		p.Pos.X = newX
+	}
+	if !w.IsSolid(int(math.Floor(p.Pos.X)), int(math.Floor(newY))) && !w.barrelBlocks(p.Pos, Vec2{p.Pos.X, newY}) {
+		p.Pos.Y = newY
+	}
+}
@@ -0,0 +1,103 @@
+package sim
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+)
+
+// testWorld builds a world from map tile rows, facing east, for tests that
+// need a known layout rather than a generated one.
+func testWorld(t *testing.T, tiles ...string) *World {
+	t.Helper()
+	data := fmt.Sprintf(`{"name": "test", "facing": "east", "tiles": ["%s"]}`, strings.Join(tiles, `", "`))
+	defs := BuiltinEnemyDefs()
+	m, err := ParseMap("test.json", []byte(data), defs)
+	if err != nil {
+		t.Fatalf("ParseMap: %v", err)
+	}
+	w := NewWorld(1, 1, Settings{FireRate: DefaultFireRate, BulletSpeed: DefaultBulletSpeed}, defs)
+	w.rng, w.rngSrc = newRNG(1)
+	w.loadMap(m, true)
+	return w
+}
+
+var openRoom = []string{
+	"#########",
+	"#.......#",
+	"#.......#",
+	"#...P...#",
+	"#.......#",
+	"#.......#",
+	"#########",
+}
+
+func TestStepMovement(t *testing.T) {
+	step := moveSpeed * TickDT
+	tests := []struct {
+		name   string
+		in     Input
+		dx, dy float64
+	}{
+		{"forward", Input{Forward: 1}, step, 0},
+		{"backward", Input{Forward: -1}, -step, 0},
+		{"strafe right", Input{Strafe: 1}, 0, step},
+		{"half stick", Input{Forward: 0.5}, step / 2, 0},
+		{"forward clamped", Input{Forward: 5}, step, 0},
+		{"strafe clamped", Input{Strafe: -3}, 0, -step},
+		{"diagonal normalised", Input{Forward: 1, Strafe: 1}, step / math.Sqrt2, step / math.Sqrt2},
+		{"sprint", Input{Forward: 1, Sprint: true}, step * sprintMul, 0},
+		{"idle", Input{}, 0, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := testWorld(t, openRoom...)
+			start := w.Player.Pos
+			w.Step(tt.in)
+			dx, dy := w.Player.Pos.X-start.X, w.Player.Pos.Y-start.Y
+			if math.Abs(dx-tt.dx) > 1e-9 || math.Abs(dy-tt.dy) > 1e-9 {
+				t.Errorf("moved (%.6f, %.6f), want (%.6f, %.6f)", dx, dy, tt.dx, tt.dy)
+			}
+		})
+	}
+}
+
+func TestStepCollision(t *testing.T) {
+	tests := []struct {
+		name string
+		in   Input
+		// check reports what is wrong with where the player ended up
+		check func(p Vec2) string
+	}{
+		{"wall ahead stops x", Input{Forward: 1, Sprint: true}, func(p Vec2) string {
+			if p.X >= 8 {
+				return "walked into the east wall"
+			}
+			if p.X < 7.5 {
+				return "stopped short of the east wall"
+			}
+			return ""
+		}},
+		{"slides along wall", Input{Forward: 1, Strafe: -1}, func(p Vec2) string {
+			if p.Y < 1 || p.X >= 8 {
+				return "walked into a wall"
+			}
+			if p.Y > 1.5 || p.X < 7.5 {
+				return "did not slide into the corner"
+			}
+			return ""
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := testWorld(t, openRoom...)
+			for range 300 {
+				w.Step(tt.in)
+			}
+			if msg := tt.check(w.Player.Pos); msg != "" {
+				t.Errorf("player at (%.3f, %.3f): %s", w.Player.Pos.X, w.Player.Pos.Y, msg)
+			}
+		})
+	}
+}
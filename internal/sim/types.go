@@ -0,0 +1,241 @@
+package sim
+
+type Vec2 struct{ X, Y float64 }
+
+type Player struct {
+	Pos        Vec2
+	Angle      float64
+	HP         int
+	Armor      int
+	ArmorClass ArmorClass
+	Ammo       int // bullets, for the pistol and chaingun
+	Shells     int
+	Rockets    int
+	Cooldown   float64
+	MuzzleTime float64
+	Score      int
+	Combo      int        // kills chained so far, each within comboWindow of the last
+	ComboTime  float64    // seconds left to chain another kill onto the combo
+	Shots      int        // rounds fired on this level
+	Hits       int        // rounds fired on this level that struck an enemy
+	Hurt       int        // health lost on this level
+	Dealt      int        // damage done to enemies on this level, by any means
+	Keys       uint8      // bit per KeyColor held on this level
+	Weapon     WeaponType // the weapon in hand
+	Weapons    uint8      // bit per WeaponType carried besides the pistol
+}
+
+type Enemy struct {
+	Pos    Vec2
+	HP     int
+	Type   EnemyType
+	Dead   bool
+	Blink  float64
+	AITime float64
+	Bite   float64 // seconds until touching the player hurts again
+	Dying  float64 // seconds since it was killed; the front end plays the death from it
+
+	// Awareness; see updateAwareness
+	State     AIState
+	StateTime float64 // seconds in State; while chasing, since the player was last seen
+	Facing    float64 // radians, where the enemy is looking
+	LastSeen  Vec2    // last known player position
+	Home      Vec2    // where the enemy was placed; patrols stay near it
+	Waypoint  Vec2    // current patrol point
+}
+
+type PickupType int
+
+const (
+	PickupMedkit PickupType = iota
+	PickupAmmo
+	PickupKeyRed
+	PickupKeyBlue
+	PickupKeyYellow
+	PickupShells
+	PickupRockets
+	PickupShotgun
+	PickupChaingun
+	PickupRocketLauncher
+	PickupArmorGreen
+	PickupArmorBlue
+)
+
+// Key returns the keycard a pickup grants, or KeyNone.
+func (t PickupType) Key() KeyColor {
+	switch t {
+	case PickupKeyRed:
+		return KeyRed
+	case PickupKeyBlue:
+		return KeyBlue
+	case PickupKeyYellow:
+		return KeyYellow
+	default:
+		return KeyNone
+	}
+}
+
+// Armor returns the armor class a pickup gives, or ArmorNone.
+func (t PickupType) Armor() ArmorClass {
+	switch t {
+	case PickupArmorGreen:
+		return ArmorGreen
+	case PickupArmorBlue:
+		return ArmorBlue
+	default:
+		return ArmorNone
+	}
+}
+
+// Weapon returns the weapon a pickup grants, if it grants one.
+func (t PickupType) Weapon() (WeaponType, bool) {
+	switch t {
+	case PickupShotgun:
+		return WeaponShotgun, true
+	case PickupChaingun:
+		return WeaponChaingun, true
+	case PickupRocketLauncher:
+		return WeaponRocketLauncher, true
+	default:
+		return 0, false
+	}
+}
+
+// Ammo returns the ammo type a pickup holds, if it is an ammo pickup.
+func (t PickupType) Ammo() (AmmoType, bool) {
+	switch t {
+	case PickupAmmo:
+		return AmmoBullets, true
+	case PickupShells:
+		return AmmoShells, true
+	case PickupRockets:
+		return AmmoRockets, true
+	default:
+		return 0, false
+	}
+}
+
+// WeaponPickup returns the pickup type for a weapon; the pistol has none and
+// gets a bullet pickup.
+func WeaponPickup(t WeaponType) PickupType {
+	switch t {
+	case WeaponShotgun:
+		return PickupShotgun
+	case WeaponChaingun:
+		return PickupChaingun
+	case WeaponRocketLauncher:
+		return PickupRocketLauncher
+	default:
+		return PickupAmmo
+	}
+}
+
+// AmmoPickup returns the pickup type for an ammo type.
+func AmmoPickup(t AmmoType) PickupType {
+	switch t {
+	case AmmoShells:
+		return PickupShells
+	case AmmoRockets:
+		return PickupRockets
+	default:
+		return PickupAmmo
+	}
+}
+
+// KeyPickup returns the pickup type for a keycard.
+func KeyPickup(k KeyColor) PickupType {
+	switch k {
+	case KeyBlue:
+		return PickupKeyBlue
+	case KeyYellow:
+		return PickupKeyYellow
+	default:
+		return PickupKeyRed
+	}
+}
+
+type Pickup struct {
+	Pos     Vec2
+	Type    PickupType
+	Taken   bool
+	Dropped bool // left by a dead enemy, so it does not count toward the level's items
+}
+
+type Projectile struct {
+	Pos        Vec2
+	Vel        Vec2
+	TTL        float64
+	Friendly   bool
+	Radius     float64
+	Damage     int
+	WhizPlayed bool    // Track if whiz sound has been played for this bullet
+	CurveAngle float64 // Random angle for bullet curving
+	CurveRate  float64 // How fast the bullet curves
+
+	Splash       float64 // explosion radius on impact, 0 for none
+	SplashDamage int     // damage at the centre of the explosion
+}
+
+const (
+	TileEmpty = 0
+	TileWall  = 1
+	TileDoor  = 2
+	TileExit  = 3 // floor that ends the level when the completion rule allows
+)
+
+// Settings are the player-tunable values that affect the simulation.
+type Settings struct {
+	FireRate    float64
+	BulletSpeed float64
+}
+
+// Input is everything the player can do in one tick, already translated from
+// devices by the front end.
+type Input struct {
+	Forward float64 // -1..1, positive moves along the view direction
+	Strafe  float64 // -1..1, positive moves to the right
+	Turn    float64 // radians added to the view angle this tick
+	Sprint  bool
+	Fire    bool
+	Use     bool // pressed this tick; opens the door in front of the player
+
+	SelectWeapon int // weapon slot (1-based) chosen this tick, 0 for none
+	CycleWeapon  int // -1 or 1 to switch to the previous or next carried weapon
+}
+
+// Outcome reports what a tick did to the level as a whole.
+type Outcome int
+
+const (
+	Running Outcome = iota
+	PlayerDied
+	LevelCleared
+)
+
+type EventKind int
+
+const (
+	EventShot      EventKind = iota // player fired, Item = WeaponType
+	EventKill                       // an enemy died, Amount = score awarded, Item = EnemyType
+	EventHeal                       // medkit taken, Amount = HP restored
+	EventAmmo                       // ammo taken, Amount = rounds gained, Item = AmmoType
+	EventWhiz                       // enemy bullet passed close to the player
+	EventDoor                       // a door started opening
+	EventLocked                     // a locked door was used, Amount = KeyColor needed
+	EventKey                        // keycard taken, Amount = KeyColor
+	EventSecret                     // a secret was found
+	EventWeapon                     // weapon taken, Amount = rounds that came with it, Item = WeaponType
+	EventExplosion                  // a rocket or barrel exploded at Pos
+	EventPuff                       // a hitscan shot struck a wall at Pos
+	EventBlood                      // a hitscan shot struck an enemy at Pos
+	EventArmor                      // armor taken, Amount = armor points now worn, Item = ArmorClass
+)
+
+// Event is a side effect of a tick that the front end may want to present
+// (sounds, messages). The simulation never depends on them being consumed.
+type Event struct {
+	Kind   EventKind
+	Amount int
+	Item   int  // which weapon or ammo type, for the events that say so
+	Pos    Vec2 // where it happened, for impacts
+}
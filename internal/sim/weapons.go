@@ -0,0 +1,313 @@
+package sim
+
+import (
+	"math"
+	"math/rand"
+	"slices"
+)
+
+// WeaponType is a weapon the player can carry; slot keys select them in order.
+type WeaponType int
+
+const (
+	WeaponPistol WeaponType = iota
+	WeaponShotgun
+	WeaponChaingun
+	WeaponRocketLauncher
+)
+
+// AmmoType is what a weapon fires.
+type AmmoType int
+
+const (
+	AmmoBullets AmmoType = iota
+	AmmoShells
+	AmmoRockets
+)
+
+var ammoNames = []string{"bullets", "shells", "rockets"}
+
+func (a AmmoType) String() string {
+	if a < 0 || int(a) >= len(ammoNames) {
+		return "unknown"
+	}
+	return ammoNames[a]
+}
+
+// ammoPickupAmounts is how many rounds an ammo pickup of each type holds.
+var ammoPickupAmounts = []int{
+	AmmoBullets: ammoPickupAmt,
+	AmmoShells:  8,
+	AmmoRockets: 2,
+}
+
+// WeaponDef describes how a weapon fires. Cooldowns and projectile speeds
+// are the values at the default settings; Settings.FireRate and
+// Settings.BulletSpeed scale them for every weapon alike.
+type WeaponDef struct {
+	Name     string
+	Ammo     AmmoType
+	Cooldown float64 // seconds between shots
+	Damage   int     // per pellet
+	Pellets  int     // pellets per shot, fanned evenly across Spread
+	Spread   float64 // radians; a single pellet is jittered within it instead
+	Hitscan  bool    // hits instantly along a ray instead of firing a projectile
+	Range    float64 // hitscan reach in tiles
+	Speed    float64 // projectile speed in tiles per second
+	TTL      float64 // seconds a projectile flies
+	Curve    bool    // the projectile wobbles in flight like the original pistol round
+
+	Splash       float64 // radius of the explosion on impact, 0 for none
+	SplashDamage int     // damage at the centre of the explosion, falling to none at Splash
+
+	Level  int // first generated level that places the weapon, 0 for never
+	Pickup int // rounds that come with the weapon
+}
+
+var weaponDefs = []WeaponDef{
+	WeaponPistol: {
+		Name: "Pistol", Ammo: AmmoBullets, Cooldown: DefaultFireRate,
+		Damage: 1, Pellets: 1, Speed: DefaultBulletSpeed, TTL: playerShotTTL, Curve: true,
+	},
+	WeaponShotgun: {
+		Name: "Shotgun", Ammo: AmmoShells, Cooldown: 0.9,
+		Damage: 1, Pellets: 7, Spread: 0.2, Hitscan: true, Range: 24,
+		Level: 1, Pickup: 8,
+	},
+	WeaponChaingun: {
+		Name: "Chaingun", Ammo: AmmoBullets, Cooldown: 0.1,
+		Damage: 1, Pellets: 1, Spread: 0.05, Hitscan: true, Range: 32,
+		Level: 2, Pickup: 40,
+	},
+	WeaponRocketLauncher: {
+		Name: "Rocket Launcher", Ammo: AmmoRockets, Cooldown: 0.8,
+		Damage: 3, Pellets: 1, Speed: 14, TTL: 3,
+		Splash: 2.5, SplashDamage: 4,
+		Level: 3, Pickup: 3,
+	},
+}
+
+// WeaponCount is the number of weapon slots.
+var WeaponCount = len(weaponDefs)
+
+// Def returns how a weapon fires.
+func (t WeaponType) Def() *WeaponDef {
+	if t < 0 || int(t) >= len(weaponDefs) {
+		return &weaponDefs[WeaponPistol]
+	}
+	return &weaponDefs[t]
+}
+
+func (t WeaponType) String() string { return t.Def().Name }
+
+// HasWeapon reports whether the player carries a weapon. The pistol is
+// always carried.
+func (p *Player) HasWeapon(t WeaponType) bool {
+	return t == WeaponPistol || p.Weapons&(1<<t) != 0
+}
+
+// AmmoFor returns the player's count of an ammo type.
+func (p *Player) AmmoFor(t AmmoType) *int {
+	switch t {
+	case AmmoShells:
+		return &p.Shells
+	case AmmoRockets:
+		return &p.Rockets
+	default:
+		return &p.Ammo
+	}
+}
+
+// switchWeapon applies the weapon selection in the input. Choosing a weapon
+// the player does not carry does nothing; cycling skips over them.
+func (w *World) switchWeapon(in Input) {
+	p := &w.Player
+	if slot := WeaponType(in.SelectWeapon - 1); in.SelectWeapon > 0 && int(slot) < WeaponCount && p.HasWeapon(slot) {
+		p.Weapon = slot
+	}
+	if in.CycleWeapon == 0 {
+		return
+	}
+	step := 1
+	if in.CycleWeapon < 0 {
+		step = -1
+	}
+	for i, t := 0, p.Weapon; i < WeaponCount; i++ {
+		t = WeaponType((int(t) + step + WeaponCount) % WeaponCount)
+		if p.HasWeapon(t) {
+			p.Weapon = t
+			return
+		}
+	}
+}
+
+// fireWeapon fires the player's current weapon once: every pellet either
+// hits along a ray or leaves as a projectile.
+func (w *World) fireWeapon() {
+	p := &w.Player
+	def := p.Weapon.Def()
+	p.Shots += def.Pellets
+	for i := 0; i < def.Pellets; i++ {
+		angle := p.Angle
+		switch {
+		case def.Pellets > 1:
+			angle += def.Spread * (float64(i)/float64(def.Pellets-1) - 0.5)
+		case def.Spread > 0:
+			angle += (w.rng.Float64() - 0.5) * def.Spread
+		}
+		if def.Hitscan {
+			w.hitscan(angle, def.Damage, def.Range)
+		} else {
+			w.fireProjectile(angle, def)
+		}
+	}
+	w.emitItem(EventShot, 1, int(p.Weapon))
+	w.makeNoise()
+}
+
+// hitscan damages the nearest enemy the ray from the player along angle
+// or barrel it touches before it meets a wall or runs out of reach. The
+// impact is reported as blood on an enemy or a puff on anything else.
+func (w *World) hitscan(angle float64, damage int, reach float64) {
+	p := &w.Player
+	dirX, dirY := math.Cos(angle), math.Sin(angle)
+	best, wall := w.wallDistance(p.Pos, dirX, dirY, reach)
+	var hit *Enemy
+	var barrel *Barrel
+	for _, e := range w.Enemies {
+		if e.Dead {
+			continue
+		}
+		ex, ey := e.Pos.X-p.Pos.X, e.Pos.Y-p.Pos.Y
+		along := ex*dirX + ey*dirY
+		if along <= 0 || along >= best {
+			continue
+		}
+		if side := ex*dirY - ey*dirX; math.Abs(side) < w.EnemyDef(e.Type).Radius+enemyHitPad {
+			hit, best = e, along
+		}
+	}
+	for _, b := range w.Barrels {
+		if b.Exploded {
+			continue
+		}
+		bx, by := b.Pos.X-p.Pos.X, b.Pos.Y-p.Pos.Y
+		along := bx*dirX + by*dirY
+		if along <= 0 || along >= best {
+			continue
+		}
+		if side := bx*dirY - by*dirX; math.Abs(side) < barrelRadius {
+			hit, barrel, best = nil, b, along
+		}
+	}
+	switch {
+	case hit != nil:
+		// on the near side of the body, so the blood is drawn over it
+		along := best - w.EnemyDef(hit.Type).Radius
+		w.emitAt(EventBlood, Vec2{p.Pos.X + dirX*along, p.Pos.Y + dirY*along})
+		p.Hits++
+		w.hurtEnemy(hit, damage)
+	case barrel != nil:
+		along := best - barrelRadius
+		w.emitAt(EventPuff, Vec2{p.Pos.X + dirX*along, p.Pos.Y + dirY*along})
+		w.hurtBarrel(barrel, damage)
+	case wall:
+		along := best - impactStandoff
+		w.emitAt(EventPuff, Vec2{p.Pos.X + dirX*along, p.Pos.Y + dirY*along})
+	}
+}
+
+// fireProjectile launches one player projectile along angle.
+func (w *World) fireProjectile(angle float64, def *WeaponDef) {
+	p := &w.Player
+	dirx, diry := math.Cos(angle), math.Sin(angle)
+	speed := def.Speed * w.Settings.BulletSpeed / DefaultBulletSpeed
+	b := &Projectile{
+		Pos:          Vec2{p.Pos.X + dirx*0.4, p.Pos.Y + diry*0.4},
+		Vel:          Vec2{dirx * speed, diry * speed},
+		TTL:          def.TTL,
+		Friendly:     true,
+		Radius:       0.05,
+		Damage:       def.Damage,
+		Splash:       def.Splash,
+		SplashDamage: def.SplashDamage,
+	}
+	if def.Curve {
+		b.CurveAngle = (w.rng.Float64() - 0.5) * 0.3 // Random curve between -0.15 and 0.15 radians
+		b.CurveRate = 0.5 + w.rng.Float64()*0.5      // Curve rate between 0.5 and 1.0
+	}
+	w.Bullets = append(w.Bullets, b)
+}
+
+// explode deals splash damage around a point, falling off linearly to nothing
+// at radius. Walls and closed doors shield whatever is behind them, the
+// player is not spared their own rockets, and barrels caught in the blast
+// light up in turn.
+func (w *World) explode(at Vec2, radius float64, damage int) {
+	w.emitAt(EventExplosion, at)
+	for _, e := range w.Enemies {
+		if e.Dead {
+			continue
+		}
+		if dmg := splashDamage(at, e.Pos, radius, damage); dmg > 0 && w.HasLineOfSight(at, e.Pos) {
+			w.hurtEnemy(e, dmg)
+		}
+	}
+	for _, b := range w.Barrels {
+		if b.Exploded {
+			continue
+		}
+		if dmg := splashDamage(at, b.Pos, radius, damage); dmg > 0 && w.HasLineOfSight(at, b.Pos) {
+			w.hurtBarrel(b, dmg)
+		}
+	}
+	p := &w.Player
+	if dmg := splashDamage(at, p.Pos, radius, damage); dmg > 0 && w.HasLineOfSight(at, p.Pos) {
+		w.damagePlayer(dmg)
+	}
+}
+
+func splashDamage(at, to Vec2, radius float64, damage int) int {
+	d := math.Hypot(to.X-at.X, to.Y-at.Y)
+	if d >= radius {
+		return 0
+	}
+	return int(math.Ceil(float64(damage) * (1 - d/radius)))
+}
+
+// hurtEnemy applies damage dealt by the player. The enemy learns where the
+// player is, and dies when out of hit points.
+func (w *World) hurtEnemy(e *Enemy, damage int) {
+	w.Player.Dealt += min(damage, e.HP)
+	e.HP -= damage
+	e.Blink = 0.12
+	w.alert(e, w.Player.Pos)
+	if e.HP <= 0 {
+		w.killEnemy(e)
+	}
+}
+
+// levelItems picks the weapon and ammo pickups for a generated level: every
+// weapon introduced by this level or earlier, and ammo split between bullets
+// and the ammo those weapons use.
+func levelItems(level, ammos int, rng *rand.Rand) []PickupType {
+	var items []PickupType
+	var extra []PickupType
+	for t, def := range weaponDefs {
+		if def.Level == 0 || def.Level > level {
+			continue
+		}
+		items = append(items, WeaponPickup(WeaponType(t)))
+		if k := AmmoPickup(def.Ammo); k != PickupAmmo && !slices.Contains(extra, k) {
+			extra = append(extra, k)
+		}
+	}
+	for range ammos {
+		k := PickupAmmo
+		if len(extra) > 0 && rng.Intn(2) == 0 {
+			k = extra[rng.Intn(len(extra))]
+		}
+		items = append(items, k)
+	}
+	return items
+}
@@ -0,0 +1,334 @@
+// Package sim is the headless game simulation: level generation, world state
+// and the fixed-rate tick. It has no Ebiten dependency, so it can be stepped
+// from tests and tools as well as from the windowed front end.
+package sim
+
+import (
+	"math"
+	"math/rand"
+)
+
+// World is the complete state of a run in progress.
+type World struct {
+	W, H      int
+	Grid      []int
+	Reachable []bool
+
+	Player  Player
+	Enemies []*Enemy
+	Pickups []*Pickup
+	Bullets []*Projectile
+	Doors   []*Door
+	Barrels []*Barrel
+
+	Level           int
+	TotalLevels     int
+	Defeated        int
+	LevelEnemyTotal int
+	LevelTime       float64 // seconds spent on the current level
+
+	Rule    Completion // what ends the current level
+	Secrets []*Secret
+
+	Seed     int64   // run seed every level seed is derived from
+	Time     float64 // seconds simulated this run
+	Settings Settings
+
+	// EnemyDefs is the enemy registry the run is played with.
+	EnemyDefs EnemyDefs
+
+	// Campaign supplies authored maps for some levels; nil generates them all.
+	Campaign *Campaign
+	MapName  string  // name of the authored map being played, if any
+	Par      float64 // par time in seconds; estimated for generated levels, 0 if an authored map sets none
+
+	// rng drives all in-level randomness; it continues from the level
+	// generator so a run is fully determined by its seed and inputs.
+	rng     *rand.Rand
+	rngSrc  *rngSource
+	events  []Event
+	doorIdx map[int]*Door
+	nav     navField
+}
+
+// NewWorld returns an empty run; call SetupLevel to generate the first level.
+func NewWorld(seed int64, totalLevels int, settings Settings, defs EnemyDefs) *World {
+	return &World{
+		Level:       1,
+		TotalLevels: totalLevels,
+		Seed:        seed,
+		Settings:    settings,
+		EnemyDefs:   defs,
+	}
+}
+
+// EnemyDef returns the definition of an enemy type in the run's registry.
+func (w *World) EnemyDef(t EnemyType) *EnemyDef { return w.EnemyDefs.Def(t) }
+
+// LevelSeed derives the generator seed for one level of a run (splitmix64 over
+// the run seed and level index), so a run seed plus level count always
+// reproduces the same sequence of maps.
+func LevelSeed(runSeed int64, level int) int64 {
+	z := uint64(runSeed) + uint64(level)*0x9E3779B97F4A7C15
+	z = (z ^ (z >> 30)) * 0xBF58476D1CE4E5B9
+	z = (z ^ (z >> 27)) * 0x94D049BB133111EB
+	return int64(z ^ (z >> 31))
+}
+
+// Piecewise scale: level 1 => 0.5x, middle => 1.0x, last => 3.0x
+func scaleForLevel(level, total int) float64 {
+	if total <= 1 {
+		return 1.0
+	}
+	mid := (total + 1) / 2 // middle index (1-based)
+	if level <= mid {
+		// 1..mid maps 0.5 -> 1.0
+		t := float64(level-1) / float64(mid-1)
+		if mid == 1 {
+			t = 1
+		}
+		return 0.5 + t*(1.0-0.5)
+	}
+	// mid..total maps 1.0 -> 3.0
+	t := float64(level-mid) / float64(total-mid)
+	if total == mid {
+		t = 1
+	}
+	return 1.0 + t*(3.0-1.0)
+}
+
+// Randomize within ±pct (e.g., pct=0.30 => ±30%)
+func jitter(val float64, pct float64, rng *rand.Rand) float64 {
+	delta := (rng.Float64()*2 - 1) * pct
+	return val * (1 + delta)
+}
+
+// clamp ints to >=1
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// SetupLevel loads the campaign's map for the level or, failing that, uses
+// piecewise scaling + jitter for map dims, enemies, and food.
+// A fresh level also resets the player; otherwise HP and ammo carry over.
+func (w *World) SetupLevel(level int, fresh bool) {
+	if level < 1 {
+		level = 1
+	}
+	if level > w.TotalLevels {
+		level = w.TotalLevels
+	}
+	w.Level = level
+
+	rng, src := newRNG(uint64(LevelSeed(w.Seed, level)))
+	if m := w.Campaign.MapFor(level); m != nil {
+		w.rng, w.rngSrc = rng, src
+		w.loadMap(m, fresh)
+		return
+	}
+	w.MapName, w.Par = "", 0
+
+	// Map dimensions
+	scale := scaleForLevel(level, w.TotalLevels)
+	targetW := jitter(float64(MaxMapW)*scale, 0.30, rng)
+	targetH := jitter(float64(MaxMapH)*scale, 0.30, rng)
+	mw := maxInt(int(targetW+0.5), BaseMapW/2) // keep reasonable minimums
+	mh := maxInt(int(targetH+0.5), BaseMapH/2)
+
+	// Enemy total (we'll split by type later)
+	targetEnemies := jitter(float64(BaseEnemyValue)*scale, 0.30, rng)
+	totalEnemies := maxInt(int(targetEnemies+0.5), 1)
+
+	// Food total (medkits + ammo)
+	targetFood := jitter(float64(BaseFoodValue)*scale, 0.30, rng)
+	totalFood := maxInt(int(targetFood+0.5), 1)
+
+	// Split enemies by type according to their spawn weights
+	spawns := w.EnemyDefs.spawnCounts(totalEnemies)
+
+	// Split food 50/50 into medkits and ammo, and add the level's weapons
+	med := totalFood / 2
+	items := levelItems(level, totalFood-med, rng)
+	items = append(items, levelArmor(level)...)
+
+	// Later levels lock more rooms behind keycard doors
+	locks := min(level/2, len(KeyColors))
+
+	// A barrel for every few enemies
+	barrelCount := totalEnemies / barrelsPerEnemy
+
+	grid, spawn, enemies, pickups, doors, secrets, barrels := generateMap(mw, mh, rng, w.EnemyDefs, spawns, med, items, locks, barrelCount)
+
+	w.rng, w.rngSrc = rng, src
+	w.W, w.H = mw, mh
+	w.Grid = grid
+	w.Enemies = enemies
+	w.Pickups = pickups
+	w.Bullets = nil
+	w.Doors = doors
+	w.indexDoors()
+	w.Secrets = secrets
+	w.Barrels = barrels
+	w.initAwareness()
+	w.Player.startLevelTally()
+	w.LevelEnemyTotal = len(enemies)
+	w.LevelTime = 0
+	w.Rule = CompleteExit
+
+	if fresh {
+		w.Player = Player{Pos: spawn, Angle: -math.Pi / 2, HP: playerStartHP, Ammo: playerStartAmmo}
+		w.Defeated = 0
+	} else {
+		w.Player.Pos = spawn
+		w.Player.Angle = -math.Pi / 2
+	}
+
+	sx, sy := int(math.Floor(spawn.X)), int(math.Floor(spawn.Y))
+	w.Reachable = floodFillReachable(w.Grid, w.W, w.H, sx, sy)
+	w.Par = generatedPar(w.Grid, w.W, w.H, sx, sy, len(enemies))
+}
+
+// loadMap replaces the level with a copy of an authored map.
+func (w *World) loadMap(m *Map, fresh bool) {
+	w.W, w.H = m.W, m.H
+	w.Grid = append([]int(nil), m.Grid...)
+	w.Enemies = make([]*Enemy, len(m.Enemies))
+	for i := range m.Enemies {
+		e := m.Enemies[i]
+		w.Enemies[i] = &e
+	}
+	w.Pickups = make([]*Pickup, len(m.Pickups))
+	for i := range m.Pickups {
+		pk := m.Pickups[i]
+		w.Pickups[i] = &pk
+	}
+	w.Bullets = nil
+	w.Doors = make([]*Door, len(m.Doors))
+	for i := range m.Doors {
+		d := m.Doors[i]
+		w.Doors[i] = &d
+	}
+	w.indexDoors()
+	w.Secrets = make([]*Secret, len(m.Secrets))
+	for i := range m.Secrets {
+		s := m.Secrets[i]
+		w.Secrets[i] = &s
+	}
+	w.Barrels = make([]*Barrel, len(m.Barrels))
+	for i := range m.Barrels {
+		b := m.Barrels[i]
+		w.Barrels[i] = &b
+	}
+	w.initAwareness()
+	w.Player.startLevelTally()
+	w.LevelEnemyTotal = len(w.Enemies)
+	w.LevelTime = 0
+	w.Rule = m.Complete
+	w.MapName, w.Par = m.Name, m.Par
+
+	if fresh {
+		w.Player = Player{Pos: m.Start, Angle: m.Angle, HP: playerStartHP, Ammo: playerStartAmmo}
+		w.Defeated = 0
+	} else {
+		w.Player.Pos = m.Start
+		w.Player.Angle = m.Angle
+	}
+
+	sx, sy := int(math.Floor(m.Start.X)), int(math.Floor(m.Start.Y))
+	w.Reachable = floodFillReachable(w.Grid, w.W, w.H, sx, sy)
+}
+
+// IsSolid reports whether a cell blocks movement, bullets and sight; everything
+// off the map is solid, and so is a door until it is fully open.
+func (w *World) IsSolid(ix, iy int) bool {
+	if ix < 0 || iy < 0 || ix >= w.W || iy >= w.H {
+		return true
+	}
+	switch w.Grid[iy*w.W+ix] {
+	case TileWall:
+		return true
+	case TileDoor:
+		d := w.doorIdx[iy*w.W+ix]
+		return d == nil || d.Open < 1
+	}
+	return false
+}
+
+func (w *World) IsSolidAtFloat(x, y float64) bool {
+	return w.IsSolid(int(math.Floor(x)), int(math.Floor(y)))
+}
+
+// floodFillReachable marks every cell connected to sx,sy, passing through doors.
+func floodFillReachable(grid []int, w, h, sx, sy int) []bool {
+	return floodFill(grid, w, h, sx, sy, func(idx int) bool { return grid[idx] == TileWall })
+}
+
+// distanceField returns the number of steps from sx,sy to every cell without
+// crossing a blocked cell, or -1 where it cannot get to.
+func distanceField(grid []int, w, h, sx, sy int, blocked func(idx int) bool) []int {
+	dist := make([]int, w*h)
+	for i := range dist {
+		dist[i] = -1
+	}
+	if sx < 0 || sy < 0 || sx >= w || sy >= h || blocked(sy*w+sx) {
+		return dist
+	}
+	queue := make([]int, 0, w*h/4)
+	dist[sy*w+sx] = 0
+	queue = append(queue, sy*w+sx)
+	for head := 0; head < len(queue); head++ {
+		idx := queue[head]
+		x, y := idx%w, idx/w
+		for _, n := range [4][2]int{{x + 1, y}, {x - 1, y}, {x, y + 1}, {x, y - 1}} {
+			if n[0] < 0 || n[1] < 0 || n[0] >= w || n[1] >= h {
+				continue
+			}
+			next := n[1]*w + n[0]
+			if dist[next] >= 0 || blocked(next) {
+				continue
+			}
+			dist[next] = dist[idx] + 1
+			queue = append(queue, next)
+		}
+	}
+	return dist
+}
+
+// floodFill marks every cell connected to sx,sy without crossing a blocked cell.
+func floodFill(grid []int, w, h, sx, sy int, blocked func(idx int) bool) []bool {
+	reach := make([]bool, w*h)
+	if sx < 0 || sy < 0 || sx >= w || sy >= h {
+		return reach
+	}
+	if blocked(sy*w + sx) {
+		return reach
+	}
+	qx := make([]int, 0, w*h/4)
+	qy := make([]int, 0, w*h/4)
+	push := func(x, y int) {
+		idx := y*w + x
+		if x < 0 || y < 0 || x >= w || y >= h {
+			return
+		}
+		if blocked(idx) || reach[idx] {
+			return
+		}
+		reach[idx] = true
+		qx = append(qx, x)
+		qy = append(qy, y)
+	}
+	push(sx, sy)
+	head := 0
+	for head < len(qx) {
+		cx, cy := qx[head], qy[head]
+		head++
+		push(cx+1, cy)
+		push(cx-1, cy)
+		push(cx, cy+1)
+		push(cx, cy-1)
+	}
+	return reach
+}
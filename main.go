@@ -1,6 +1,7 @@
 package main
 
 import (
+	"flag"
 	"fmt"
 	"log"
 	"os"
@@ -11,7 +12,29 @@ import (
 )
 
 func main() {
-	g := engine.NewGame()
+	seed := flag.Int64("seed", 0, "run seed for level generation (0 = random each run); saved to settings")
+	record := flag.String("record", "", "file to record each run's demo to (default doomlike/demo.lmp under $XDG_DATA_HOME)")
+	playDemo := flag.String("playdemo", "", "play back a recorded demo file on startup")
+	campaign := flag.String("campaign", "", "campaign manifest listing authored map files to play in order")
+	editMap := flag.String("edit", "", "open a map file in the level editor on startup (created on first save)")
+	enemies := flag.String("enemies", "", "enemy definitions file that replaces or adds to the built-in monsters")
+	database := flag.String("db", "", "database file (default $"+engine.DatabaseEnv+", else doomlike/doomlike.db under $XDG_DATA_HOME); "+engine.MemoryDatabase+" keeps nothing on disk")
+	importSettings := flag.String("import-settings", "", "load a settings file into the current profile on startup")
+	exportSettings := flag.String("export-settings", "", "write the current profile's settings to a file on startup")
+	flag.Parse()
+
+	opts := engine.Options{
+		RecordDemo: *record, PlayDemo: *playDemo, Campaign: *campaign, EditMap: *editMap, Enemies: *enemies,
+		Database: *database, ImportSettings: *importSettings, ExportSettings: *exportSettings,
+	}
+	flag.Visit(func(f *flag.Flag) {
+		if f.Name == "seed" {
+			opts.Seed = *seed
+			opts.SeedSet = true
+		}
+	})
+
+	g := engine.NewGame(opts)
 	defer g.Close() // Ensure database is closed when game exits
 
 	ebiten.SetWindowSize(engine.ScreenW, engine.ScreenH)